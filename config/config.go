@@ -49,6 +49,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/utils/storage"
 	"github.com/DioneProtocol/odysseygo/utils/timer"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/proposervm"
 )
@@ -73,6 +74,7 @@ var (
 	errInvalidDelegationFee                   = errors.New("delegation fee must be in the range [0, 1,000,000]")
 	errInvalidMinStakeDuration                = errors.New("min stake duration must be > 0")
 	errMinStakeDurationAboveMax               = errors.New("max stake duration can't be less than min stake duration")
+	errInvalidEarlyUnstakePenalty             = errors.New("early unstake penalty must be in the range [0, 1,000,000]")
 	errStakeMaxConsumptionTooLarge            = fmt.Errorf("max stake consumption must be less than or equal to %d", reward.PercentDenominator)
 	errMintRateTooLarge                       = fmt.Errorf("mint rate must be less than or equal to %d", reward.PercentDenominator)
 	errStakeMaxConsumptionBelowMin            = errors.New("stake max consumption can't be less than min stake consumption")
@@ -779,6 +781,7 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 		SybilProtectionEnabled:        v.GetBool(SybilProtectionEnabledKey),
 		SybilProtectionDisabledWeight: v.GetUint64(SybilProtectionDisabledWeightKey),
 		PartialSyncPrimaryNetwork:     v.GetBool(PartialSyncPrimaryNetworkKey),
+		UptimeScoresEnabled:           v.GetBool(UptimeScoresEnabledKey),
 		StakingKeyPath:                GetExpandedArg(v, StakingTLSKeyPathKey),
 		StakingCertPath:               GetExpandedArg(v, StakingCertPathKey),
 		StakingSignerPath:             GetExpandedArg(v, StakingSignerKeyPathKey),
@@ -809,6 +812,9 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 		config.MaxValidatorStakeDuration = v.GetDuration(MaxValidatorStakeDurationKey)
 		config.MinDelegatorStakeDuration = v.GetDuration(MinDelegatorStakeDurationKey)
 		config.MaxDelegatorStakeDuration = v.GetDuration(MaxDelegatorStakeDurationKey)
+		config.EarlyUnstakePenalty = v.GetUint64(EarlyUnstakePenaltyKey)
+		config.URewardRecycleConfig.Policy = reward.URewardRecyclePolicy(v.GetString(URewardRecyclePolicyKey))
+		config.URewardRecycleConfig.Interval = v.GetDuration(URewardRecycleIntervalKey)
 		config.RewardConfig.MaxConsumptionRate = v.GetUint64(StakeMaxConsumptionRateKey)
 		config.RewardConfig.MinConsumptionRate = v.GetUint64(StakeMinConsumptionRateKey)
 		config.RewardConfig.MintingPeriod = v.GetDuration(StakeMintingPeriodKey)
@@ -818,6 +824,11 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 		config.MintConfig.MaxMintAmount = v.GetUint64(MaxMintAmountKey)
 		config.MintConfig.MintRate = v.GetUint64(MintRateKey)
 		config.MintConfig.MintingPeriod = v.GetDuration(MintingPeriodKey)
+		if v.IsSet(MintUpgradesKey) {
+			if err := json.Unmarshal([]byte(v.GetString(MintUpgradesKey)), &config.MintUpgrades); err != nil {
+				return node.StakingConfig{}, fmt.Errorf("couldn't parse %s: %w", MintUpgradesKey, err)
+			}
+		}
 		switch {
 		case config.UptimeRequirement < 0 || config.UptimeRequirement > 1:
 			return node.StakingConfig{}, errInvalidUptimeRequirement
@@ -833,6 +844,8 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 			return node.StakingConfig{}, errInvalidMinStakeDuration
 		case config.MaxDelegatorStakeDuration < config.MinDelegatorStakeDuration:
 			return node.StakingConfig{}, errMinStakeDurationAboveMax
+		case config.EarlyUnstakePenalty > reward.PercentDenominator:
+			return node.StakingConfig{}, errInvalidEarlyUnstakePenalty
 		case config.RewardConfig.MaxConsumptionRate > reward.PercentDenominator:
 			return node.StakingConfig{}, errStakeMaxConsumptionTooLarge
 		case config.RewardConfig.MaxConsumptionRate < config.RewardConfig.MinConsumptionRate:
@@ -842,6 +855,9 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 		case config.MintConfig.MintRate > reward.PercentDenominator:
 			return node.StakingConfig{}, errMintRateTooLarge
 		}
+		if err := config.URewardRecycleConfig.Verify(); err != nil {
+			return node.StakingConfig{}, err
+		}
 	} else {
 		config.StakingConfig = genesis.GetStakingConfig(networkID)
 	}
@@ -860,6 +876,17 @@ func getTxFeeConfig(v *viper.Viper, networkID uint32) genesis.TxFeeConfig {
 			AddPrimaryNetworkDelegatorFee: v.GetUint64(AddPrimaryNetworkDelegatorFeeKey),
 			AddSubnetValidatorFee:         v.GetUint64(AddSubnetValidatorFeeKey),
 			AddSubnetDelegatorFee:         v.GetUint64(AddSubnetDelegatorFeeKey),
+			FeeBurnConfig: reward.FeeBurnConfig{
+				OChainBurnRate: v.GetUint64(OChainFeeBurnRateKey),
+				AChainBurnRate: v.GetUint64(AChainFeeBurnRateKey),
+				DChainBurnRate: v.GetUint64(DChainFeeBurnRateKey),
+			},
+			DynamicFeeConfig: fees.DynamicFeeConfig{
+				TargetBlockSize:  v.GetUint64(DynamicFeeTargetBlockSizeKey),
+				AdjustmentWindow: v.GetUint64(DynamicFeeAdjustmentWindowKey),
+				MinFeeRate:       v.GetUint64(DynamicFeeMinRateKey),
+				MaxFeeRate:       v.GetUint64(DynamicFeeMaxRateKey),
+			},
 		}
 	}
 	return genesis.GetTxFeeConfig(networkID)
@@ -1422,6 +1449,12 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 
 	// Tx Fee
 	nodeConfig.TxFeeConfig = getTxFeeConfig(v, nodeConfig.NetworkID)
+	if err := nodeConfig.FeeBurnConfig.Verify(); err != nil {
+		return node.Config{}, err
+	}
+	if err := nodeConfig.DynamicFeeConfig.Verify(); err != nil {
+		return node.Config{}, err
+	}
 
 	// Genesis Data
 	genesisStakingCfg := nodeConfig.StakingConfig.StakingConfig
@@ -1436,6 +1469,9 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	if nodeConfig.MintConfig.MintSince < genesisTimeUnix {
 		nodeConfig.MintConfig.MintSince = genesisTimeUnix
 	}
+	if err := nodeConfig.MintSchedule().Verify(); err != nil {
+		return node.Config{}, err
+	}
 
 	// StateSync Configs
 	nodeConfig.StateSyncConfig, err = getStateSyncConfig(v)
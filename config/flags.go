@@ -101,6 +101,13 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Uint64(AddPrimaryNetworkDelegatorFeeKey, genesis.LocalParams.AddPrimaryNetworkDelegatorFee, "Transaction fee, in nDIONE, for transactions that add new primary network delegators")
 	fs.Uint64(AddSubnetValidatorFeeKey, genesis.LocalParams.AddSubnetValidatorFee, "Transaction fee, in nDIONE, for transactions that add new subnet validators")
 	fs.Uint64(AddSubnetDelegatorFeeKey, genesis.LocalParams.AddSubnetDelegatorFee, "Transaction fee, in nDIONE, for transactions that add new subnet delegators")
+	fs.Uint64(OChainFeeBurnRateKey, genesis.LocalParams.FeeBurnConfig.OChainBurnRate, "Share, in the range [0, 1000000], of the O-Chain fees that is burned instead of being distributed to stakers")
+	fs.Uint64(AChainFeeBurnRateKey, genesis.LocalParams.FeeBurnConfig.AChainBurnRate, "Share, in the range [0, 1000000], of the A-Chain fees that is burned instead of being distributed to stakers")
+	fs.Uint64(DChainFeeBurnRateKey, genesis.LocalParams.FeeBurnConfig.DChainBurnRate, "Share, in the range [0, 1000000], of the D-Chain fees that is burned instead of being distributed to stakers")
+	fs.Uint64(DynamicFeeTargetBlockSizeKey, genesis.LocalParams.DynamicFeeConfig.TargetBlockSize, "Size, in bytes, of the O-Chain and A-Chain blocks that keep the fee rate unchanged. Dynamic fees are disabled if 0")
+	fs.Uint64(DynamicFeeAdjustmentWindowKey, genesis.LocalParams.DynamicFeeConfig.AdjustmentWindow, "Dampening of the fee rate changes. A block of twice the target size increases the fee rate by 1/window")
+	fs.Uint64(DynamicFeeMinRateKey, genesis.LocalParams.DynamicFeeConfig.MinFeeRate, "Minimum fee rate, where 1000000 charges the static fees")
+	fs.Uint64(DynamicFeeMaxRateKey, genesis.LocalParams.DynamicFeeConfig.MaxFeeRate, "Maximum fee rate, where 1000000 charges the static fees")
 
 	// Database
 	fs.String(DBTypeKey, leveldb.Name, fmt.Sprintf("Database type to use. Should be one of {%s, %s}", leveldb.Name, memdb.Name))
@@ -263,6 +270,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Bool(PartialSyncPrimaryNetworkKey, false, "Only sync the O-chain on the Primary Network. If the node is a Primary Network validator, it will report unhealthy")
 	// Uptime Requirement
 	fs.Float64(UptimeRequirementKey, genesis.LocalParams.UptimeRequirement, "Fraction of time a validator must be online to receive rewards")
+	fs.Bool(UptimeScoresEnabledKey, false, "If true, the uptime of a validator is read from its uptime score attested on the O-chain, if any, rather than from the local view of the node when deciding whether it is rewarded")
 	// Minimum Stake required to validate the Primary Network
 	fs.Uint64(MinValidatorStakeKey, genesis.LocalParams.MinValidatorStake, "Minimum stake, in nDIONE, required to validate the primary network")
 	// Maximum Stake that can be staked and delegated to a validator on the Primary Network
@@ -278,6 +286,11 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(MinDelegatorStakeDurationKey, genesis.LocalParams.MinDelegatorStakeDuration, "Minimum delegator staking duration")
 	// Maximum Delegator Stake Duration
 	fs.Duration(MaxDelegatorStakeDurationKey, genesis.LocalParams.MaxDelegatorStakeDuration, "Maximum delegator staking duration")
+	// Early Unstake Penalty
+	fs.Uint64(EarlyUnstakePenaltyKey, genesis.LocalParams.EarlyUnstakePenalty, "Share, in the range [0, 1000000], of the stake and accrued reward forfeited by a staker that stops staking before its end time")
+	// Undistributed Reward Recycling
+	fs.String(URewardRecyclePolicyKey, string(genesis.LocalParams.URewardRecycleConfig.Policy), "Policy used to recycle the undistributed reward pool. Must be one of {keep, fees, burn}")
+	fs.Duration(URewardRecycleIntervalKey, genesis.LocalParams.URewardRecycleConfig.Interval, "Interval at which the undistributed reward pool is recycled")
 	// Stake Reward Configs
 	fs.Uint64(StakeMaxConsumptionRateKey, genesis.LocalParams.RewardConfig.MaxConsumptionRate, "Maximum consumption rate of the remaining tokens to mint in the staking function")
 	fs.Uint64(StakeMinConsumptionRateKey, genesis.LocalParams.RewardConfig.MinConsumptionRate, "Minimum consumption rate of the remaining tokens to mint in the staking function")
@@ -288,6 +301,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Uint64(MintRateKey, 0, "Mint rate is the percent of the total supply to mint during the minting period")
 	fs.Int64(MintSinceKey, 0, "Timestamp of the start of the minting period")
 	fs.Duration(MintingPeriodKey, 0, "Minting period")
+	fs.String(MintUpgradesKey, "", "JSON list of mint configs replacing the mint config at their mintSince timestamp, in order")
 	// Subnets
 	fs.String(TrackSubnetsKey, "", "List of subnets for the node to track. A node tracking a subnet will track the uptimes of the subnet validators and attempt to sync all the chains in the subnet. Before validating a subnet, a node should be tracking the subnet to avoid impacting their subnet validation uptime")
 
@@ -22,7 +22,15 @@ const (
 	AddPrimaryNetworkDelegatorFeeKey                   = "add-primary-network-delegator-fee"
 	AddSubnetValidatorFeeKey                           = "add-subnet-validator-fee"
 	AddSubnetDelegatorFeeKey                           = "add-subnet-delegator-fee"
+	OChainFeeBurnRateKey                               = "o-chain-fee-burn-rate"
+	AChainFeeBurnRateKey                               = "a-chain-fee-burn-rate"
+	DChainFeeBurnRateKey                               = "d-chain-fee-burn-rate"
+	DynamicFeeTargetBlockSizeKey                       = "dynamic-fee-target-block-size"
+	DynamicFeeAdjustmentWindowKey                      = "dynamic-fee-adjustment-window"
+	DynamicFeeMinRateKey                               = "dynamic-fee-min-rate"
+	DynamicFeeMaxRateKey                               = "dynamic-fee-max-rate"
 	UptimeRequirementKey                               = "uptime-requirement"
+	UptimeScoresEnabledKey                             = "uptime-scores-enabled"
 	MinValidatorStakeKey                               = "min-validator-stake"
 	MaxValidatorStakeKey                               = "max-validator-stake"
 	MinDelegatorStakeKey                               = "min-delegator-stake"
@@ -31,10 +39,14 @@ const (
 	MintRateKey                                        = "mint-rate"
 	MintSinceKey                                       = "mint-since"
 	MintingPeriodKey                                   = "mint-period"
+	MintUpgradesKey                                    = "mint-upgrades"
 	MinValidatorStakeDurationKey                       = "min-validator-stake-duration"
 	MaxValidatorStakeDurationKey                       = "max-validator-stake-duration"
 	MinDelegatorStakeDurationKey                       = "min-delegator-stake-duration"
 	MaxDelegatorStakeDurationKey                       = "max-delegator-stake-duration"
+	EarlyUnstakePenaltyKey                             = "early-unstake-penalty"
+	URewardRecyclePolicyKey                            = "u-reward-recycle-policy"
+	URewardRecycleIntervalKey                          = "u-reward-recycle-interval"
 	StakeMaxConsumptionRateKey                         = "stake-max-consumption-rate"
 	StakeMinConsumptionRateKey                         = "stake-min-consumption-rate"
 	StakeMintingPeriodKey                              = "stake-minting-period"
@@ -8,6 +8,7 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
 	"github.com/DioneProtocol/odysseygo/vms/nftfx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/genesis"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
@@ -75,5 +76,6 @@ func GetVMAliases() map[ids.ID][]string {
 		secp256k1fx.ID:      {"secp256k1fx"},
 		nftfx.ID:            {"nftfx"},
 		propertyfx.ID:       {"propertyfx"},
+		htlcfx.ID:           {"htlcfx"},
 	}
 }
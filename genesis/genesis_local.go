@@ -57,6 +57,11 @@ var (
 			MaxValidatorStakeDuration: 365 * 24 * time.Hour,
 			MinDelegatorStakeDuration: 24 * time.Hour,
 			MaxDelegatorStakeDuration: 365 * 24 * time.Hour,
+			EarlyUnstakePenalty:       100000, // 10%
+			URewardRecycleConfig: reward.URewardRecycleConfig{
+				Policy:   reward.URewardKeep,
+				Interval: 24 * time.Hour,
+			},
 			RewardConfig: reward.Config{
 				MaxConsumptionRate: .12 * reward.PercentDenominator,
 				MinConsumptionRate: .10 * reward.PercentDenominator,
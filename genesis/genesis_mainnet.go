@@ -39,6 +39,11 @@ var (
 			MaxValidatorStakeDuration: 6 * 365 * 24 * time.Hour,
 			MinDelegatorStakeDuration: 30 * 24 * time.Hour,
 			MaxDelegatorStakeDuration: 6 * 365 * 24 * time.Hour,
+			EarlyUnstakePenalty:       100000, // 10%
+			URewardRecycleConfig: reward.URewardRecycleConfig{
+				Policy:   reward.URewardKeep,
+				Interval: 24 * time.Hour,
+			},
 			RewardConfig: reward.Config{
 				MaxConsumptionRate: .12 * reward.PercentDenominator,
 				MinConsumptionRate: .10 * reward.PercentDenominator,
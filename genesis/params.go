@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 )
 
@@ -35,10 +36,27 @@ type StakingConfig struct {
 	// MaxDelegatorStakeDuration is the maximum amount of time a delegator can delegate
 	// for in a single period.
 	MaxDelegatorStakeDuration time.Duration `json:"maxDelegatorStakeDuration"`
+	// EarlyUnstakePenalty is the share, in the range [0, 1000000], of the
+	// stake and accrued reward forfeited by a staker of the primary network
+	// that stops staking before its end time.
+	EarlyUnstakePenalty uint64 `json:"earlyUnstakePenalty"`
+	// URewardRecycleConfig is how the undistributed reward pool is recycled
+	// once the undistributed reward recycle network upgrade is activated.
+	URewardRecycleConfig reward.URewardRecycleConfig `json:"uRewardRecycleConfig"`
 	// RewardConfig is the config for the reward function.
 	RewardConfig reward.Config `json:"rewardConfig"`
 	// Config for the minting function
 	MintConfig reward.MintConfig `json:"mintConfig"`
+	// Configs replacing [MintConfig] at their [MintSince], in order. Used to
+	// change the minting function in a network upgrade.
+	MintUpgrades []reward.MintConfig `json:"mintUpgrades"`
+}
+
+// MintSchedule returns the mint configs in effect over time
+func (c *StakingConfig) MintSchedule() reward.MintSchedule {
+	schedule := make(reward.MintSchedule, 0, len(c.MintUpgrades)+1)
+	schedule = append(schedule, c.MintConfig)
+	return append(schedule, c.MintUpgrades...)
 }
 
 type TxFeeConfig struct {
@@ -60,6 +78,11 @@ type TxFeeConfig struct {
 	AddSubnetValidatorFee uint64 `json:"addSubnetValidatorFee"`
 	// Transaction fee for adding a subnet delegator
 	AddSubnetDelegatorFee uint64 `json:"addSubnetDelegatorFee"`
+	// Share of the fees collected from each chain that is burned instead of
+	// being distributed to the stakers
+	FeeBurnConfig reward.FeeBurnConfig `json:"feeBurnConfig"`
+	// Adjustment of the O-chain and A-chain fees from their block sizes
+	DynamicFeeConfig fees.DynamicFeeConfig `json:"dynamicFeeConfig"`
 }
 
 type Params struct {
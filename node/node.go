@@ -77,6 +77,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms"
 	"github.com/DioneProtocol/odysseygo/vms/alpha"
 	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
 	"github.com/DioneProtocol/odysseygo/vms/nftfx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
@@ -904,7 +905,10 @@ func (n *Node) initVMs() error {
 				AddPrimaryNetworkDelegatorFee: n.Config.AddPrimaryNetworkDelegatorFee,
 				AddSubnetValidatorFee:         n.Config.AddSubnetValidatorFee,
 				AddSubnetDelegatorFee:         n.Config.AddSubnetDelegatorFee,
+				FeeBurnConfig:                 n.Config.FeeBurnConfig,
+				DynamicFeeConfig:              n.Config.DynamicFeeConfig,
 				UptimePercentage:              n.Config.UptimeRequirement,
+				UptimeScoresEnabled:           n.Config.UptimeScoresEnabled,
 				MinValidatorStake:             n.Config.MinValidatorStake,
 				MaxValidatorStake:             n.Config.MaxValidatorStake,
 				MinDelegatorStake:             n.Config.MinDelegatorStake,
@@ -913,25 +917,47 @@ func (n *Node) initVMs() error {
 				MaxValidatorStakeDuration:     n.Config.MaxValidatorStakeDuration,
 				MinDelegatorStakeDuration:     n.Config.MinDelegatorStakeDuration,
 				MaxDelegatorStakeDuration:     n.Config.MaxDelegatorStakeDuration,
+				EarlyUnstakePenalty:           n.Config.EarlyUnstakePenalty,
+				URewardRecycleConfig:          n.Config.URewardRecycleConfig,
 				RewardConfig:                  n.Config.RewardConfig,
-				MintConfig:                    n.Config.MintConfig,
+				MintSchedule:                  n.Config.MintSchedule(),
 				ApricotPhase3Time:             version.GetApricotPhase3Time(n.Config.NetworkID),
 				ApricotPhase5Time:             version.GetApricotPhase5Time(n.Config.NetworkID),
 				BanffTime:                     version.GetBanffTime(n.Config.NetworkID),
 				CortinaTime:                   version.GetCortinaTime(n.Config.NetworkID),
+				FeeBurnTime:                   version.GetFeeBurnTime(n.Config.NetworkID),
+				DynamicFeeTime:                version.GetDynamicFeeTime(n.Config.NetworkID),
+				BaseTxTime:                    version.GetBaseTxTime(n.Config.NetworkID),
+				TransferSubnetOwnershipTime:   version.GetTransferSubnetOwnershipTime(n.Config.NetworkID),
+				StateSyncTime:                 version.GetStateSyncTime(n.Config.NetworkID),
+				BLSKeyRotationTime:            version.GetBLSKeyRotationTime(n.Config.NetworkID),
+				AutoRestakeTime:               version.GetAutoRestakeTime(n.Config.NetworkID),
+				EarlyUnstakeTime:              version.GetEarlyUnstakeTime(n.Config.NetworkID),
+				RedelegateTime:                version.GetRedelegateTime(n.Config.NetworkID),
+				UptimeAttestationTime:         version.GetUptimeAttestationTime(n.Config.NetworkID),
+				SubnetFeesTime:                version.GetSubnetFeesTime(n.Config.NetworkID),
+				CancelPendingStakerTime:       version.GetCancelPendingStakerTime(n.Config.NetworkID),
+				URewardRecycleTime:            version.GetURewardRecycleTime(n.Config.NetworkID),
+				OrionFeeTime:                  version.GetOrionFeeTime(n.Config.NetworkID),
+				WeightedOwnersTime:            version.GetWeightedOwnersTime(n.Config.NetworkID),
 				UseCurrentHeight:              n.Config.UseCurrentHeight,
 			},
 		}),
 		vmRegisterer.Register(context.TODO(), constants.AlphaID, &alpha.Factory{
 			Config: alphaconfig.Config{
-				TxFee:            n.Config.TxFee,
-				CreateAssetTxFee: n.Config.CreateAssetTxFee,
+				TxFee:              n.Config.TxFee,
+				CreateAssetTxFee:   n.Config.CreateAssetTxFee,
+				DynamicFeeConfig:   n.Config.DynamicFeeConfig,
+				DynamicFeeTime:     version.GetDynamicFeeTime(n.Config.NetworkID),
+				HTLCTime:           version.GetHTLCTime(n.Config.NetworkID),
+				WeightedOwnersTime: version.GetWeightedOwnersTime(n.Config.NetworkID),
 			},
 		}),
 		vmRegisterer.Register(context.TODO(), constants.DeltaID, &coreth.Factory{}),
 		n.VMManager.RegisterFactory(context.TODO(), secp256k1fx.ID, &secp256k1fx.Factory{}),
 		n.VMManager.RegisterFactory(context.TODO(), nftfx.ID, &nftfx.Factory{}),
 		n.VMManager.RegisterFactory(context.TODO(), propertyfx.ID, &propertyfx.Factory{}),
+		n.VMManager.RegisterFactory(context.TODO(), htlcfx.ID, &htlcfx.Factory{}),
 	)
 	if errs.Errored() {
 		return errs.Err
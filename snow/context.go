@@ -115,6 +115,7 @@ func DefaultContextTest() *Context {
 		BCLookup:     ids.NewAliaser(),
 		Metrics:      metrics.NewOptionalGatherer(),
 		ChainDataDir: "",
+		FeeCollector: feecollector.NewDummyCollector(),
 	}
 }
 
@@ -135,6 +135,19 @@ func RemoveWeight(m Manager, subnetID ids.ID, nodeID ids.NodeID, weight uint64)
 	return vdrs.RemoveWeight(nodeID, weight)
 }
 
+// SetPublicKey is a helper that fetches the validator set of [subnetID] from
+// [m] and replaces the public key of [nodeID] in the validator set.
+// Returns an error if:
+// - [subnetID] does not have a registered validator set in [m]
+// - replacing the public key of [nodeID] in the validator set returns an error
+func SetPublicKey(m Manager, subnetID ids.ID, nodeID ids.NodeID, pk *bls.PublicKey) error {
+	vdrs, ok := m.Get(subnetID)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrMissingValidators, subnetID)
+	}
+	return vdrs.SetPublicKey(nodeID, pk)
+}
+
 // Contains is a helper that fetches the validator set of [subnetID] from [m]
 // and returns if the validator set contains [nodeID]. If [m] does not contain a
 // validator set for [subnetID], false is returned.
@@ -193,6 +193,20 @@ func (mr *MockSetMockRecorder) Sample(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sample", reflect.TypeOf((*MockSet)(nil).Sample), arg0)
 }
 
+// SetPublicKey mocks base method.
+func (m *MockSet) SetPublicKey(arg0 ids.NodeID, arg1 *bls.PublicKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPublicKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPublicKey indicates an expected call of SetPublicKey.
+func (mr *MockSetMockRecorder) SetPublicKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublicKey", reflect.TypeOf((*MockSet)(nil).SetPublicKey), arg0, arg1)
+}
+
 // String mocks base method.
 func (m *MockSet) String() string {
 	m.ctrl.T.Helper()
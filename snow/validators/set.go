@@ -63,6 +63,11 @@ type Set interface {
 	// If an error is returned, the set will be unmodified.
 	RemoveWeight(nodeID ids.NodeID, weight uint64) error
 
+	// SetPublicKey replaces the public key of an existing staker.
+	// Returns an error if:
+	// - [nodeID] is not already in the validator set
+	SetPublicKey(nodeID ids.NodeID, pk *bls.PublicKey) error
+
 	// Contains returns true if there is a validator with the specified ID
 	// currently in the set.
 	Contains(ids.NodeID) bool
@@ -279,6 +284,18 @@ func (s *vdrSet) removeWeight(nodeID ids.NodeID, weight uint64) error {
 	return nil
 }
 
+func (s *vdrSet) SetPublicKey(nodeID ids.NodeID, pk *bls.PublicKey) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	vdr, nodeExists := s.vdrs[nodeID]
+	if !nodeExists {
+		return errMissingValidator
+	}
+	vdr.PublicKey = pk
+	return nil
+}
+
 func (s *vdrSet) Get(nodeID ids.NodeID) (*Validator, bool) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -188,6 +188,30 @@ func TestSetGet(t *testing.T) {
 	require.Equal(uint64(2), vdr1.Weight)
 }
 
+func TestSetSetPublicKey(t *testing.T) {
+	require := require.New(t)
+
+	s := NewSet()
+
+	nodeID := ids.GenerateTestNodeID()
+	err := s.SetPublicKey(nodeID, nil)
+	require.ErrorIs(err, errMissingValidator)
+
+	require.NoError(s.Add(nodeID, nil, ids.Empty, 1))
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+
+	pk := bls.PublicFromSecretKey(sk)
+	require.NoError(s.SetPublicKey(nodeID, pk))
+
+	vdr, ok := s.Get(nodeID)
+	require.True(ok)
+	require.Equal(pk, vdr.PublicKey)
+	require.Equal(uint64(1), vdr.Weight)
+	require.Equal(pk, s.Map()[nodeID].PublicKey)
+}
+
 func TestSetContains(t *testing.T) {
 	require := require.New(t)
 
@@ -8,6 +8,9 @@ import (
 	"time"
 
 	_ "embed"
+
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 )
 
 // RPCChainVMProtocol should be bumped anytime changes are made which require
@@ -98,6 +101,88 @@ var (
 		// constants.TestnetID: time.Date(2023, time.April, 6, 15, 0, 0, 0, time.UTC),
 	}
 	CortinaDefaultTime = time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC)
+
+	// The upgrades below aren't scheduled on Mainnet or Testnet yet. They're
+	// only active from genesis on local networks.
+	FeeBurnTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	FeeBurnDefaultTime = mockable.MaxTime
+
+	DynamicFeeTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	DynamicFeeDefaultTime = mockable.MaxTime
+
+	BaseTxTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	BaseTxDefaultTime = mockable.MaxTime
+
+	TransferSubnetOwnershipTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	TransferSubnetOwnershipDefaultTime = mockable.MaxTime
+
+	StateSyncTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	StateSyncDefaultTime = mockable.MaxTime
+
+	BLSKeyRotationTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	BLSKeyRotationDefaultTime = mockable.MaxTime
+
+	AutoRestakeTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	AutoRestakeDefaultTime = mockable.MaxTime
+
+	EarlyUnstakeTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	EarlyUnstakeDefaultTime = mockable.MaxTime
+
+	RedelegateTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	RedelegateDefaultTime = mockable.MaxTime
+
+	UptimeAttestationTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	UptimeAttestationDefaultTime = mockable.MaxTime
+
+	SubnetFeesTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	SubnetFeesDefaultTime = mockable.MaxTime
+
+	CancelPendingStakerTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	CancelPendingStakerDefaultTime = mockable.MaxTime
+
+	HTLCTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	HTLCDefaultTime = mockable.MaxTime
+
+	URewardRecycleTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	URewardRecycleDefaultTime = mockable.MaxTime
+
+	OrionFeeTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	OrionFeeDefaultTime = mockable.MaxTime
+
+	WeightedOwnersTimes = map[uint32]time.Time{
+		constants.LocalID: time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC),
+	}
+	WeightedOwnersDefaultTime = mockable.MaxTime
 )
 
 func init() {
@@ -170,6 +255,118 @@ func GetCortinaTime(networkID uint32) time.Time {
 	return CortinaDefaultTime
 }
 
+func GetFeeBurnTime(networkID uint32) time.Time {
+	if upgradeTime, exists := FeeBurnTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return FeeBurnDefaultTime
+}
+
+func GetDynamicFeeTime(networkID uint32) time.Time {
+	if upgradeTime, exists := DynamicFeeTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return DynamicFeeDefaultTime
+}
+
+func GetBaseTxTime(networkID uint32) time.Time {
+	if upgradeTime, exists := BaseTxTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return BaseTxDefaultTime
+}
+
+func GetTransferSubnetOwnershipTime(networkID uint32) time.Time {
+	if upgradeTime, exists := TransferSubnetOwnershipTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return TransferSubnetOwnershipDefaultTime
+}
+
+func GetStateSyncTime(networkID uint32) time.Time {
+	if upgradeTime, exists := StateSyncTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return StateSyncDefaultTime
+}
+
+func GetBLSKeyRotationTime(networkID uint32) time.Time {
+	if upgradeTime, exists := BLSKeyRotationTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return BLSKeyRotationDefaultTime
+}
+
+func GetAutoRestakeTime(networkID uint32) time.Time {
+	if upgradeTime, exists := AutoRestakeTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return AutoRestakeDefaultTime
+}
+
+func GetEarlyUnstakeTime(networkID uint32) time.Time {
+	if upgradeTime, exists := EarlyUnstakeTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return EarlyUnstakeDefaultTime
+}
+
+func GetRedelegateTime(networkID uint32) time.Time {
+	if upgradeTime, exists := RedelegateTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return RedelegateDefaultTime
+}
+
+func GetUptimeAttestationTime(networkID uint32) time.Time {
+	if upgradeTime, exists := UptimeAttestationTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return UptimeAttestationDefaultTime
+}
+
+func GetSubnetFeesTime(networkID uint32) time.Time {
+	if upgradeTime, exists := SubnetFeesTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return SubnetFeesDefaultTime
+}
+
+func GetCancelPendingStakerTime(networkID uint32) time.Time {
+	if upgradeTime, exists := CancelPendingStakerTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return CancelPendingStakerDefaultTime
+}
+
+func GetHTLCTime(networkID uint32) time.Time {
+	if upgradeTime, exists := HTLCTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return HTLCDefaultTime
+}
+
+func GetURewardRecycleTime(networkID uint32) time.Time {
+	if upgradeTime, exists := URewardRecycleTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return URewardRecycleDefaultTime
+}
+
+func GetOrionFeeTime(networkID uint32) time.Time {
+	if upgradeTime, exists := OrionFeeTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return OrionFeeDefaultTime
+}
+
+func GetWeightedOwnersTime(networkID uint32) time.Time {
+	if upgradeTime, exists := WeightedOwnersTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return WeightedOwnersDefaultTime
+}
+
 func GetCompatibility(networkID uint32) Compatibility {
 	return NewCompatibility(
 		CurrentApp,
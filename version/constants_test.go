@@ -5,11 +5,42 @@ package version
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/utils/constants"
 )
 
 func TestCurrentRPCChainVMCompatible(t *testing.T) {
 	compatibleVersions := RPCChainVMProtocolCompatibility[RPCChainVMProtocol]
 	require.Contains(t, compatibleVersions, Current)
 }
+
+func TestUnscheduledUpgradesNotActive(t *testing.T) {
+	upgrades := map[string]func(uint32) time.Time{
+		"FeeBurn":                 GetFeeBurnTime,
+		"DynamicFee":              GetDynamicFeeTime,
+		"BaseTx":                  GetBaseTxTime,
+		"TransferSubnetOwnership": GetTransferSubnetOwnershipTime,
+		"StateSync":               GetStateSyncTime,
+		"BLSKeyRotation":          GetBLSKeyRotationTime,
+		"AutoRestake":             GetAutoRestakeTime,
+		"EarlyUnstake":            GetEarlyUnstakeTime,
+		"Redelegate":              GetRedelegateTime,
+		"UptimeAttestation":       GetUptimeAttestationTime,
+		"SubnetFees":              GetSubnetFeesTime,
+		"CancelPendingStaker":     GetCancelPendingStakerTime,
+		"HTLC":                    GetHTLCTime,
+		"URewardRecycle":          GetURewardRecycleTime,
+		"OrionFee":                GetOrionFeeTime,
+		"WeightedOwners":          GetWeightedOwnersTime,
+	}
+	now := time.Now()
+	for _, networkID := range []uint32{constants.MainnetID, constants.TestnetID} {
+		for name, getUpgradeTime := range upgrades {
+			upgradeTime := getUpgradeTime(networkID)
+			require.False(t, upgradeTime.Before(now), "%s is active on %s since %s", name, constants.NetworkName(networkID), upgradeTime)
+		}
+	}
+}
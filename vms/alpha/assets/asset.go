@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package assets
+
+import (
+	"github.com/DioneProtocol/odysseygo/codec"
+	"github.com/DioneProtocol/odysseygo/codec/linearcodec"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+// CodecVersion is the current default codec version
+const CodecVersion = 0
+
+// Codec is used to serialize the indexed assets
+var Codec codec.Manager
+
+func init() {
+	c := linearcodec.NewDefault()
+	Codec = codec.NewDefaultManager()
+
+	if err := Codec.RegisterCodec(CodecVersion, c); err != nil {
+		panic(err)
+	}
+}
+
+// Asset is an asset created by a CreateAssetTx.
+type Asset struct {
+	AssetID      ids.ID `serialize:"true"`
+	Name         string `serialize:"true"`
+	Symbol       string `serialize:"true"`
+	Denomination byte   `serialize:"true"`
+
+	// Addresses that owned the UTXOs consumed by the CreateAssetTx
+	Creators []ids.ShortID `serialize:"true"`
+
+	// Height of the block that accepted the CreateAssetTx. Assets created in
+	// genesis, or before the chain was linearized, have a height of 0.
+	CreationHeight uint64 `serialize:"true"`
+
+	// Position of the asset in the order the assets were created
+	Index uint64 `serialize:"true"`
+
+	// Total amount of the asset issued by the secp256k1fx, both by the
+	// CreateAssetTx and by later mint operations
+	Supply uint64 `serialize:"true"`
+
+	// Unspent secp256k1fx mint outputs of the asset
+	MintAuthorities []*MintAuthority `serialize:"true"`
+}
+
+// MintAuthority is an unspent secp256k1fx.MintOutput.
+type MintAuthority struct {
+	UTXOID ids.ID                   `serialize:"true"`
+	Owners secp256k1fx.OutputOwners `serialize:"true"`
+}
+
+// Less orders the assets by creation
+func (a *Asset) Less(other *Asset) bool {
+	return a.Index < other.Index
+}
+
+func (a *Asset) addMintAuthority(utxoID ids.ID, out *secp256k1fx.MintOutput) {
+	a.MintAuthorities = append(a.MintAuthorities, &MintAuthority{
+		UTXOID: utxoID,
+		Owners: out.OutputOwners,
+	})
+}
+
+func (a *Asset) removeMintAuthority(utxoID ids.ID) {
+	for i, authority := range a.MintAuthorities {
+		if authority.UTXOID == utxoID {
+			a.MintAuthorities = append(a.MintAuthorities[:i], a.MintAuthorities[i+1:]...)
+			return
+		}
+	}
+}
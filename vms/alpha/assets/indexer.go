@@ -0,0 +1,383 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package assets
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils"
+	safemath "github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/index"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+var (
+	_ Indexer = (*indexer)(nil)
+	_ Indexer = (*noIndexer)(nil)
+
+	ErrIndexingDisabled = errors.New("asset indexing is disabled")
+	ErrNoSearchCriteria = errors.New("no search criteria provided")
+
+	assetPrefix   = []byte("asset")
+	orderPrefix   = []byte("order")
+	termPrefix    = []byte("term")
+	creatorPrefix = []byte("creator")
+
+	numAssetsKey = []byte("numAssets")
+)
+
+// Indexer maintains the assets created on the chain, searchable by their
+// name, symbol, and creators.
+type Indexer interface {
+	// Accept is called when [tx] is accepted by the block at [height], but
+	// before its state changes are applied.
+	// [inputUTXOs] are the UTXOs [tx] consumes.
+	// If the error is non-nil, do not persist [tx] to disk as accepted in the
+	// VM.
+	Accept(tx *txs.Tx, inputUTXOs []*dione.UTXO, height uint64) error
+
+	// List returns the assets in the order they were created, starting at the
+	// [cursor]th asset. The length of the returned slice <= [limit].
+	List(cursor uint64, limit int) ([]*Asset, error)
+
+	// Search returns the assets, in the order they were created, that match
+	// every word of [query] and were created by [creator]. A word matches an
+	// asset if it's a case-insensitive prefix of the asset's symbol or of a
+	// word of the asset's name. If [creator] is [ids.ShortEmpty], the assets
+	// aren't filtered by their creators.
+	// The length of the returned slice <= [limit].
+	Search(query string, creator ids.ShortID, limit int) ([]*Asset, error)
+}
+
+/*
+ * DB
+ * |-- complete -> true if every asset was indexed
+ * |-- numAssets -> number of indexed assets
+ * |-. asset
+ * | '-- assetID -> Asset
+ * |-. order
+ * | '-- index -> assetID
+ * |-. term
+ * | '-- term + assetID -> nil
+ * '-. creator
+ *   '-- address + assetID -> nil
+ */
+type indexer struct {
+	db        database.Database
+	assetDB   database.Database
+	orderDB   database.Database
+	termDB    database.Database
+	creatorDB database.Database
+}
+
+// NewIndexer returns a new Indexer persisting to [db]. [chainInitialized]
+// should be true if the chain has accepted transactions before, as they
+// wouldn't be indexed.
+func NewIndexer(
+	db database.Database,
+	chainInitialized bool,
+	allowIncompleteIndices bool,
+) (Indexer, error) {
+	if err := index.CheckIndexStatus(db, true, chainInitialized, allowIncompleteIndices); err != nil {
+		return nil, err
+	}
+	return &indexer{
+		db:        db,
+		assetDB:   prefixdb.New(assetPrefix, db),
+		orderDB:   prefixdb.New(orderPrefix, db),
+		termDB:    prefixdb.New(termPrefix, db),
+		creatorDB: prefixdb.New(creatorPrefix, db),
+	}, nil
+}
+
+func (i *indexer) Accept(tx *txs.Tx, inputUTXOs []*dione.UTXO, height uint64) error {
+	// Assets modified by [tx]
+	modified := map[ids.ID]*Asset{}
+
+	if createAssetTx, ok := tx.Unsigned.(*txs.CreateAssetTx); ok {
+		asset, err := i.create(tx.ID(), createAssetTx, inputUTXOs, height)
+		if err != nil {
+			return err
+		}
+		modified[asset.AssetID] = asset
+	}
+
+	for _, utxo := range inputUTXOs {
+		if _, ok := utxo.Out.(*secp256k1fx.MintOutput); !ok {
+			continue
+		}
+		asset, err := i.getModified(modified, utxo.AssetID())
+		if err != nil {
+			return err
+		}
+		if asset != nil {
+			asset.removeMintAuthority(utxo.InputID())
+		}
+	}
+
+	if operationTx, ok := tx.Unsigned.(*txs.OperationTx); ok {
+		for _, op := range operationTx.Ops {
+			mintOp, ok := op.Op.(*secp256k1fx.MintOperation)
+			if !ok {
+				continue
+			}
+			asset, err := i.getModified(modified, op.AssetID())
+			if err != nil {
+				return err
+			}
+			if asset != nil {
+				asset.Supply = addSupply(asset.Supply, mintOp.TransferOutput.Amt)
+			}
+		}
+	}
+
+	for _, utxo := range tx.UTXOs() {
+		out, ok := utxo.Out.(*secp256k1fx.MintOutput)
+		if !ok {
+			continue
+		}
+		asset, err := i.getModified(modified, utxo.AssetID())
+		if err != nil {
+			return err
+		}
+		if asset != nil {
+			asset.addMintAuthority(utxo.InputID(), out)
+		}
+	}
+
+	for assetID, asset := range modified {
+		assetBytes, err := Codec.Marshal(CodecVersion, asset)
+		if err != nil {
+			return err
+		}
+		if err := i.assetDB.Put(assetID[:], assetBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// create indexes the asset created by [tx] and returns it.
+func (i *indexer) create(
+	assetID ids.ID,
+	tx *txs.CreateAssetTx,
+	inputUTXOs []*dione.UTXO,
+	height uint64,
+) (*Asset, error) {
+	numAssets, err := database.GetUInt64(i.db, numAssetsKey)
+	if err != nil && err != database.ErrNotFound {
+		return nil, err
+	}
+
+	creators := set.Set[ids.ShortID]{}
+	for _, utxo := range inputUTXOs {
+		out, ok := utxo.Out.(dione.Addressable)
+		if !ok {
+			continue
+		}
+		for _, addrBytes := range out.Addresses() {
+			addr, err := ids.ToShortID(addrBytes)
+			if err != nil {
+				return nil, err
+			}
+			creators.Add(addr)
+		}
+	}
+
+	asset := &Asset{
+		AssetID:        assetID,
+		Name:           tx.Name,
+		Symbol:         tx.Symbol,
+		Denomination:   tx.Denomination,
+		Creators:       creators.List(),
+		CreationHeight: height,
+		Index:          numAssets,
+	}
+	utils.Sort(asset.Creators)
+	for _, state := range tx.States {
+		for _, out := range state.Outs {
+			if out, ok := out.(*secp256k1fx.TransferOutput); ok {
+				asset.Supply = addSupply(asset.Supply, out.Amt)
+			}
+		}
+	}
+
+	if err := i.orderDB.Put(database.PackUInt64(numAssets), assetID[:]); err != nil {
+		return nil, err
+	}
+	if err := database.PutUInt64(i.db, numAssetsKey, numAssets+1); err != nil {
+		return nil, err
+	}
+	for _, term := range terms(tx.Name, tx.Symbol) {
+		if err := i.termDB.Put(append([]byte(term), assetID[:]...), nil); err != nil {
+			return nil, err
+		}
+	}
+	for _, creator := range asset.Creators {
+		if err := i.creatorDB.Put(append(creator[:], assetID[:]...), nil); err != nil {
+			return nil, err
+		}
+	}
+	return asset, nil
+}
+
+// getModified returns the asset [assetID], adding it to [modified]. Returns
+// nil if the asset wasn't indexed.
+func (i *indexer) getModified(modified map[ids.ID]*Asset, assetID ids.ID) (*Asset, error) {
+	if asset, ok := modified[assetID]; ok {
+		return asset, nil
+	}
+	asset, err := i.getAsset(assetID)
+	if err == database.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	modified[assetID] = asset
+	return asset, nil
+}
+
+func (i *indexer) getAsset(assetID ids.ID) (*Asset, error) {
+	assetBytes, err := i.assetDB.Get(assetID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	asset := &Asset{}
+	_, err = Codec.Unmarshal(assetBytes, asset)
+	return asset, err
+}
+
+func (i *indexer) List(cursor uint64, limit int) ([]*Asset, error) {
+	iter := i.orderDB.NewIteratorWithStart(database.PackUInt64(cursor))
+	defer iter.Release()
+
+	var assets []*Asset
+	for len(assets) < limit && iter.Next() {
+		assetID, err := ids.ToID(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		asset, err := i.getAsset(assetID)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, asset)
+	}
+	return assets, iter.Error()
+}
+
+func (i *indexer) Search(query string, creator ids.ShortID, limit int) ([]*Asset, error) {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 && creator == ids.ShortEmpty {
+		return nil, ErrNoSearchCriteria
+	}
+
+	var matches set.Set[ids.ID]
+	filter := func(db database.Database, prefix []byte) error {
+		found, err := assetIDsWithPrefix(db, prefix)
+		if err != nil {
+			return err
+		}
+		if matches == nil {
+			matches = found
+			return nil
+		}
+		for assetID := range matches {
+			if !found.Contains(assetID) {
+				matches.Remove(assetID)
+			}
+		}
+		return nil
+	}
+
+	if creator != ids.ShortEmpty {
+		if err := filter(i.creatorDB, creator[:]); err != nil {
+			return nil, err
+		}
+	}
+	for _, word := range words {
+		if err := filter(i.termDB, []byte(word)); err != nil {
+			return nil, err
+		}
+	}
+
+	assets := make([]*Asset, 0, matches.Len())
+	for assetID := range matches {
+		asset, err := i.getAsset(assetID)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, asset)
+	}
+	utils.Sort(assets)
+	if len(assets) > limit {
+		assets = assets[:limit]
+	}
+	return assets, nil
+}
+
+// assetIDsWithPrefix returns the asset IDs that end the keys of [db] starting
+// with [prefix].
+func assetIDsWithPrefix(db database.Iteratee, prefix []byte) (set.Set[ids.ID], error) {
+	iter := db.NewIteratorWithPrefix(prefix)
+	defer iter.Release()
+
+	assetIDs := set.Set[ids.ID]{}
+	for iter.Next() {
+		key := iter.Key()
+		assetID, err := ids.ToID(key[len(key)-ids.IDLen:])
+		if err != nil {
+			return nil, err
+		}
+		assetIDs.Add(assetID)
+	}
+	return assetIDs, iter.Error()
+}
+
+// terms returns the lowercase search terms of an asset: the words of its name
+// and its symbol.
+func terms(name, symbol string) []string {
+	terms := set.Of(strings.Fields(strings.ToLower(name))...)
+	if symbol != "" {
+		terms.Add(strings.ToLower(symbol))
+	}
+	return terms.List()
+}
+
+// addSupply returns [supply] + [amount], capped at the maximum uint64.
+func addSupply(supply, amount uint64) uint64 {
+	newSupply, err := safemath.Add64(supply, amount)
+	if err != nil {
+		return math.MaxUint64
+	}
+	return newSupply
+}
+
+type noIndexer struct{}
+
+func NewNoIndexer(db database.Database, allowIncomplete bool) (Indexer, error) {
+	return &noIndexer{}, index.CheckIndexStatus(db, false, true, allowIncomplete)
+}
+
+func (*noIndexer) Accept(*txs.Tx, []*dione.UTXO, uint64) error {
+	return nil
+}
+
+func (*noIndexer) List(uint64, int) ([]*Asset, error) {
+	return nil, ErrIndexingDisabled
+}
+
+func (*noIndexer) Search(string, ids.ShortID, int) ([]*Asset, error) {
+	return nil, ErrIndexingDisabled
+}
@@ -0,0 +1,321 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package assets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/fxs"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/index"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func newCreateAssetTx(t *testing.T, parser txs.Parser, name, symbol string, supply uint64, minter ids.ShortID) *txs.Tx {
+	tx := &txs.Tx{Unsigned: &txs.CreateAssetTx{
+		Name:   name,
+		Symbol: symbol,
+		States: []*txs.InitialState{{
+			Outs: []verify.State{
+				&secp256k1fx.TransferOutput{
+					Amt: supply,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{minter},
+					},
+				},
+				&secp256k1fx.MintOutput{
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{minter},
+					},
+				},
+			},
+		}},
+	}}
+	require.NoError(t, parser.InitializeTx(tx))
+	return tx
+}
+
+func newFeeUTXO(owner ids.ShortID) *dione.UTXO {
+	return &dione.UTXO{
+		UTXOID: dione.UTXOID{TxID: ids.GenerateTestID()},
+		Asset:  dione.Asset{ID: ids.GenerateTestID()},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{owner},
+			},
+		},
+	}
+}
+
+func TestIndexer(t *testing.T) {
+	require := require.New(t)
+
+	parser, err := txs.NewParser([]fxs.Fx{&secp256k1fx.Fx{}})
+	require.NoError(err)
+
+	db := memdb.New()
+	indexer, err := NewIndexer(db, false, false)
+	require.NoError(err)
+
+	creator0 := ids.GenerateTestShortID()
+	creator1 := ids.GenerateTestShortID()
+	minter := ids.GenerateTestShortID()
+
+	goldTx := newCreateAssetTx(t, parser, "Golden Coin", "GLD", 100, minter)
+	require.NoError(indexer.Accept(goldTx, []*dione.UTXO{newFeeUTXO(creator0)}, 5))
+
+	silverTx := newCreateAssetTx(t, parser, "Silver Coin", "SLV", 50, minter)
+	require.NoError(indexer.Accept(silverTx, []*dione.UTXO{newFeeUTXO(creator1)}, 6))
+
+	// Mint more gold, replacing its mint output
+	goldMintUTXO := goldTx.UTXOs()[1]
+	mintTx := &txs.Tx{Unsigned: &txs.OperationTx{
+		Ops: []*txs.Operation{{
+			Asset: dione.Asset{ID: goldTx.ID()},
+			UTXOIDs: []*dione.UTXOID{
+				&goldMintUTXO.UTXOID,
+			},
+			Op: &secp256k1fx.MintOperation{
+				MintInput: secp256k1fx.Input{SigIndices: []uint32{0}},
+				MintOutput: secp256k1fx.MintOutput{
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{minter},
+					},
+				},
+				TransferOutput: secp256k1fx.TransferOutput{
+					Amt: 25,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{minter},
+					},
+				},
+			},
+		}},
+	}}
+	require.NoError(parser.InitializeTx(mintTx))
+	require.NoError(indexer.Accept(mintTx, []*dione.UTXO{goldMintUTXO}, 7))
+
+	// Reload the index to make sure everything was persisted
+	indexer, err = NewIndexer(db, true, false)
+	require.NoError(err)
+
+	expectedGold := &Asset{
+		AssetID:        goldTx.ID(),
+		Name:           "Golden Coin",
+		Symbol:         "GLD",
+		Creators:       []ids.ShortID{creator0},
+		CreationHeight: 5,
+		Index:          0,
+		Supply:         125,
+		MintAuthorities: []*MintAuthority{{
+			UTXOID: mintTx.UTXOs()[0].InputID(),
+			Owners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{minter},
+			},
+		}},
+	}
+	expectedSilver := &Asset{
+		AssetID:        silverTx.ID(),
+		Name:           "Silver Coin",
+		Symbol:         "SLV",
+		Creators:       []ids.ShortID{creator1},
+		CreationHeight: 6,
+		Index:          1,
+		Supply:         50,
+		MintAuthorities: []*MintAuthority{{
+			UTXOID: silverTx.UTXOs()[1].InputID(),
+			Owners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{minter},
+			},
+		}},
+	}
+
+	assets, err := indexer.List(0, 10)
+	require.NoError(err)
+	require.Equal([]*Asset{expectedGold, expectedSilver}, assets)
+
+	assets, err = indexer.List(1, 10)
+	require.NoError(err)
+	require.Equal([]*Asset{expectedSilver}, assets)
+
+	assets, err = indexer.List(0, 1)
+	require.NoError(err)
+	require.Equal([]*Asset{expectedGold}, assets)
+
+	tests := []struct {
+		name     string
+		query    string
+		creator  ids.ShortID
+		limit    int
+		expected []*Asset
+	}{
+		{
+			name:     "shared word",
+			query:    "coin",
+			limit:    10,
+			expected: []*Asset{expectedGold, expectedSilver},
+		},
+		{
+			name:     "limited",
+			query:    "coin",
+			limit:    1,
+			expected: []*Asset{expectedGold},
+		},
+		{
+			name:     "word prefix",
+			query:    "Gold",
+			limit:    10,
+			expected: []*Asset{expectedGold},
+		},
+		{
+			name:     "symbol",
+			query:    "slv",
+			limit:    10,
+			expected: []*Asset{expectedSilver},
+		},
+		{
+			name:     "every word must match",
+			query:    "silver gld",
+			limit:    10,
+			expected: []*Asset{},
+		},
+		{
+			name:     "creator",
+			creator:  creator1,
+			limit:    10,
+			expected: []*Asset{expectedSilver},
+		},
+		{
+			name:     "query and creator",
+			query:    "coin",
+			creator:  creator0,
+			limit:    10,
+			expected: []*Asset{expectedGold},
+		},
+		{
+			name:     "unknown creator",
+			query:    "coin",
+			creator:  minter,
+			limit:    10,
+			expected: []*Asset{},
+		},
+	}
+	for _, test := range tests {
+		assets, err := indexer.Search(test.query, test.creator, test.limit)
+		require.NoError(err, test.name)
+		require.Equal(test.expected, assets, test.name)
+	}
+
+	_, err = indexer.Search(" ", ids.ShortEmpty, 10)
+	require.ErrorIs(err, ErrNoSearchCriteria)
+}
+
+func TestIndexerIgnoresUnindexedAssets(t *testing.T) {
+	require := require.New(t)
+
+	parser, err := txs.NewParser([]fxs.Fx{&secp256k1fx.Fx{}})
+	require.NoError(err)
+
+	indexer, err := NewIndexer(memdb.New(), true, true)
+	require.NoError(err)
+
+	// The asset was created before indexing was enabled
+	createAssetTx := newCreateAssetTx(t, parser, "Golden Coin", "GLD", 100, ids.GenerateTestShortID())
+	require.NoError(indexer.Accept(createAssetTx, nil, 0))
+
+	mintUTXO := createAssetTx.UTXOs()[1]
+	mintUTXO.Asset.ID = ids.GenerateTestID()
+	require.NoError(indexer.Accept(&txs.Tx{Unsigned: &txs.BaseTx{}}, []*dione.UTXO{mintUTXO}, 1))
+
+	assets, err := indexer.List(0, 10)
+	require.NoError(err)
+	require.Len(assets, 1)
+	require.Len(assets[0].MintAuthorities, 1)
+}
+
+func TestIndexStatus(t *testing.T) {
+	type run struct {
+		enableIndexing   bool
+		chainInitialized bool
+		allowIncomplete  bool
+		expectedErr      error
+	}
+	tests := []struct {
+		name string
+		runs []run
+	}{
+		{
+			name: "enabled from genesis",
+			runs: []run{
+				{enableIndexing: true},
+				{enableIndexing: true, chainInitialized: true},
+			},
+		},
+		{
+			name: "enabled after genesis",
+			runs: []run{
+				{enableIndexing: true, chainInitialized: true, expectedErr: index.ErrIndexingRequiredFromGenesis},
+			},
+		},
+		{
+			name: "enabled after genesis allowing incomplete",
+			runs: []run{
+				{enableIndexing: true, chainInitialized: true, allowIncomplete: true},
+				{enableIndexing: true, chainInitialized: true, expectedErr: index.ErrIndexingRequiredFromGenesis},
+			},
+		},
+		{
+			name: "disabled from genesis",
+			runs: []run{
+				{},
+				{enableIndexing: true, chainInitialized: true, expectedErr: index.ErrIndexingRequiredFromGenesis},
+			},
+		},
+		{
+			name: "disabled while complete",
+			runs: []run{
+				{enableIndexing: true},
+				{expectedErr: index.ErrCausesIncompleteIndex},
+			},
+		},
+		{
+			name: "disabled while complete allowing incomplete",
+			runs: []run{
+				{enableIndexing: true},
+				{allowIncomplete: true},
+				{enableIndexing: true, chainInitialized: true, expectedErr: index.ErrIndexingRequiredFromGenesis},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			// Every run restarts the node on the same database
+			db := memdb.New()
+			for _, run := range test.runs {
+				var err error
+				if run.enableIndexing {
+					_, err = NewIndexer(db, run.chainInitialized, run.allowIncomplete)
+				} else {
+					_, err = NewNoIndexer(db, run.allowIncomplete)
+				}
+				require.ErrorIs(err, run.expectedErr)
+			}
+		})
+	}
+}
@@ -28,12 +28,14 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/version"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/block"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/config"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/fxs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/states"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/mempool"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 
 	blkexecutor "github.com/DioneProtocol/odysseygo/vms/alpha/block/executor"
@@ -124,6 +126,7 @@ func TestBuilderBuildBlock(t *testing.T) {
 				preferredState := states.NewMockChain(ctrl)
 				preferredState.EXPECT().GetLastAccepted().Return(preferredID)
 				preferredState.EXPECT().GetTimestamp().Return(preferredTimestamp)
+				preferredState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				manager := blkexecutor.NewMockManager(ctrl)
 				manager.EXPECT().Preferred().Return(preferredID)
@@ -168,6 +171,7 @@ func TestBuilderBuildBlock(t *testing.T) {
 				preferredState := states.NewMockChain(ctrl)
 				preferredState.EXPECT().GetLastAccepted().Return(preferredID)
 				preferredState.EXPECT().GetTimestamp().Return(preferredTimestamp)
+				preferredState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				manager := blkexecutor.NewMockManager(ctrl)
 				manager.EXPECT().Preferred().Return(preferredID)
@@ -213,6 +217,7 @@ func TestBuilderBuildBlock(t *testing.T) {
 				preferredState := states.NewMockChain(ctrl)
 				preferredState.EXPECT().GetLastAccepted().Return(preferredID)
 				preferredState.EXPECT().GetTimestamp().Return(preferredTimestamp)
+				preferredState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				manager := blkexecutor.NewMockManager(ctrl)
 				manager.EXPECT().Preferred().Return(preferredID)
@@ -259,6 +264,7 @@ func TestBuilderBuildBlock(t *testing.T) {
 				preferredState := states.NewMockChain(ctrl)
 				preferredState.EXPECT().GetLastAccepted().Return(preferredID)
 				preferredState.EXPECT().GetTimestamp().Return(preferredTimestamp)
+				preferredState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				// tx1 and tx2 both consume [inputID].
 				// tx1 is added to the block first, so tx2 should be dropped.
@@ -357,6 +363,7 @@ func TestBuilderBuildBlock(t *testing.T) {
 				preferredState := states.NewMockChain(ctrl)
 				preferredState.EXPECT().GetLastAccepted().Return(preferredID)
 				preferredState.EXPECT().GetTimestamp().Return(preferredTimestamp)
+				preferredState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				manager := blkexecutor.NewMockManager(ctrl)
 				manager.EXPECT().Preferred().Return(preferredID)
@@ -431,6 +438,7 @@ func TestBuilderBuildBlock(t *testing.T) {
 				preferredState := states.NewMockChain(ctrl)
 				preferredState.EXPECT().GetLastAccepted().Return(preferredID)
 				preferredState.EXPECT().GetTimestamp().Return(preferredTimestamp)
+				preferredState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				manager := blkexecutor.NewMockManager(ctrl)
 				manager.EXPECT().Preferred().Return(preferredID)
@@ -522,17 +530,18 @@ func TestBlockBuilderAddLocalTx(t *testing.T) {
 		Ctx: &snow.Context{
 			Log: logging.NoLog{},
 		},
-		Codec: parser.Codec(),
+		Config: &config.Config{},
+		Codec:  parser.Codec(),
 	}
 
 	baseDBManager := manager.NewMemDB(version.Semantic1_0_0)
 	baseDB := versiondb.New(baseDBManager.Current().Database)
 
-	state, err := states.New(baseDB, parser, registerer, trackChecksums)
+	state, err := states.New(baseDB, parser, registerer, trackChecksums, false)
 	require.NoError(err)
 
 	clk := &mockable.Clock{}
-	onAccept := func(*txs.Tx) error { return nil }
+	onAccept := func(*txs.Tx, uint64) error { return nil }
 	now := time.Now()
 	parentTimestamp := now.Add(-2 * time.Second)
 	parentID := ids.GenerateTestID()
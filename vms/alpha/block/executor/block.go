@@ -199,6 +199,14 @@ func (b *Block) Verify(context.Context) error {
 	stateDiff.SetLastAccepted(blkID)
 	stateDiff.AddBlock(b.Block)
 
+	// The txs of the block were charged the fee rate of its parent. The fee
+	// rate of the following block is adjusted from the size of this one.
+	cfg := b.manager.backend.Config
+	if cfg.DynamicFeeConfig.IsEnabled() && cfg.IsDynamicFeeActivated(newChainTime) {
+		feeRate := cfg.DynamicFeeConfig.NextFeeRate(stateDiff.GetFeeRate(), len(b.Bytes()))
+		stateDiff.SetFeeRate(feeRate)
+	}
+
 	b.manager.blkIDToState[blkID] = blockState
 	b.manager.mempool.Remove(txs)
 	return nil
@@ -210,7 +218,7 @@ func (b *Block) Accept(context.Context) error {
 
 	txs := b.Txs()
 	for _, tx := range txs {
-		if err := b.manager.onAccept(tx); err != nil {
+		if err := b.manager.onAccept(tx, b.Height()); err != nil {
 			return fmt.Errorf(
 				"failed to mark tx %q as accepted: %w",
 				blkID,
@@ -240,15 +248,26 @@ func (b *Block) Accept(context.Context) error {
 		)
 	}
 
-	accumulatedFee := b.AccumulatedFee(b.manager.backend.Ctx.DIONEAssetID)
-	if err := b.manager.backend.Ctx.FeeCollector.AddAChainValue(accumulatedFee); err != nil {
+	ctx := b.manager.backend.Ctx
+	feeDiff := ctx.FeeCollector.NewDiff(ctx.ChainID)
+	accumulatedFee := b.AccumulatedFee(ctx.DIONEAssetID)
+	if err := feeDiff.AddAChainValue(accumulatedFee); err != nil {
 		return fmt.Errorf("failed to collect fee: %w", err)
 	}
+	feeBatch, err := feeDiff.CommitBatch(blkID, b.Height())
+	if err != nil {
+		return fmt.Errorf(
+			"failed to stage fee diff for block %s: %w",
+			blkID,
+			err,
+		)
+	}
 
-	// Note that this method writes [batch] to the database.
-	if err := b.manager.backend.Ctx.SharedMemory.Apply(blkState.atomicRequests, batch); err != nil {
+	// Note that this method writes [batch] and [feeBatch] to the database.
+	if err := ctx.SharedMemory.Apply(blkState.atomicRequests, batch, feeBatch); err != nil {
 		return fmt.Errorf("failed to apply state diff to shared memory: %w", err)
 	}
+	feeDiff.Apply()
 
 	if err := b.manager.metrics.MarkBlockAccepted(b); err != nil {
 		return err
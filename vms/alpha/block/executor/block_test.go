@@ -23,12 +23,14 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/block"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/config"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/states"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/executor"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/mempool"
 	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 )
 
 func TestBlockVerify(t *testing.T) {
@@ -230,6 +232,7 @@ func TestBlockVerify(t *testing.T) {
 				mockParentState := states.NewMockDiff(ctrl)
 				mockParentState.EXPECT().GetLastAccepted().Return(parentID)
 				mockParentState.EXPECT().GetTimestamp().Return(blockTimestamp.Add(1))
+				mockParentState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				return &Block{
 					Block: mockBlock,
@@ -280,6 +283,7 @@ func TestBlockVerify(t *testing.T) {
 				mockParentState := states.NewMockDiff(ctrl)
 				mockParentState.EXPECT().GetLastAccepted().Return(parentID)
 				mockParentState.EXPECT().GetTimestamp().Return(blockTimestamp)
+				mockParentState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				mempool := mempool.NewMockMempool(ctrl)
 				mempool.EXPECT().MarkDropped(tx.ID(), errTest).Times(1)
@@ -335,6 +339,7 @@ func TestBlockVerify(t *testing.T) {
 				mockParentState := states.NewMockDiff(ctrl)
 				mockParentState.EXPECT().GetLastAccepted().Return(parentID)
 				mockParentState.EXPECT().GetTimestamp().Return(blockTimestamp)
+				mockParentState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				mempool := mempool.NewMockMempool(ctrl)
 				mempool.EXPECT().MarkDropped(tx.ID(), errTest).Times(1)
@@ -417,6 +422,7 @@ func TestBlockVerify(t *testing.T) {
 				mockParentState := states.NewMockDiff(ctrl)
 				mockParentState.EXPECT().GetLastAccepted().Return(parentID)
 				mockParentState.EXPECT().GetTimestamp().Return(blockTimestamp)
+				mockParentState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				mempool := mempool.NewMockMempool(ctrl)
 				mempool.EXPECT().MarkDropped(tx2.ID(), ErrConflictingBlockTxs).Times(1)
@@ -483,6 +489,7 @@ func TestBlockVerify(t *testing.T) {
 				mockParentState := states.NewMockDiff(ctrl)
 				mockParentState.EXPECT().GetLastAccepted().Return(parentID)
 				mockParentState.EXPECT().GetTimestamp().Return(blockTimestamp)
+				mockParentState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				return &Block{
 					Block: mockBlock,
@@ -535,6 +542,7 @@ func TestBlockVerify(t *testing.T) {
 				mockParentState := states.NewMockDiff(ctrl)
 				mockParentState.EXPECT().GetLastAccepted().Return(parentID)
 				mockParentState.EXPECT().GetTimestamp().Return(blockTimestamp)
+				mockParentState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				mockMempool := mempool.NewMockMempool(ctrl)
 				mockMempool.EXPECT().Remove([]*txs.Tx{tx})
@@ -544,6 +552,7 @@ func TestBlockVerify(t *testing.T) {
 						mempool: mockMempool,
 						metrics: metrics.NewMockMetrics(ctrl),
 						backend: &executor.Backend{
+							Config: &config.Config{},
 							Ctx: &snow.Context{
 								DIONEAssetID: ids.ID{},
 							},
@@ -677,6 +686,7 @@ func TestBlockAccept(t *testing.T) {
 				mockBlock := block.NewMockBlock(ctrl)
 				mockBlock.EXPECT().ID().Return(blockID).AnyTimes()
 				mockBlock.EXPECT().Txs().Return([]*txs.Tx{}).AnyTimes()
+				mockBlock.EXPECT().Height().Return(uint64(0)).AnyTimes()
 				mockBlock.EXPECT().AccumulatedFee(ids.ID{}).Return(uint64(100)).Times(1)
 
 				mempool := mempool.NewMockMempool(ctrl)
@@ -689,13 +699,16 @@ func TestBlockAccept(t *testing.T) {
 				mockManagerState.EXPECT().Abort()
 
 				mockSharedMemory := atomic.NewMockSharedMemory(ctrl)
-				mockSharedMemory.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(errTest)
+				mockSharedMemory.EXPECT().Apply(gomock.Any(), gomock.Any(), gomock.Any()).Return(errTest)
 
 				mockOnAcceptState := states.NewMockDiff(ctrl)
 				mockOnAcceptState.EXPECT().Apply(mockManagerState)
 
 				mockFeeCollector := feecollector.NewMockFeeCollector(ctrl)
-				mockFeeCollector.EXPECT().AddAChainValue(uint64(100)).Times(1)
+				mockFeeDiff := feecollector.NewMockDiff(ctrl)
+				mockFeeDiff.EXPECT().AddAChainValue(uint64(100)).Times(1)
+				mockFeeDiff.EXPECT().CommitBatch(blockID, gomock.Any()).Return(nil, nil).Times(1)
+				mockFeeCollector.EXPECT().NewDiff(ids.Empty).Return(mockFeeDiff).Times(1)
 
 				return &Block{
 					Block: mockBlock,
@@ -726,6 +739,7 @@ func TestBlockAccept(t *testing.T) {
 				mockBlock := block.NewMockBlock(ctrl)
 				mockBlock.EXPECT().ID().Return(blockID).AnyTimes()
 				mockBlock.EXPECT().Txs().Return([]*txs.Tx{}).AnyTimes()
+				mockBlock.EXPECT().Height().Return(uint64(0)).AnyTimes()
 				mockBlock.EXPECT().AccumulatedFee(ids.ID{}).Return(uint64(100)).Times(1)
 
 				mempool := mempool.NewMockMempool(ctrl)
@@ -738,13 +752,17 @@ func TestBlockAccept(t *testing.T) {
 				mockManagerState.EXPECT().Abort()
 
 				mockSharedMemory := atomic.NewMockSharedMemory(ctrl)
-				mockSharedMemory.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(nil)
+				mockSharedMemory.EXPECT().Apply(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 				mockOnAcceptState := states.NewMockDiff(ctrl)
 				mockOnAcceptState.EXPECT().Apply(mockManagerState)
 
 				mockFeeCollector := feecollector.NewMockFeeCollector(ctrl)
-				mockFeeCollector.EXPECT().AddAChainValue(uint64(100)).Times(1)
+				mockFeeDiff := feecollector.NewMockDiff(ctrl)
+				mockFeeDiff.EXPECT().AddAChainValue(uint64(100)).Times(1)
+				mockFeeDiff.EXPECT().CommitBatch(blockID, gomock.Any()).Return(nil, nil).Times(1)
+				mockFeeDiff.EXPECT().Apply().Times(1)
+				mockFeeCollector.EXPECT().NewDiff(ids.Empty).Return(mockFeeDiff).Times(1)
 
 				metrics := metrics.NewMockMetrics(ctrl)
 				metrics.EXPECT().MarkBlockAccepted(gomock.Any()).Return(errTest)
@@ -794,13 +812,17 @@ func TestBlockAccept(t *testing.T) {
 				mockManagerState.EXPECT().Checksums().Return(ids.Empty, ids.Empty)
 
 				mockSharedMemory := atomic.NewMockSharedMemory(ctrl)
-				mockSharedMemory.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(nil)
+				mockSharedMemory.EXPECT().Apply(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 				mockOnAcceptState := states.NewMockDiff(ctrl)
 				mockOnAcceptState.EXPECT().Apply(mockManagerState)
 
 				mockFeeCollector := feecollector.NewMockFeeCollector(ctrl)
-				mockFeeCollector.EXPECT().AddAChainValue(uint64(100)).Times(1)
+				mockFeeDiff := feecollector.NewMockDiff(ctrl)
+				mockFeeDiff.EXPECT().AddAChainValue(uint64(100)).Times(1)
+				mockFeeDiff.EXPECT().CommitBatch(blockID, gomock.Any()).Return(nil, nil).Times(1)
+				mockFeeDiff.EXPECT().Apply().Times(1)
+				mockFeeCollector.EXPECT().NewDiff(ids.Empty).Return(mockFeeDiff).Times(1)
 
 				metrics := metrics.NewMockMetrics(ctrl)
 				metrics.EXPECT().MarkBlockAccepted(gomock.Any()).Return(nil)
@@ -904,6 +926,7 @@ func TestBlockReject(t *testing.T) {
 				mockPreferredState := states.NewMockDiff(ctrl)
 				mockPreferredState.EXPECT().GetLastAccepted().Return(ids.GenerateTestID()).AnyTimes()
 				mockPreferredState.EXPECT().GetTimestamp().Return(time.Now()).AnyTimes()
+				mockPreferredState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator)).AnyTimes()
 
 				return &Block{
 					Block: mockBlock,
@@ -963,6 +986,7 @@ func TestBlockReject(t *testing.T) {
 				mockPreferredState := states.NewMockDiff(ctrl)
 				mockPreferredState.EXPECT().GetLastAccepted().Return(ids.GenerateTestID()).AnyTimes()
 				mockPreferredState.EXPECT().GetTimestamp().Return(time.Now()).AnyTimes()
+				mockPreferredState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator)).AnyTimes()
 
 				return &Block{
 					Block: mockBlock,
@@ -54,7 +54,7 @@ func NewManager(
 	state states.State,
 	backend *executor.Backend,
 	clk *mockable.Clock,
-	onAccept func(*txs.Tx) error,
+	onAccept func(*txs.Tx, uint64) error,
 ) Manager {
 	lastAccepted := state.GetLastAccepted()
 	return &manager{
@@ -77,9 +77,10 @@ type manager struct {
 	mempool mempool.Mempool
 	clk     *mockable.Clock
 	// Invariant: onAccept is called when [tx] is being marked as accepted, but
-	// before its state changes are applied.
+	// before its state changes are applied. It is provided the height of the
+	// block that accepted [tx].
 	// Invariant: any error returned by onAccept should be considered fatal.
-	onAccept func(*txs.Tx) error
+	onAccept func(*txs.Tx, uint64) error
 
 	// blkIDToState is a map from a block's ID to the state of the block.
 	// Blocks are put into this map when they are verified.
@@ -18,6 +18,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/alpha/states"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/executor"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 )
 
 var (
@@ -167,6 +168,7 @@ func TestManagerVerifyTx(t *testing.T) {
 				state := states.NewMockState(ctrl)
 				state.EXPECT().GetLastAccepted().Return(preferred)
 				state.EXPECT().GetTimestamp().Return(time.Time{})
+				state.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				return &manager{
 					backend: &executor.Backend{
@@ -200,6 +202,7 @@ func TestManagerVerifyTx(t *testing.T) {
 				state := states.NewMockState(ctrl)
 				state.EXPECT().GetLastAccepted().Return(preferred)
 				state.EXPECT().GetTimestamp().Return(time.Time{})
+				state.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				return &manager{
 					backend: &executor.Backend{
@@ -240,6 +243,7 @@ func TestManagerVerifyTx(t *testing.T) {
 				diffState := states.NewMockDiff(ctrl)
 				diffState.EXPECT().GetLastAccepted().Return(preferredID)
 				diffState.EXPECT().GetTimestamp().Return(time.Time{})
+				diffState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				return &manager{
 					backend: &executor.Backend{
@@ -279,6 +283,7 @@ func TestManagerVerifyTx(t *testing.T) {
 				state := states.NewMockState(ctrl)
 				state.EXPECT().GetLastAccepted().Return(preferred)
 				state.EXPECT().GetTimestamp().Return(time.Time{})
+				state.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator))
 
 				return &manager{
 					backend: &executor.Backend{
@@ -30,6 +30,8 @@ type Client interface {
 	GetBlockByHeight(ctx context.Context, height uint64, options ...rpc.Option) ([]byte, error)
 	// GetHeight returns the height of the last accepted block.
 	GetHeight(ctx context.Context, options ...rpc.Option) (uint64, error)
+	// GetFeeRates returns the fees charged to the txs of the next block
+	GetFeeRates(ctx context.Context, options ...rpc.Option) (*GetFeeRatesReply, error)
 	// GetTxStatus returns the status of [txID]
 	//
 	// Deprecated: GetTxStatus only returns Accepted or Unknown, GetTx should be
@@ -65,6 +67,48 @@ type Client interface {
 	) ([][]byte, ids.ShortID, ids.ID, error)
 	// GetAssetDescription returns a description of [assetID]
 	GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (*GetAssetDescriptionReply, error)
+	// GetAssetSupply returns the minted, burned, and outstanding amounts of
+	// the mintable asset [assetID]. The supply of an asset created before the
+	// supply tracking was deployed isn't tracked.
+	GetAssetSupply(ctx context.Context, assetID string, options ...rpc.Option) (*GetAssetSupplyReply, error)
+	// ListAssets returns at most [pageSize] assets, in order of creation,
+	// starting at the [cursor]th asset. Also returns the cursor of the next
+	// page. Requires the asset index to be enabled.
+	ListAssets(ctx context.Context, cursor uint64, pageSize uint64, options ...rpc.Option) ([]IndexedAsset, uint64, error)
+	// SearchAssets returns at most [limit] assets, in order of creation, whose
+	// symbol or name words are prefixed by every word of [query]. If [creator]
+	// isn't empty, only the assets it created are returned. Requires the asset
+	// index to be enabled.
+	SearchAssets(ctx context.Context, query string, creator ids.ShortID, limit uint64, options ...rpc.Option) ([]IndexedAsset, error)
+	// GetNFTsByOwner returns at most [limit] NFTs currently owned by [addr],
+	// ordered by asset, group, and ID. If [assetID] isn't empty, only the NFTs
+	// of the asset are returned. If [groupID] is non-nil, only the NFTs of the
+	// group of the asset are returned. If [startAfter] isn't empty, the NFTs up
+	// to and including it are skipped. Requires the NFT index to be enabled.
+	GetNFTsByOwner(
+		ctx context.Context,
+		addr ids.ShortID,
+		assetID string,
+		groupID *uint32,
+		startAfter ids.ID,
+		limit uint64,
+		options ...rpc.Option,
+	) ([]IndexedNFT, error)
+	// GetNFTGroup returns at most [limit] NFTs of the group [groupID] of
+	// [assetID], ordered by ID. If [startAfter] isn't empty, the NFTs up to
+	// and including it are skipped. Requires the NFT index to be enabled.
+	GetNFTGroup(
+		ctx context.Context,
+		assetID string,
+		groupID uint32,
+		startAfter ids.ID,
+		limit uint64,
+		options ...rpc.Option,
+	) ([]IndexedNFT, error)
+	// GetNFTHistory returns the NFT [nftID] and at most [limit] of its
+	// transfers, oldest first, starting at the [cursor]th transfer. Requires
+	// the NFT index to be enabled.
+	GetNFTHistory(ctx context.Context, nftID ids.ID, cursor uint64, limit uint64, options ...rpc.Option) (*GetNFTHistoryReply, error)
 	// GetBalance returns the balance of [assetID] held by [addr].
 	// If [includePartial], balance includes partial owned (i.e. in a multisig) funds.
 	//
@@ -74,6 +118,9 @@ type Client interface {
 	//
 	// Deprecated: GetUTXOs should be used instead.
 	GetAllBalances(ctx context.Context, addr ids.ShortID, includePartial bool, options ...rpc.Option) ([]Balance, error)
+	// GetHTLCs returns the hashed timelock contracts that [addrs] are either the
+	// recipient or the sender of
+	GetHTLCs(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) ([]HTLC, error)
 	// CreateAsset creates a new asset and returns its assetID
 	//
 	// Deprecated: Transactions should be issued using the
@@ -264,6 +311,12 @@ func (c *client) GetHeight(ctx context.Context, options ...rpc.Option) (uint64,
 	return uint64(res.Height), err
 }
 
+func (c *client) GetFeeRates(ctx context.Context, options ...rpc.Option) (*GetFeeRatesReply, error) {
+	res := &GetFeeRatesReply{}
+	err := c.requester.SendRequest(ctx, "alpha.getFeeRates", struct{}{}, res, options...)
+	return res, err
+}
+
 func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (ids.ID, error) {
 	txStr, err := formatting.Encode(formatting.Hex, txBytes)
 	if err != nil {
@@ -376,6 +429,101 @@ func (c *client) GetAssetDescription(ctx context.Context, assetID string, option
 	return res, err
 }
 
+func (c *client) GetAssetSupply(ctx context.Context, assetID string, options ...rpc.Option) (*GetAssetSupplyReply, error) {
+	res := &GetAssetSupplyReply{}
+	err := c.requester.SendRequest(ctx, "alpha.getAssetSupply", &GetAssetSupplyArgs{
+		AssetID: assetID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) ListAssets(
+	ctx context.Context,
+	cursor uint64,
+	pageSize uint64,
+	options ...rpc.Option,
+) ([]IndexedAsset, uint64, error) {
+	res := &ListAssetsReply{}
+	err := c.requester.SendRequest(ctx, "alpha.listAssets", &ListAssetsArgs{
+		Cursor:   json.Uint64(cursor),
+		PageSize: json.Uint64(pageSize),
+	}, res, options...)
+	return res.Assets, uint64(res.Cursor), err
+}
+
+func (c *client) SearchAssets(
+	ctx context.Context,
+	query string,
+	creator ids.ShortID,
+	limit uint64,
+	options ...rpc.Option,
+) ([]IndexedAsset, error) {
+	args := &SearchAssetsArgs{
+		Query: query,
+		Limit: json.Uint64(limit),
+	}
+	if creator != ids.ShortEmpty {
+		args.Creator = creator.String()
+	}
+	res := &SearchAssetsReply{}
+	err := c.requester.SendRequest(ctx, "alpha.searchAssets", args, res, options...)
+	return res.Assets, err
+}
+
+func (c *client) GetNFTsByOwner(
+	ctx context.Context,
+	addr ids.ShortID,
+	assetID string,
+	groupID *uint32,
+	startAfter ids.ID,
+	limit uint64,
+	options ...rpc.Option,
+) ([]IndexedNFT, error) {
+	res := &GetNFTsReply{}
+	err := c.requester.SendRequest(ctx, "alpha.getNFTsByOwner", &GetNFTsByOwnerArgs{
+		Address:    addr.String(),
+		AssetID:    assetID,
+		GroupID:    (*json.Uint32)(groupID),
+		StartAfter: startAfter,
+		Limit:      json.Uint64(limit),
+	}, res, options...)
+	return res.NFTs, err
+}
+
+func (c *client) GetNFTGroup(
+	ctx context.Context,
+	assetID string,
+	groupID uint32,
+	startAfter ids.ID,
+	limit uint64,
+	options ...rpc.Option,
+) ([]IndexedNFT, error) {
+	res := &GetNFTsReply{}
+	err := c.requester.SendRequest(ctx, "alpha.getNFTGroup", &GetNFTGroupArgs{
+		AssetID:    assetID,
+		GroupID:    json.Uint32(groupID),
+		StartAfter: startAfter,
+		Limit:      json.Uint64(limit),
+	}, res, options...)
+	return res.NFTs, err
+}
+
+func (c *client) GetNFTHistory(
+	ctx context.Context,
+	nftID ids.ID,
+	cursor uint64,
+	limit uint64,
+	options ...rpc.Option,
+) (*GetNFTHistoryReply, error) {
+	res := &GetNFTHistoryReply{}
+	err := c.requester.SendRequest(ctx, "alpha.getNFTHistory", &GetNFTHistoryArgs{
+		NFTID:  nftID,
+		Cursor: json.Uint64(cursor),
+		Limit:  json.Uint64(limit),
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetBalance(
 	ctx context.Context,
 	addr ids.ShortID,
@@ -406,6 +554,18 @@ func (c *client) GetAllBalances(
 	return res.Balances, err
 }
 
+func (c *client) GetHTLCs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	options ...rpc.Option,
+) ([]HTLC, error) {
+	res := &GetHTLCsReply{}
+	err := c.requester.SendRequest(ctx, "alpha.getHTLCs", &api.JSONAddresses{
+		Addresses: ids.ShortIDsToStrings(addrs),
+	}, res, options...)
+	return res.HTLCs, err
+}
+
 // ClientHolder describes how much an address owns of an asset
 type ClientHolder struct {
 	Amount  uint64
@@ -3,6 +3,12 @@
 
 package config
 
+import (
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
+)
+
 // Struct collecting all the foundational parameters of the ALPHA
 type Config struct {
 	// Fee that is burned by every non-asset creating transaction
@@ -10,4 +16,48 @@ type Config struct {
 
 	// Fee that must be burned by every asset creating transaction
 	CreateAssetTxFee uint64
+
+	// Adjustment of the fees from the block sizes once the dynamic fee network
+	// upgrade is activated
+	DynamicFeeConfig fees.DynamicFeeConfig
+
+	// Time of the dynamic fee network upgrade
+	DynamicFeeTime time.Time
+
+	// Time of the HTLC network upgrade, from which assets can be locked by
+	// the htlcfx
+	HTLCTime time.Time
+
+	// Time of the network upgrade allowing the secp256k1fx weighted owners to
+	// be used
+	WeightedOwnersTime time.Time
+}
+
+func (c *Config) IsDynamicFeeActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.DynamicFeeTime)
+}
+
+func (c *Config) IsHTLCActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.HTLCTime)
+}
+
+func (c *Config) IsWeightedOwnersActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.WeightedOwnersTime)
+}
+
+// GetFeeRate returns the rate the static fees are charged at on top of a state
+// with [feeRate] and [timestamp].
+func (c *Config) GetFeeRate(feeRate uint64, timestamp time.Time) uint64 {
+	if !c.DynamicFeeConfig.IsEnabled() || !c.IsDynamicFeeActivated(timestamp) {
+		return fees.RateDenominator
+	}
+	return feeRate
+}
+
+// GetMinFee returns the lowest amount the static [fee] can be charged at.
+func (c *Config) GetMinFee(fee uint64) uint64 {
+	if !c.DynamicFeeConfig.IsEnabled() {
+		return fee
+	}
+	return fees.CalculateFee(fee, c.DynamicFeeConfig.MinFeeRate)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
 	"github.com/DioneProtocol/odysseygo/vms/nftfx"
 	"github.com/DioneProtocol/odysseygo/vms/propertyfx"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
@@ -17,6 +18,7 @@ var (
 	_ Fx = (*secp256k1fx.Fx)(nil)
 	_ Fx = (*nftfx.Fx)(nil)
 	_ Fx = (*propertyfx.Fx)(nil)
+	_ Fx = (*htlcfx.Fx)(nil)
 )
 
 type ParsedFx struct {
@@ -0,0 +1,374 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package nfts
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/index"
+	"github.com/DioneProtocol/odysseygo/vms/nftfx"
+)
+
+var (
+	_ Indexer = (*indexer)(nil)
+	_ Indexer = (*noIndexer)(nil)
+
+	ErrIndexingDisabled  = errors.New("NFT indexing is disabled")
+	ErrGroupWithoutAsset = errors.New("filtering by group requires an asset")
+
+	nftPrefix     = []byte("nft")
+	utxoPrefix    = []byte("utxo")
+	ownerPrefix   = []byte("owner")
+	groupPrefix   = []byte("group")
+	historyPrefix = []byte("history")
+)
+
+// Indexer maintains the NFTs minted on the chain, queryable by their owners
+// and groups.
+type Indexer interface {
+	// Accept is called when [tx] is accepted by the block at [height].
+	// If the error is non-nil, do not persist [tx] to disk as accepted in the
+	// VM.
+	Accept(tx *txs.Tx, height uint64) error
+
+	// Get returns the NFT [nftID]. Returns [database.ErrNotFound] if the NFT
+	// isn't indexed.
+	Get(nftID ids.ID) (*NFT, error)
+
+	// ByOwner returns the NFTs currently owned by [owner], ordered by asset,
+	// group, and ID. If [assetID] isn't [ids.Empty], only the NFTs of the
+	// asset are returned. If [groupID] is non-nil, only the NFTs of the group
+	// are returned.
+	// If [startAfter] isn't [ids.Empty], the NFTs up to and including the NFT
+	// [startAfter] are skipped.
+	// The length of the returned slice <= [limit].
+	ByOwner(
+		owner ids.ShortID,
+		assetID ids.ID,
+		groupID *uint32,
+		startAfter ids.ID,
+		limit int,
+	) ([]*NFT, error)
+
+	// Group returns the NFTs of the group [groupID] of the asset [assetID],
+	// ordered by ID. If [startAfter] isn't [ids.Empty], the NFTs up to and
+	// including the NFT [startAfter] are skipped.
+	// The length of the returned slice <= [limit].
+	Group(assetID ids.ID, groupID uint32, startAfter ids.ID, limit int) ([]*NFT, error)
+
+	// History returns the transfers of the NFT [nftID], oldest first,
+	// starting at the [cursor]th transfer.
+	// The length of the returned slice <= [limit].
+	History(nftID ids.ID, cursor uint64, limit int) ([]*Transfer, error)
+}
+
+/*
+ * DB
+ * |-- complete -> true if every NFT was indexed
+ * |-. nft
+ * | '-- nftID -> NFT
+ * |-. utxo
+ * | '-- utxoID -> nftID
+ * |-. owner
+ * | '-- address + assetID + groupID + nftID -> nil
+ * |-. group
+ * | '-- assetID + groupID + nftID -> nil
+ * '-. history
+ *   '-- nftID + index -> Transfer
+ */
+type indexer struct {
+	nftDB     database.Database
+	utxoDB    database.Database
+	ownerDB   database.Database
+	groupDB   database.Database
+	historyDB database.Database
+}
+
+// NewIndexer returns a new Indexer persisting to [db]. [chainInitialized]
+// should be true if the chain has accepted transactions before, as they
+// wouldn't be indexed.
+func NewIndexer(
+	db database.Database,
+	chainInitialized bool,
+	allowIncompleteIndices bool,
+) (Indexer, error) {
+	if err := index.CheckIndexStatus(db, true, chainInitialized, allowIncompleteIndices); err != nil {
+		return nil, err
+	}
+	return &indexer{
+		nftDB:     prefixdb.New(nftPrefix, db),
+		utxoDB:    prefixdb.New(utxoPrefix, db),
+		ownerDB:   prefixdb.New(ownerPrefix, db),
+		groupDB:   prefixdb.New(groupPrefix, db),
+		historyDB: prefixdb.New(historyPrefix, db),
+	}, nil
+}
+
+func (i *indexer) Accept(tx *txs.Tx, height uint64) error {
+	txID := tx.ID()
+	utxos := tx.UTXOs()
+	switch utx := tx.Unsigned.(type) {
+	case *txs.CreateAssetTx:
+		// NFTs can be minted by the initial states of the asset
+		return i.mintAll(txID, height, utxos)
+	case *txs.OperationTx:
+		// The UTXOs produced by the operations follow the outputs of the
+		// transaction, in the order of the operations
+		outputIndex := len(utx.Outs)
+		for _, op := range utx.Ops {
+			numOuts := len(op.Op.Outs())
+			produced := utxos[outputIndex : outputIndex+numOuts]
+			outputIndex += numOuts
+
+			var err error
+			if _, ok := op.Op.(*nftfx.TransferOperation); ok {
+				err = i.transfer(txID, height, op.UTXOIDs[0].InputID(), produced[0])
+			} else {
+				err = i.mintAll(txID, height, produced)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mintAll indexes the NFTs held by [utxos].
+func (i *indexer) mintAll(txID ids.ID, height uint64, utxos []*dione.UTXO) error {
+	for _, utxo := range utxos {
+		out, ok := utxo.Out.(*nftfx.TransferOutput)
+		if !ok {
+			continue
+		}
+
+		nftID := utxo.InputID()
+		nft := &NFT{
+			ID:      nftID,
+			AssetID: utxo.AssetID(),
+			GroupID: out.GroupID,
+			Payload: out.Payload,
+		}
+		if err := i.groupDB.Put(groupKey(nft.AssetID, nft.GroupID, nftID), nil); err != nil {
+			return err
+		}
+		if err := i.move(nft, txID, height, utxo.InputID(), out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transfer moves the NFT held by [spentUTXOID] into [utxo]. NFTs minted
+// before indexing was enabled are ignored.
+func (i *indexer) transfer(txID ids.ID, height uint64, spentUTXOID ids.ID, utxo *dione.UTXO) error {
+	nftIDBytes, err := i.utxoDB.Get(spentUTXOID[:])
+	if err == database.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	nftID, err := ids.ToID(nftIDBytes)
+	if err != nil {
+		return err
+	}
+	nft, err := i.Get(nftID)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range nft.Owners.Addrs {
+		if err := i.ownerDB.Delete(ownerKey(addr, nft.AssetID, nft.GroupID, nftID)); err != nil {
+			return err
+		}
+	}
+	if err := i.utxoDB.Delete(spentUTXOID[:]); err != nil {
+		return err
+	}
+
+	out, ok := utxo.Out.(*nftfx.TransferOutput)
+	if !ok {
+		// should never happen because the transfer was verified
+		return nil
+	}
+	return i.move(nft, txID, height, utxo.InputID(), out)
+}
+
+// move records that [nft] is now held by the UTXO [utxoID] with the output
+// [out].
+func (i *indexer) move(nft *NFT, txID ids.ID, height uint64, utxoID ids.ID, out *nftfx.TransferOutput) error {
+	transfer := &Transfer{
+		TxID:   txID,
+		Height: height,
+		UTXOID: utxoID,
+		Owners: out.OutputOwners,
+	}
+	transferBytes, err := Codec.Marshal(CodecVersion, transfer)
+	if err != nil {
+		return err
+	}
+	if err := i.historyDB.Put(historyKey(nft.ID, nft.NumTransfers), transferBytes); err != nil {
+		return err
+	}
+
+	nft.UTXOID = utxoID
+	nft.Owners = out.OutputOwners
+	nft.NumTransfers++
+	nftBytes, err := Codec.Marshal(CodecVersion, nft)
+	if err != nil {
+		return err
+	}
+	if err := i.nftDB.Put(nft.ID[:], nftBytes); err != nil {
+		return err
+	}
+	if err := i.utxoDB.Put(utxoID[:], nft.ID[:]); err != nil {
+		return err
+	}
+	for _, addr := range nft.Owners.Addrs {
+		if err := i.ownerDB.Put(ownerKey(addr, nft.AssetID, nft.GroupID, nft.ID), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *indexer) Get(nftID ids.ID) (*NFT, error) {
+	nftBytes, err := i.nftDB.Get(nftID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	nft := &NFT{}
+	_, err = Codec.Unmarshal(nftBytes, nft)
+	return nft, err
+}
+
+func (i *indexer) ByOwner(
+	owner ids.ShortID,
+	assetID ids.ID,
+	groupID *uint32,
+	startAfter ids.ID,
+	limit int,
+) ([]*NFT, error) {
+	prefix := owner[:]
+	switch {
+	case groupID != nil && assetID == ids.Empty:
+		return nil, ErrGroupWithoutAsset
+	case groupID != nil:
+		prefix = append(owner[:], groupKeyPrefix(assetID, *groupID)...)
+	case assetID != ids.Empty:
+		prefix = append(owner[:], assetID[:]...)
+	}
+
+	var start []byte
+	if startAfter != ids.Empty {
+		nft, err := i.Get(startAfter)
+		if err != nil {
+			return nil, err
+		}
+		start = ownerKey(owner, nft.AssetID, nft.GroupID, nft.ID)
+	}
+	return i.nftsWithPrefix(i.ownerDB, start, prefix, limit)
+}
+
+func (i *indexer) Group(assetID ids.ID, groupID uint32, startAfter ids.ID, limit int) ([]*NFT, error) {
+	prefix := groupKeyPrefix(assetID, groupID)
+
+	var start []byte
+	if startAfter != ids.Empty {
+		start = groupKey(assetID, groupID, startAfter)
+	}
+	return i.nftsWithPrefix(i.groupDB, start, prefix, limit)
+}
+
+// nftsWithPrefix returns the NFTs that end the keys of [db] starting with
+// [prefix], skipping the keys up to and including [start].
+func (i *indexer) nftsWithPrefix(db database.Iteratee, start, prefix []byte, limit int) ([]*NFT, error) {
+	iter := db.NewIteratorWithStartAndPrefix(start, prefix)
+	defer iter.Release()
+
+	nfts := []*NFT{}
+	for len(nfts) < limit && iter.Next() {
+		key := iter.Key()
+		if bytes.Equal(key, start) {
+			continue
+		}
+		nftID, err := ids.ToID(key[len(key)-ids.IDLen:])
+		if err != nil {
+			return nil, err
+		}
+		nft, err := i.Get(nftID)
+		if err != nil {
+			return nil, err
+		}
+		nfts = append(nfts, nft)
+	}
+	return nfts, iter.Error()
+}
+
+func (i *indexer) History(nftID ids.ID, cursor uint64, limit int) ([]*Transfer, error) {
+	iter := i.historyDB.NewIteratorWithStartAndPrefix(historyKey(nftID, cursor), nftID[:])
+	defer iter.Release()
+
+	transfers := []*Transfer{}
+	for len(transfers) < limit && iter.Next() {
+		transfer := &Transfer{}
+		if _, err := Codec.Unmarshal(iter.Value(), transfer); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers, iter.Error()
+}
+
+func ownerKey(owner ids.ShortID, assetID ids.ID, groupID uint32, nftID ids.ID) []byte {
+	return append(owner[:], groupKey(assetID, groupID, nftID)...)
+}
+
+func groupKey(assetID ids.ID, groupID uint32, nftID ids.ID) []byte {
+	return append(groupKeyPrefix(assetID, groupID), nftID[:]...)
+}
+
+// groupKeyPrefix returns the prefix of the keys of the NFTs of the group
+// [groupID] of the asset [assetID].
+func groupKeyPrefix(assetID ids.ID, groupID uint32) []byte {
+	return append(assetID[:], database.PackUInt32(groupID)...)
+}
+
+func historyKey(nftID ids.ID, index uint64) []byte {
+	return append(nftID[:], database.PackUInt64(index)...)
+}
+
+type noIndexer struct{}
+
+func NewNoIndexer(db database.Database, allowIncomplete bool) (Indexer, error) {
+	return &noIndexer{}, index.CheckIndexStatus(db, false, true, allowIncomplete)
+}
+
+func (*noIndexer) Accept(*txs.Tx, uint64) error {
+	return nil
+}
+
+func (*noIndexer) Get(ids.ID) (*NFT, error) {
+	return nil, ErrIndexingDisabled
+}
+
+func (*noIndexer) ByOwner(ids.ShortID, ids.ID, *uint32, ids.ID, int) ([]*NFT, error) {
+	return nil, ErrIndexingDisabled
+}
+
+func (*noIndexer) Group(ids.ID, uint32, ids.ID, int) ([]*NFT, error) {
+	return nil, ErrIndexingDisabled
+}
+
+func (*noIndexer) History(ids.ID, uint64, int) ([]*Transfer, error) {
+	return nil, ErrIndexingDisabled
+}
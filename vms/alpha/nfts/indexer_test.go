@@ -0,0 +1,316 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package nfts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/fxs"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/nftfx"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func newOwners(addr ids.ShortID) secp256k1fx.OutputOwners {
+	return secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}
+}
+
+func newTransferTx(t *testing.T, parser txs.Parser, utxoID *dione.UTXOID, nft *NFT, to ids.ShortID) *txs.Tx {
+	tx := &txs.Tx{Unsigned: &txs.OperationTx{
+		Ops: []*txs.Operation{{
+			Asset:   dione.Asset{ID: nft.AssetID},
+			UTXOIDs: []*dione.UTXOID{utxoID},
+			Op: &nftfx.TransferOperation{
+				Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+				Output: nftfx.TransferOutput{
+					GroupID:      nft.GroupID,
+					Payload:      nft.Payload,
+					OutputOwners: newOwners(to),
+				},
+			},
+		}},
+	}}
+	require.NoError(t, parser.InitializeTx(tx))
+	return tx
+}
+
+func TestIndexer(t *testing.T) {
+	require := require.New(t)
+
+	parser, err := txs.NewParser([]fxs.Fx{
+		&secp256k1fx.Fx{},
+		&nftfx.Fx{},
+	})
+	require.NoError(err)
+
+	db := memdb.New()
+	indexer, err := NewIndexer(db, false, false)
+	require.NoError(err)
+
+	alice := ids.ShortID{1}
+	bob := ids.ShortID{2}
+
+	// The asset mints an NFT of group 0 to alice
+	createAssetTx := &txs.Tx{Unsigned: &txs.CreateAssetTx{
+		Name:   "Tickets",
+		Symbol: "TIX",
+		States: []*txs.InitialState{{
+			FxIndex: 1,
+			Outs: []verify.State{
+				&nftfx.TransferOutput{
+					Payload:      []byte("first"),
+					OutputOwners: newOwners(alice),
+				},
+				&nftfx.MintOutput{
+					GroupID:      1,
+					OutputOwners: newOwners(alice),
+				},
+			},
+		}},
+	}}
+	require.NoError(parser.InitializeTx(createAssetTx))
+	require.NoError(indexer.Accept(createAssetTx, 0))
+	assetID := createAssetTx.ID()
+
+	// Mint two NFTs of group 1, to alice and bob
+	mintTx := &txs.Tx{Unsigned: &txs.OperationTx{
+		Ops: []*txs.Operation{{
+			Asset: dione.Asset{ID: assetID},
+			UTXOIDs: []*dione.UTXOID{
+				&createAssetTx.UTXOs()[1].UTXOID,
+			},
+			Op: &nftfx.MintOperation{
+				MintInput: secp256k1fx.Input{SigIndices: []uint32{0}},
+				GroupID:   1,
+				Payload:   []byte("second"),
+				Outputs: []*secp256k1fx.OutputOwners{
+					{Threshold: 1, Addrs: []ids.ShortID{alice}},
+					{Threshold: 1, Addrs: []ids.ShortID{bob}},
+				},
+			},
+		}},
+	}}
+	require.NoError(parser.InitializeTx(mintTx))
+	require.NoError(indexer.Accept(mintTx, 5))
+
+	firstNFTID := createAssetTx.UTXOs()[0].InputID()
+	aliceNFTID := mintTx.UTXOs()[0].InputID()
+	bobNFTID := mintTx.UTXOs()[1].InputID()
+
+	// Alice sends her NFT of group 1 to bob
+	aliceNFT, err := indexer.Get(aliceNFTID)
+	require.NoError(err)
+	transferTx := newTransferTx(t, parser, &mintTx.UTXOs()[0].UTXOID, aliceNFT, bob)
+	require.NoError(indexer.Accept(transferTx, 6))
+
+	// Reload the index to make sure everything was persisted
+	indexer, err = NewIndexer(db, true, false)
+	require.NoError(err)
+
+	expectedFirst := &NFT{
+		ID:           firstNFTID,
+		AssetID:      assetID,
+		GroupID:      0,
+		Payload:      []byte("first"),
+		UTXOID:       firstNFTID,
+		Owners:       newOwners(alice),
+		NumTransfers: 1,
+	}
+	expectedAlice := &NFT{
+		ID:           aliceNFTID,
+		AssetID:      assetID,
+		GroupID:      1,
+		Payload:      []byte("second"),
+		UTXOID:       transferTx.UTXOs()[0].InputID(),
+		Owners:       newOwners(bob),
+		NumTransfers: 2,
+	}
+	expectedBob := &NFT{
+		ID:           bobNFTID,
+		AssetID:      assetID,
+		GroupID:      1,
+		Payload:      []byte("second"),
+		UTXOID:       bobNFTID,
+		Owners:       newOwners(bob),
+		NumTransfers: 1,
+	}
+
+	// Bob's NFTs are ordered by ID within the group
+	expectedBobNFTs := []*NFT{expectedAlice, expectedBob}
+	if bobNFTID.Less(aliceNFTID) {
+		expectedBobNFTs = []*NFT{expectedBob, expectedAlice}
+	}
+
+	group0 := uint32(0)
+	group1 := uint32(1)
+	tests := []struct {
+		name       string
+		owner      ids.ShortID
+		assetID    ids.ID
+		groupID    *uint32
+		startAfter ids.ID
+		limit      int
+		expected   []*NFT
+	}{
+		{
+			name:     "all of alice",
+			owner:    alice,
+			limit:    10,
+			expected: []*NFT{expectedFirst},
+		},
+		{
+			name:     "all of bob",
+			owner:    bob,
+			limit:    10,
+			expected: expectedBobNFTs,
+		},
+		{
+			name:     "asset",
+			owner:    bob,
+			assetID:  assetID,
+			limit:    10,
+			expected: expectedBobNFTs,
+		},
+		{
+			name:     "empty group",
+			owner:    bob,
+			assetID:  assetID,
+			groupID:  &group0,
+			limit:    10,
+			expected: []*NFT{},
+		},
+		{
+			name:     "group",
+			owner:    bob,
+			assetID:  assetID,
+			groupID:  &group1,
+			limit:    10,
+			expected: expectedBobNFTs,
+		},
+		{
+			name:     "limited",
+			owner:    bob,
+			limit:    1,
+			expected: expectedBobNFTs[:1],
+		},
+		{
+			name:       "start after",
+			owner:      bob,
+			startAfter: expectedBobNFTs[0].ID,
+			limit:      10,
+			expected:   expectedBobNFTs[1:],
+		},
+		{
+			name:     "unknown asset",
+			owner:    bob,
+			assetID:  ids.GenerateTestID(),
+			limit:    10,
+			expected: []*NFT{},
+		},
+	}
+	for _, test := range tests {
+		nfts, err := indexer.ByOwner(test.owner, test.assetID, test.groupID, test.startAfter, test.limit)
+		require.NoError(err, test.name)
+		require.Equal(test.expected, nfts, test.name)
+	}
+
+	_, err = indexer.ByOwner(bob, ids.Empty, &group1, ids.Empty, 10)
+	require.ErrorIs(err, ErrGroupWithoutAsset)
+
+	nfts, err := indexer.Group(assetID, 1, ids.Empty, 10)
+	require.NoError(err)
+	require.Equal(expectedBobNFTs, nfts)
+
+	nfts, err = indexer.Group(assetID, 1, expectedBobNFTs[0].ID, 10)
+	require.NoError(err)
+	require.Equal(expectedBobNFTs[1:], nfts)
+
+	nfts, err = indexer.Group(assetID, 0, ids.Empty, 10)
+	require.NoError(err)
+	require.Equal([]*NFT{expectedFirst}, nfts)
+
+	history, err := indexer.History(aliceNFTID, 0, 10)
+	require.NoError(err)
+	require.Equal([]*Transfer{
+		{
+			TxID:   mintTx.ID(),
+			Height: 5,
+			UTXOID: aliceNFTID,
+			Owners: newOwners(alice),
+		},
+		{
+			TxID:   transferTx.ID(),
+			Height: 6,
+			UTXOID: transferTx.UTXOs()[0].InputID(),
+			Owners: newOwners(bob),
+		},
+	}, history)
+
+	history, err = indexer.History(aliceNFTID, 1, 10)
+	require.NoError(err)
+	require.Len(history, 1)
+	require.Equal(transferTx.ID(), history[0].TxID)
+
+	history, err = indexer.History(firstNFTID, 0, 10)
+	require.NoError(err)
+	require.Len(history, 1)
+	require.Equal(uint64(0), history[0].Height)
+
+	_, err = indexer.Get(ids.GenerateTestID())
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestIndexerIgnoresUnindexedNFTs(t *testing.T) {
+	require := require.New(t)
+
+	parser, err := txs.NewParser([]fxs.Fx{
+		&secp256k1fx.Fx{},
+		&nftfx.Fx{},
+	})
+	require.NoError(err)
+
+	indexer, err := NewIndexer(memdb.New(), true, true)
+	require.NoError(err)
+
+	// The NFT was minted before indexing was enabled
+	owner := ids.GenerateTestShortID()
+	transferTx := newTransferTx(
+		t,
+		parser,
+		&dione.UTXOID{TxID: ids.GenerateTestID()},
+		&NFT{AssetID: ids.GenerateTestID()},
+		owner,
+	)
+	require.NoError(indexer.Accept(transferTx, 1))
+
+	nfts, err := indexer.ByOwner(owner, ids.Empty, nil, ids.Empty, 10)
+	require.NoError(err)
+	require.Empty(nfts)
+}
+
+func TestNoIndexer(t *testing.T) {
+	require := require.New(t)
+
+	indexer, err := NewNoIndexer(memdb.New(), false)
+	require.NoError(err)
+
+	_, err = indexer.Get(ids.GenerateTestID())
+	require.ErrorIs(err, ErrIndexingDisabled)
+	_, err = indexer.ByOwner(ids.GenerateTestShortID(), ids.Empty, nil, ids.Empty, 10)
+	require.ErrorIs(err, ErrIndexingDisabled)
+	_, err = indexer.Group(ids.GenerateTestID(), 0, ids.Empty, 10)
+	require.ErrorIs(err, ErrIndexingDisabled)
+	_, err = indexer.History(ids.GenerateTestID(), 0, 10)
+	require.ErrorIs(err, ErrIndexingDisabled)
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package nfts
+
+import (
+	"github.com/DioneProtocol/odysseygo/codec"
+	"github.com/DioneProtocol/odysseygo/codec/linearcodec"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+// CodecVersion is the current default codec version
+const CodecVersion = 0
+
+// Codec is used to serialize the indexed NFTs
+var Codec codec.Manager
+
+func init() {
+	c := linearcodec.NewDefault()
+	Codec = codec.NewDefaultManager()
+
+	if err := Codec.RegisterCodec(CodecVersion, c); err != nil {
+		panic(err)
+	}
+}
+
+// NFT is an nftfx.TransferOutput followed across its transfers.
+type NFT struct {
+	// ID of the UTXO the NFT was minted in
+	ID      ids.ID `serialize:"true"`
+	AssetID ids.ID `serialize:"true"`
+	GroupID uint32 `serialize:"true"`
+	Payload []byte `serialize:"true"`
+
+	// UTXO currently holding the NFT
+	UTXOID ids.ID                   `serialize:"true"`
+	Owners secp256k1fx.OutputOwners `serialize:"true"`
+
+	// Number of times the NFT changed hands, counting its mint
+	NumTransfers uint64 `serialize:"true"`
+}
+
+// Transfer is a change of the owners of an NFT. The first transfer of every
+// NFT is its mint.
+type Transfer struct {
+	TxID ids.ID `serialize:"true"`
+	// Height of the block that accepted the transaction. NFTs minted in
+	// genesis, or before the chain was linearized, have a height of 0.
+	Height uint64                   `serialize:"true"`
+	UTXOID ids.ID                   `serialize:"true"`
+	Owners secp256k1fx.OutputOwners `serialize:"true"`
+}
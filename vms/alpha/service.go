@@ -21,12 +21,17 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/json"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/assets"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/nfts"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/components/keystore"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
 	"github.com/DioneProtocol/odysseygo/vms/nftfx"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+	"github.com/DioneProtocol/odysseygo/vms/types"
 
 	safemath "github.com/DioneProtocol/odysseygo/utils/math"
 )
@@ -51,6 +56,8 @@ var (
 	errNoKeys             = errors.New("from addresses have no keys or funds")
 	errMissingPrivateKey  = errors.New("argument 'privateKey' not given")
 	errNotLinearized      = errors.New("chain is not linearized")
+	errSupplyNotTracked   = errors.New("supply of the asset isn't tracked, as it isn't mintable or predates the supply tracking")
+	errUnknownNFT         = errors.New("unknown NFT")
 )
 
 // FormattedAssetID defines a JSON formatted struct containing an assetID as a string
@@ -180,6 +187,28 @@ func (s *Service) GetHeight(_ *http.Request, _ *struct{}, reply *api.GetHeightRe
 	return nil
 }
 
+// GetFeeRatesReply is the response from GetFeeRates
+type GetFeeRatesReply struct {
+	// Rate, in parts of 1000000, the static fees are charged at
+	FeeRate          json.Uint64 `json:"feeRate"`
+	TxFee            json.Uint64 `json:"txFee"`
+	CreateAssetTxFee json.Uint64 `json:"createAssetTxFee"`
+}
+
+// GetFeeRates returns the fees charged to the txs of the next block.
+func (s *Service) GetFeeRates(_ *http.Request, _ *struct{}, reply *GetFeeRatesReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "getFeeRates"),
+	)
+
+	feeRate := s.vm.GetFeeRate(s.vm.state.GetFeeRate(), s.vm.state.GetTimestamp())
+	reply.FeeRate = json.Uint64(feeRate)
+	reply.TxFee = json.Uint64(fees.CalculateFee(s.vm.TxFee, feeRate))
+	reply.CreateAssetTxFee = json.Uint64(fees.CalculateFee(s.vm.CreateAssetTxFee, feeRate))
+	return nil
+}
+
 // IssueTx attempts to issue a transaction into consensus
 func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, reply *api.JSONTxID) error {
 	s.vm.ctx.Log.Debug("API called",
@@ -481,6 +510,428 @@ func (s *Service) GetAssetDescription(_ *http.Request, args *GetAssetDescription
 	return nil
 }
 
+type GetAssetSupplyArgs struct {
+	AssetID string `json:"assetID"`
+}
+
+// GetAssetSupplyReply describes how much of an asset exists
+type GetAssetSupplyReply struct {
+	AssetID     ids.ID      `json:"assetID"`
+	Minted      json.Uint64 `json:"minted"`
+	Burned      json.Uint64 `json:"burned"`
+	Outstanding json.Uint64 `json:"outstanding"`
+}
+
+// GetAssetSupply returns the supply of a mintable asset. Every NFT counts as
+// one unit of its asset.
+//
+// The supply is only tracked for the assets created once the node runs a
+// version tracking it. It can't be recovered for the assets created earlier,
+// as the transactions they were minted and burned by may have been pruned, so
+// an error is returned for them.
+func (s *Service) GetAssetSupply(_ *http.Request, args *GetAssetSupplyArgs, reply *GetAssetSupplyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "getAssetSupply"),
+		logging.UserString("assetID", args.AssetID),
+	)
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return err
+	}
+
+	supply, err := s.vm.state.GetAssetSupply(assetID)
+	if err == database.ErrNotFound {
+		return fmt.Errorf("%w: %s", errSupplyNotTracked, assetID)
+	}
+	if err != nil {
+		return err
+	}
+
+	reply.AssetID = assetID
+	reply.Minted = json.Uint64(supply.Minted)
+	reply.Burned = json.Uint64(supply.Burned)
+	reply.Outstanding = json.Uint64(supply.Outstanding())
+	return nil
+}
+
+// MintAuthority describes an unspent output that can mint more of an asset
+type MintAuthority struct {
+	UTXOID    ids.ID      `json:"utxoID"`
+	Locktime  json.Uint64 `json:"locktime"`
+	Threshold json.Uint32 `json:"threshold"`
+	Addresses []string    `json:"addresses"`
+}
+
+// IndexedAsset describes an asset recorded by the asset index
+type IndexedAsset struct {
+	AssetID      ids.ID     `json:"assetID"`
+	Name         string     `json:"name"`
+	Symbol       string     `json:"symbol"`
+	Denomination json.Uint8 `json:"denomination"`
+	// Total amount of the asset issued by the secp256k1fx
+	Supply          json.Uint64     `json:"supply"`
+	MintAuthorities []MintAuthority `json:"mintAuthorities"`
+	Creators        []string        `json:"creators"`
+	CreationHeight  json.Uint64     `json:"creationHeight"`
+}
+
+type ListAssetsArgs struct {
+	// Cursor used as a page index / offset
+	Cursor json.Uint64 `json:"cursor"`
+	// PageSize num of items per page
+	PageSize json.Uint64 `json:"pageSize"`
+}
+
+type ListAssetsReply struct {
+	Assets []IndexedAsset `json:"assets"`
+	// Cursor used as a page index / offset
+	Cursor json.Uint64 `json:"cursor"`
+}
+
+// ListAssets returns the assets created on this chain, in order of creation
+func (s *Service) ListAssets(_ *http.Request, args *ListAssetsArgs, reply *ListAssetsReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "listAssets"),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+	if pageSize > maxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
+	} else if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	indexedAssets, err := s.vm.assetIndexer.List(cursor, int(pageSize))
+	if err != nil {
+		return err
+	}
+	reply.Assets, err = s.formatIndexedAssets(indexedAssets)
+	if err != nil {
+		return err
+	}
+	reply.Cursor = json.Uint64(cursor + uint64(len(reply.Assets)))
+	return nil
+}
+
+type SearchAssetsArgs struct {
+	// Words that must each prefix either the symbol of the asset or a word of
+	// its name. Matching is case-insensitive.
+	Query string `json:"query"`
+	// If provided, only the assets created by this address are returned
+	Creator string `json:"creator"`
+	// Maximum number of assets to return
+	Limit json.Uint64 `json:"limit"`
+}
+
+type SearchAssetsReply struct {
+	Assets []IndexedAsset `json:"assets"`
+}
+
+// SearchAssets returns the assets matching the query and created by the
+// creator, in order of creation
+func (s *Service) SearchAssets(_ *http.Request, args *SearchAssetsArgs, reply *SearchAssetsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "searchAssets"),
+		logging.UserString("query", args.Query),
+		logging.UserString("creator", args.Creator),
+	)
+
+	limit, err := getLimit(args.Limit)
+	if err != nil {
+		return err
+	}
+
+	creator := ids.ShortEmpty
+	if args.Creator != "" {
+		creator, err = dione.ParseServiceAddress(s.vm, args.Creator)
+		if err != nil {
+			return fmt.Errorf("couldn't parse argument 'creator' to address: %w", err)
+		}
+	}
+
+	indexedAssets, err := s.vm.assetIndexer.Search(args.Query, creator, limit)
+	if err != nil {
+		return err
+	}
+	reply.Assets, err = s.formatIndexedAssets(indexedAssets)
+	return err
+}
+
+func (s *Service) formatIndexedAssets(indexedAssets []*assets.Asset) ([]IndexedAsset, error) {
+	formatted := make([]IndexedAsset, len(indexedAssets))
+	for i, asset := range indexedAssets {
+		creators, err := s.formatLocalAddresses(asset.Creators)
+		if err != nil {
+			return nil, err
+		}
+
+		mintAuthorities := make([]MintAuthority, len(asset.MintAuthorities))
+		for j, authority := range asset.MintAuthorities {
+			addrs, err := s.formatLocalAddresses(authority.Owners.Addrs)
+			if err != nil {
+				return nil, err
+			}
+			mintAuthorities[j] = MintAuthority{
+				UTXOID:    authority.UTXOID,
+				Locktime:  json.Uint64(authority.Owners.Locktime),
+				Threshold: json.Uint32(authority.Owners.Threshold),
+				Addresses: addrs,
+			}
+		}
+
+		formatted[i] = IndexedAsset{
+			AssetID:         asset.AssetID,
+			Name:            asset.Name,
+			Symbol:          asset.Symbol,
+			Denomination:    json.Uint8(asset.Denomination),
+			Supply:          json.Uint64(asset.Supply),
+			MintAuthorities: mintAuthorities,
+			Creators:        creators,
+			CreationHeight:  json.Uint64(asset.CreationHeight),
+		}
+	}
+	return formatted, nil
+}
+
+// IndexedNFT describes an NFT recorded by the NFT index
+type IndexedNFT struct {
+	// ID of the UTXO the NFT was minted in
+	NFTID   ids.ID              `json:"nftID"`
+	AssetID ids.ID              `json:"assetID"`
+	GroupID json.Uint32         `json:"groupID"`
+	Payload types.JSONByteSlice `json:"payload"`
+	// Set if the payload follows the NFT metadata convention
+	Metadata *nftfx.Metadata `json:"metadata,omitempty"`
+	// UTXO currently holding the NFT
+	UTXOID       ids.ID      `json:"utxoID"`
+	Locktime     json.Uint64 `json:"locktime"`
+	Threshold    json.Uint32 `json:"threshold"`
+	Addresses    []string    `json:"addresses"`
+	NumTransfers json.Uint64 `json:"numTransfers"`
+}
+
+// NFTTransfer describes a change of the owners of an NFT
+type NFTTransfer struct {
+	TxID      ids.ID      `json:"txID"`
+	Height    json.Uint64 `json:"height"`
+	UTXOID    ids.ID      `json:"utxoID"`
+	Locktime  json.Uint64 `json:"locktime"`
+	Threshold json.Uint32 `json:"threshold"`
+	Addresses []string    `json:"addresses"`
+}
+
+type GetNFTsByOwnerArgs struct {
+	Address string `json:"address"`
+	// If provided, only the NFTs of this asset are returned
+	AssetID string `json:"assetID"`
+	// If provided, only the NFTs of this group of the asset are returned
+	GroupID *json.Uint32 `json:"groupID"`
+	// If provided, the NFTs up to and including this NFT are skipped
+	StartAfter ids.ID `json:"startAfter"`
+	// Maximum number of NFTs to return
+	Limit json.Uint64 `json:"limit"`
+}
+
+type GetNFTsReply struct {
+	NFTs []IndexedNFT `json:"nfts"`
+}
+
+// GetNFTsByOwner returns the NFTs currently owned by the address, ordered by
+// asset, group, and ID
+func (s *Service) GetNFTsByOwner(_ *http.Request, args *GetNFTsByOwnerArgs, reply *GetNFTsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "getNFTsByOwner"),
+		logging.UserString("address", args.Address),
+		logging.UserString("assetID", args.AssetID),
+	)
+
+	limit, err := getLimit(args.Limit)
+	if err != nil {
+		return err
+	}
+
+	owner, err := dione.ParseServiceAddress(s.vm, args.Address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse argument 'address' to address: %w", err)
+	}
+
+	assetID := ids.Empty
+	if args.AssetID != "" {
+		assetID, err = s.vm.lookupAssetID(args.AssetID)
+		if err != nil {
+			return err
+		}
+	}
+
+	groupID := (*uint32)(args.GroupID)
+	indexedNFTs, err := s.vm.nftIndexer.ByOwner(owner, assetID, groupID, args.StartAfter, limit)
+	if err != nil {
+		return err
+	}
+	reply.NFTs, err = s.formatIndexedNFTs(indexedNFTs)
+	return err
+}
+
+type GetNFTGroupArgs struct {
+	AssetID string      `json:"assetID"`
+	GroupID json.Uint32 `json:"groupID"`
+	// If provided, the NFTs up to and including this NFT are skipped
+	StartAfter ids.ID `json:"startAfter"`
+	// Maximum number of NFTs to return
+	Limit json.Uint64 `json:"limit"`
+}
+
+// GetNFTGroup returns the NFTs of a group of an asset, ordered by ID
+func (s *Service) GetNFTGroup(_ *http.Request, args *GetNFTGroupArgs, reply *GetNFTsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "getNFTGroup"),
+		logging.UserString("assetID", args.AssetID),
+		zap.Uint32("groupID", uint32(args.GroupID)),
+	)
+
+	limit, err := getLimit(args.Limit)
+	if err != nil {
+		return err
+	}
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return err
+	}
+
+	indexedNFTs, err := s.vm.nftIndexer.Group(assetID, uint32(args.GroupID), args.StartAfter, limit)
+	if err != nil {
+		return err
+	}
+	reply.NFTs, err = s.formatIndexedNFTs(indexedNFTs)
+	return err
+}
+
+type GetNFTHistoryArgs struct {
+	NFTID ids.ID `json:"nftID"`
+	// Index of the first transfer to return
+	Cursor json.Uint64 `json:"cursor"`
+	// Maximum number of transfers to return
+	Limit json.Uint64 `json:"limit"`
+}
+
+type GetNFTHistoryReply struct {
+	NFT IndexedNFT `json:"nft"`
+	// Transfers of the NFT, oldest first. The first transfer is the mint.
+	Transfers []NFTTransfer `json:"transfers"`
+	// Index of the transfer following the returned ones
+	Cursor json.Uint64 `json:"cursor"`
+}
+
+// GetNFTHistory returns an NFT and the changes of its owners
+func (s *Service) GetNFTHistory(_ *http.Request, args *GetNFTHistoryArgs, reply *GetNFTHistoryReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "getNFTHistory"),
+		zap.Stringer("nftID", args.NFTID),
+	)
+
+	limit, err := getLimit(args.Limit)
+	if err != nil {
+		return err
+	}
+
+	indexedNFT, err := s.vm.nftIndexer.Get(args.NFTID)
+	if err == database.ErrNotFound {
+		return fmt.Errorf("%w: %s", errUnknownNFT, args.NFTID)
+	}
+	if err != nil {
+		return err
+	}
+	formatted, err := s.formatIndexedNFTs([]*nfts.NFT{indexedNFT})
+	if err != nil {
+		return err
+	}
+	reply.NFT = formatted[0]
+
+	transfers, err := s.vm.nftIndexer.History(args.NFTID, uint64(args.Cursor), limit)
+	if err != nil {
+		return err
+	}
+	reply.Transfers = make([]NFTTransfer, len(transfers))
+	for i, transfer := range transfers {
+		addrs, err := s.formatLocalAddresses(transfer.Owners.Addrs)
+		if err != nil {
+			return err
+		}
+		reply.Transfers[i] = NFTTransfer{
+			TxID:      transfer.TxID,
+			Height:    json.Uint64(transfer.Height),
+			UTXOID:    transfer.UTXOID,
+			Locktime:  json.Uint64(transfer.Owners.Locktime),
+			Threshold: json.Uint32(transfer.Owners.Threshold),
+			Addresses: addrs,
+		}
+	}
+	reply.Cursor = args.Cursor + json.Uint64(len(transfers))
+	return nil
+}
+
+func (s *Service) formatIndexedNFTs(indexedNFTs []*nfts.NFT) ([]IndexedNFT, error) {
+	formatted := make([]IndexedNFT, len(indexedNFTs))
+	for i, nft := range indexedNFTs {
+		addrs, err := s.formatLocalAddresses(nft.Owners.Addrs)
+		if err != nil {
+			return nil, err
+		}
+
+		// Payloads that don't follow the metadata convention are still
+		// returned as is
+		metadata, _ := nftfx.ParseMetadata(nft.Payload)
+		formatted[i] = IndexedNFT{
+			NFTID:        nft.ID,
+			AssetID:      nft.AssetID,
+			GroupID:      json.Uint32(nft.GroupID),
+			Payload:      nft.Payload,
+			Metadata:     metadata,
+			UTXOID:       nft.UTXOID,
+			Locktime:     json.Uint64(nft.Owners.Locktime),
+			Threshold:    json.Uint32(nft.Owners.Threshold),
+			Addresses:    addrs,
+			NumTransfers: json.Uint64(nft.NumTransfers),
+		}
+	}
+	return formatted, nil
+}
+
+// getLimit returns the maximum number of items to return, defaulting to
+// [maxPageSize].
+func getLimit(limit json.Uint64) (int, error) {
+	switch {
+	case uint64(limit) > maxPageSize:
+		return 0, fmt.Errorf("limit > maximum allowed (%d)", maxPageSize)
+	case limit == 0:
+		return int(maxPageSize), nil
+	default:
+		return int(limit), nil
+	}
+}
+
+func (s *Service) formatLocalAddresses(addrs []ids.ShortID) ([]string, error) {
+	formatted := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addrStr, err := s.vm.FormatLocalAddress(addr)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't format address %s: %w", addr, err)
+		}
+		formatted[i] = addrStr
+	}
+	return formatted, nil
+}
+
 // GetBalanceArgs are arguments for passing into GetBalance requests
 type GetBalanceArgs struct {
 	Address        string `json:"address"`
@@ -631,6 +1082,109 @@ func (s *Service) GetAllBalances(_ *http.Request, args *GetAllBalancesArgs, repl
 	return nil
 }
 
+// HTLCOwners describes who can spend an HTLC
+type HTLCOwners struct {
+	Locktime  json.Uint64 `json:"locktime"`
+	Threshold json.Uint32 `json:"threshold"`
+	Addresses []string    `json:"addresses"`
+}
+
+// HTLC describes funds locked in a hashed timelock contract
+type HTLC struct {
+	UTXOID  ids.ID      `json:"utxoID"`
+	AssetID ids.ID      `json:"assetID"`
+	Amount  json.Uint64 `json:"amount"`
+	// SHA-256 hash of the preimage the recipient must reveal, encoded in hex
+	Hash string `json:"hash"`
+	// Unix time from which the sender can spend the funds back. Relative
+	// timeouts are resolved once the HTLC is accepted.
+	Timeout   json.Uint64 `json:"timeout"`
+	TimedOut  bool        `json:"timedOut"`
+	Recipient HTLCOwners  `json:"recipient"`
+	Sender    HTLCOwners  `json:"sender"`
+}
+
+// GetHTLCsReply is the response from a call to GetHTLCs
+type GetHTLCsReply struct {
+	HTLCs []HTLC `json:"htlcs"`
+}
+
+// GetHTLCs returns the hashed timelock contracts that [args.Addresses] are
+// either the recipient or the sender of
+func (s *Service) GetHTLCs(_ *http.Request, args *api.JSONAddresses, reply *GetHTLCsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "getHTLCs"),
+		logging.UserStrings("addresses", args.Addresses),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetUTXOsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	}
+
+	addrSet, err := dione.ParseServiceAddresses(s.vm, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	utxos, err := dione.GetAllUTXOs(s.vm.state, addrSet)
+	if err != nil {
+		return fmt.Errorf("problem retrieving UTXOs: %w", err)
+	}
+
+	now := s.vm.clock.Unix()
+	reply.HTLCs = []HTLC{}
+	for _, utxo := range utxos {
+		out, ok := utxo.Out.(*htlcfx.TransferOutput)
+		if !ok {
+			continue
+		}
+
+		hash, err := formatting.Encode(formatting.HexNC, out.Hash[:])
+		if err != nil {
+			return fmt.Errorf("couldn't encode hash: %w", err)
+		}
+		recipient, err := s.formatHTLCOwners(&out.Recipient)
+		if err != nil {
+			return err
+		}
+		sender, err := s.formatHTLCOwners(&out.Sender)
+		if err != nil {
+			return err
+		}
+		reply.HTLCs = append(reply.HTLCs, HTLC{
+			UTXOID:    utxo.InputID(),
+			AssetID:   utxo.AssetID(),
+			Amount:    json.Uint64(out.Amt),
+			Hash:      hash,
+			Timeout:   json.Uint64(out.Timeout),
+			TimedOut:  now >= out.Timeout,
+			Recipient: recipient,
+			Sender:    sender,
+		})
+	}
+	return nil
+}
+
+func (s *Service) formatHTLCOwners(owners *secp256k1fx.OutputOwners) (HTLCOwners, error) {
+	addrs := make([]string, len(owners.Addrs))
+	for i, addr := range owners.Addrs {
+		addrStr, err := s.vm.FormatLocalAddress(addr)
+		if err != nil {
+			return HTLCOwners{}, fmt.Errorf("couldn't format address %s: %w", addr, err)
+		}
+		addrs[i] = addrStr
+	}
+	return HTLCOwners{
+		Locktime:  json.Uint64(owners.Locktime),
+		Threshold: json.Uint32(owners.Threshold),
+		Addresses: addrs,
+	}, nil
+}
+
 // Holder describes how much an address owns of an asset
 type Holder struct {
 	Amount  json.Uint64 `json:"amount"`
@@ -695,11 +1249,12 @@ func (s *Service) CreateAsset(_ *http.Request, args *CreateAssetArgs, reply *Ass
 		return err
 	}
 
+	createAssetTxFee := s.vm.dynamicFee(s.vm.CreateAssetTxFee)
 	amountsSpent, ins, keys, err := s.vm.Spend(
 		utxos,
 		kc,
 		map[ids.ID]uint64{
-			s.vm.feeAssetID: s.vm.CreateAssetTxFee,
+			s.vm.feeAssetID: createAssetTxFee,
 		},
 	)
 	if err != nil {
@@ -707,11 +1262,11 @@ func (s *Service) CreateAsset(_ *http.Request, args *CreateAssetArgs, reply *Ass
 	}
 
 	outs := []*dione.TransferableOutput{}
-	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent > s.vm.CreateAssetTxFee {
+	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent > createAssetTxFee {
 		outs = append(outs, &dione.TransferableOutput{
 			Asset: dione.Asset{ID: s.vm.feeAssetID},
 			Out: &secp256k1fx.TransferOutput{
-				Amt: amountSpent - s.vm.CreateAssetTxFee,
+				Amt: amountSpent - createAssetTxFee,
 				OutputOwners: secp256k1fx.OutputOwners{
 					Locktime:  0,
 					Threshold: 1,
@@ -850,11 +1405,12 @@ func (s *Service) CreateNFTAsset(_ *http.Request, args *CreateNFTAssetArgs, repl
 		return err
 	}
 
+	createAssetTxFee := s.vm.dynamicFee(s.vm.CreateAssetTxFee)
 	amountsSpent, ins, keys, err := s.vm.Spend(
 		utxos,
 		kc,
 		map[ids.ID]uint64{
-			s.vm.feeAssetID: s.vm.CreateAssetTxFee,
+			s.vm.feeAssetID: createAssetTxFee,
 		},
 	)
 	if err != nil {
@@ -862,11 +1418,11 @@ func (s *Service) CreateNFTAsset(_ *http.Request, args *CreateNFTAssetArgs, repl
 	}
 
 	outs := []*dione.TransferableOutput{}
-	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent > s.vm.CreateAssetTxFee {
+	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent > createAssetTxFee {
 		outs = append(outs, &dione.TransferableOutput{
 			Asset: dione.Asset{ID: s.vm.feeAssetID},
 			Out: &secp256k1fx.TransferOutput{
-				Amt: amountSpent - s.vm.CreateAssetTxFee,
+				Amt: amountSpent - createAssetTxFee,
 				OutputOwners: secp256k1fx.OutputOwners{
 					Locktime:  0,
 					Threshold: 1,
@@ -1198,12 +1754,13 @@ func (s *Service) SendMultiple(_ *http.Request, args *SendMultipleArgs, reply *a
 		})
 	}
 
+	txFee := s.vm.dynamicFee(s.vm.TxFee)
 	amountsWithFee := make(map[ids.ID]uint64, len(amounts)+1)
 	for assetID, amount := range amounts {
 		amountsWithFee[assetID] = amount
 	}
 
-	amountWithFee, err := safemath.Add64(amounts[s.vm.feeAssetID], s.vm.TxFee)
+	amountWithFee, err := safemath.Add64(amounts[s.vm.feeAssetID], txFee)
 	if err != nil {
 		return fmt.Errorf("problem calculating required spend amount: %w", err)
 	}
@@ -1310,11 +1867,12 @@ func (s *Service) Mint(_ *http.Request, args *MintArgs, reply *api.JSONTxIDChang
 		return err
 	}
 
+	txFee := s.vm.dynamicFee(s.vm.TxFee)
 	amountsSpent, ins, keys, err := s.vm.Spend(
 		feeUTXOs,
 		feeKc,
 		map[ids.ID]uint64{
-			s.vm.feeAssetID: s.vm.TxFee,
+			s.vm.feeAssetID: txFee,
 		},
 	)
 	if err != nil {
@@ -1322,11 +1880,11 @@ func (s *Service) Mint(_ *http.Request, args *MintArgs, reply *api.JSONTxIDChang
 	}
 
 	outs := []*dione.TransferableOutput{}
-	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent > s.vm.TxFee {
+	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent > txFee {
 		outs = append(outs, &dione.TransferableOutput{
 			Asset: dione.Asset{ID: s.vm.feeAssetID},
 			Out: &secp256k1fx.TransferOutput{
-				Amt: amountSpent - s.vm.TxFee,
+				Amt: amountSpent - txFee,
 				OutputOwners: secp256k1fx.OutputOwners{
 					Locktime:  0,
 					Threshold: 1,
@@ -1427,11 +1985,12 @@ func (s *Service) SendNFT(_ *http.Request, args *SendNFTArgs, reply *api.JSONTxI
 		return err
 	}
 
+	txFee := s.vm.dynamicFee(s.vm.TxFee)
 	amountsSpent, ins, secpKeys, err := s.vm.Spend(
 		utxos,
 		kc,
 		map[ids.ID]uint64{
-			s.vm.feeAssetID: s.vm.TxFee,
+			s.vm.feeAssetID: txFee,
 		},
 	)
 	if err != nil {
@@ -1439,11 +1998,11 @@ func (s *Service) SendNFT(_ *http.Request, args *SendNFTArgs, reply *api.JSONTxI
 	}
 
 	outs := []*dione.TransferableOutput{}
-	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent > s.vm.TxFee {
+	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent > txFee {
 		outs = append(outs, &dione.TransferableOutput{
 			Asset: dione.Asset{ID: s.vm.feeAssetID},
 			Out: &secp256k1fx.TransferOutput{
-				Amt: amountSpent - s.vm.TxFee,
+				Amt: amountSpent - txFee,
 				OutputOwners: secp256k1fx.OutputOwners{
 					Locktime:  0,
 					Threshold: 1,
@@ -1521,6 +2080,9 @@ func (s *Service) MintNFT(_ *http.Request, args *MintNFTArgs, reply *api.JSONTxI
 	if err != nil {
 		return fmt.Errorf("problem decoding payload bytes: %w", err)
 	}
+	if err := nftfx.VerifyPayload(payloadBytes); err != nil {
+		return fmt.Errorf("problem verifying payload metadata: %w", err)
+	}
 
 	// Parse the from addresses
 	fromAddrs, err := dione.ParseServiceAddresses(s.vm, args.From)
@@ -1543,11 +2105,12 @@ func (s *Service) MintNFT(_ *http.Request, args *MintNFTArgs, reply *api.JSONTxI
 		return err
 	}
 
+	txFee := s.vm.dynamicFee(s.vm.TxFee)
 	amountsSpent, ins, secpKeys, err := s.vm.Spend(
 		feeUTXOs,
 		feeKc,
 		map[ids.ID]uint64{
-			s.vm.feeAssetID: s.vm.TxFee,
+			s.vm.feeAssetID: txFee,
 		},
 	)
 	if err != nil {
@@ -1555,11 +2118,11 @@ func (s *Service) MintNFT(_ *http.Request, args *MintNFTArgs, reply *api.JSONTxI
 	}
 
 	outs := []*dione.TransferableOutput{}
-	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent > s.vm.TxFee {
+	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent > txFee {
 		outs = append(outs, &dione.TransferableOutput{
 			Asset: dione.Asset{ID: s.vm.feeAssetID},
 			Out: &secp256k1fx.TransferOutput{
-				Amt: amountSpent - s.vm.TxFee,
+				Amt: amountSpent - txFee,
 				OutputOwners: secp256k1fx.OutputOwners{
 					Locktime:  0,
 					Threshold: 1,
@@ -1659,16 +2222,17 @@ func (s *Service) Import(_ *http.Request, args *ImportArgs, reply *api.JSONTxID)
 		return err
 	}
 
+	txFee := s.vm.dynamicFee(s.vm.TxFee)
 	ins := []*dione.TransferableInput{}
 	keys := [][]*secp256k1.PrivateKey{}
 
-	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent < s.vm.TxFee {
+	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent < txFee {
 		var localAmountsSpent map[ids.ID]uint64
 		localAmountsSpent, ins, keys, err = s.vm.Spend(
 			utxos,
 			kc,
 			map[ids.ID]uint64{
-				s.vm.feeAssetID: s.vm.TxFee - amountSpent,
+				s.vm.feeAssetID: txFee - amountSpent,
 			},
 		)
 		if err != nil {
@@ -1685,7 +2249,7 @@ func (s *Service) Import(_ *http.Request, args *ImportArgs, reply *api.JSONTxID)
 
 	// Because we ensured that we had enough inputs for the fee, we can
 	// safely just remove it without concern for underflow.
-	amountsSpent[s.vm.feeAssetID] -= s.vm.TxFee
+	amountsSpent[s.vm.feeAssetID] -= txFee
 
 	keys = append(keys, importKeys...)
 
@@ -1801,15 +2365,16 @@ func (s *Service) Export(_ *http.Request, args *ExportArgs, reply *api.JSONTxIDC
 		return err
 	}
 
+	txFee := s.vm.dynamicFee(s.vm.TxFee)
 	amounts := map[ids.ID]uint64{}
 	if assetID == s.vm.feeAssetID {
-		amountWithFee, err := safemath.Add64(uint64(args.Amount), s.vm.TxFee)
+		amountWithFee, err := safemath.Add64(uint64(args.Amount), txFee)
 		if err != nil {
 			return fmt.Errorf("problem calculating required spend amount: %w", err)
 		}
 		amounts[s.vm.feeAssetID] = amountWithFee
 	} else {
-		amounts[s.vm.feeAssetID] = s.vm.TxFee
+		amounts[s.vm.feeAssetID] = txFee
 		amounts[assetID] = uint64(args.Amount)
 	}
 
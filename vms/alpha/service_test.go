@@ -31,16 +31,20 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/utils/formatting"
 	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
 	"github.com/DioneProtocol/odysseygo/utils/json"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/assets"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/block"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/block/executor"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/config"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/nfts"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/states"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/index"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
 	"github.com/DioneProtocol/odysseygo/vms/nftfx"
 	"github.com/DioneProtocol/odysseygo/vms/propertyfx"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
@@ -468,6 +472,292 @@ func TestServiceGetAllBalances(t *testing.T) {
 	require.Empty(reply.Balances)
 }
 
+func TestServiceGetHTLCs(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	recipient := ids.GenerateTestShortID()
+	recipientStr, err := env.vm.FormatLocalAddress(recipient)
+	require.NoError(err)
+	sender := ids.GenerateTestShortID()
+	senderStr, err := env.vm.FormatLocalAddress(sender)
+	require.NoError(err)
+
+	now := uint64(env.vm.clock.Time().Unix())
+	assetID := ids.GenerateTestID()
+	hash := hashing.ComputeHash256Array([]byte("preimage"))
+	htlcUTXO := &dione.UTXO{
+		UTXOID: dione.UTXOID{
+			TxID:        ids.GenerateTestID(),
+			OutputIndex: 0,
+		},
+		Asset: dione.Asset{ID: assetID},
+		Out: &htlcfx.TransferOutput{
+			Amt:     1337,
+			Hash:    hash,
+			Timeout: now + 1,
+			Recipient: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{recipient},
+			},
+			Sender: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{sender},
+			},
+		},
+	}
+	// A UTXO of [recipient] that isn't an HTLC
+	transferUTXO := &dione.UTXO{
+		UTXOID: dione.UTXOID{
+			TxID:        ids.GenerateTestID(),
+			OutputIndex: 0,
+		},
+		Asset: dione.Asset{ID: assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1337,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{recipient},
+			},
+		},
+	}
+	env.vm.state.AddUTXO(htlcUTXO)
+	env.vm.state.AddUTXO(transferUTXO)
+	require.NoError(env.vm.state.Commit())
+
+	hashStr, err := formatting.Encode(formatting.HexNC, hash[:])
+	require.NoError(err)
+	expectedHTLC := HTLC{
+		UTXOID:   htlcUTXO.InputID(),
+		AssetID:  assetID,
+		Amount:   1337,
+		Hash:     hashStr,
+		Timeout:  json.Uint64(now + 1),
+		TimedOut: false,
+		Recipient: HTLCOwners{
+			Threshold: 1,
+			Addresses: []string{recipientStr},
+		},
+		Sender: HTLCOwners{
+			Threshold: 1,
+			Addresses: []string{senderStr},
+		},
+	}
+
+	// The HTLC is returned to both its recipient and its sender
+	for _, addrStr := range []string{recipientStr, senderStr} {
+		reply := &GetHTLCsReply{}
+		require.NoError(env.service.GetHTLCs(nil, &api.JSONAddresses{
+			Addresses: []string{addrStr},
+		}, reply))
+		require.Equal([]HTLC{expectedHTLC}, reply.HTLCs)
+	}
+
+	reply := &GetHTLCsReply{}
+	require.NoError(env.service.GetHTLCs(nil, &api.JSONAddresses{
+		Addresses: []string{ids.GenerateTestShortID().String()},
+	}, reply))
+	require.Empty(reply.HTLCs)
+
+	err = env.service.GetHTLCs(nil, &api.JSONAddresses{}, reply)
+	require.ErrorIs(err, errNoAddresses)
+}
+
+func TestServiceListAndSearchAssets(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		vmStaticConfig: &config.Config{},
+		vmDynamicConfig: &Config{
+			IndexAssets: true,
+		},
+		additionalFxs: []*common.Fx{{
+			ID: propertyfx.ID,
+			Fx: &propertyfx.Fx{},
+		}},
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	key := keys[0]
+	keyAddrStr, err := env.vm.FormatLocalAddress(key.PublicKey().Address())
+	require.NoError(err)
+
+	createAssetTx := newDioneCreateAssetTxWithOutputs(t, env.vm)
+	issueAndAccept(require, env.vm, env.issuer, createAssetTx)
+
+	mintSecpOpTx := buildOperationTxWithOp(buildSecpMintOp(createAssetTx, key, 0))
+	require.NoError(mintSecpOpTx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{key}}))
+	issueAndAccept(require, env.vm, env.issuer, mintSecpOpTx)
+
+	// The mint operation consumed the first mint output of the asset and
+	// produced a new one
+	expectedAsset := IndexedAsset{
+		AssetID: createAssetTx.ID(),
+		Name:    "Team Rocket",
+		Symbol:  "TR",
+		Supply:  1,
+		MintAuthorities: []MintAuthority{
+			{
+				UTXOID:    createAssetTx.UTXOs()[1].InputID(),
+				Threshold: 1,
+				Addresses: []string{keyAddrStr},
+			},
+			{
+				UTXOID:    mintSecpOpTx.UTXOs()[0].InputID(),
+				Threshold: 1,
+				Addresses: []string{keyAddrStr},
+			},
+		},
+		Creators:       []string{},
+		CreationHeight: 1,
+	}
+
+	// The genesis assets are listed before the created asset
+	listReply := &ListAssetsReply{}
+	require.NoError(env.service.ListAssets(nil, &ListAssetsArgs{}, listReply))
+	require.Len(listReply.Assets, 5)
+	require.Equal(json.Uint64(5), listReply.Cursor)
+	require.Equal(env.genesisTx.ID(), listReply.Assets[0].AssetID)
+	require.Equal(json.Uint64(0), listReply.Assets[0].CreationHeight)
+	require.Equal(json.Uint64(3*startBalance), listReply.Assets[0].Supply)
+	require.Empty(listReply.Assets[0].MintAuthorities)
+	require.Equal(expectedAsset, listReply.Assets[4])
+
+	listReply = &ListAssetsReply{}
+	require.NoError(env.service.ListAssets(nil, &ListAssetsArgs{
+		Cursor:   3,
+		PageSize: 1,
+	}, listReply))
+	require.Len(listReply.Assets, 1)
+	require.Equal(json.Uint64(4), listReply.Cursor)
+
+	err = env.service.ListAssets(nil, &ListAssetsArgs{
+		PageSize: json.Uint64(maxPageSize + 1),
+	}, listReply)
+	require.ErrorContains(err, "pageSize > maximum allowed")
+
+	searchReply := &SearchAssetsReply{}
+	require.NoError(env.service.SearchAssets(nil, &SearchAssetsArgs{
+		Query: "team ROCK",
+	}, searchReply))
+	require.Equal([]IndexedAsset{expectedAsset}, searchReply.Assets)
+
+	searchReply = &SearchAssetsReply{}
+	require.NoError(env.service.SearchAssets(nil, &SearchAssetsArgs{
+		Query: "my",
+		Limit: 2,
+	}, searchReply))
+	require.Len(searchReply.Assets, 2)
+
+	searchReply = &SearchAssetsReply{}
+	require.NoError(env.service.SearchAssets(nil, &SearchAssetsArgs{
+		Query:   "team",
+		Creator: keyAddrStr,
+	}, searchReply))
+	require.Empty(searchReply.Assets)
+
+	err = env.service.SearchAssets(nil, &SearchAssetsArgs{}, searchReply)
+	require.ErrorIs(err, assets.ErrNoSearchCriteria)
+}
+
+func TestServiceListAssetsDisabled(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	err := env.service.ListAssets(nil, &ListAssetsArgs{}, &ListAssetsReply{})
+	require.ErrorIs(err, assets.ErrIndexingDisabled)
+
+	err = env.service.SearchAssets(nil, &SearchAssetsArgs{
+		Query: "dione",
+	}, &SearchAssetsReply{})
+	require.ErrorIs(err, assets.ErrIndexingDisabled)
+}
+
+func TestServiceGetAssetSupply(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		vmStaticConfig: &config.Config{},
+		additionalFxs: []*common.Fx{{
+			ID: propertyfx.ID,
+			Fx: &propertyfx.Fx{},
+		}},
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	key := keys[0]
+	createAssetTx := newDioneCreateAssetTxWithOutputs(t, env.vm)
+	issueAndAccept(require, env.vm, env.issuer, createAssetTx)
+
+	reply := &GetAssetSupplyReply{}
+	require.NoError(env.service.GetAssetSupply(nil, &GetAssetSupplyArgs{
+		AssetID: createAssetTx.ID().String(),
+	}, reply))
+	require.Equal(&GetAssetSupplyReply{
+		AssetID: createAssetTx.ID(),
+	}, reply)
+
+	mintSecpOpTx := buildOperationTxWithOp(buildSecpMintOp(createAssetTx, key, 0))
+	require.NoError(mintSecpOpTx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{key}}))
+	issueAndAccept(require, env.vm, env.issuer, mintSecpOpTx)
+
+	mintNFTTx := buildOperationTxWithOp(buildNFTxMintOp(createAssetTx, key, 2, 1))
+	require.NoError(mintNFTTx.SignNFTFx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{key}}))
+	issueAndAccept(require, env.vm, env.issuer, mintNFTTx)
+
+	reply = &GetAssetSupplyReply{}
+	require.NoError(env.service.GetAssetSupply(nil, &GetAssetSupplyArgs{
+		AssetID: createAssetTx.ID().String(),
+	}, reply))
+	require.Equal(&GetAssetSupplyReply{
+		AssetID:     createAssetTx.ID(),
+		Minted:      2,
+		Outstanding: 2,
+	}, reply)
+
+	// The genesis DIONE asset can't be minted, so its supply isn't tracked
+	err := env.service.GetAssetSupply(nil, &GetAssetSupplyArgs{
+		AssetID: env.genesisTx.ID().String(),
+	}, &GetAssetSupplyReply{})
+	require.ErrorIs(err, errSupplyNotTracked)
+
+	// An asset created before the supply tracking was deployed has no supply
+	// recorded, and minting more of it doesn't start tracking it
+	oldKey := keys[1]
+	oldCreateAssetTx := buildCreateAssetTx(oldKey)
+	require.NoError(env.vm.parser.InitializeTx(oldCreateAssetTx))
+	env.vm.state.AddTx(oldCreateAssetTx)
+	for _, utxo := range oldCreateAssetTx.UTXOs() {
+		env.vm.state.AddUTXO(utxo)
+	}
+	require.NoError(env.vm.state.Commit())
+
+	mintOldAssetTx := buildOperationTxWithOp(buildSecpMintOp(oldCreateAssetTx, oldKey, 0))
+	require.NoError(mintOldAssetTx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{oldKey}}))
+	issueAndAccept(require, env.vm, env.issuer, mintOldAssetTx)
+
+	err = env.service.GetAssetSupply(nil, &GetAssetSupplyArgs{
+		AssetID: oldCreateAssetTx.ID().String(),
+	}, &GetAssetSupplyReply{})
+	require.ErrorIs(err, errSupplyNotTracked)
+}
+
 func TestServiceGetTx(t *testing.T) {
 	require := require.New(t)
 
@@ -1663,6 +1953,192 @@ func TestNFTWorkflow(t *testing.T) {
 	}
 }
 
+func TestServiceNFTIndex(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		vmDynamicConfig: &Config{
+			IndexNFTs: true,
+		},
+		keystoreUsers: []*user{{
+			username:    username,
+			password:    password,
+			initialKeys: keys,
+		}},
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	minterAddrStr, err := env.vm.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+	ownerAddrStr, err := env.vm.FormatLocalAddress(keys[1].PublicKey().Address())
+	require.NoError(err)
+	spendHeader := api.JSONSpendHeader{
+		UserPass: api.UserPass{
+			Username: username,
+			Password: password,
+		},
+		JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: minterAddrStr},
+	}
+
+	createReply := &AssetIDChangeAddr{}
+	require.NoError(env.service.CreateNFTAsset(nil, &CreateNFTAssetArgs{
+		JSONSpendHeader: spendHeader,
+		Name:            "Tickets",
+		Symbol:          "TIX",
+		MinterSets: []Owners{{
+			Threshold: 1,
+			Minters:   []string{minterAddrStr},
+		}},
+	}, createReply))
+	buildAndAccept(require, env.vm, env.issuer, createReply.AssetID)
+	assetID := createReply.AssetID
+
+	// Payloads that are JSON objects must be valid metadata
+	invalidPayload, err := formatting.Encode(formatting.Hex, []byte(`{"description": "ticket"}`))
+	require.NoError(err)
+	err = env.service.MintNFT(nil, &MintNFTArgs{
+		JSONSpendHeader: spendHeader,
+		AssetID:         assetID.String(),
+		Payload:         invalidPayload,
+		To:              minterAddrStr,
+		Encoding:        formatting.Hex,
+	}, &api.JSONTxIDChangeAddr{})
+	require.ErrorIs(err, nftfx.ErrMissingMetadataName)
+
+	payload := []byte(`{"name": "Golden Ticket", "attributes": [{"trait_type": "color", "value": "gold"}]}`)
+	payloadStr, err := formatting.Encode(formatting.Hex, payload)
+	require.NoError(err)
+	mintReply := &api.JSONTxIDChangeAddr{}
+	require.NoError(env.service.MintNFT(nil, &MintNFTArgs{
+		JSONSpendHeader: spendHeader,
+		AssetID:         assetID.String(),
+		Payload:         payloadStr,
+		To:              minterAddrStr,
+		Encoding:        formatting.Hex,
+	}, mintReply))
+	buildAndAccept(require, env.vm, env.issuer, mintReply.TxID)
+
+	sendReply := &api.JSONTxIDChangeAddr{}
+	require.NoError(env.service.SendNFT(nil, &SendNFTArgs{
+		JSONSpendHeader: spendHeader,
+		AssetID:         assetID.String(),
+		To:              ownerAddrStr,
+	}, sendReply))
+	buildAndAccept(require, env.vm, env.issuer, sendReply.TxID)
+
+	mintTx, err := env.vm.state.GetTx(mintReply.TxID)
+	require.NoError(err)
+	sendTx, err := env.vm.state.GetTx(sendReply.TxID)
+	require.NoError(err)
+	nftID := mintTx.UTXOs()[len(mintTx.UTXOs())-1].InputID()
+	nftUTXOID := sendTx.UTXOs()[len(sendTx.UTXOs())-1].InputID()
+
+	expectedNFT := IndexedNFT{
+		NFTID:   nftID,
+		AssetID: assetID,
+		Payload: payload,
+		Metadata: &nftfx.Metadata{
+			Name: "Golden Ticket",
+			Attributes: []nftfx.Attribute{{
+				TraitType: "color",
+				Value:     "gold",
+			}},
+		},
+		UTXOID:       nftUTXOID,
+		Threshold:    1,
+		Addresses:    []string{ownerAddrStr},
+		NumTransfers: 2,
+	}
+
+	group := json.Uint32(0)
+	nftsReply := &GetNFTsReply{}
+	require.NoError(env.service.GetNFTsByOwner(nil, &GetNFTsByOwnerArgs{
+		Address: ownerAddrStr,
+		AssetID: assetID.String(),
+		GroupID: &group,
+	}, nftsReply))
+	require.Equal([]IndexedNFT{expectedNFT}, nftsReply.NFTs)
+
+	nftsReply = &GetNFTsReply{}
+	require.NoError(env.service.GetNFTsByOwner(nil, &GetNFTsByOwnerArgs{
+		Address: minterAddrStr,
+	}, nftsReply))
+	require.Empty(nftsReply.NFTs)
+
+	nftsReply = &GetNFTsReply{}
+	require.NoError(env.service.GetNFTGroup(nil, &GetNFTGroupArgs{
+		AssetID: assetID.String(),
+	}, nftsReply))
+	require.Equal([]IndexedNFT{expectedNFT}, nftsReply.NFTs)
+
+	err = env.service.GetNFTGroup(nil, &GetNFTGroupArgs{
+		AssetID: assetID.String(),
+		Limit:   json.Uint64(maxPageSize + 1),
+	}, nftsReply)
+	require.ErrorContains(err, "limit > maximum allowed")
+
+	historyReply := &GetNFTHistoryReply{}
+	require.NoError(env.service.GetNFTHistory(nil, &GetNFTHistoryArgs{
+		NFTID: nftID,
+	}, historyReply))
+	require.Equal(&GetNFTHistoryReply{
+		NFT: expectedNFT,
+		Transfers: []NFTTransfer{
+			{
+				TxID:      mintReply.TxID,
+				Height:    2,
+				UTXOID:    nftID,
+				Threshold: 1,
+				Addresses: []string{minterAddrStr},
+			},
+			{
+				TxID:      sendReply.TxID,
+				Height:    3,
+				UTXOID:    nftUTXOID,
+				Threshold: 1,
+				Addresses: []string{ownerAddrStr},
+			},
+		},
+		Cursor: 2,
+	}, historyReply)
+
+	err = env.service.GetNFTHistory(nil, &GetNFTHistoryArgs{
+		NFTID: ids.GenerateTestID(),
+	}, &GetNFTHistoryReply{})
+	require.ErrorIs(err, errUnknownNFT)
+}
+
+func TestServiceNFTIndexDisabled(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	addrStr, err := env.vm.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+
+	err = env.service.GetNFTsByOwner(nil, &GetNFTsByOwnerArgs{
+		Address: addrStr,
+	}, &GetNFTsReply{})
+	require.ErrorIs(err, nfts.ErrIndexingDisabled)
+
+	err = env.service.GetNFTGroup(nil, &GetNFTGroupArgs{
+		AssetID: env.genesisTx.ID().String(),
+	}, &GetNFTsReply{})
+	require.ErrorIs(err, nfts.ErrIndexingDisabled)
+
+	err = env.service.GetNFTHistory(nil, &GetNFTHistoryArgs{
+		NFTID: ids.GenerateTestID(),
+	}, &GetNFTHistoryReply{})
+	require.ErrorIs(err, nfts.ErrIndexingDisabled)
+}
+
 func TestImportExportKey(t *testing.T) {
 	require := require.New(t)
 
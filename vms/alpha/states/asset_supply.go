@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package states
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/wrappers"
+)
+
+// AssetSupply tracks how much of a mintable asset exists.
+type AssetSupply struct {
+	// Amount of the asset created by its CreateAssetTx and by mint operations.
+	// Every NFT counts as one unit.
+	Minted uint64 `serialize:"true" json:"minted"`
+	// Amount of the asset consumed by transactions without being produced
+	// again, such as the fees paid in the asset
+	Burned uint64 `serialize:"true" json:"burned"`
+}
+
+// Outstanding returns the amount of the asset that currently exists
+func (s AssetSupply) Outstanding() uint64 {
+	if s.Burned > s.Minted {
+		return 0
+	}
+	return s.Minted - s.Burned
+}
+
+type supplyMetrics struct {
+	minted, burned, outstanding *prometheus.GaugeVec
+}
+
+func newSupplyMetrics(registerer prometheus.Registerer) (*supplyMetrics, error) {
+	m := &supplyMetrics{
+		minted: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "asset_minted",
+				Help: "Amount of the asset that was minted",
+			},
+			[]string{"assetID"},
+		),
+		burned: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "asset_burned",
+				Help: "Amount of the asset that was burned",
+			},
+			[]string{"assetID"},
+		),
+		outstanding: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "asset_outstanding",
+				Help: "Amount of the asset that currently exists",
+			},
+			[]string{"assetID"},
+		),
+	}
+	errs := wrappers.Errs{}
+	errs.Add(
+		registerer.Register(m.minted),
+		registerer.Register(m.burned),
+		registerer.Register(m.outstanding),
+	)
+	return m, errs.Err
+}
+
+func (m *supplyMetrics) set(assetID ids.ID, supply AssetSupply) {
+	assetIDStr := assetID.String()
+	m.minted.WithLabelValues(assetIDStr).Set(float64(supply.Minted))
+	m.burned.WithLabelValues(assetIDStr).Set(float64(supply.Burned))
+	m.outstanding.WithLabelValues(assetIDStr).Set(float64(supply.Outstanding()))
+}
@@ -37,8 +37,11 @@ type diff struct {
 	addedBlockIDs map[uint64]ids.ID      // map of height -> blockID
 	addedBlocks   map[ids.ID]block.Block // map of blockID -> block
 
+	modifiedSupplies map[ids.ID]AssetSupply // map of assetID -> supply
+
 	lastAccepted ids.ID
 	timestamp    time.Time
+	feeRate      uint64
 }
 
 func NewDiff(
@@ -58,6 +61,9 @@ func NewDiff(
 		addedBlocks:   make(map[ids.ID]block.Block),
 		lastAccepted:  parentState.GetLastAccepted(),
 		timestamp:     parentState.GetTimestamp(),
+		feeRate:       parentState.GetFeeRate(),
+
+		modifiedSupplies: make(map[ids.ID]AssetSupply),
 	}, nil
 }
 
@@ -146,6 +152,30 @@ func (d *diff) SetTimestamp(t time.Time) {
 	d.timestamp = t
 }
 
+func (d *diff) GetFeeRate() uint64 {
+	return d.feeRate
+}
+
+func (d *diff) SetFeeRate(feeRate uint64) {
+	d.feeRate = feeRate
+}
+
+func (d *diff) GetAssetSupply(assetID ids.ID) (AssetSupply, error) {
+	if supply, modified := d.modifiedSupplies[assetID]; modified {
+		return supply, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return AssetSupply{}, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+	return parentState.GetAssetSupply(assetID)
+}
+
+func (d *diff) SetAssetSupply(assetID ids.ID, supply AssetSupply) {
+	d.modifiedSupplies[assetID] = supply
+}
+
 func (d *diff) Apply(state Chain) {
 	for utxoID, utxo := range d.modifiedUTXOs {
 		if utxo != nil {
@@ -163,6 +193,11 @@ func (d *diff) Apply(state Chain) {
 		state.AddBlock(blk)
 	}
 
+	for assetID, supply := range d.modifiedSupplies {
+		state.SetAssetSupply(assetID, supply)
+	}
+
 	state.SetLastAccepted(d.lastAccepted)
 	state.SetTimestamp(d.timestamp)
+	state.SetFeeRate(d.feeRate)
 }
@@ -92,6 +92,21 @@ func (mr *MockChainMockRecorder) DeleteUTXO(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUTXO", reflect.TypeOf((*MockChain)(nil).DeleteUTXO), arg0)
 }
 
+// GetAssetSupply mocks base method.
+func (m *MockChain) GetAssetSupply(arg0 ids.ID) (AssetSupply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetSupply", arg0)
+	ret0, _ := ret[0].(AssetSupply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetSupply indicates an expected call of GetAssetSupply.
+func (mr *MockChainMockRecorder) GetAssetSupply(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetSupply", reflect.TypeOf((*MockChain)(nil).GetAssetSupply), arg0)
+}
+
 // GetBlock mocks base method.
 func (m *MockChain) GetBlock(arg0 ids.ID) (block.Block, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +137,20 @@ func (mr *MockChainMockRecorder) GetBlockIDAtHeight(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockIDAtHeight", reflect.TypeOf((*MockChain)(nil).GetBlockIDAtHeight), arg0)
 }
 
+// GetFeeRate mocks base method.
+func (m *MockChain) GetFeeRate() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeRate")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetFeeRate indicates an expected call of GetFeeRate.
+func (mr *MockChainMockRecorder) GetFeeRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeRate", reflect.TypeOf((*MockChain)(nil).GetFeeRate))
+}
+
 // GetLastAccepted mocks base method.
 func (m *MockChain) GetLastAccepted() ids.ID {
 	m.ctrl.T.Helper()
@@ -180,6 +209,30 @@ func (mr *MockChainMockRecorder) GetUTXO(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXO", reflect.TypeOf((*MockChain)(nil).GetUTXO), arg0)
 }
 
+// SetAssetSupply mocks base method.
+func (m *MockChain) SetAssetSupply(arg0 ids.ID, arg1 AssetSupply) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAssetSupply", arg0, arg1)
+}
+
+// SetAssetSupply indicates an expected call of SetAssetSupply.
+func (mr *MockChainMockRecorder) SetAssetSupply(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAssetSupply", reflect.TypeOf((*MockChain)(nil).SetAssetSupply), arg0, arg1)
+}
+
+// SetFeeRate mocks base method.
+func (m *MockChain) SetFeeRate(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFeeRate", arg0)
+}
+
+// SetFeeRate indicates an expected call of SetFeeRate.
+func (mr *MockChainMockRecorder) SetFeeRate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeeRate", reflect.TypeOf((*MockChain)(nil).SetFeeRate), arg0)
+}
+
 // SetLastAccepted mocks base method.
 func (m *MockChain) SetLastAccepted(arg0 ids.ID) {
 	m.ctrl.T.Helper()
@@ -345,6 +398,21 @@ func (mr *MockStateMockRecorder) DeleteUTXO(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUTXO", reflect.TypeOf((*MockState)(nil).DeleteUTXO), arg0)
 }
 
+// GetAssetSupply mocks base method.
+func (m *MockState) GetAssetSupply(arg0 ids.ID) (AssetSupply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetSupply", arg0)
+	ret0, _ := ret[0].(AssetSupply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetSupply indicates an expected call of GetAssetSupply.
+func (mr *MockStateMockRecorder) GetAssetSupply(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetSupply", reflect.TypeOf((*MockState)(nil).GetAssetSupply), arg0)
+}
+
 // GetBlock mocks base method.
 func (m *MockState) GetBlock(arg0 ids.ID) (block.Block, error) {
 	m.ctrl.T.Helper()
@@ -375,6 +443,20 @@ func (mr *MockStateMockRecorder) GetBlockIDAtHeight(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockIDAtHeight", reflect.TypeOf((*MockState)(nil).GetBlockIDAtHeight), arg0)
 }
 
+// GetFeeRate mocks base method.
+func (m *MockState) GetFeeRate() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeRate")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetFeeRate indicates an expected call of GetFeeRate.
+func (mr *MockStateMockRecorder) GetFeeRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeRate", reflect.TypeOf((*MockState)(nil).GetFeeRate))
+}
+
 // GetLastAccepted mocks base method.
 func (m *MockState) GetLastAccepted() ids.ID {
 	m.ctrl.T.Helper()
@@ -476,6 +558,30 @@ func (mr *MockStateMockRecorder) Prune(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prune", reflect.TypeOf((*MockState)(nil).Prune), arg0, arg1)
 }
 
+// SetAssetSupply mocks base method.
+func (m *MockState) SetAssetSupply(arg0 ids.ID, arg1 AssetSupply) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAssetSupply", arg0, arg1)
+}
+
+// SetAssetSupply indicates an expected call of SetAssetSupply.
+func (mr *MockStateMockRecorder) SetAssetSupply(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAssetSupply", reflect.TypeOf((*MockState)(nil).SetAssetSupply), arg0, arg1)
+}
+
+// SetFeeRate mocks base method.
+func (m *MockState) SetFeeRate(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFeeRate", arg0)
+}
+
+// SetFeeRate indicates an expected call of SetFeeRate.
+func (mr *MockStateMockRecorder) SetFeeRate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeeRate", reflect.TypeOf((*MockState)(nil).SetFeeRate), arg0)
+}
+
 // SetInitialized mocks base method.
 func (m *MockState) SetInitialized() error {
 	m.ctrl.T.Helper()
@@ -612,6 +718,21 @@ func (mr *MockDiffMockRecorder) DeleteUTXO(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUTXO", reflect.TypeOf((*MockDiff)(nil).DeleteUTXO), arg0)
 }
 
+// GetAssetSupply mocks base method.
+func (m *MockDiff) GetAssetSupply(arg0 ids.ID) (AssetSupply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetSupply", arg0)
+	ret0, _ := ret[0].(AssetSupply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetSupply indicates an expected call of GetAssetSupply.
+func (mr *MockDiffMockRecorder) GetAssetSupply(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetSupply", reflect.TypeOf((*MockDiff)(nil).GetAssetSupply), arg0)
+}
+
 // GetBlock mocks base method.
 func (m *MockDiff) GetBlock(arg0 ids.ID) (block.Block, error) {
 	m.ctrl.T.Helper()
@@ -642,6 +763,20 @@ func (mr *MockDiffMockRecorder) GetBlockIDAtHeight(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockIDAtHeight", reflect.TypeOf((*MockDiff)(nil).GetBlockIDAtHeight), arg0)
 }
 
+// GetFeeRate mocks base method.
+func (m *MockDiff) GetFeeRate() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeRate")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetFeeRate indicates an expected call of GetFeeRate.
+func (mr *MockDiffMockRecorder) GetFeeRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeRate", reflect.TypeOf((*MockDiff)(nil).GetFeeRate))
+}
+
 // GetLastAccepted mocks base method.
 func (m *MockDiff) GetLastAccepted() ids.ID {
 	m.ctrl.T.Helper()
@@ -700,6 +835,30 @@ func (mr *MockDiffMockRecorder) GetUTXO(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXO", reflect.TypeOf((*MockDiff)(nil).GetUTXO), arg0)
 }
 
+// SetAssetSupply mocks base method.
+func (m *MockDiff) SetAssetSupply(arg0 ids.ID, arg1 AssetSupply) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAssetSupply", arg0, arg1)
+}
+
+// SetAssetSupply indicates an expected call of SetAssetSupply.
+func (mr *MockDiffMockRecorder) SetAssetSupply(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAssetSupply", reflect.TypeOf((*MockDiff)(nil).SetAssetSupply), arg0, arg1)
+}
+
+// SetFeeRate mocks base method.
+func (m *MockDiff) SetFeeRate(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFeeRate", arg0)
+}
+
+// SetFeeRate indicates an expected call of SetFeeRate.
+func (mr *MockDiffMockRecorder) SetFeeRate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeeRate", reflect.TypeOf((*MockDiff)(nil).SetFeeRate), arg0)
+}
+
 // SetLastAccepted mocks base method.
 func (m *MockDiff) SetLastAccepted(arg0 ids.ID) {
 	m.ctrl.T.Helper()
@@ -30,6 +30,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/alpha/block"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 )
 
 const (
@@ -50,11 +51,13 @@ var (
 	txPrefix        = []byte("tx")
 	blockIDPrefix   = []byte("blockID")
 	blockPrefix     = []byte("block")
+	supplyPrefix    = []byte("supply")
 	singletonPrefix = []byte("singleton")
 
 	isInitializedKey = []byte{0x00}
 	timestampKey     = []byte{0x01}
 	lastAcceptedKey  = []byte{0x02}
+	feeRateKey       = []byte{0x03}
 
 	errStatusWithoutTx = errors.New("unexpected status without transactions")
 
@@ -69,6 +72,12 @@ type ReadOnlyChain interface {
 	GetBlock(blkID ids.ID) (block.Block, error)
 	GetLastAccepted() ids.ID
 	GetTimestamp() time.Time
+	// GetFeeRate returns the rate, in parts of [fees.RateDenominator], the
+	// static fees are charged at by the next block.
+	GetFeeRate() uint64
+	// GetAssetSupply returns the supply of the mintable asset [assetID].
+	// Returns [database.ErrNotFound] if the supply of the asset isn't tracked.
+	GetAssetSupply(assetID ids.ID) (AssetSupply, error)
 }
 
 type Chain interface {
@@ -80,6 +89,8 @@ type Chain interface {
 	AddBlock(block block.Block)
 	SetLastAccepted(blkID ids.ID)
 	SetTimestamp(t time.Time)
+	SetFeeRate(feeRate uint64)
+	SetAssetSupply(assetID ids.ID, supply AssetSupply)
 }
 
 // State persistently maintains a set of UTXOs, transaction, statuses, and
@@ -140,10 +151,13 @@ type State interface {
  * | '-- height -> blockID
  * |-. blocks
  * | '-- blockID -> block bytes
+ * |-. supplies
+ * | '-- assetID -> supply bytes
  * '-. singletons
  *   |-- initializedKey -> nil
  *   |-- timestampKey -> timestamp
- *   '-- lastAcceptedKey -> lastAccepted
+ *   |-- lastAcceptedKey -> lastAccepted
+ *   '-- feeRateKey -> feeRate
  */
 type state struct {
 	parser block.Parser
@@ -169,9 +183,14 @@ type state struct {
 	blockCache  cache.Cacher[ids.ID, block.Block] // cache of blockID -> Block. If the entry is nil, it is not in the database
 	blockDB     database.Database
 
+	modifiedSupplies map[ids.ID]AssetSupply // map of assetID -> supply
+	supplyDB         database.Database
+	supplyMetrics    *supplyMetrics // nil if the supply metrics are disabled
+
 	// [lastAccepted] is the most recently accepted block.
 	lastAccepted, persistedLastAccepted ids.ID
 	timestamp, persistedTimestamp       time.Time
+	feeRate, persistedFeeRate           uint64
 	singletonDB                         database.Database
 
 	trackChecksum bool
@@ -183,12 +202,14 @@ func New(
 	parser block.Parser,
 	metrics prometheus.Registerer,
 	trackChecksums bool,
+	supplyMetricsEnabled bool,
 ) (State, error) {
 	utxoDB := prefixdb.New(utxoPrefix, db)
 	statusDB := prefixdb.New(statusPrefix, db)
 	txDB := prefixdb.New(txPrefix, db)
 	blockIDDB := prefixdb.New(blockIDPrefix, db)
 	blockDB := prefixdb.New(blockPrefix, db)
+	supplyDB := prefixdb.New(supplyPrefix, db)
 	singletonDB := prefixdb.New(singletonPrefix, db)
 
 	statusCache, err := metercacher.New[ids.ID, *choices.Status](
@@ -255,10 +276,20 @@ func New(
 		blockCache:  blockCache,
 		blockDB:     blockDB,
 
-		singletonDB: singletonDB,
+		modifiedSupplies: make(map[ids.ID]AssetSupply),
+		supplyDB:         supplyDB,
+
+		feeRate:          fees.RateDenominator,
+		persistedFeeRate: fees.RateDenominator,
+		singletonDB:      singletonDB,
 
 		trackChecksum: trackChecksums,
 	}
+	if supplyMetricsEnabled {
+		if err := s.initSupplyMetrics(metrics); err != nil {
+			return nil, err
+		}
+	}
 	return s, s.initTxChecksum()
 }
 
@@ -445,6 +476,53 @@ func (s *state) AddBlock(block block.Block) {
 	s.addedBlocks[blkID] = block
 }
 
+func (s *state) GetAssetSupply(assetID ids.ID) (AssetSupply, error) {
+	if supply, exists := s.modifiedSupplies[assetID]; exists {
+		return supply, nil
+	}
+
+	supplyBytes, err := s.supplyDB.Get(assetID[:])
+	if err != nil {
+		return AssetSupply{}, err
+	}
+
+	var supply AssetSupply
+	_, err = s.parser.Codec().Unmarshal(supplyBytes, &supply)
+	return supply, err
+}
+
+func (s *state) SetAssetSupply(assetID ids.ID, supply AssetSupply) {
+	s.modifiedSupplies[assetID] = supply
+}
+
+// initSupplyMetrics registers the supply metrics and reports the supplies that
+// are already tracked.
+func (s *state) initSupplyMetrics(registerer prometheus.Registerer) error {
+	supplyMetrics, err := newSupplyMetrics(registerer)
+	if err != nil {
+		return err
+	}
+
+	iter := s.supplyDB.NewIterator()
+	defer iter.Release()
+
+	for iter.Next() {
+		assetID, err := ids.ToID(iter.Key())
+		if err != nil {
+			return err
+		}
+
+		var supply AssetSupply
+		if _, err := s.parser.Codec().Unmarshal(iter.Value(), &supply); err != nil {
+			return err
+		}
+		supplyMetrics.set(assetID, supply)
+	}
+
+	s.supplyMetrics = supplyMetrics
+	return iter.Error()
+}
+
 func (s *state) InitializeChainState(stopVertexID ids.ID, genesisTimestamp time.Time) error {
 	lastAccepted, err := database.GetID(s.singletonDB, lastAcceptedKey)
 	if err == database.ErrNotFound {
@@ -455,8 +533,23 @@ func (s *state) InitializeChainState(stopVertexID ids.ID, genesisTimestamp time.
 	s.lastAccepted = lastAccepted
 	s.persistedLastAccepted = lastAccepted
 	s.timestamp, err = database.GetTimestamp(s.singletonDB, timestampKey)
+	if err != nil {
+		return err
+	}
 	s.persistedTimestamp = s.timestamp
-	return err
+
+	// The fee rate isn't stored until a block is accepted after dynamic fees
+	// are introduced, so the static fees are charged until then.
+	feeRate, err := database.GetUInt64(s.singletonDB, feeRateKey)
+	switch err {
+	case nil:
+		s.feeRate = feeRate
+		s.persistedFeeRate = feeRate
+	case database.ErrNotFound:
+	default:
+		return err
+	}
+	return nil
 }
 
 func (s *state) initializeChainState(stopVertexID ids.ID, genesisTimestamp time.Time) error {
@@ -501,6 +594,14 @@ func (s *state) SetTimestamp(t time.Time) {
 	s.timestamp = t
 }
 
+func (s *state) GetFeeRate() uint64 {
+	return s.feeRate
+}
+
+func (s *state) SetFeeRate(feeRate uint64) {
+	s.feeRate = feeRate
+}
+
 func (s *state) Commit() error {
 	defer s.Abort()
 	batch, err := s.CommitBatch()
@@ -529,6 +630,7 @@ func (s *state) Close() error {
 		s.txDB.Close(),
 		s.blockIDDB.Close(),
 		s.blockDB.Close(),
+		s.supplyDB.Close(),
 		s.singletonDB.Close(),
 		s.db.Close(),
 	)
@@ -542,6 +644,7 @@ func (s *state) write() error {
 		s.writeTxs(),
 		s.writeBlockIDs(),
 		s.writeBlocks(),
+		s.writeSupplies(),
 		s.writeMetadata(),
 	)
 	return errs.Err
@@ -609,6 +712,25 @@ func (s *state) writeBlocks() error {
 	return nil
 }
 
+func (s *state) writeSupplies() error {
+	for assetID, supply := range s.modifiedSupplies {
+		assetID := assetID
+
+		delete(s.modifiedSupplies, assetID)
+		supplyBytes, err := s.parser.Codec().Marshal(txs.CodecVersion, &supply)
+		if err != nil {
+			return fmt.Errorf("failed to marshal asset supply: %w", err)
+		}
+		if err := s.supplyDB.Put(assetID[:], supplyBytes); err != nil {
+			return fmt.Errorf("failed to write asset supply: %w", err)
+		}
+		if s.supplyMetrics != nil {
+			s.supplyMetrics.set(assetID, supply)
+		}
+	}
+	return nil
+}
+
 func (s *state) writeMetadata() error {
 	if !s.persistedTimestamp.Equal(s.timestamp) {
 		if err := database.PutTimestamp(s.singletonDB, timestampKey, s.timestamp); err != nil {
@@ -616,6 +738,12 @@ func (s *state) writeMetadata() error {
 		}
 		s.persistedTimestamp = s.timestamp
 	}
+	if s.persistedFeeRate != s.feeRate {
+		if err := database.PutUInt64(s.singletonDB, feeRateKey, s.feeRate); err != nil {
+			return fmt.Errorf("failed to write fee rate: %w", err)
+		}
+		s.persistedFeeRate = s.feeRate
+	}
 	if s.persistedLastAccepted != s.lastAccepted {
 		if err := database.PutID(s.singletonDB, lastAcceptedKey, s.lastAccepted); err != nil {
 			return fmt.Errorf("failed to write last accepted: %w", err)
@@ -4,6 +4,7 @@
 package states
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -101,7 +102,7 @@ func TestState(t *testing.T) {
 
 	db := memdb.New()
 	vdb := versiondb.New(db)
-	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false)
 	require.NoError(err)
 
 	s.AddUTXO(populatedUTXO)
@@ -109,7 +110,7 @@ func TestState(t *testing.T) {
 	s.AddBlock(populatedBlk)
 	require.NoError(s.Commit())
 
-	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false)
 	require.NoError(err)
 
 	ChainUTXOTest(t, s)
@@ -122,7 +123,7 @@ func TestDiff(t *testing.T) {
 
 	db := memdb.New()
 	vdb := versiondb.New(db)
-	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false)
 	require.NoError(err)
 
 	s.AddUTXO(populatedUTXO)
@@ -143,6 +144,69 @@ func TestDiff(t *testing.T) {
 	ChainBlockTest(t, d)
 }
 
+func TestAssetSupply(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, true)
+	require.NoError(err)
+
+	assetID := ids.GenerateTestID()
+	_, err = s.GetAssetSupply(assetID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	s.SetAssetSupply(assetID, AssetSupply{Minted: 10})
+	require.NoError(s.Commit())
+
+	parentID := ids.GenerateTestID()
+	d, err := NewDiff(parentID, &versions{
+		chains: map[ids.ID]Chain{
+			parentID: s,
+		},
+	})
+	require.NoError(err)
+
+	supply, err := d.GetAssetSupply(assetID)
+	require.NoError(err)
+	require.Equal(AssetSupply{Minted: 10}, supply)
+
+	d.SetAssetSupply(assetID, AssetSupply{Minted: 15, Burned: 3})
+	supply, err = s.GetAssetSupply(assetID)
+	require.NoError(err)
+	require.Equal(AssetSupply{Minted: 10}, supply)
+
+	d.Apply(s)
+	require.NoError(s.Commit())
+
+	// Reload the state to make sure the supply was persisted and reported
+	registerer := prometheus.NewRegistry()
+	s, err = New(vdb, parser, registerer, trackChecksums, true)
+	require.NoError(err)
+
+	supply, err = s.GetAssetSupply(assetID)
+	require.NoError(err)
+	require.Equal(AssetSupply{Minted: 15, Burned: 3}, supply)
+	require.Equal(uint64(12), supply.Outstanding())
+
+	metrics, err := registerer.Gather()
+	require.NoError(err)
+	values := make(map[string]float64)
+	for _, metric := range metrics {
+		if !strings.HasPrefix(metric.GetName(), "asset_") {
+			continue
+		}
+		for _, m := range metric.GetMetric() {
+			values[metric.GetName()] = m.GetGauge().GetValue()
+		}
+	}
+	require.Equal(map[string]float64{
+		"asset_minted":      15,
+		"asset_burned":      3,
+		"asset_outstanding": 12,
+	}, values)
+}
+
 func ChainUTXOTest(t *testing.T, c Chain) {
 	require := require.New(t)
 
@@ -282,7 +346,7 @@ func TestInitializeChainState(t *testing.T) {
 
 	db := memdb.New()
 	vdb := versiondb.New(db)
-	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false)
 	require.NoError(err)
 
 	stopVertexID := ids.GenerateTestID()
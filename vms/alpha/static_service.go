@@ -19,6 +19,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
 	"github.com/DioneProtocol/odysseygo/vms/nftfx"
 	"github.com/DioneProtocol/odysseygo/vms/propertyfx"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
@@ -44,6 +45,11 @@ var (
 	_ fxs.FxOperation   = (*propertyfx.MintOperation)(nil)
 	_ fxs.FxOperation   = (*propertyfx.BurnOperation)(nil)
 	_ verify.Verifiable = (*propertyfx.Credential)(nil)
+
+	_ dione.TransferableIn  = (*htlcfx.TransferInput)(nil)
+	_ dione.TransferableOut = (*htlcfx.TransferOutput)(nil)
+	_ dione.Addressable     = (*htlcfx.TransferOutput)(nil)
+	_ verify.Verifiable     = (*htlcfx.Credential)(nil)
 )
 
 // StaticService defines the base service for the asset vm
@@ -81,6 +87,7 @@ func (*StaticService) BuildGenesis(_ *http.Request, args *BuildGenesisArgs, repl
 		&secp256k1fx.Fx{},
 		&nftfx.Fx{},
 		&propertyfx.Fx{},
+		&htlcfx.Fx{},
 	})
 	if err != nil {
 		return err
@@ -40,7 +40,8 @@ func (tx *Tx) Accept(context.Context) error {
 		return fmt.Errorf("%w: %s", errTxNotProcessing, s)
 	}
 
-	if err := tx.vm.onAccept(tx.tx); err != nil {
+	// Transactions accepted before the chain was linearized have no height
+	if err := tx.vm.onAccept(tx.tx, 0); err != nil {
 		return err
 	}
 
@@ -8,11 +8,17 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/chains/atomic"
 	"github.com/DioneProtocol/odysseygo/codec"
+	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/math"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/states"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
+	"github.com/DioneProtocol/odysseygo/vms/nftfx"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 )
 
 var _ txs.Visitor = (*Executor)(nil)
@@ -26,14 +32,17 @@ type Executor struct {
 }
 
 func (e *Executor) BaseTx(tx *txs.BaseTx) error {
-	txID := e.Tx.ID()
-	dione.Consume(e.State, tx.Ins)
-	dione.Produce(e.State, txID, tx.Outs)
-	return nil
+	if err := e.baseTx(tx); err != nil {
+		return err
+	}
+	return e.burn(tx.Ins, tx.Outs)
 }
 
 func (e *Executor) CreateAssetTx(tx *txs.CreateAssetTx) error {
-	if err := e.BaseTx(&tx.BaseTx); err != nil {
+	if err := e.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	if err := e.burn(tx.Ins, tx.Outs); err != nil {
 		return err
 	}
 
@@ -41,7 +50,7 @@ func (e *Executor) CreateAssetTx(tx *txs.CreateAssetTx) error {
 	index := len(tx.Outs)
 	for _, state := range tx.States {
 		for _, out := range state.Outs {
-			e.State.AddUTXO(&dione.UTXO{
+			err := e.addUTXO(&dione.UTXO{
 				UTXOID: dione.UTXOID{
 					TxID:        txID,
 					OutputIndex: uint32(index),
@@ -51,14 +60,26 @@ func (e *Executor) CreateAssetTx(tx *txs.CreateAssetTx) error {
 				},
 				Out: out,
 			})
+			if err != nil {
+				return err
+			}
 			index++
 		}
 	}
+
+	if supply, mintable, err := InitialAssetSupply(tx); err != nil {
+		return err
+	} else if mintable {
+		e.State.SetAssetSupply(txID, supply)
+	}
 	return nil
 }
 
 func (e *Executor) OperationTx(tx *txs.OperationTx) error {
-	if err := e.BaseTx(&tx.BaseTx); err != nil {
+	if err := e.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	if err := e.burn(tx.Ins, tx.Outs); err != nil {
 		return err
 	}
 
@@ -70,7 +91,7 @@ func (e *Executor) OperationTx(tx *txs.OperationTx) error {
 		}
 		asset := op.AssetID()
 		for _, out := range op.Op.Outs() {
-			e.State.AddUTXO(&dione.UTXO{
+			err := e.addUTXO(&dione.UTXO{
 				UTXOID: dione.UTXOID{
 					TxID:        txID,
 					OutputIndex: uint32(index),
@@ -78,14 +99,36 @@ func (e *Executor) OperationTx(tx *txs.OperationTx) error {
 				Asset: dione.Asset{ID: asset},
 				Out:   out,
 			})
+			if err != nil {
+				return err
+			}
 			index++
 		}
+
+		var minted uint64
+		switch op := op.Op.(type) {
+		case *secp256k1fx.MintOperation:
+			minted = op.TransferOutput.Amt
+		case *nftfx.MintOperation:
+			minted = uint64(len(op.Outputs))
+		default:
+			continue
+		}
+		if err := e.mint(asset, minted); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 func (e *Executor) ImportTx(tx *txs.ImportTx) error {
-	if err := e.BaseTx(&tx.BaseTx); err != nil {
+	if err := e.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	ins := make([]*dione.TransferableInput, 0, len(tx.Ins)+len(tx.ImportedIns))
+	ins = append(ins, tx.Ins...)
+	ins = append(ins, tx.ImportedIns...)
+	if err := e.burn(ins, tx.Outs); err != nil {
 		return err
 	}
 
@@ -105,7 +148,13 @@ func (e *Executor) ImportTx(tx *txs.ImportTx) error {
 }
 
 func (e *Executor) ExportTx(tx *txs.ExportTx) error {
-	if err := e.BaseTx(&tx.BaseTx); err != nil {
+	if err := e.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	outs := make([]*dione.TransferableOutput, 0, len(tx.Outs)+len(tx.ExportedOuts))
+	outs = append(outs, tx.Outs...)
+	outs = append(outs, tx.ExportedOuts...)
+	if err := e.burn(tx.Ins, outs); err != nil {
 		return err
 	}
 
@@ -113,13 +162,19 @@ func (e *Executor) ExportTx(tx *txs.ExportTx) error {
 	index := len(tx.Outs)
 	elems := make([]*atomic.Element, len(tx.ExportedOuts))
 	for i, out := range tx.ExportedOuts {
+		// The destination chain can't resolve the relative timeout of an
+		// htlcfx output, so it is resolved before the output is exported.
+		resolvedOut, err := e.resolveOut(out.Out)
+		if err != nil {
+			return err
+		}
 		utxo := &dione.UTXO{
 			UTXOID: dione.UTXOID{
 				TxID:        txID,
 				OutputIndex: uint32(index),
 			},
 			Asset: dione.Asset{ID: out.AssetID()},
-			Out:   out.Out,
+			Out:   resolvedOut,
 		}
 		index++
 
@@ -145,3 +200,146 @@ func (e *Executor) ExportTx(tx *txs.ExportTx) error {
 	}
 	return nil
 }
+
+func (e *Executor) baseTx(tx *txs.BaseTx) error {
+	txID := e.Tx.ID()
+	dione.Consume(e.State, tx.Ins)
+	for index, out := range tx.Outs {
+		err := e.addUTXO(&dione.UTXO{
+			UTXOID: dione.UTXOID{
+				TxID:        txID,
+				OutputIndex: uint32(index),
+			},
+			Asset: out.Asset,
+			Out:   out.Output(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addUTXO adds [utxo] to the state, with its output resolved by [resolveOut].
+//
+// As a result, the bytes of a stored htlcfx UTXO with a relative timeout
+// differ from the bytes of the output in the tx that created it, while its
+// UTXO ID doesn't change. Such a UTXO must be fetched from the chain to be
+// spent, rather than derived from the tx.
+func (e *Executor) addUTXO(utxo *dione.UTXO) error {
+	out, err := e.resolveOut(utxo.Out)
+	if err != nil {
+		return err
+	}
+	utxo.Out = out
+	e.State.AddUTXO(utxo)
+	return nil
+}
+
+// resolveOut resolves the relative timeout of an htlcfx output against the
+// chain time the output is accepted at, so that the output can be spent
+// without knowing when it was accepted. Other outputs are returned as is.
+func (e *Executor) resolveOut(out verify.State) (verify.State, error) {
+	htlcOut, ok := out.(*htlcfx.TransferOutput)
+	if !ok {
+		return out, nil
+	}
+	acceptedAt := uint64(e.State.GetTimestamp().Unix())
+	resolved, err := htlcOut.Resolve(acceptedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve HTLC timeout: %w", err)
+	}
+	return resolved, nil
+}
+
+// mint adds [amount] to the minted supply of [assetID], if its supply is
+// tracked.
+func (e *Executor) mint(assetID ids.ID, amount uint64) error {
+	supply, err := e.State.GetAssetSupply(assetID)
+	if err == database.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	supply.Minted, err = math.Add64(supply.Minted, amount)
+	if err != nil {
+		return err
+	}
+	e.State.SetAssetSupply(assetID, supply)
+	return nil
+}
+
+// burn adds the amounts of the assets that [ins] consume, but [outs] don't
+// produce, to the burned supplies of the assets whose supply is tracked.
+func (e *Executor) burn(ins []*dione.TransferableInput, outs []*dione.TransferableOutput) error {
+	consumed := make(map[ids.ID]uint64, len(ins))
+	for _, in := range ins {
+		assetID := in.AssetID()
+		amount, err := math.Add64(consumed[assetID], in.In.Amount())
+		if err != nil {
+			return err
+		}
+		consumed[assetID] = amount
+	}
+
+	produced := make(map[ids.ID]uint64, len(outs))
+	for _, out := range outs {
+		assetID := out.AssetID()
+		amount, err := math.Add64(produced[assetID], out.Out.Amount())
+		if err != nil {
+			return err
+		}
+		produced[assetID] = amount
+	}
+
+	for assetID, consumedAmount := range consumed {
+		producedAmount := produced[assetID]
+		if consumedAmount <= producedAmount {
+			continue
+		}
+
+		supply, err := e.State.GetAssetSupply(assetID)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		supply.Burned, err = math.Add64(supply.Burned, consumedAmount-producedAmount)
+		if err != nil {
+			return err
+		}
+		e.State.SetAssetSupply(assetID, supply)
+	}
+	return nil
+}
+
+// InitialAssetSupply returns the supply created by [tx]. Returns false if the
+// asset can't be minted after its creation, in which case its supply isn't
+// tracked.
+func InitialAssetSupply(tx *txs.CreateAssetTx) (states.AssetSupply, bool, error) {
+	var (
+		supply   states.AssetSupply
+		mintable bool
+		err      error
+	)
+	for _, state := range tx.States {
+		for _, out := range state.Outs {
+			switch out := out.(type) {
+			case *secp256k1fx.MintOutput, *nftfx.MintOutput:
+				mintable = true
+			case *secp256k1fx.TransferOutput:
+				supply.Minted, err = math.Add64(supply.Minted, out.Amt)
+			case *nftfx.TransferOutput:
+				supply.Minted, err = math.Add64(supply.Minted, 1)
+			}
+			if err != nil {
+				return states.AssetSupply{}, false, err
+			}
+		}
+	}
+	return supply, mintable, nil
+}
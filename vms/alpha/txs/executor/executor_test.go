@@ -5,6 +5,7 @@ package executor
 
 import (
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 )
 
@@ -44,7 +46,7 @@ func TestBaseTxExecutor(t *testing.T) {
 	db := memdb.New()
 	vdb := versiondb.New(db)
 	registerer := prometheus.NewRegistry()
-	state, err := states.New(vdb, parser, registerer, trackChecksums)
+	state, err := states.New(vdb, parser, registerer, trackChecksums, false)
 	require.NoError(err)
 
 	utxoID := dione.UTXOID{
@@ -138,6 +140,85 @@ func TestBaseTxExecutor(t *testing.T) {
 	require.Equal(expectedOutputUTXO, outputUTXO)
 }
 
+func TestBaseTxExecutorResolvesHTLCTimeout(t *testing.T) {
+	require := require.New(t)
+
+	parser, err := block.NewParser([]fxs.Fx{
+		&secp256k1fx.Fx{},
+		&htlcfx.Fx{},
+	})
+	require.NoError(err)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	registerer := prometheus.NewRegistry()
+	state, err := states.New(vdb, parser, registerer, trackChecksums, false)
+	require.NoError(err)
+
+	acceptedAt := time.Unix(1_000, 0)
+	state.SetTimestamp(acceptedAt)
+
+	owners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{keys[0].Address()},
+	}
+	baseTx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: dione.BaseTx{
+		NetworkID:    constants.UnitTestID,
+		BlockchainID: chainID,
+		Outs: []*dione.TransferableOutput{
+			{
+				Asset: dione.Asset{ID: assetID},
+				Out: &htlcfx.TransferOutput{
+					Amt:             1,
+					RelativeTimeout: 60,
+					Recipient:       owners,
+					Sender:          owners,
+				},
+			},
+			{
+				Asset: dione.Asset{ID: assetID},
+				Out: &htlcfx.TransferOutput{
+					Amt:       1,
+					Timeout:   2_000,
+					Recipient: owners,
+					Sender:    owners,
+				},
+			},
+		},
+	}}}
+	require.NoError(parser.InitializeTx(baseTx))
+
+	require.NoError(baseTx.Unsigned.Visit(&Executor{
+		Codec: parser.Codec(),
+		State: state,
+		Tx:    baseTx,
+	}))
+
+	// The relative timeout is counted from the time the output was accepted
+	utxos := baseTx.UTXOs()
+	utxo, err := state.GetUTXO(utxos[0].InputID())
+	require.NoError(err)
+	require.Equal(&htlcfx.TransferOutput{
+		Amt:       1,
+		Timeout:   1_060,
+		Recipient: owners,
+		Sender:    owners,
+	}, utxo.Out)
+
+	// The stored UTXO keeps its ID but not the bytes of the output in the tx
+	require.Equal(utxos[0].InputID(), utxo.InputID())
+	txUTXOBytes, err := parser.Codec().Marshal(txs.CodecVersion, utxos[0])
+	require.NoError(err)
+	utxoBytes, err := parser.Codec().Marshal(txs.CodecVersion, utxo)
+	require.NoError(err)
+	require.NotEqual(txUTXOBytes, utxoBytes)
+
+	// Absolute timeouts are left as is
+	utxo, err = state.GetUTXO(utxos[1].InputID())
+	require.NoError(err)
+	require.Equal(utxos[1].Out, utxo.Out)
+}
+
 func TestCreateAssetTxExecutor(t *testing.T) {
 	require := require.New(t)
 
@@ -149,7 +230,7 @@ func TestCreateAssetTxExecutor(t *testing.T) {
 	db := memdb.New()
 	vdb := versiondb.New(db)
 	registerer := prometheus.NewRegistry()
-	state, err := states.New(vdb, parser, registerer, trackChecksums)
+	state, err := states.New(vdb, parser, registerer, trackChecksums, false)
 	require.NoError(err)
 
 	utxoID := dione.UTXOID{
@@ -279,6 +360,14 @@ func TestCreateAssetTxExecutor(t *testing.T) {
 		require.Equal(expectedOutputUTXOID, outputUTXOID)
 		require.Equal(expectedOutputUTXO, outputUTXO)
 	}
+
+	// Verify the supply of the mintable asset is tracked, while the supply of
+	// the fee asset isn't
+	supply, err := executor.State.GetAssetSupply(txID)
+	require.NoError(err)
+	require.Equal(states.AssetSupply{}, supply)
+	_, err = executor.State.GetAssetSupply(assetID)
+	require.ErrorIs(err, database.ErrNotFound)
 }
 
 func TestOperationTxExecutor(t *testing.T) {
@@ -292,7 +381,7 @@ func TestOperationTxExecutor(t *testing.T) {
 	db := memdb.New()
 	vdb := versiondb.New(db)
 	registerer := prometheus.NewRegistry()
-	state, err := states.New(vdb, parser, registerer, trackChecksums)
+	state, err := states.New(vdb, parser, registerer, trackChecksums, false)
 	require.NoError(err)
 
 	outputOwners := secp256k1fx.OutputOwners{
@@ -330,6 +419,9 @@ func TestOperationTxExecutor(t *testing.T) {
 	// Populate the UTXOs that we will be consuming
 	state.AddUTXO(utxo)
 	state.AddUTXO(opUTXO)
+	state.SetAssetSupply(assetID, states.AssetSupply{
+		Minted: 100 * units.KiloDione,
+	})
 	require.NoError(state.Commit())
 
 	operationTx := &txs.Tx{Unsigned: &txs.OperationTx{
@@ -449,4 +541,160 @@ func TestOperationTxExecutor(t *testing.T) {
 		require.Equal(expectedOutputUTXOID, outputUTXOID)
 		require.Equal(expectedOutputUTXO, outputUTXO)
 	}
+	// Verify the minted amount and the burned fee were added to the supply
+	supply, err := executor.State.GetAssetSupply(assetID)
+	require.NoError(err)
+	require.Equal(states.AssetSupply{
+		Minted: 100*units.KiloDione + 12345,
+		Burned: 10 * units.KiloDione,
+	}, supply)
+	require.Equal(90*units.KiloDione+12345, supply.Outstanding())
+}
+
+func TestExportTxExecutorSupply(t *testing.T) {
+	require := require.New(t)
+
+	secpFx := &secp256k1fx.Fx{}
+	parser, err := block.NewParser([]fxs.Fx{secpFx})
+	require.NoError(err)
+	codec := parser.Codec()
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	registerer := prometheus.NewRegistry()
+	state, err := states.New(vdb, parser, registerer, trackChecksums, false)
+	require.NoError(err)
+
+	outputOwners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs: []ids.ShortID{
+			keys[0].Address(),
+		},
+	}
+	utxoID := dione.UTXOID{
+		TxID:        ids.GenerateTestID(),
+		OutputIndex: 1,
+	}
+	state.AddUTXO(&dione.UTXO{
+		UTXOID: utxoID,
+		Asset:  dione.Asset{ID: assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          20 * units.KiloDione,
+			OutputOwners: outputOwners,
+		},
+	})
+	state.SetAssetSupply(assetID, states.AssetSupply{
+		Minted: 100 * units.KiloDione,
+	})
+	require.NoError(state.Commit())
+
+	exportTx := &txs.Tx{Unsigned: &txs.ExportTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: chainID,
+			Ins: []*dione.TransferableInput{{
+				UTXOID: utxoID,
+				Asset:  dione.Asset{ID: assetID},
+				In: &secp256k1fx.TransferInput{
+					Amt:   20 * units.KiloDione,
+					Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+				},
+			}},
+			Outs: []*dione.TransferableOutput{{
+				Asset: dione.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          5 * units.KiloDione,
+					OutputOwners: outputOwners,
+				},
+			}},
+		}},
+		DestinationChain: constants.OmegaChainID,
+		ExportedOuts: []*dione.TransferableOutput{{
+			Asset: dione.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          10 * units.KiloDione,
+				OutputOwners: outputOwners,
+			},
+		}},
+	}}
+	require.NoError(exportTx.SignSECP256K1Fx(codec, [][]*secp256k1.PrivateKey{{keys[0]}}))
+
+	executor := &Executor{
+		Codec: codec,
+		State: state,
+		Tx:    exportTx,
+	}
+	require.NoError(exportTx.Unsigned.Visit(executor))
+
+	// Only the fee is burned, the exported amount still exists on the
+	// destination chain
+	supply, err := executor.State.GetAssetSupply(assetID)
+	require.NoError(err)
+	require.Equal(states.AssetSupply{
+		Minted: 100 * units.KiloDione,
+		Burned: 5 * units.KiloDione,
+	}, supply)
+}
+
+func TestExportTxExecutorResolvesHTLCTimeout(t *testing.T) {
+	require := require.New(t)
+
+	parser, err := block.NewParser([]fxs.Fx{
+		&secp256k1fx.Fx{},
+		&htlcfx.Fx{},
+	})
+	require.NoError(err)
+	codec := parser.Codec()
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	registerer := prometheus.NewRegistry()
+	state, err := states.New(vdb, parser, registerer, trackChecksums, false)
+	require.NoError(err)
+
+	acceptedAt := time.Unix(1_000, 0)
+	state.SetTimestamp(acceptedAt)
+
+	owners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{keys[0].Address()},
+	}
+	exportTx := &txs.Tx{Unsigned: &txs.ExportTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: chainID,
+		}},
+		DestinationChain: constants.OmegaChainID,
+		ExportedOuts: []*dione.TransferableOutput{{
+			Asset: dione.Asset{ID: assetID},
+			Out: &htlcfx.TransferOutput{
+				Amt:             1,
+				RelativeTimeout: 60,
+				Recipient:       owners,
+				Sender:          owners,
+			},
+		}},
+	}}
+	require.NoError(parser.InitializeTx(exportTx))
+
+	executor := &Executor{
+		Codec: codec,
+		State: state,
+		Tx:    exportTx,
+	}
+	require.NoError(exportTx.Unsigned.Visit(executor))
+
+	// The relative timeout is resolved before the output reaches shared memory
+	requests := executor.AtomicRequests[constants.OmegaChainID]
+	require.Len(requests.PutRequests, 1)
+
+	utxo := &dione.UTXO{}
+	_, err = codec.Unmarshal(requests.PutRequests[0].Value, utxo)
+	require.NoError(err)
+	require.Equal(&htlcfx.TransferOutput{
+		Amt:       1,
+		Timeout:   1_060,
+		Recipient: owners,
+		Sender:    owners,
+	}, utxo.Out)
 }
@@ -12,16 +12,22 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/alpha/states"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 )
 
 var (
 	_ txs.Visitor = (*SemanticVerifier)(nil)
 
-	errAssetIDMismatch = errors.New("asset IDs in the input don't match the utxo")
-	errNotAnAsset      = errors.New("not an asset")
-	errIncompatibleFx  = errors.New("incompatible feature extension")
-	errUnknownFx       = errors.New("unknown feature extension")
+	errAssetIDMismatch  = errors.New("asset IDs in the input don't match the utxo")
+	errNotAnAsset       = errors.New("not an asset")
+	errIncompatibleFx   = errors.New("incompatible feature extension")
+	errUnknownFx        = errors.New("unknown feature extension")
+	errHTLCNotActivated = errors.New("attempting to use the htlcfx before its activation")
+
+	errWeightedOwnersNotActivated = errors.New("attempting to use weighted owners before their activation")
 )
 
 type SemanticVerifier struct {
@@ -31,6 +37,18 @@ type SemanticVerifier struct {
 }
 
 func (v *SemanticVerifier) BaseTx(tx *txs.BaseTx) error {
+	err := v.verifyDynamicFee(
+		v.Config.TxFee,
+		[][]*dione.TransferableInput{tx.Ins},
+		[][]*dione.TransferableOutput{tx.Outs},
+	)
+	if err != nil {
+		return err
+	}
+	return v.verifyBaseTx(tx)
+}
+
+func (v *SemanticVerifier) verifyBaseTx(tx *txs.BaseTx) error {
 	for i, in := range tx.Ins {
 		// Note: Verification of the length of [t.tx.Creds] happens during
 		// syntactic verification, which happens before semantic verification.
@@ -50,13 +68,38 @@ func (v *SemanticVerifier) BaseTx(tx *txs.BaseTx) error {
 		if err := v.verifyFxUsage(fxIndex, assetID); err != nil {
 			return err
 		}
+		if err := v.verifyWeightedOwners(out.Out); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 func (v *SemanticVerifier) CreateAssetTx(tx *txs.CreateAssetTx) error {
-	return v.BaseTx(&tx.BaseTx)
+	err := v.verifyDynamicFee(
+		v.Config.CreateAssetTxFee,
+		[][]*dione.TransferableInput{tx.Ins},
+		[][]*dione.TransferableOutput{tx.Outs},
+	)
+	if err != nil {
+		return err
+	}
+
+	// Note: The fx indices of the initial states are verified during
+	// syntactic verification, which happens before semantic verification.
+	for _, state := range tx.States {
+		isHTLCFx := v.Fxs[state.FxIndex].ID == htlcfx.ID
+		if isHTLCFx && !v.Config.IsHTLCActivated(v.State.GetTimestamp()) {
+			return errHTLCNotActivated
+		}
+		for _, out := range state.Outs {
+			if err := v.verifyWeightedOwners(out); err != nil {
+				return err
+			}
+		}
+	}
+	return v.verifyBaseTx(&tx.BaseTx)
 }
 
 func (v *SemanticVerifier) OperationTx(tx *txs.OperationTx) error {
@@ -81,7 +124,18 @@ func (v *SemanticVerifier) OperationTx(tx *txs.OperationTx) error {
 }
 
 func (v *SemanticVerifier) ImportTx(tx *txs.ImportTx) error {
-	if err := v.BaseTx(&tx.BaseTx); err != nil {
+	err := v.verifyDynamicFee(
+		v.Config.TxFee,
+		[][]*dione.TransferableInput{
+			tx.Ins,
+			tx.ImportedIns,
+		},
+		[][]*dione.TransferableOutput{tx.Outs},
+	)
+	if err != nil {
+		return err
+	}
+	if err := v.verifyBaseTx(&tx.BaseTx); err != nil {
 		return err
 	}
 
@@ -122,7 +176,18 @@ func (v *SemanticVerifier) ImportTx(tx *txs.ImportTx) error {
 }
 
 func (v *SemanticVerifier) ExportTx(tx *txs.ExportTx) error {
-	if err := v.BaseTx(&tx.BaseTx); err != nil {
+	err := v.verifyDynamicFee(
+		v.Config.TxFee,
+		[][]*dione.TransferableInput{tx.Ins},
+		[][]*dione.TransferableOutput{
+			tx.Outs,
+			tx.ExportedOuts,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if err := v.verifyBaseTx(&tx.BaseTx); err != nil {
 		return err
 	}
 
@@ -146,6 +211,29 @@ func (v *SemanticVerifier) ExportTx(tx *txs.ExportTx) error {
 	return nil
 }
 
+// verifyDynamicFee verifies that the tx burns the static [fee] charged at the
+// fee rate of the chain. The lowest fee the tx can burn is verified during
+// syntactic verification.
+func (v *SemanticVerifier) verifyDynamicFee(
+	fee uint64,
+	allIns [][]*dione.TransferableInput,
+	allOuts [][]*dione.TransferableOutput,
+) error {
+	// The state isn't read when dynamic fees are disabled.
+	if !v.Config.DynamicFeeConfig.IsEnabled() {
+		return nil
+	}
+
+	feeRate := v.Config.GetFeeRate(v.State.GetFeeRate(), v.State.GetTimestamp())
+	return dione.VerifyTx(
+		fees.CalculateFee(fee, feeRate),
+		v.FeeAssetID,
+		allIns,
+		allOuts,
+		v.Codec,
+	)
+}
+
 func (v *SemanticVerifier) verifyTransfer(
 	tx txs.UnsignedTx,
 	in *dione.TransferableInput,
@@ -233,15 +321,35 @@ func (v *SemanticVerifier) verifyFxUsage(
 		return errNotAnAsset
 	}
 
+	isHTLCFx := v.Fxs[fxID].ID == htlcfx.ID
+	if isHTLCFx && !v.Config.IsHTLCActivated(v.State.GetTimestamp()) {
+		return errHTLCNotActivated
+	}
+
 	for _, state := range createAssetTx.States {
 		if state.FxIndex == uint32(fxID) {
 			return nil
 		}
+		// The htlcfx locks the fungible tokens of the secp256k1fx, so it can
+		// be used with any asset supporting the secp256k1fx.
+		if isHTLCFx && v.Fxs[state.FxIndex].ID == secp256k1fx.ID {
+			return nil
+		}
 	}
 
 	return errIncompatibleFx
 }
 
+// verifyWeightedOwners verifies that [out] only has weighted owners once they
+// are activated.
+func (v *SemanticVerifier) verifyWeightedOwners(out verify.State) error {
+	_, isWeighted := out.(*secp256k1fx.WeightedTransferOutput)
+	if isWeighted && !v.Config.IsWeightedOwnersActivated(v.State.GetTimestamp()) {
+		return errWeightedOwnersNotActivated
+	}
+	return nil
+}
+
 func (v *SemanticVerifier) getFx(val interface{}) (int, error) {
 	valType := reflect.TypeOf(val)
 	fx, exists := v.TypeToFxIndex[valType]
@@ -6,6 +6,7 @@ package executor
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -22,11 +23,14 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/version"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/config"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/fxs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/states"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 )
 
@@ -387,6 +391,476 @@ func TestSemanticVerifierBaseTx(t *testing.T) {
 	}
 }
 
+func TestSemanticVerifierBaseTxDynamicFee(t *testing.T) {
+	ctx := newContext(t)
+
+	typeToFxIndex := make(map[reflect.Type]int)
+	secpFx := &secp256k1fx.Fx{}
+	parser, err := txs.NewCustomParser(
+		typeToFxIndex,
+		new(mockable.Clock),
+		logging.NoWarn{},
+		[]fxs.Fx{
+			secpFx,
+		},
+	)
+	require.NoError(t, err)
+
+	feeAssetID := ids.GenerateTestID()
+	utxoID := dione.UTXOID{
+		TxID:        ids.GenerateTestID(),
+		OutputIndex: 2,
+	}
+	baseTx := txs.BaseTx{
+		BaseTx: dione.BaseTx{
+			Ins: []*dione.TransferableInput{{
+				UTXOID: utxoID,
+				Asset:  dione.Asset{ID: feeAssetID},
+				In: &secp256k1fx.TransferInput{
+					Amt: 1_000,
+					Input: secp256k1fx.Input{
+						SigIndices: []uint32{0},
+					},
+				},
+			}},
+		},
+	}
+	tx := &txs.Tx{
+		Unsigned: &baseTx,
+	}
+	require.NoError(t, tx.SignSECP256K1Fx(
+		parser.Codec(),
+		[][]*secp256k1.PrivateKey{
+			{keys[0]},
+		},
+	))
+
+	backend := &Backend{
+		Ctx: ctx,
+		Config: &config.Config{
+			TxFee: 1_000,
+			DynamicFeeConfig: fees.DynamicFeeConfig{
+				TargetBlockSize:  1_000,
+				AdjustmentWindow: 8,
+				MinFeeRate:       1,
+				MaxFeeRate:       2 * fees.RateDenominator,
+			},
+		},
+		Fxs: []*fxs.ParsedFx{
+			{
+				ID: secp256k1fx.ID,
+				Fx: secpFx,
+			},
+		},
+		TypeToFxIndex: typeToFxIndex,
+		Codec:         parser.Codec(),
+		FeeAssetID:    feeAssetID,
+		Bootstrapped:  true,
+	}
+	require.NoError(t, secpFx.Bootstrapped())
+
+	tests := []struct {
+		name    string
+		feeRate uint64
+		err     error
+	}{
+		{
+			// The fee is paid, so the verification reaches the UTXO lookup
+			name:    "fee rate lowers the fee",
+			feeRate: fees.RateDenominator / 2,
+			err:     database.ErrNotFound,
+		},
+		{
+			name:    "fee rate raises the fee",
+			feeRate: 2 * fees.RateDenominator,
+			err:     dione.ErrInsufficientFunds,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			state := states.NewMockChain(ctrl)
+			state.EXPECT().GetFeeRate().Return(test.feeRate)
+			state.EXPECT().GetTimestamp().Return(time.Now())
+			state.EXPECT().GetUTXO(utxoID.InputID()).Return(nil, database.ErrNotFound).AnyTimes()
+
+			err := tx.Unsigned.Visit(&SemanticVerifier{
+				Backend: backend,
+				State:   state,
+				Tx:      tx,
+			})
+			require.ErrorIs(err, test.err)
+		})
+	}
+}
+
+func TestSemanticVerifierBaseTxHTLC(t *testing.T) {
+	ctx := newContext(t)
+
+	typeToFxIndex := make(map[reflect.Type]int)
+	secpFx := &secp256k1fx.Fx{}
+	htlcFx := &htlcfx.Fx{}
+	parser, err := txs.NewCustomParser(
+		typeToFxIndex,
+		new(mockable.Clock),
+		logging.NoWarn{},
+		[]fxs.Fx{
+			secpFx,
+			htlcFx,
+		},
+	)
+	require.NoError(t, err)
+
+	htlcTime := time.Unix(1_000, 0)
+	asset := dione.Asset{
+		ID: ids.GenerateTestID(),
+	}
+	owners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs: []ids.ShortID{
+			keys[0].Address(),
+		},
+	}
+	tx := &txs.Tx{
+		Unsigned: &txs.BaseTx{
+			BaseTx: dione.BaseTx{
+				Outs: []*dione.TransferableOutput{{
+					Asset: asset,
+					Out: &htlcfx.TransferOutput{
+						Amt:       1,
+						Timeout:   uint64(htlcTime.Unix()) + 1,
+						Recipient: owners,
+						Sender:    owners,
+					},
+				}},
+			},
+		},
+	}
+
+	backend := &Backend{
+		Ctx: ctx,
+		Config: &config.Config{
+			HTLCTime: htlcTime,
+		},
+		Fxs: []*fxs.ParsedFx{
+			{
+				ID: secp256k1fx.ID,
+				Fx: secpFx,
+			},
+			{
+				ID: htlcfx.ID,
+				Fx: htlcFx,
+			},
+		},
+		TypeToFxIndex: typeToFxIndex,
+		Codec:         parser.Codec(),
+		FeeAssetID:    ids.GenerateTestID(),
+		Bootstrapped:  true,
+	}
+
+	tests := []struct {
+		name      string
+		timestamp time.Time
+		fxIndices []uint32
+		err       error
+	}{
+		{
+			name:      "before activation",
+			timestamp: htlcTime.Add(-time.Second),
+			fxIndices: []uint32{0},
+			err:       errHTLCNotActivated,
+		},
+		{
+			name:      "asset supporting the secp256k1fx",
+			timestamp: htlcTime,
+			fxIndices: []uint32{0},
+			err:       nil,
+		},
+		{
+			name:      "asset supporting the htlcfx",
+			timestamp: htlcTime,
+			fxIndices: []uint32{1},
+			err:       nil,
+		},
+		{
+			name:      "asset not supporting the secp256k1fx",
+			timestamp: htlcTime,
+			fxIndices: nil,
+			err:       errIncompatibleFx,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			initialStates := make([]*txs.InitialState, len(test.fxIndices))
+			for i, fxIndex := range test.fxIndices {
+				initialStates[i] = &txs.InitialState{
+					FxIndex: fxIndex,
+				}
+			}
+			createAssetTx := &txs.Tx{
+				Unsigned: &txs.CreateAssetTx{
+					States: initialStates,
+				},
+			}
+
+			state := states.NewMockChain(ctrl)
+			state.EXPECT().GetTx(asset.ID).Return(createAssetTx, nil)
+			state.EXPECT().GetTimestamp().Return(test.timestamp)
+
+			err := tx.Unsigned.Visit(&SemanticVerifier{
+				Backend: backend,
+				State:   state,
+				Tx:      tx,
+			})
+			require.ErrorIs(err, test.err)
+		})
+	}
+}
+
+func TestSemanticVerifierHTLCActivation(t *testing.T) {
+	ctx := newContext(t)
+
+	typeToFxIndex := make(map[reflect.Type]int)
+	secpFx := &secp256k1fx.Fx{}
+	htlcFx := &htlcfx.Fx{}
+	parser, err := txs.NewCustomParser(
+		typeToFxIndex,
+		new(mockable.Clock),
+		logging.NoWarn{},
+		[]fxs.Fx{
+			secpFx,
+			htlcFx,
+		},
+	)
+	require.NoError(t, err)
+
+	htlcTime := time.Unix(1_000, 0)
+	asset := dione.Asset{
+		ID: ids.GenerateTestID(),
+	}
+	owners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs: []ids.ShortID{
+			keys[0].Address(),
+		},
+	}
+	htlcOut := &dione.TransferableOutput{
+		Asset: asset,
+		Out: &htlcfx.TransferOutput{
+			Amt:             1,
+			RelativeTimeout: 60,
+			Recipient:       owners,
+			Sender:          owners,
+		},
+	}
+
+	backend := &Backend{
+		Ctx: ctx,
+		Config: &config.Config{
+			HTLCTime: htlcTime,
+		},
+		Fxs: []*fxs.ParsedFx{
+			{
+				ID: secp256k1fx.ID,
+				Fx: secpFx,
+			},
+			{
+				ID: htlcfx.ID,
+				Fx: htlcFx,
+			},
+		},
+		TypeToFxIndex: typeToFxIndex,
+		Codec:         parser.Codec(),
+		FeeAssetID:    ids.GenerateTestID(),
+	}
+	createAssetTx := &txs.Tx{
+		Unsigned: &txs.CreateAssetTx{
+			States: []*txs.InitialState{{
+				FxIndex: 0,
+			}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		tx     *txs.Tx
+		stateF func(*states.MockChain)
+	}{
+		{
+			name: "exported output",
+			tx: &txs.Tx{
+				Unsigned: &txs.ExportTx{
+					DestinationChain: ctx.DChainID,
+					ExportedOuts:     []*dione.TransferableOutput{htlcOut},
+				},
+			},
+			stateF: func(state *states.MockChain) {
+				state.EXPECT().GetTx(asset.ID).Return(createAssetTx, nil)
+			},
+		},
+		{
+			name: "initial state",
+			tx: &txs.Tx{
+				Unsigned: &txs.CreateAssetTx{
+					States: []*txs.InitialState{{
+						FxIndex: 1,
+						Outs:    []verify.State{htlcOut.Out},
+					}},
+				},
+			},
+			stateF: func(*states.MockChain) {},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			state := states.NewMockChain(ctrl)
+			test.stateF(state)
+			state.EXPECT().GetTimestamp().Return(htlcTime.Add(-time.Second))
+
+			err := test.tx.Unsigned.Visit(&SemanticVerifier{
+				Backend: backend,
+				State:   state,
+				Tx:      test.tx,
+			})
+			require.ErrorIs(err, errHTLCNotActivated)
+		})
+	}
+}
+
+func TestSemanticVerifierWeightedOwnersActivation(t *testing.T) {
+	ctx := newContext(t)
+
+	typeToFxIndex := make(map[reflect.Type]int)
+	secpFx := &secp256k1fx.Fx{}
+	parser, err := txs.NewCustomParser(
+		typeToFxIndex,
+		new(mockable.Clock),
+		logging.NoWarn{},
+		[]fxs.Fx{
+			secpFx,
+		},
+	)
+	require.NoError(t, err)
+
+	weightedOwnersTime := time.Unix(1_000, 0)
+	asset := dione.Asset{
+		ID: ids.GenerateTestID(),
+	}
+	weightedOut := &secp256k1fx.WeightedTransferOutput{
+		Amt: 1,
+		WeightedOutputOwners: secp256k1fx.WeightedOutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				keys[0].Address(),
+			},
+			Weights: []uint32{1},
+		},
+	}
+
+	backend := &Backend{
+		Ctx: ctx,
+		Config: &config.Config{
+			WeightedOwnersTime: weightedOwnersTime,
+		},
+		Fxs: []*fxs.ParsedFx{
+			{
+				ID: secp256k1fx.ID,
+				Fx: secpFx,
+			},
+		},
+		TypeToFxIndex: typeToFxIndex,
+		Codec:         parser.Codec(),
+		FeeAssetID:    ids.GenerateTestID(),
+	}
+	createAssetTx := &txs.Tx{
+		Unsigned: &txs.CreateAssetTx{
+			States: []*txs.InitialState{{
+				FxIndex: 0,
+			}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		tx          *txs.Tx
+		stateF      func(*states.MockChain)
+		timestamp   time.Time
+		expectedErr error
+	}{
+		{
+			name: "output before activation",
+			tx: &txs.Tx{
+				Unsigned: &txs.BaseTx{BaseTx: dione.BaseTx{
+					Outs: []*dione.TransferableOutput{{
+						Asset: asset,
+						Out:   weightedOut,
+					}},
+				}},
+			},
+			stateF: func(state *states.MockChain) {
+				state.EXPECT().GetTx(asset.ID).Return(createAssetTx, nil)
+			},
+			timestamp:   weightedOwnersTime.Add(-time.Second),
+			expectedErr: errWeightedOwnersNotActivated,
+		},
+		{
+			name: "output after activation",
+			tx: &txs.Tx{
+				Unsigned: &txs.BaseTx{BaseTx: dione.BaseTx{
+					Outs: []*dione.TransferableOutput{{
+						Asset: asset,
+						Out:   weightedOut,
+					}},
+				}},
+			},
+			stateF: func(state *states.MockChain) {
+				state.EXPECT().GetTx(asset.ID).Return(createAssetTx, nil)
+			},
+			timestamp:   weightedOwnersTime,
+			expectedErr: nil,
+		},
+		{
+			name: "initial state before activation",
+			tx: &txs.Tx{
+				Unsigned: &txs.CreateAssetTx{
+					States: []*txs.InitialState{{
+						FxIndex: 0,
+						Outs:    []verify.State{weightedOut},
+					}},
+				},
+			},
+			stateF:      func(*states.MockChain) {},
+			timestamp:   weightedOwnersTime.Add(-time.Second),
+			expectedErr: errWeightedOwnersNotActivated,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			state := states.NewMockChain(ctrl)
+			test.stateF(state)
+			state.EXPECT().GetTimestamp().Return(test.timestamp)
+
+			err := test.tx.Unsigned.Visit(&SemanticVerifier{
+				Backend: backend,
+				State:   state,
+				Tx:      test.tx,
+			})
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
 func TestSemanticVerifierExportTx(t *testing.T) {
 	ctx := newContext(t)
 	ctrl := gomock.NewController(t)
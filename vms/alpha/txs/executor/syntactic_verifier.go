@@ -14,6 +14,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 )
 
 const (
@@ -43,6 +44,7 @@ var (
 	errDoubleSpend                  = errors.New("inputs attempt to double spend an input")
 	errNoImportInputs               = errors.New("no import inputs")
 	errNoExportOutputs              = errors.New("no export outputs")
+	errWeightedOwnersExport         = errors.New("outputs with weighted owners can't be exported")
 )
 
 type SyntacticVerifier struct {
@@ -56,7 +58,7 @@ func (v *SyntacticVerifier) BaseTx(tx *txs.BaseTx) error {
 	}
 
 	err := dione.VerifyTx(
-		v.Config.TxFee,
+		v.Config.GetMinFee(v.Config.TxFee),
 		v.FeeAssetID,
 		[][]*dione.TransferableInput{tx.Ins},
 		[][]*dione.TransferableOutput{tx.Outs},
@@ -119,7 +121,7 @@ func (v *SyntacticVerifier) CreateAssetTx(tx *txs.CreateAssetTx) error {
 	}
 
 	err := dione.VerifyTx(
-		v.Config.CreateAssetTxFee,
+		v.Config.GetMinFee(v.Config.CreateAssetTxFee),
 		v.FeeAssetID,
 		[][]*dione.TransferableInput{tx.Ins},
 		[][]*dione.TransferableOutput{tx.Outs},
@@ -167,7 +169,7 @@ func (v *SyntacticVerifier) OperationTx(tx *txs.OperationTx) error {
 	}
 
 	err := dione.VerifyTx(
-		v.Config.TxFee,
+		v.Config.GetMinFee(v.Config.TxFee),
 		v.FeeAssetID,
 		[][]*dione.TransferableInput{tx.Ins},
 		[][]*dione.TransferableOutput{tx.Outs},
@@ -227,7 +229,7 @@ func (v *SyntacticVerifier) ImportTx(tx *txs.ImportTx) error {
 	}
 
 	err := dione.VerifyTx(
-		v.Config.TxFee,
+		v.Config.GetMinFee(v.Config.TxFee),
 		v.FeeAssetID,
 		[][]*dione.TransferableInput{
 			tx.Ins,
@@ -268,8 +270,16 @@ func (v *SyntacticVerifier) ExportTx(tx *txs.ExportTx) error {
 		return err
 	}
 
+	for _, out := range tx.ExportedOuts {
+		// Chains register the weighted types with different type IDs, so they
+		// can't be sent through shared memory.
+		if _, ok := out.Out.(*secp256k1fx.WeightedTransferOutput); ok {
+			return errWeightedOwnersExport
+		}
+	}
+
 	err := dione.VerifyTx(
-		v.Config.TxFee,
+		v.Config.GetMinFee(v.Config.TxFee),
 		v.FeeAssetID,
 		[][]*dione.TransferableInput{tx.Ins},
 		[][]*dione.TransferableOutput{
@@ -2051,6 +2051,29 @@ func TestSyntacticVerifierExportTx(t *testing.T) {
 			},
 			err: dione.ErrMemoTooLarge,
 		},
+		{
+			name: "weighted exported output",
+			txFunc: func() *txs.Tx {
+				output := output
+				output.Out = &secp256k1fx.WeightedTransferOutput{
+					Amt: 12345,
+					WeightedOutputOwners: secp256k1fx.WeightedOutputOwners{
+						Threshold: 1,
+						Addrs:     outputOwners.Addrs,
+						Weights:   []uint32{1},
+					},
+				}
+				tx := tx
+				tx.ExportedOuts = []*dione.TransferableOutput{
+					&output,
+				}
+				return &txs.Tx{
+					Unsigned: &tx,
+					Creds:    creds,
+				}
+			},
+			err: errWeightedOwnersExport,
+		},
 		{
 			name: "invalid output",
 			txFunc: func() *txs.Tx {
@@ -15,6 +15,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/utils/wrappers"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/fxs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 )
 
 // CodecVersion is the current default codec version
@@ -99,6 +100,23 @@ func NewCustomParser(
 			return nil, err
 		}
 	}
+
+	// The weighted owners were added to the secp256k1fx after its release.
+	// They are registered after the types of every fx so that the IDs of the
+	// existing types are unchanged.
+	for i, fx := range fxs {
+		if _, ok := fx.(*secp256k1fx.Fx); !ok {
+			continue
+		}
+		registry := &codecRegistry{
+			codecs:      []codec.Registry{gc, c},
+			index:       i,
+			typeToIndex: vm.typeToFxIndex,
+		}
+		if err := secp256k1fx.RegisterWeightedTypes(registry); err != nil {
+			return nil, err
+		}
+	}
 	return &parser{
 		cm:  cm,
 		gcm: gcm,
@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/fxs"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
+	"github.com/DioneProtocol/odysseygo/vms/nftfx"
+	"github.com/DioneProtocol/odysseygo/vms/propertyfx"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestParserWeightedTypes(t *testing.T) {
+	require := require.New(t)
+
+	typeToFxIndex := make(map[reflect.Type]int)
+	parser, err := NewCustomParser(
+		typeToFxIndex,
+		&mockable.Clock{},
+		logging.NoLog{},
+		[]fxs.Fx{
+			&secp256k1fx.Fx{},
+			&nftfx.Fx{},
+			&propertyfx.Fx{},
+			&htlcfx.Fx{},
+		},
+	)
+	require.NoError(err)
+
+	// The weighted types are handled by the secp256k1fx
+	for _, typ := range []interface{}{
+		&secp256k1fx.WeightedTransferOutput{},
+		&secp256k1fx.WeightedOutputOwners{},
+	} {
+		fxIndex, ok := typeToFxIndex[reflect.TypeOf(typ)]
+		require.True(ok)
+		require.Zero(fxIndex)
+	}
+	require.Equal(3, typeToFxIndex[reflect.TypeOf(&htlcfx.Credential{})])
+
+	out := &dione.TransferableOutput{
+		Asset: dione.Asset{ID: ids.GenerateTestID()},
+		Out: &secp256k1fx.WeightedTransferOutput{
+			Amt: 1,
+			WeightedOutputOwners: secp256k1fx.WeightedOutputOwners{
+				Threshold: 3,
+				Addrs:     []ids.ShortID{{1}, {2}},
+				Weights:   []uint32{2, 1},
+			},
+		},
+	}
+	outBytes, err := parser.Codec().Marshal(CodecVersion, out)
+	require.NoError(err)
+
+	parsedOut := &dione.TransferableOutput{}
+	_, err = parser.Codec().Unmarshal(outBytes, parsedOut)
+	require.NoError(err)
+	require.Equal(out, parsedOut)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/cache"
 	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
 	"github.com/DioneProtocol/odysseygo/database/versiondb"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/pubsub"
@@ -34,17 +35,21 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/utils/wrappers"
 	"github.com/DioneProtocol/odysseygo/version"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/assets"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/block"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/config"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/network"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/nfts"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/states"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/mempool"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/utxo"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/components/index"
 	"github.com/DioneProtocol/odysseygo/vms/components/keystore"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 
 	blockbuilder "github.com/DioneProtocol/odysseygo/vms/alpha/block/builder"
@@ -56,6 +61,9 @@ import (
 const assetToFxCacheSize = 1024
 
 var (
+	assetIndexPrefix = []byte("assetIndex")
+	nftIndexPrefix   = []byte("nftIndex")
+
 	errIncompatibleFx            = errors.New("incompatible feature extension")
 	errUnknownFx                 = errors.New("unknown feature extension")
 	errGenesisAssetMustHaveState = errors.New("genesis asset must have non-empty state")
@@ -112,6 +120,9 @@ type VM struct {
 
 	addressTxsIndexer index.AddressTxsIndexer
 
+	assetIndexer assets.Indexer
+	nftIndexer   nfts.Indexer
+
 	txBackend *txexecutor.Backend
 
 	// These values are only initialized after the chain has been linearized.
@@ -136,8 +147,12 @@ func (*VM) Disconnected(context.Context, ids.NodeID) error {
 
 type Config struct {
 	IndexTransactions    bool `json:"index-transactions"`
+	IndexAssets          bool `json:"index-assets"`
+	IndexNFTs            bool `json:"index-nfts"`
 	IndexAllowIncomplete bool `json:"index-allow-incomplete"`
 	ChecksumsEnabled     bool `json:"checksums-enabled"`
+	// Report the supply of every mintable asset as Prometheus gauges
+	AssetSupplyMetricsEnabled bool `json:"asset-supply-metrics-enabled"`
 }
 
 func (vm *VM) Initialize(
@@ -189,6 +204,7 @@ func (vm *VM) Initialize(
 
 	vm.pubsub = pubsub.New(ctx.Log)
 
+	fxs = withHTLCFx(fxs)
 	typedFxs := make([]extensions.Fx, len(fxs))
 	vm.fxs = make([]*extensions.ParsedFx, len(fxs))
 	for i, fxContainer := range fxs {
@@ -226,6 +242,7 @@ func (vm *VM) Initialize(
 		vm.parser,
 		vm.registerer,
 		alphaConfig.ChecksumsEnabled,
+		alphaConfig.AssetSupplyMetricsEnabled,
 	)
 	if err != nil {
 		return err
@@ -233,6 +250,42 @@ func (vm *VM) Initialize(
 
 	vm.state = state
 
+	stateInitialized, err := vm.state.IsInitialized()
+	if err != nil {
+		return err
+	}
+
+	// use no op impl when disabled in config
+	assetIndexDB := prefixdb.New(assetIndexPrefix, vm.db)
+	if alphaConfig.IndexAssets {
+		vm.ctx.Log.Info("asset indexing is enabled")
+		vm.assetIndexer, err = assets.NewIndexer(assetIndexDB, stateInitialized, alphaConfig.IndexAllowIncomplete)
+		if err != nil {
+			return fmt.Errorf("failed to initialize asset indexer: %w", err)
+		}
+	} else {
+		vm.ctx.Log.Info("asset indexing is disabled")
+		vm.assetIndexer, err = assets.NewNoIndexer(assetIndexDB, alphaConfig.IndexAllowIncomplete)
+		if err != nil {
+			return fmt.Errorf("failed to initialize disabled asset indexer: %w", err)
+		}
+	}
+
+	nftIndexDB := prefixdb.New(nftIndexPrefix, vm.db)
+	if alphaConfig.IndexNFTs {
+		vm.ctx.Log.Info("NFT indexing is enabled")
+		vm.nftIndexer, err = nfts.NewIndexer(nftIndexDB, stateInitialized, alphaConfig.IndexAllowIncomplete)
+		if err != nil {
+			return fmt.Errorf("failed to initialize NFT indexer: %w", err)
+		}
+	} else {
+		vm.ctx.Log.Info("NFT indexing is disabled")
+		vm.nftIndexer, err = nfts.NewNoIndexer(nftIndexDB, alphaConfig.IndexAllowIncomplete)
+		if err != nil {
+			return fmt.Errorf("failed to initialize disabled NFT indexer: %w", err)
+		}
+	}
+
 	if err := vm.initGenesis(genesisBytes); err != nil {
 		return err
 	}
@@ -408,6 +461,10 @@ func (vm *VM) Linearize(_ context.Context, stopVertexID ids.ID, toEngine chan<-
 		return err
 	}
 
+	if err := vm.reconcileFeeCollector(); err != nil {
+		return fmt.Errorf("failed to reconcile fee collector: %w", err)
+	}
+
 	mempool, err := mempool.New("mempool", vm.registerer, toEngine)
 	if err != nil {
 		return fmt.Errorf("failed to create mempool: %w", err)
@@ -514,6 +571,22 @@ func (vm *VM) IssueTx(b []byte) (ids.ID, error) {
  ******************************************************************************
  */
 
+// withHTLCFx returns [fxs] with the htlcfx appended, unless the chain already
+// lists it. The htlcfx is supported by every chain rather than listed in the
+// genesis of the chains, so that its activation doesn't change the IDs of the
+// existing chains.
+func withHTLCFx(fxs []*common.Fx) []*common.Fx {
+	for _, fx := range fxs {
+		if fx != nil && fx.ID == htlcfx.ID {
+			return fxs
+		}
+	}
+	return append(fxs[:len(fxs):len(fxs)], &common.Fx{
+		ID: htlcfx.ID,
+		Fx: &htlcfx.Fx{},
+	})
+}
+
 func (vm *VM) initGenesis(genesisBytes []byte) error {
 	genesisCodec := vm.parser.GenesisCodec()
 	genesis := Genesis{}
@@ -547,7 +620,15 @@ func (vm *VM) initGenesis(genesisBytes []byte) error {
 		}
 
 		if !stateInitialized {
-			vm.initState(tx)
+			if err := vm.initState(tx); err != nil {
+				return err
+			}
+			if err := vm.assetIndexer.Accept(tx, nil, 0); err != nil {
+				return fmt.Errorf("error indexing genesis asset: %w", err)
+			}
+			if err := vm.nftIndexer.Accept(tx, 0); err != nil {
+				return fmt.Errorf("error indexing genesis NFTs: %w", err)
+			}
 		}
 		if index == 0 {
 			vm.ctx.Log.Info("fee asset is established",
@@ -565,15 +646,17 @@ func (vm *VM) initGenesis(genesisBytes []byte) error {
 	return nil
 }
 
-func (vm *VM) initState(tx *txs.Tx) {
+func (vm *VM) initState(tx *txs.Tx) error {
 	txID := tx.ID()
 	vm.ctx.Log.Info("initializing genesis asset",
 		zap.Stringer("txID", txID),
 	)
 	vm.state.AddTx(tx)
-	for _, utxo := range tx.UTXOs() {
-		vm.state.AddUTXO(utxo)
-	}
+	return tx.Unsigned.Visit(&txexecutor.Executor{
+		Codec: vm.parser.Codec(),
+		State: vm.state,
+		Tx:    tx,
+	})
 }
 
 // LoadUser returns:
@@ -636,11 +719,10 @@ func (vm *VM) lookupAssetID(asset string) (ids.ID, error) {
 	return ids.ID{}, fmt.Errorf("asset '%s' not found", asset)
 }
 
-// Invariant: onAccept is called when [tx] is being marked as accepted, but
-// before its state changes are applied.
+// Invariant: onAccept is called when [tx] is being marked as accepted by the
+// block at [height], but before its state changes are applied.
 // Invariant: any error returned by onAccept should be considered fatal.
-// TODO: Remove [onAccept] once the deprecated APIs this powers are removed.
-func (vm *VM) onAccept(tx *txs.Tx) error {
+func (vm *VM) onAccept(tx *txs.Tx, height uint64) error {
 	// Fetch the input UTXOs
 	txID := tx.ID()
 	inputUTXOIDs := tx.Unsigned.InputUTXOs()
@@ -673,8 +755,48 @@ func (vm *VM) onAccept(tx *txs.Tx) error {
 	if err := vm.addressTxsIndexer.Accept(txID, inputUTXOs, outputUTXOs); err != nil {
 		return fmt.Errorf("error indexing tx: %w", err)
 	}
+	if err := vm.assetIndexer.Accept(tx, inputUTXOs, height); err != nil {
+		return fmt.Errorf("error indexing assets: %w", err)
+	}
+	if err := vm.nftIndexer.Accept(tx, height); err != nil {
+		return fmt.Errorf("error indexing NFTs: %w", err)
+	}
 
 	vm.pubsub.Publish(NewPubSubFilterer(tx))
 	vm.walletService.decided(txID)
 	return nil
 }
+
+// dynamicFee returns [fee] adjusted by the fee rate of the last accepted
+// state.
+func (vm *VM) dynamicFee(fee uint64) uint64 {
+	if !vm.DynamicFeeConfig.IsEnabled() {
+		return fee
+	}
+	feeRate := vm.GetFeeRate(vm.state.GetFeeRate(), vm.state.GetTimestamp())
+	return fees.CalculateFee(fee, feeRate)
+}
+
+// reconcileFeeCollector verifies that the fee pool changes committed by this
+// chain match its last accepted block, unwinding them if they don't.
+func (vm *VM) reconcileFeeCollector() error {
+	lastAcceptedID := vm.state.GetLastAccepted()
+	lastAccepted, err := vm.state.GetBlock(lastAcceptedID)
+	if err != nil {
+		return err
+	}
+	unwound, err := vm.ctx.FeeCollector.Reconcile(
+		vm.ctx.ChainID,
+		lastAcceptedID,
+		lastAccepted.Height(),
+	)
+	if err != nil {
+		return err
+	}
+	if unwound {
+		vm.ctx.Log.Warn("unwound fee collector pools of a block that wasn't accepted",
+			zap.Stringer("lastAcceptedID", lastAcceptedID),
+		)
+	}
+	return nil
+}
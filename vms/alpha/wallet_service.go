@@ -247,7 +247,7 @@ func (w *WalletService) SendMultiple(_ *http.Request, args *SendMultipleArgs, re
 
 	amountsWithFee := maps.Clone(amounts)
 
-	amountWithFee, err := math.Add64(amounts[w.vm.feeAssetID], w.vm.TxFee)
+	amountWithFee, err := math.Add64(amounts[w.vm.feeAssetID], w.vm.dynamicFee(w.vm.TxFee))
 	if err != nil {
 		return fmt.Errorf("problem calculating required spend amount: %w", err)
 	}
@@ -33,6 +33,10 @@ type UTXOState interface {
 
 	// Checksum returns the current UTXOChecksum.
 	Checksum() ids.ID
+
+	// IterateUTXOs calls [f] with every persisted UTXO, in no particular
+	// order, until [f] returns an error.
+	IterateUTXOs(f func(*UTXO) error) error
 }
 
 // UTXOReader is a thin wrapper around a database to provide fetching of UTXOs.
@@ -251,6 +255,22 @@ func (s *utxoState) UTXOIDs(addr []byte, start ids.ID, limit int) ([]ids.ID, err
 	return utxoIDs, iter.Error()
 }
 
+func (s *utxoState) IterateUTXOs(f func(*UTXO) error) error {
+	it := s.utxoDB.NewIterator()
+	defer it.Release()
+
+	for it.Next() {
+		utxo := &UTXO{}
+		if _, err := s.codec.Unmarshal(it.Value(), utxo); err != nil {
+			return err
+		}
+		if err := f(utxo); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
 func (s *utxoState) Checksum() ids.ID {
 	return s.checksum
 }
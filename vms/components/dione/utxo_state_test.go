@@ -91,4 +91,13 @@ func TestUTXOState(t *testing.T) {
 	utxoIDs, err = s.UTXOIDs(addr[:], ids.Empty, 5)
 	require.NoError(err)
 	require.Equal([]ids.ID{utxoID}, utxoIDs)
+
+	iteratedUTXOs := []*UTXO{}
+	require.NoError(s.IterateUTXOs(func(utxo *UTXO) error {
+		iteratedUTXOs = append(iteratedUTXOs, utxo)
+		return nil
+	}))
+	require.Len(iteratedUTXOs, 1)
+	require.Equal(utxoID, iteratedUTXOs[0].InputID())
+	require.Equal(utxo, iteratedUTXOs[0])
 }
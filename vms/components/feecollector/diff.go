@@ -0,0 +1,268 @@
+package feecollector
+
+import (
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
+	"github.com/DioneProtocol/odysseygo/database/versiondb"
+	"github.com/DioneProtocol/odysseygo/ids"
+)
+
+var _ Diff = (*diff)(nil)
+
+// Diff stages changes to the fee pools made by a single chain.
+//
+// The staged changes are neither persisted nor visible through the
+// FeeCollector until [CommitBatch] is called, the returned batch is written
+// and [Apply] is called.
+type Diff interface {
+	Modifier
+
+	// CommitBatch returns a batch that, when written, persists the staged
+	// changes as the changes made by the accepted block [blkID] at [height].
+	// The returned batch should be written atomically with the block.
+	CommitBatch(blkID ids.ID, height uint64) (database.Batch, error)
+
+	// Apply makes the staged changes visible through the FeeCollector. It must
+	// be called once the batch returned by [CommitBatch] is written.
+	Apply()
+}
+
+type diff struct {
+	collector *collector
+	chainID   ids.ID
+	changes   *chainPools
+
+	// committed are the net changes of the chain including [changes]. It is
+	// populated by [CommitBatch].
+	committed *chainPools
+}
+
+func (d *diff) AddAChainValue(amount uint64) error {
+	d.changes.aChainValue += amount
+	return nil
+}
+
+func (d *diff) SubAChainValue(amount uint64) error {
+	d.changes.aChainValue -= amount
+	return nil
+}
+
+func (d *diff) AddDChainValue(amount uint64) error {
+	d.changes.dChainValue += amount
+	return nil
+}
+
+func (d *diff) SubDChainValue(amount uint64) error {
+	d.changes.dChainValue -= amount
+	return nil
+}
+
+func (d *diff) AddURewardValue(amount uint64) error {
+	d.changes.uRewardValue += amount
+	return nil
+}
+
+func (d *diff) SubURewardValue(amount uint64) error {
+	d.changes.uRewardValue -= amount
+	return nil
+}
+
+func (d *diff) AddOrionsValue(orions []ids.NodeID, amount uint64) error {
+	for _, orion := range orions {
+		d.changes.orions[orion] += amount
+	}
+	return nil
+}
+
+func (d *diff) SubOrionsValue(orions []ids.NodeID, amount uint64) error {
+	for _, orion := range orions {
+		d.changes.orions[orion] -= amount
+	}
+	return nil
+}
+
+func (d *diff) CommitBatch(blkID ids.ID, height uint64) (database.Batch, error) {
+	c := d.collector
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	committed := newChainPools()
+	if pools, ok := c.chains[d.chainID]; ok {
+		committed.add(pools)
+	}
+	committed.add(d.changes)
+	committed.lastAccepted = blkID
+
+	// A fresh versiondb is used so that batches of different chains don't
+	// share any state.
+	vdb := versiondb.New(c.db)
+	if err := committed.write(vdb, d.chainID); err != nil {
+		return nil, err
+	}
+
+	// Once [blkID] is accepted, the changes of the previous blocks can no
+	// longer be unwound.
+	journalDB := prefixdb.New(journalPrefix, chainDB(vdb, d.chainID))
+	if err := pruneJournal(journalDB, height); err != nil {
+		return nil, err
+	}
+	if err := putJournalEntry(journalDB, height, newJournalEntry(blkID, d.changes)); err != nil {
+		return nil, err
+	}
+
+	d.committed = committed
+	return vdb.CommitBatch()
+}
+
+func (d *diff) Apply() {
+	c := d.collector
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.aChainValue.Add(d.changes.aChainValue)
+	c.dChainValue.Add(d.changes.dChainValue)
+	c.uRewardValue.Add(d.changes.uRewardValue)
+	for orion, value := range d.changes.orions {
+		c.orions[orion] += value
+	}
+	c.chains[d.chainID] = d.committed
+}
+
+// chainPools are the net changes to the fee pools made by a chain.
+type chainPools struct {
+	lastAccepted ids.ID
+	aChainValue  uint64
+	dChainValue  uint64
+	uRewardValue uint64
+	orions       map[ids.NodeID]uint64
+}
+
+func newChainPools() *chainPools {
+	return &chainPools{
+		orions: make(map[ids.NodeID]uint64),
+	}
+}
+
+func (p *chainPools) add(other *chainPools) {
+	p.aChainValue += other.aChainValue
+	p.dChainValue += other.dChainValue
+	p.uRewardValue += other.uRewardValue
+	for orion, value := range other.orions {
+		p.orions[orion] += value
+	}
+}
+
+func (p *chainPools) sub(other *chainPools) {
+	p.aChainValue -= other.aChainValue
+	p.dChainValue -= other.dChainValue
+	p.uRewardValue -= other.uRewardValue
+	for orion, value := range other.orions {
+		p.orions[orion] -= value
+	}
+}
+
+func (p *chainPools) write(db database.Database, chainID ids.ID) error {
+	chainsDB := prefixdb.New(chainPrefix, db)
+	if err := chainsDB.Put(chainID[:], p.lastAccepted[:]); err != nil {
+		return err
+	}
+
+	poolsDB := chainDB(db, chainID)
+	if err := database.PutUInt64(poolsDB, aFeeKey, p.aChainValue); err != nil {
+		return err
+	}
+	if err := database.PutUInt64(poolsDB, dFeeKey, p.dChainValue); err != nil {
+		return err
+	}
+	if err := database.PutUInt64(poolsDB, uRewardKey, p.uRewardValue); err != nil {
+		return err
+	}
+
+	orionsDB := prefixdb.New(orionKey, poolsDB)
+	for orion, value := range p.orions {
+		if err := database.PutUInt64(orionsDB, orion[:], value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteOrions removes the orion values of the chain. Other values are
+// expected to be overwritten.
+func (p *chainPools) deleteOrions(db database.Database, chainID ids.ID) error {
+	orionsDB := prefixdb.New(orionKey, chainDB(db, chainID))
+	for orion := range p.orions {
+		if err := orionsDB.Delete(orion[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadChains(db database.Database) (map[ids.ID]*chainPools, error) {
+	chains := make(map[ids.ID]*chainPools)
+
+	chainsDB := prefixdb.New(chainPrefix, db)
+	iter := chainsDB.NewIterator()
+	defer iter.Release()
+	for iter.Next() {
+		chainID, err := ids.ToID(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		lastAccepted, err := ids.ToID(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		pools, err := loadChainPools(db, chainID)
+		if err != nil {
+			return nil, err
+		}
+		pools.lastAccepted = lastAccepted
+		chains[chainID] = pools
+	}
+	return chains, iter.Error()
+}
+
+func loadChainPools(db database.Database, chainID ids.ID) (*chainPools, error) {
+	poolsDB := chainDB(db, chainID)
+	pools := newChainPools()
+
+	var err error
+	pools.aChainValue, err = database.GetUInt64(poolsDB, aFeeKey)
+	if err != nil {
+		return nil, err
+	}
+	pools.dChainValue, err = database.GetUInt64(poolsDB, dFeeKey)
+	if err != nil {
+		return nil, err
+	}
+	pools.uRewardValue, err = database.GetUInt64(poolsDB, uRewardKey)
+	if err != nil {
+		return nil, err
+	}
+
+	orionsDB := prefixdb.New(orionKey, poolsDB)
+	iter := orionsDB.NewIterator()
+	defer iter.Release()
+	for iter.Next() {
+		orion, err := ids.ToNodeID(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		value, err := database.ParseUInt64(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		pools.orions[orion] = value
+	}
+	return pools, iter.Error()
+}
+
+// chainDB returns the database holding the net changes of [chainID]. [db] must
+// not be a prefixdb, so that keys don't depend on how the collector database
+// was created.
+func chainDB(db database.Database, chainID ids.ID) database.Database {
+	return prefixdb.New(chainID[:], prefixdb.New(chainPrefix, db))
+}
@@ -1,8 +1,15 @@
 package feecollector
 
-import "github.com/DioneProtocol/odysseygo/ids"
+import (
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+)
 
-var _ FeeCollector = &dummyFeeCollector{}
+var (
+	_ FeeCollector = &dummyFeeCollector{}
+	_ Diff         = &dummyDiff{}
+)
 
 // dummyFeeCollector is used instead of the collector in subnets in order
 // not to change fees in the main subnet
@@ -59,3 +66,30 @@ func (*dummyFeeCollector) SubOrionsValue(orions []ids.NodeID, amount uint64) err
 func (*dummyFeeCollector) SubURewardValue(amount uint64) error {
 	return nil
 }
+
+func (*dummyFeeCollector) NewDiff(ids.ID) Diff {
+	return &dummyDiff{}
+}
+
+func (*dummyFeeCollector) Reconcile(ids.ID, ids.ID, uint64) (bool, error) {
+	return false, nil
+}
+
+func (*dummyFeeCollector) GetChainPools(ids.ID) *Pools {
+	return &Pools{}
+}
+
+func (*dummyFeeCollector) SetChainPools(ids.ID, ids.ID, uint64, *Pools) error {
+	return nil
+}
+
+// dummyDiff discards all changes
+type dummyDiff struct {
+	dummyFeeCollector
+}
+
+func (*dummyDiff) CommitBatch(ids.ID, uint64) (database.Batch, error) {
+	return memdb.New().NewBatch(), nil
+}
+
+func (*dummyDiff) Apply() {}
@@ -1,25 +1,58 @@
 package feecollector
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 
 	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/database/linkeddb"
 	"github.com/DioneProtocol/odysseygo/database/prefixdb"
+	"github.com/DioneProtocol/odysseygo/database/versiondb"
 	"github.com/DioneProtocol/odysseygo/ids"
 )
 
 var (
 	_ FeeCollector = &collector{}
 
+	errUnreconcilable = errors.New("committed fee pool changes don't match the accepted blocks")
+
 	aFeeKey    = []byte("afee")
 	dFeeKey    = []byte("dfee")
 	uRewardKey = []byte("uRewardKey")
 	orionKey   = []byte("orion")
+
+	chainPrefix   = []byte("chain")
+	journalPrefix = []byte("journal")
 )
 
-type FeeCollector interface {
+/*
+ * DB
+ * |-- aFeeKey -> A-chain fee changed outside of a Diff
+ * |-- dFeeKey -> D-chain fee changed outside of a Diff
+ * |-- uRewardKey -> undistributed reward changed outside of a Diff
+ * |-. orion
+ * | '-. list
+ * |   '-- nodeID -> orion fee changed outside of a Diff
+ * '-. chain
+ *   |-- chainID -> blockID of the last block a Diff was committed with
+ *   '-. chainID
+ *     |-- aFeeKey -> net A-chain fee change committed by the chain
+ *     |-- dFeeKey -> net D-chain fee change committed by the chain
+ *     |-- uRewardKey -> net undistributed reward change committed by the chain
+ *     |-. orion
+ *     | '-- nodeID -> net orion fee change committed by the chain
+ *     '-. journal
+ *       '-- height -> blockID + changes committed with the block
+ *
+ * All values are stored modulo 2^64, so a pool is the sum of its root value and
+ * the net changes of every chain. The journal of a chain only holds the changes
+ * of the last block committed by the chain.
+ */
+
+type Modifier interface {
 	AddDChainValue(amount uint64) error
 	AddAChainValue(amount uint64) error
 	AddOrionsValue(orions []ids.NodeID, amount uint64) error
@@ -29,12 +62,36 @@ type FeeCollector interface {
 	SubAChainValue(amount uint64) error
 	SubOrionsValue(orions []ids.NodeID, amount uint64) error
 	SubURewardValue(amount uint64) error
+}
+
+type FeeCollector interface {
+	Modifier
 
 	GetDChainValue() uint64
 	GetAChainValue() uint64
 
 	GetOrionValue(ids.NodeID) uint64
 	GetURewardValue() uint64
+
+	// NewDiff returns a Diff that stages the changes made by [chainID] so they
+	// can be written atomically with the block that caused them.
+	NewDiff(chainID ids.ID) Diff
+
+	// Reconcile verifies that the changes committed by [chainID] match its last
+	// accepted block [lastAcceptedID] at [lastAcceptedHeight]. If the last
+	// committed block is the child of [lastAcceptedID], which the chain didn't
+	// persist, its journaled changes are unwound. Returns true if the changes
+	// were unwound.
+	Reconcile(chainID ids.ID, lastAcceptedID ids.ID, lastAcceptedHeight uint64) (bool, error)
+
+	// GetChainPools returns the net changes committed by [chainID].
+	GetChainPools(chainID ids.ID) *Pools
+
+	// SetChainPools replaces the net changes committed by [chainID] with
+	// [pools], as if they were all committed by the accepted block [blkID] at
+	// [height]. It is used when the chain state syncs to [blkID] without
+	// executing the blocks before it.
+	SetChainPools(chainID ids.ID, blkID ids.ID, height uint64, pools *Pools) error
 }
 
 type collector struct {
@@ -44,6 +101,9 @@ type collector struct {
 	uRewardValue *atomic.Uint64
 	orions       map[ids.NodeID]uint64
 
+	// chains are the net changes committed by every chain through a Diff
+	chains map[ids.ID]*chainPools
+
 	orionsDb linkeddb.LinkedDB
 	db       database.Database
 }
@@ -62,14 +122,6 @@ func New(db database.Database) (FeeCollector, error) {
 		return nil, err
 	}
 
-	aChainValue := atomic.Uint64{}
-	dChainValue := atomic.Uint64{}
-	uRewardValue := atomic.Uint64{}
-
-	aChainValue.Store(aChainValueUint)
-	dChainValue.Store(dChainValueUint)
-	uRewardValue.Store(uRewardValueUint)
-
 	orions := make(map[ids.NodeID]uint64)
 	orionsDb := prefixdb.New(orionKey, db)
 	orionsListDb := linkeddb.NewDefault(orionsDb)
@@ -84,6 +136,30 @@ func New(db database.Database) (FeeCollector, error) {
 		}
 		orions[orion] = value
 	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	chains, err := loadChains(versiondb.New(db))
+	if err != nil {
+		return nil, err
+	}
+	for _, pools := range chains {
+		aChainValueUint += pools.aChainValue
+		dChainValueUint += pools.dChainValue
+		uRewardValueUint += pools.uRewardValue
+		for orion, value := range pools.orions {
+			orions[orion] += value
+		}
+	}
+
+	aChainValue := atomic.Uint64{}
+	dChainValue := atomic.Uint64{}
+	uRewardValue := atomic.Uint64{}
+
+	aChainValue.Store(aChainValueUint)
+	dChainValue.Store(dChainValueUint)
+	uRewardValue.Store(uRewardValueUint)
 
 	return &collector{
 		db:           db,
@@ -91,14 +167,23 @@ func New(db database.Database) (FeeCollector, error) {
 		dChainValue:  &dChainValue,
 		uRewardValue: &uRewardValue,
 		orions:       orions,
+		chains:       chains,
 		orionsDb:     orionsListDb,
 	}, nil
 }
 
-func (c *collector) updateChainValue(newValue uint64, key []byte) error {
+// updateChainValue persists the value of the pool under the root [key]. The
+// value persisted under the root key is the total minus the net changes
+// committed by the chains.
+func (c *collector) updateChainValue(value *atomic.Uint64, chainValue func(*chainPools) uint64, key []byte) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	return database.PutUInt64(c.db, key, newValue)
+
+	rootValue := value.Load()
+	for _, pools := range c.chains {
+		rootValue -= chainValue(pools)
+	}
+	return database.PutUInt64(c.db, key, rootValue)
 }
 
 func (c *collector) updateOrions(orions []ids.NodeID, value uint64) error {
@@ -107,7 +192,11 @@ func (c *collector) updateOrions(orions []ids.NodeID, value uint64) error {
 
 	for _, orion := range orions {
 		c.orions[orion] += value
-		if err := database.PutUInt64(c.orionsDb, orion.Bytes(), c.orions[orion]); err != nil {
+		rootValue := c.orions[orion]
+		for _, pools := range c.chains {
+			rootValue -= pools.orions[orion]
+		}
+		if err := database.PutUInt64(c.orionsDb, orion.Bytes(), rootValue); err != nil {
 			return err
 		}
 	}
@@ -120,13 +209,13 @@ func (c *collector) GetAChainValue() uint64 {
 }
 
 func (c *collector) AddAChainValue(amount uint64) error {
-	newValue := c.aChainValue.Add(amount)
-	return c.updateChainValue(newValue, aFeeKey)
+	c.aChainValue.Add(amount)
+	return c.updateChainValue(c.aChainValue, getAChainValue, aFeeKey)
 }
 
 func (c *collector) SubAChainValue(amount uint64) error {
-	newValue := c.aChainValue.Add(^(amount - 1))
-	return c.updateChainValue(newValue, aFeeKey)
+	c.aChainValue.Add(^(amount - 1))
+	return c.updateChainValue(c.aChainValue, getAChainValue, aFeeKey)
 }
 
 func (c *collector) GetDChainValue() uint64 {
@@ -134,13 +223,13 @@ func (c *collector) GetDChainValue() uint64 {
 }
 
 func (c *collector) AddDChainValue(amount uint64) error {
-	newValue := c.dChainValue.Add(amount)
-	return c.updateChainValue(newValue, dFeeKey)
+	c.dChainValue.Add(amount)
+	return c.updateChainValue(c.dChainValue, getDChainValue, dFeeKey)
 }
 
 func (c *collector) SubDChainValue(amount uint64) error {
-	newValue := c.dChainValue.Add(^(amount - 1))
-	return c.updateChainValue(newValue, dFeeKey)
+	c.dChainValue.Add(^(amount - 1))
+	return c.updateChainValue(c.dChainValue, getDChainValue, dFeeKey)
 }
 
 func (c *collector) GetURewardValue() uint64 {
@@ -148,13 +237,13 @@ func (c *collector) GetURewardValue() uint64 {
 }
 
 func (c *collector) AddURewardValue(amount uint64) error {
-	newValue := c.uRewardValue.Add(amount)
-	return c.updateChainValue(newValue, uRewardKey)
+	c.uRewardValue.Add(amount)
+	return c.updateChainValue(c.uRewardValue, getURewardValue, uRewardKey)
 }
 
 func (c *collector) SubURewardValue(amount uint64) error {
-	newValue := c.uRewardValue.Add(^(amount - 1))
-	return c.updateChainValue(newValue, uRewardKey)
+	c.uRewardValue.Add(^(amount - 1))
+	return c.updateChainValue(c.uRewardValue, getURewardValue, uRewardKey)
 }
 
 func (c *collector) AddOrionsValue(orions []ids.NodeID, amount uint64) error {
@@ -170,3 +259,151 @@ func (c *collector) GetOrionValue(nodeID ids.NodeID) uint64 {
 	defer c.lock.Unlock()
 	return c.orions[nodeID]
 }
+
+func (c *collector) NewDiff(chainID ids.ID) Diff {
+	return &diff{
+		collector: c,
+		chainID:   chainID,
+		changes:   newChainPools(),
+	}
+}
+
+func (c *collector) Reconcile(chainID ids.ID, lastAcceptedID ids.ID, lastAcceptedHeight uint64) (bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	vdb := versiondb.New(c.db)
+	pools, ok := c.chains[chainID]
+	if !ok {
+		// The chain has never committed a Diff, so all of its previous changes
+		// were persisted under the root keys.
+		pools = newChainPools()
+		pools.lastAccepted = lastAcceptedID
+		if err := pools.write(vdb, chainID); err != nil {
+			return false, err
+		}
+		if err := vdb.Commit(); err != nil {
+			return false, err
+		}
+		c.chains[chainID] = pools
+		return false, nil
+	}
+	if pools.lastAccepted == lastAcceptedID {
+		return false, nil
+	}
+
+	journalDB := prefixdb.New(journalPrefix, chainDB(vdb, chainID))
+	entry, height, err := lastJournalEntry(journalDB)
+	if err != nil && err != database.ErrNotFound {
+		return false, err
+	}
+	if err == database.ErrNotFound || entry.BlkID != pools.lastAccepted || height != lastAcceptedHeight+1 {
+		return false, fmt.Errorf("%w: last committed block is %s, last accepted block is %s at height %d",
+			errUnreconcilable,
+			pools.lastAccepted,
+			lastAcceptedID,
+			lastAcceptedHeight,
+		)
+	}
+
+	unwound := newChainPools()
+	unwound.add(pools)
+	unwound.sub(entry.changes())
+	unwound.lastAccepted = lastAcceptedID
+
+	if err := journalDB.Delete(database.PackUInt64(height)); err != nil {
+		return false, err
+	}
+	if err := pools.deleteOrions(vdb, chainID); err != nil {
+		return false, err
+	}
+	if err := unwound.write(vdb, chainID); err != nil {
+		return false, err
+	}
+	if err := vdb.Commit(); err != nil {
+		return false, err
+	}
+
+	// Swap the net changes of the chain in the totals.
+	c.aChainValue.Add(unwound.aChainValue - pools.aChainValue)
+	c.dChainValue.Add(unwound.dChainValue - pools.dChainValue)
+	c.uRewardValue.Add(unwound.uRewardValue - pools.uRewardValue)
+	for orion, value := range pools.orions {
+		c.orions[orion] -= value
+	}
+	for orion, value := range unwound.orions {
+		c.orions[orion] += value
+	}
+	c.chains[chainID] = unwound
+	return true, nil
+}
+
+func (c *collector) GetChainPools(chainID ids.ID) *Pools {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	pools, ok := c.chains[chainID]
+	if !ok {
+		return newPools(newChainPools())
+	}
+	return newPools(pools)
+}
+
+func (c *collector) SetChainPools(chainID ids.ID, blkID ids.ID, height uint64, pools *Pools) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	vdb := versiondb.New(c.db)
+	replaced, ok := c.chains[chainID]
+	if !ok {
+		replaced = newChainPools()
+	}
+
+	// The journal of the replaced changes is dropped, so that the journal
+	// only holds the changes of [blkID].
+	journalDB := prefixdb.New(journalPrefix, chainDB(vdb, chainID))
+	if err := pruneJournal(journalDB, math.MaxUint64); err != nil {
+		return err
+	}
+
+	synced := pools.chainPools()
+	synced.lastAccepted = blkID
+	if err := putJournalEntry(journalDB, height, newJournalEntry(blkID, synced)); err != nil {
+		return err
+	}
+
+	if err := replaced.deleteOrions(vdb, chainID); err != nil {
+		return err
+	}
+	if err := synced.write(vdb, chainID); err != nil {
+		return err
+	}
+	if err := vdb.Commit(); err != nil {
+		return err
+	}
+
+	// Swap the net changes of the chain in the totals.
+	c.aChainValue.Add(synced.aChainValue - replaced.aChainValue)
+	c.dChainValue.Add(synced.dChainValue - replaced.dChainValue)
+	c.uRewardValue.Add(synced.uRewardValue - replaced.uRewardValue)
+	for orion, value := range replaced.orions {
+		c.orions[orion] -= value
+	}
+	for orion, value := range synced.orions {
+		c.orions[orion] += value
+	}
+	c.chains[chainID] = synced
+	return nil
+}
+
+func getAChainValue(p *chainPools) uint64 {
+	return p.aChainValue
+}
+
+func getDChainValue(p *chainPools) uint64 {
+	return p.dChainValue
+}
+
+func getURewardValue(p *chainPools) uint64 {
+	return p.uRewardValue
+}
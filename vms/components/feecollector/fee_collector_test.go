@@ -0,0 +1,251 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package feecollector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+)
+
+func commitDiff(t *testing.T, c FeeCollector, chainID ids.ID, blkID ids.ID, height uint64, modify func(Modifier)) {
+	require := require.New(t)
+
+	diff := c.NewDiff(chainID)
+	modify(diff)
+	batch, err := diff.CommitBatch(blkID, height)
+	require.NoError(err)
+	require.NoError(batch.Write())
+	diff.Apply()
+}
+
+func TestDiffCommit(t *testing.T) {
+	require := require.New(t)
+
+	db := prefixdb.New([]byte("fee collector"), memdb.New())
+	c, err := New(db)
+	require.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	require.NoError(c.AddAChainValue(100))
+	require.NoError(c.AddDChainValue(200))
+	require.NoError(c.AddOrionsValue([]ids.NodeID{nodeID}, 50))
+
+	chainID := ids.GenerateTestID()
+	diff := c.NewDiff(chainID)
+	require.NoError(diff.SubAChainValue(40))
+	require.NoError(diff.SubDChainValue(150))
+	require.NoError(diff.SubOrionsValue([]ids.NodeID{nodeID}, 50))
+	require.NoError(diff.AddURewardValue(10))
+
+	// Staged changes aren't visible before being applied.
+	require.Equal(uint64(100), c.GetAChainValue())
+	require.Equal(uint64(200), c.GetDChainValue())
+	require.Equal(uint64(50), c.GetOrionValue(nodeID))
+	require.Zero(c.GetURewardValue())
+
+	batch, err := diff.CommitBatch(ids.GenerateTestID(), 1)
+	require.NoError(err)
+	require.NoError(batch.Write())
+	diff.Apply()
+
+	require.Equal(uint64(60), c.GetAChainValue())
+	require.Equal(uint64(50), c.GetDChainValue())
+	require.Zero(c.GetOrionValue(nodeID))
+	require.Equal(uint64(10), c.GetURewardValue())
+
+	// Changes made outside of a Diff must not overwrite committed changes.
+	require.NoError(c.AddAChainValue(5))
+
+	reloaded, err := New(db)
+	require.NoError(err)
+	require.Equal(uint64(65), reloaded.GetAChainValue())
+	require.Equal(uint64(50), reloaded.GetDChainValue())
+	require.Zero(reloaded.GetOrionValue(nodeID))
+	require.Equal(uint64(10), reloaded.GetURewardValue())
+}
+
+func TestDiffNotWritten(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	c, err := New(db)
+	require.NoError(err)
+	require.NoError(c.AddAChainValue(100))
+
+	diff := c.NewDiff(ids.GenerateTestID())
+	require.NoError(diff.SubAChainValue(100))
+	_, err = diff.CommitBatch(ids.GenerateTestID(), 1)
+	require.NoError(err)
+
+	// The node crashed before the block was written.
+	reloaded, err := New(db)
+	require.NoError(err)
+	require.Equal(uint64(100), reloaded.GetAChainValue())
+}
+
+func TestReconcile(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	c, err := New(db)
+	require.NoError(err)
+	require.NoError(c.AddAChainValue(100))
+
+	chainID := ids.GenerateTestID()
+	genesisID := ids.GenerateTestID()
+	unwound, err := c.Reconcile(chainID, genesisID, 0)
+	require.NoError(err)
+	require.False(unwound)
+
+	nodeID := ids.GenerateTestNodeID()
+	blk1ID := ids.GenerateTestID()
+	commitDiff(t, c, chainID, blk1ID, 1, func(m Modifier) {
+		require.NoError(m.SubAChainValue(30))
+		require.NoError(m.AddOrionsValue([]ids.NodeID{nodeID}, 5))
+	})
+	blk2ID := ids.GenerateTestID()
+	commitDiff(t, c, chainID, blk2ID, 2, func(m Modifier) {
+		require.NoError(m.SubAChainValue(20))
+		require.NoError(m.AddOrionsValue([]ids.NodeID{nodeID}, 7))
+	})
+	require.Equal(uint64(50), c.GetAChainValue())
+	require.Equal(uint64(12), c.GetOrionValue(nodeID))
+
+	// The committed changes match the last accepted block.
+	reloaded, err := New(db)
+	require.NoError(err)
+	unwound, err = reloaded.Reconcile(chainID, blk2ID, 2)
+	require.NoError(err)
+	require.False(unwound)
+	require.Equal(uint64(50), reloaded.GetAChainValue())
+
+	// Only [blk1ID] was persisted by the chain.
+	unwound, err = reloaded.Reconcile(chainID, blk1ID, 1)
+	require.NoError(err)
+	require.True(unwound)
+	require.Equal(uint64(70), reloaded.GetAChainValue())
+	require.Equal(uint64(5), reloaded.GetOrionValue(nodeID))
+
+	reloaded, err = New(db)
+	require.NoError(err)
+	require.Equal(uint64(70), reloaded.GetAChainValue())
+	require.Equal(uint64(5), reloaded.GetOrionValue(nodeID))
+
+	unwound, err = reloaded.Reconcile(chainID, blk1ID, 1)
+	require.NoError(err)
+	require.False(unwound)
+
+	// The changes of [blk1ID] were pruned once [blk2ID] was committed, so they
+	// can't be unwound.
+	_, err = reloaded.Reconcile(chainID, genesisID, 0)
+	require.ErrorIs(err, errUnreconcilable)
+
+	// The changes of a block the collector never committed can't be rebuilt.
+	_, err = reloaded.Reconcile(chainID, ids.GenerateTestID(), 3)
+	require.ErrorIs(err, errUnreconcilable)
+}
+
+func TestJournalPruned(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	c, err := New(db)
+	require.NoError(err)
+
+	chainID := ids.GenerateTestID()
+	for height := uint64(1); height <= 3; height++ {
+		commitDiff(t, c, chainID, ids.GenerateTestID(), height, func(m Modifier) {
+			require.NoError(m.AddAChainValue(height))
+		})
+	}
+
+	// Only the changes of the last committed block are kept.
+	journalDB := prefixdb.New(journalPrefix, chainDB(db, chainID))
+	iter := journalDB.NewIterator()
+	defer iter.Release()
+
+	require.True(iter.Next())
+	require.Equal(database.PackUInt64(3), iter.Key())
+	require.False(iter.Next())
+	require.NoError(iter.Error())
+}
+
+func TestSetChainPools(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	c, err := New(db)
+	require.NoError(err)
+	require.NoError(c.AddAChainValue(100))
+
+	chainID := ids.GenerateTestID()
+	nodeID := ids.GenerateTestNodeID()
+	commitDiff(t, c, chainID, ids.GenerateTestID(), 1, func(m Modifier) {
+		require.NoError(m.SubAChainValue(30))
+		require.NoError(m.AddOrionsValue([]ids.NodeID{nodeID}, 5))
+	})
+
+	syncedNodeID := ids.GenerateTestNodeID()
+	synced := &Pools{
+		AChainValue:  10,
+		URewardValue: 3,
+		Orions: []OrionValue{
+			{
+				NodeID: syncedNodeID,
+				Value:  7,
+			},
+		},
+	}
+	syncedBlkID := ids.GenerateTestID()
+	require.NoError(c.SetChainPools(chainID, syncedBlkID, 10, synced))
+	require.Equal(synced, c.GetChainPools(chainID))
+	require.Equal(uint64(110), c.GetAChainValue())
+	require.Equal(uint64(3), c.GetURewardValue())
+	require.Zero(c.GetOrionValue(nodeID))
+	require.Equal(uint64(7), c.GetOrionValue(syncedNodeID))
+
+	// The synced pools are committed by [syncedBlkID].
+	reloaded, err := New(db)
+	require.NoError(err)
+	require.Equal(synced, reloaded.GetChainPools(chainID))
+	unwound, err := reloaded.Reconcile(chainID, syncedBlkID, 10)
+	require.NoError(err)
+	require.False(unwound)
+	require.Equal(synced, reloaded.GetChainPools(chainID))
+	require.Equal(uint64(110), reloaded.GetAChainValue())
+	require.Equal(uint64(7), reloaded.GetOrionValue(syncedNodeID))
+}
+
+func TestJournalEntryRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	nodeID := ids.GenerateTestNodeID()
+	changes := newChainPools()
+	changes.aChainValue = 1
+	changes.dChainValue = 2
+	changes.uRewardValue = 3
+	changes.orions[nodeID] = 4
+	changes.orions[ids.GenerateTestNodeID()] = 0
+
+	blkID := ids.GenerateTestID()
+	entryBytes, err := Codec.Marshal(CodecVersion, newJournalEntry(blkID, changes))
+	require.NoError(err)
+
+	entry, err := parseJournalEntry(entryBytes)
+	require.NoError(err)
+	require.Equal(blkID, entry.BlkID)
+	require.Len(entry.Orions, 1)
+
+	parsed := entry.changes()
+	require.Equal(changes.aChainValue, parsed.aChainValue)
+	require.Equal(changes.dChainValue, parsed.dChainValue)
+	require.Equal(changes.uRewardValue, parsed.uRewardValue)
+	require.Equal(uint64(4), parsed.orions[nodeID])
+}
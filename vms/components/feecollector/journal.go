@@ -0,0 +1,175 @@
+package feecollector
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/DioneProtocol/odysseygo/codec"
+	"github.com/DioneProtocol/odysseygo/codec/linearcodec"
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+)
+
+// CodecVersion is the current default codec version
+const CodecVersion = 0
+
+// Codec is used to serialize the journal
+var Codec codec.Manager
+
+func init() {
+	c := linearcodec.NewDefault()
+	Codec = codec.NewDefaultManager()
+
+	if err := Codec.RegisterCodec(CodecVersion, c); err != nil {
+		panic(err)
+	}
+}
+
+// journalEntry is the change to the fee pools committed with an accepted
+// block
+type journalEntry struct {
+	BlkID        ids.ID       `serialize:"true"`
+	AChainValue  uint64       `serialize:"true"`
+	DChainValue  uint64       `serialize:"true"`
+	URewardValue uint64       `serialize:"true"`
+	Orions       []OrionValue `serialize:"true"`
+}
+
+// OrionValue is the value of the orion fee pool of a node
+type OrionValue struct {
+	NodeID ids.NodeID `serialize:"true"`
+	Value  uint64     `serialize:"true"`
+}
+
+// Pools are the net changes to the fee pools committed by a chain. Orions are
+// sorted by NodeID, so that equal pools have equal serializations.
+type Pools struct {
+	AChainValue  uint64       `serialize:"true" json:"aChainValue"`
+	DChainValue  uint64       `serialize:"true" json:"dChainValue"`
+	URewardValue uint64       `serialize:"true" json:"uRewardValue"`
+	Orions       []OrionValue `serialize:"true" json:"orions"`
+}
+
+func newPools(p *chainPools) *Pools {
+	pools := &Pools{
+		AChainValue:  p.aChainValue,
+		DChainValue:  p.dChainValue,
+		URewardValue: p.uRewardValue,
+	}
+	for orion, value := range p.orions {
+		if value == 0 {
+			continue
+		}
+		pools.Orions = append(pools.Orions, OrionValue{
+			NodeID: orion,
+			Value:  value,
+		})
+	}
+	sort.Slice(pools.Orions, func(i, j int) bool {
+		return bytes.Compare(pools.Orions[i].NodeID[:], pools.Orions[j].NodeID[:]) < 0
+	})
+	return pools
+}
+
+func (p *Pools) chainPools() *chainPools {
+	pools := newChainPools()
+	pools.aChainValue = p.AChainValue
+	pools.dChainValue = p.DChainValue
+	pools.uRewardValue = p.URewardValue
+	for _, orion := range p.Orions {
+		pools.orions[orion.NodeID] += orion.Value
+	}
+	return pools
+}
+
+func newJournalEntry(blkID ids.ID, changes *chainPools) *journalEntry {
+	entry := &journalEntry{
+		BlkID:        blkID,
+		AChainValue:  changes.aChainValue,
+		DChainValue:  changes.dChainValue,
+		URewardValue: changes.uRewardValue,
+	}
+	for orion, value := range changes.orions {
+		if value == 0 {
+			continue
+		}
+		entry.Orions = append(entry.Orions, OrionValue{
+			NodeID: orion,
+			Value:  value,
+		})
+	}
+	return entry
+}
+
+func putJournalEntry(db database.KeyValueWriter, height uint64, entry *journalEntry) error {
+	entryBytes, err := Codec.Marshal(CodecVersion, entry)
+	if err != nil {
+		return err
+	}
+	return db.Put(database.PackUInt64(height), entryBytes)
+}
+
+// lastJournalEntry returns the journaled changes with the greatest height.
+// Returns [database.ErrNotFound] if the journal is empty.
+func lastJournalEntry(db database.Iteratee) (*journalEntry, uint64, error) {
+	var (
+		lastBytes  []byte
+		lastHeight uint64
+		found      bool
+	)
+	iter := db.NewIterator()
+	defer iter.Release()
+	for iter.Next() {
+		height, err := database.ParseUInt64(iter.Key())
+		if err != nil {
+			return nil, 0, err
+		}
+		lastBytes = iter.Value()
+		lastHeight = height
+		found = true
+	}
+	if err := iter.Error(); err != nil {
+		return nil, 0, err
+	}
+	if !found {
+		return nil, 0, database.ErrNotFound
+	}
+	entry, err := parseJournalEntry(lastBytes)
+	return entry, lastHeight, err
+}
+
+// pruneJournal deletes the journaled changes below [height].
+func pruneJournal(db database.Database, height uint64) error {
+	iter := db.NewIterator()
+	defer iter.Release()
+	for iter.Next() {
+		entryHeight, err := database.ParseUInt64(iter.Key())
+		if err != nil {
+			return err
+		}
+		if entryHeight >= height {
+			break
+		}
+		if err := db.Delete(iter.Key()); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+func parseJournalEntry(bytes []byte) (*journalEntry, error) {
+	entry := &journalEntry{}
+	_, err := Codec.Unmarshal(bytes, entry)
+	return entry, err
+}
+
+func (e *journalEntry) changes() *chainPools {
+	changes := newChainPools()
+	changes.aChainValue = e.AChainValue
+	changes.dChainValue = e.DChainValue
+	changes.uRewardValue = e.URewardValue
+	for _, orion := range e.Orions {
+		changes.orions[orion.NodeID] += orion.Value
+	}
+	return changes
+}
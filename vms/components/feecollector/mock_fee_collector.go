@@ -2,7 +2,7 @@
 // See the file LICENSE for licensing terms.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/DioneProtocol/odysseygo/vms/components/feecollector (interfaces: FeeCollector)
+// Source: github.com/DioneProtocol/odysseygo/vms/components/feecollector (interfaces: FeeCollector,Diff)
 
 // Package feecollector is a generated GoMock package.
 package feecollector
@@ -10,6 +10,7 @@ package feecollector
 import (
 	reflect "reflect"
 
+	database "github.com/DioneProtocol/odysseygo/database"
 	ids "github.com/DioneProtocol/odysseygo/ids"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -107,6 +108,20 @@ func (mr *MockFeeCollectorMockRecorder) GetAChainValue() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAChainValue", reflect.TypeOf((*MockFeeCollector)(nil).GetAChainValue))
 }
 
+// GetChainPools mocks base method.
+func (m *MockFeeCollector) GetChainPools(arg0 ids.ID) *Pools {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChainPools", arg0)
+	ret0, _ := ret[0].(*Pools)
+	return ret0
+}
+
+// GetChainPools indicates an expected call of GetChainPools.
+func (mr *MockFeeCollectorMockRecorder) GetChainPools(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainPools", reflect.TypeOf((*MockFeeCollector)(nil).GetChainPools), arg0)
+}
+
 // GetDChainValue mocks base method.
 func (m *MockFeeCollector) GetDChainValue() uint64 {
 	m.ctrl.T.Helper()
@@ -149,6 +164,49 @@ func (mr *MockFeeCollectorMockRecorder) GetURewardValue() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetURewardValue", reflect.TypeOf((*MockFeeCollector)(nil).GetURewardValue))
 }
 
+// NewDiff mocks base method.
+func (m *MockFeeCollector) NewDiff(arg0 ids.ID) Diff {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDiff", arg0)
+	ret0, _ := ret[0].(Diff)
+	return ret0
+}
+
+// NewDiff indicates an expected call of NewDiff.
+func (mr *MockFeeCollectorMockRecorder) NewDiff(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDiff", reflect.TypeOf((*MockFeeCollector)(nil).NewDiff), arg0)
+}
+
+// Reconcile mocks base method.
+func (m *MockFeeCollector) Reconcile(arg0, arg1 ids.ID, arg2 uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockFeeCollectorMockRecorder) Reconcile(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockFeeCollector)(nil).Reconcile), arg0, arg1, arg2)
+}
+
+// SetChainPools mocks base method.
+func (m *MockFeeCollector) SetChainPools(arg0, arg1 ids.ID, arg2 uint64, arg3 *Pools) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChainPools", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChainPools indicates an expected call of SetChainPools.
+func (mr *MockFeeCollectorMockRecorder) SetChainPools(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChainPools", reflect.TypeOf((*MockFeeCollector)(nil).SetChainPools), arg0, arg1, arg2, arg3)
+}
+
 // SubAChainValue mocks base method.
 func (m *MockFeeCollector) SubAChainValue(arg0 uint64) error {
 	m.ctrl.T.Helper()
@@ -204,3 +262,165 @@ func (mr *MockFeeCollectorMockRecorder) SubURewardValue(arg0 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubURewardValue", reflect.TypeOf((*MockFeeCollector)(nil).SubURewardValue), arg0)
 }
+
+// MockDiff is a mock of Diff interface.
+type MockDiff struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiffMockRecorder
+}
+
+// MockDiffMockRecorder is the mock recorder for MockDiff.
+type MockDiffMockRecorder struct {
+	mock *MockDiff
+}
+
+// NewMockDiff creates a new mock instance.
+func NewMockDiff(ctrl *gomock.Controller) *MockDiff {
+	mock := &MockDiff{ctrl: ctrl}
+	mock.recorder = &MockDiffMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiff) EXPECT() *MockDiffMockRecorder {
+	return m.recorder
+}
+
+// AddAChainValue mocks base method.
+func (m *MockDiff) AddAChainValue(arg0 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAChainValue", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAChainValue indicates an expected call of AddAChainValue.
+func (mr *MockDiffMockRecorder) AddAChainValue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAChainValue", reflect.TypeOf((*MockDiff)(nil).AddAChainValue), arg0)
+}
+
+// AddDChainValue mocks base method.
+func (m *MockDiff) AddDChainValue(arg0 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDChainValue", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDChainValue indicates an expected call of AddDChainValue.
+func (mr *MockDiffMockRecorder) AddDChainValue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDChainValue", reflect.TypeOf((*MockDiff)(nil).AddDChainValue), arg0)
+}
+
+// AddOrionsValue mocks base method.
+func (m *MockDiff) AddOrionsValue(arg0 []ids.NodeID, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrionsValue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOrionsValue indicates an expected call of AddOrionsValue.
+func (mr *MockDiffMockRecorder) AddOrionsValue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrionsValue", reflect.TypeOf((*MockDiff)(nil).AddOrionsValue), arg0, arg1)
+}
+
+// AddURewardValue mocks base method.
+func (m *MockDiff) AddURewardValue(arg0 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddURewardValue", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddURewardValue indicates an expected call of AddURewardValue.
+func (mr *MockDiffMockRecorder) AddURewardValue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddURewardValue", reflect.TypeOf((*MockDiff)(nil).AddURewardValue), arg0)
+}
+
+// Apply mocks base method.
+func (m *MockDiff) Apply() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Apply")
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockDiffMockRecorder) Apply() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockDiff)(nil).Apply))
+}
+
+// CommitBatch mocks base method.
+func (m *MockDiff) CommitBatch(arg0 ids.ID, arg1 uint64) (database.Batch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitBatch", arg0, arg1)
+	ret0, _ := ret[0].(database.Batch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitBatch indicates an expected call of CommitBatch.
+func (mr *MockDiffMockRecorder) CommitBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitBatch", reflect.TypeOf((*MockDiff)(nil).CommitBatch), arg0, arg1)
+}
+
+// SubAChainValue mocks base method.
+func (m *MockDiff) SubAChainValue(arg0 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubAChainValue", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubAChainValue indicates an expected call of SubAChainValue.
+func (mr *MockDiffMockRecorder) SubAChainValue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubAChainValue", reflect.TypeOf((*MockDiff)(nil).SubAChainValue), arg0)
+}
+
+// SubDChainValue mocks base method.
+func (m *MockDiff) SubDChainValue(arg0 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubDChainValue", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubDChainValue indicates an expected call of SubDChainValue.
+func (mr *MockDiffMockRecorder) SubDChainValue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubDChainValue", reflect.TypeOf((*MockDiff)(nil).SubDChainValue), arg0)
+}
+
+// SubOrionsValue mocks base method.
+func (m *MockDiff) SubOrionsValue(arg0 []ids.NodeID, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubOrionsValue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubOrionsValue indicates an expected call of SubOrionsValue.
+func (mr *MockDiffMockRecorder) SubOrionsValue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubOrionsValue", reflect.TypeOf((*MockDiff)(nil).SubOrionsValue), arg0, arg1)
+}
+
+// SubURewardValue mocks base method.
+func (m *MockDiff) SubURewardValue(arg0 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubURewardValue", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubURewardValue indicates an expected call of SubURewardValue.
+func (mr *MockDiffMockRecorder) SubURewardValue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubURewardValue", reflect.TypeOf((*MockDiff)(nil).SubURewardValue), arg0)
+}
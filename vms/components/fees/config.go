@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fees
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// RateDenominator is the denominator of fee rates. A fee rate of
+// [RateDenominator] charges the static fees.
+const RateDenominator = 1_000_000
+
+var (
+	ErrInvalidDynamicFeeConfig = errors.New("invalid dynamic fee config")
+
+	rateDenominatorBigInt = new(big.Int).SetUint64(RateDenominator)
+	maxUint64BigInt       = new(big.Int).SetUint64(math.MaxUint64)
+)
+
+// DynamicFeeConfig adjusts the fee rate applied to the static fees of a chain
+// from the size of its blocks.
+type DynamicFeeConfig struct {
+	// TargetBlockSize is the size, in bytes, of the blocks that keep the fee
+	// rate unchanged. Bigger blocks increase the fee rate and smaller blocks
+	// decrease it. Dynamic fees are disabled if it is 0.
+	TargetBlockSize uint64 `json:"targetBlockSize"`
+
+	// AdjustmentWindow dampens the fee rate changes. A block of twice
+	// [TargetBlockSize] increases the fee rate by 1/[AdjustmentWindow] and an
+	// empty block decreases it by as much.
+	AdjustmentWindow uint64 `json:"adjustmentWindow"`
+
+	// MinFeeRate is the lowest fee rate, in parts of [RateDenominator]
+	MinFeeRate uint64 `json:"minFeeRate"`
+
+	// MaxFeeRate is the highest fee rate, in parts of [RateDenominator]
+	MaxFeeRate uint64 `json:"maxFeeRate"`
+}
+
+// IsEnabled returns true if the fee rate is adjusted from the block sizes.
+func (c DynamicFeeConfig) IsEnabled() bool {
+	return c.TargetBlockSize != 0
+}
+
+// Verify returns an error if the fee rate of an enabled config can't start at
+// [RateDenominator] or can't be adjusted.
+func (c DynamicFeeConfig) Verify() error {
+	switch {
+	case !c.IsEnabled():
+		return nil
+	case c.AdjustmentWindow == 0:
+		return fmt.Errorf("%w: adjustment window is 0", ErrInvalidDynamicFeeConfig)
+	case c.MinFeeRate == 0:
+		return fmt.Errorf("%w: min fee rate is 0", ErrInvalidDynamicFeeConfig)
+	case c.MinFeeRate > RateDenominator:
+		return fmt.Errorf("%w: min fee rate of %d is above %d", ErrInvalidDynamicFeeConfig, c.MinFeeRate, RateDenominator)
+	case c.MaxFeeRate < RateDenominator:
+		return fmt.Errorf("%w: max fee rate of %d is below %d", ErrInvalidDynamicFeeConfig, c.MaxFeeRate, RateDenominator)
+	}
+	return nil
+}
+
+// NextFeeRate returns the fee rate following a block of [blockSize] bytes
+// accepted with [feeRate].
+func (c DynamicFeeConfig) NextFeeRate(feeRate uint64, blockSize int) uint64 {
+	if !c.IsEnabled() {
+		return feeRate
+	}
+
+	targetBlockSize := new(big.Int).SetUint64(c.TargetBlockSize)
+	delta := big.NewInt(int64(blockSize))
+	delta.Sub(delta, targetBlockSize)
+	delta.Mul(delta, new(big.Int).SetUint64(feeRate))
+	delta.Quo(delta, targetBlockSize)
+	delta.Quo(delta, new(big.Int).SetUint64(c.AdjustmentWindow))
+	// Blocks above the target always increase the fee rate so that low fee
+	// rates can't get stuck.
+	if delta.Sign() == 0 && uint64(blockSize) > c.TargetBlockSize {
+		delta.SetUint64(1)
+	}
+
+	nextFeeRate := new(big.Int).SetUint64(feeRate)
+	nextFeeRate.Add(nextFeeRate, delta)
+	switch {
+	case nextFeeRate.Cmp(new(big.Int).SetUint64(c.MinFeeRate)) < 0:
+		return c.MinFeeRate
+	case nextFeeRate.Cmp(new(big.Int).SetUint64(c.MaxFeeRate)) > 0:
+		return c.MaxFeeRate
+	default:
+		return nextFeeRate.Uint64()
+	}
+}
+
+// CalculateFee returns the static [fee] charged at [feeRate], capped at the
+// max uint64.
+func CalculateFee(fee, feeRate uint64) uint64 {
+	result := new(big.Int).SetUint64(fee)
+	result.Mul(result, new(big.Int).SetUint64(feeRate))
+	result.Quo(result, rateDenominatorBigInt)
+	if result.Cmp(maxUint64BigInt) > 0 {
+		return math.MaxUint64
+	}
+	return result.Uint64()
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fees
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = DynamicFeeConfig{
+	TargetBlockSize:  1_000,
+	AdjustmentWindow: 10,
+	MinFeeRate:       RateDenominator / 2,
+	MaxFeeRate:       4 * RateDenominator,
+}
+
+func TestDynamicFeeConfigVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      DynamicFeeConfig
+		expectedErr error
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:   "valid",
+			config: testConfig,
+		},
+		{
+			name: "no adjustment window",
+			config: DynamicFeeConfig{
+				TargetBlockSize: 1_000,
+				MinFeeRate:      RateDenominator,
+				MaxFeeRate:      RateDenominator,
+			},
+			expectedErr: ErrInvalidDynamicFeeConfig,
+		},
+		{
+			name: "no min fee rate",
+			config: DynamicFeeConfig{
+				TargetBlockSize:  1_000,
+				AdjustmentWindow: 10,
+				MaxFeeRate:       RateDenominator,
+			},
+			expectedErr: ErrInvalidDynamicFeeConfig,
+		},
+		{
+			name: "min fee rate above initial fee rate",
+			config: DynamicFeeConfig{
+				TargetBlockSize:  1_000,
+				AdjustmentWindow: 10,
+				MinFeeRate:       RateDenominator + 1,
+				MaxFeeRate:       2 * RateDenominator,
+			},
+			expectedErr: ErrInvalidDynamicFeeConfig,
+		},
+		{
+			name: "max fee rate below initial fee rate",
+			config: DynamicFeeConfig{
+				TargetBlockSize:  1_000,
+				AdjustmentWindow: 10,
+				MinFeeRate:       1,
+				MaxFeeRate:       RateDenominator - 1,
+			},
+			expectedErr: ErrInvalidDynamicFeeConfig,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestNextFeeRate(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          DynamicFeeConfig
+		feeRate         uint64
+		blockSize       int
+		expectedFeeRate uint64
+	}{
+		{
+			name:            "disabled",
+			feeRate:         RateDenominator,
+			blockSize:       10_000,
+			expectedFeeRate: RateDenominator,
+		},
+		{
+			name:            "target block size",
+			config:          testConfig,
+			feeRate:         RateDenominator,
+			blockSize:       1_000,
+			expectedFeeRate: RateDenominator,
+		},
+		{
+			name:            "twice the target block size",
+			config:          testConfig,
+			feeRate:         RateDenominator,
+			blockSize:       2_000,
+			expectedFeeRate: RateDenominator + RateDenominator/10,
+		},
+		{
+			name:            "empty block",
+			config:          testConfig,
+			feeRate:         RateDenominator,
+			blockSize:       0,
+			expectedFeeRate: RateDenominator - RateDenominator/10,
+		},
+		{
+			name:            "min fee rate",
+			config:          testConfig,
+			feeRate:         testConfig.MinFeeRate,
+			blockSize:       0,
+			expectedFeeRate: testConfig.MinFeeRate,
+		},
+		{
+			name:            "max fee rate",
+			config:          testConfig,
+			feeRate:         testConfig.MaxFeeRate,
+			blockSize:       100_000,
+			expectedFeeRate: testConfig.MaxFeeRate,
+		},
+		{
+			name: "always increases above the target block size",
+			config: DynamicFeeConfig{
+				TargetBlockSize:  1_000,
+				AdjustmentWindow: 10,
+				MinFeeRate:       1,
+				MaxFeeRate:       RateDenominator,
+			},
+			feeRate:         1,
+			blockSize:       1_001,
+			expectedFeeRate: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			feeRate := test.config.NextFeeRate(test.feeRate, test.blockSize)
+			require.Equal(t, test.expectedFeeRate, feeRate)
+		})
+	}
+}
+
+func TestCalculateFee(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal(uint64(1_000), CalculateFee(1_000, RateDenominator))
+	require.Equal(uint64(1_500), CalculateFee(1_000, RateDenominator+RateDenominator/2))
+	require.Equal(uint64(500), CalculateFee(1_000, RateDenominator/2))
+	require.Equal(uint64(math.MaxUint64), CalculateFee(math.MaxUint64, RateDenominator))
+	require.Equal(uint64(math.MaxUint64), CalculateFee(math.MaxUint64, 2*RateDenominator))
+}
@@ -78,7 +78,7 @@ func NewIndexer(
 		log: log,
 	}
 	// initialize the indexer
-	if err := checkIndexStatus(i.db, true, allowIncompleteIndices); err != nil {
+	if err := CheckIndexStatus(i.db, true, false, allowIncompleteIndices); err != nil {
 		return nil, err
 	}
 	// initialize the metrics
@@ -210,14 +210,21 @@ func (i *indexer) Read(address []byte, assetID ids.ID, cursor, pageSize uint64)
 	return txIDs, nil
 }
 
-// checkIndexStatus checks the indexing status in the database, returning error if the state
-// with respect to provided parameters is invalid
-func checkIndexStatus(db database.KeyValueReaderWriter, enableIndexing, allowIncomplete bool) error {
+// CheckIndexStatus checks the indexing status in the database, returning error
+// if the state with respect to provided parameters is invalid.
+// [chainInitialized] should be true if the chain accepted transactions before
+// this run, as they wouldn't be indexed.
+func CheckIndexStatus(db database.KeyValueReaderWriter, enableIndexing, chainInitialized, allowIncomplete bool) error {
 	// verify whether the index is complete.
 	idxComplete, err := database.GetBool(db, idxCompleteKey)
 	if err == database.ErrNotFound {
-		// We've not run before. Mark whether indexing is enabled this run.
-		return database.PutBool(db, idxCompleteKey, enableIndexing)
+		// We've not run before. The index is only complete if it's enabled
+		// before the chain accepted any transaction.
+		idxComplete = enableIndexing && !chainInitialized
+		if enableIndexing && !idxComplete && !allowIncomplete {
+			return ErrIndexingRequiredFromGenesis
+		}
+		return database.PutBool(db, idxCompleteKey, idxComplete)
 	} else if err != nil {
 		return err
 	}
@@ -250,7 +257,7 @@ func checkIndexStatus(db database.KeyValueReaderWriter, enableIndexing, allowInc
 type noIndexer struct{}
 
 func NewNoIndexer(db database.Database, allowIncomplete bool) (AddressTxsIndexer, error) {
-	return &noIndexer{}, checkIndexStatus(db, false, allowIncomplete)
+	return &noIndexer{}, CheckIndexStatus(db, false, false, allowIncomplete)
 }
 
 func (*noIndexer) Accept(ids.ID, []*dione.UTXO, []*dione.UTXO) error {
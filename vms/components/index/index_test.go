@@ -0,0 +1,98 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+)
+
+func TestIndexStatus(t *testing.T) {
+	tests := []struct {
+		name             string
+		initialStatus    *bool
+		enableIndexing   bool
+		chainInitialized bool
+		allowIncomplete  bool
+		expectedErr      error
+		expectedStatus   bool
+	}{
+		{
+			name:           "enabled from genesis",
+			enableIndexing: true,
+			expectedStatus: true,
+		},
+		{
+			name:             "enabled after genesis",
+			enableIndexing:   true,
+			chainInitialized: true,
+			expectedErr:      ErrIndexingRequiredFromGenesis,
+		},
+		{
+			name:             "enabled after genesis allowing incomplete",
+			enableIndexing:   true,
+			chainInitialized: true,
+			allowIncomplete:  true,
+			expectedStatus:   false,
+		},
+		{
+			name:           "disabled from genesis",
+			expectedStatus: false,
+		},
+		{
+			name:           "enabled while complete",
+			initialStatus:  &[]bool{true}[0],
+			enableIndexing: true,
+			expectedStatus: true,
+		},
+		{
+			name:           "enabled while incomplete",
+			initialStatus:  &[]bool{false}[0],
+			enableIndexing: true,
+			expectedErr:    ErrIndexingRequiredFromGenesis,
+		},
+		{
+			name:            "enabled while incomplete allowing incomplete",
+			initialStatus:   &[]bool{false}[0],
+			enableIndexing:  true,
+			allowIncomplete: true,
+			expectedStatus:  false,
+		},
+		{
+			name:          "disabled while complete",
+			initialStatus: &[]bool{true}[0],
+			expectedErr:   ErrCausesIncompleteIndex,
+		},
+		{
+			name:            "disabled while complete allowing incomplete",
+			initialStatus:   &[]bool{true}[0],
+			allowIncomplete: true,
+			expectedStatus:  false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			db := memdb.New()
+			if test.initialStatus != nil {
+				require.NoError(database.PutBool(db, idxCompleteKey, *test.initialStatus))
+			}
+
+			err := CheckIndexStatus(db, test.enableIndexing, test.chainInitialized, test.allowIncomplete)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			status, err := database.GetBool(db, idxCompleteKey)
+			require.NoError(err)
+			require.Equal(test.expectedStatus, status)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package htlcfx
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
+	"github.com/DioneProtocol/odysseygo/utils/wrappers"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+var (
+	ErrTimedOut          = errors.New("output timed out")
+	ErrNotTimedOut       = errors.New("output hasn't timed out")
+	ErrWrongPreimage     = errors.New("preimage doesn't match the hash")
+	ErrUnresolvedTimeout = errors.New("output's relative timeout wasn't resolved")
+
+	errCantOperate = errors.New("cant perform operations with this fx")
+)
+
+// Fx describes the hashed timelock contract feature extension
+type Fx struct{ secp256k1fx.Fx }
+
+func (fx *Fx) Initialize(vmIntf interface{}) error {
+	if err := fx.InitializeVM(vmIntf); err != nil {
+		return err
+	}
+
+	log := fx.VM.Logger()
+	log.Debug("initializing htlc fx")
+
+	c := fx.VM.CodecRegistry()
+	errs := wrappers.Errs{}
+	errs.Add(
+		c.RegisterType(&TransferInput{}),
+		c.RegisterType(&TransferOutput{}),
+		c.RegisterType(&Credential{}),
+	)
+	return errs.Err
+}
+
+func (*Fx) VerifyOperation(_, _, _ interface{}, _ []interface{}) error {
+	return errCantOperate
+}
+
+func (fx *Fx) VerifyTransfer(txIntf, inIntf, credIntf, utxoIntf interface{}) error {
+	tx, ok := txIntf.(secp256k1fx.UnsignedTx)
+	if !ok {
+		return secp256k1fx.ErrWrongTxType
+	}
+	in, ok := inIntf.(*TransferInput)
+	if !ok {
+		return secp256k1fx.ErrWrongInputType
+	}
+	cred, ok := credIntf.(*Credential)
+	if !ok {
+		return secp256k1fx.ErrWrongCredentialType
+	}
+	out, ok := utxoIntf.(*TransferOutput)
+	if !ok {
+		return secp256k1fx.ErrWrongUTXOType
+	}
+	return fx.VerifySpend(tx, in, cred, out)
+}
+
+// VerifySpend ensures that the utxo can be sent to any address, either by its
+// recipient revealing the preimage before the timeout or by its sender after
+// the timeout
+func (fx *Fx) VerifySpend(utx secp256k1fx.UnsignedTx, in *TransferInput, cred *Credential, utxo *TransferOutput) error {
+	if err := verify.All(utxo, in, cred); err != nil {
+		return err
+	} else if utxo.Amt != in.Amt {
+		return fmt.Errorf("%w: %d != %d", secp256k1fx.ErrMismatchedAmounts, utxo.Amt, in.Amt)
+	} else if utxo.RelativeTimeout != 0 {
+		return ErrUnresolvedTimeout
+	}
+
+	timedOut := fx.VM.Clock().Unix() >= utxo.Timeout
+	if !in.IsClaim() {
+		if !timedOut {
+			return ErrNotTimedOut
+		}
+		return fx.VerifyCredentials(utx, &in.Input, &cred.Credential, &utxo.Sender)
+	}
+
+	switch {
+	case timedOut:
+		return ErrTimedOut
+	case hashing.ComputeHash256Array(in.Preimage) != utxo.Hash:
+		return ErrWrongPreimage
+	default:
+		return fx.VerifyCredentials(utx, &in.Input, &cred.Credential, &utxo.Recipient)
+	}
+}
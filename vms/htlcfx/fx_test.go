@@ -0,0 +1,246 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package htlcfx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/codec/linearcodec"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+var (
+	txBytes  = []byte{0, 1, 2, 3, 4, 5}
+	sigBytes = [secp256k1.SignatureLen]byte{
+		0x0e, 0x33, 0x4e, 0xbc, 0x67, 0xa7, 0x3f, 0xe8,
+		0x24, 0x33, 0xac, 0xa3, 0x47, 0x88, 0xa6, 0x3d,
+		0x58, 0xe5, 0x8e, 0xf0, 0x3a, 0xd5, 0x84, 0xf1,
+		0xbc, 0xa3, 0xb2, 0xd2, 0x5d, 0x51, 0xd6, 0x9b,
+		0x0f, 0x28, 0x5d, 0xcd, 0x3f, 0x71, 0x17, 0x0a,
+		0xf9, 0xbf, 0x2d, 0xb1, 0x10, 0x26, 0x5c, 0xe9,
+		0xdc, 0xc3, 0x9d, 0x7a, 0x01, 0x50, 0x9d, 0xe8,
+		0x35, 0xbd, 0xcb, 0x29, 0x3a, 0xd1, 0x49, 0x32,
+		0x00,
+	}
+	addr = [hashing.AddrLen]byte{
+		0x01, 0x5c, 0xce, 0x6c, 0x55, 0xd6, 0xb5, 0x09,
+		0x84, 0x5c, 0x8c, 0x4e, 0x30, 0xbe, 0xd9, 0x8d,
+		0x39, 0x1a, 0xe7, 0xf0,
+	}
+	otherAddr = ids.ShortID{0xff}
+	preimage  = []byte("preimage")
+)
+
+func TestFxInitialize(t *testing.T) {
+	vm := secp256k1fx.TestVM{
+		Codec: linearcodec.NewDefault(),
+		Log:   logging.NoLog{},
+	}
+	fx := Fx{}
+	require.NoError(t, fx.Initialize(&vm))
+}
+
+func TestFxInitializeInvalid(t *testing.T) {
+	fx := Fx{}
+	err := fx.Initialize(nil)
+	require.ErrorIs(t, err, secp256k1fx.ErrWrongVMType)
+}
+
+func TestFxVerifyTransfer(t *testing.T) {
+	now := time.Date(2019, time.January, 19, 16, 25, 17, 3, time.UTC)
+	nowUnix := uint64(now.Unix())
+
+	tests := []struct {
+		name        string
+		in          *TransferInput
+		out         *TransferOutput
+		expectedErr error
+	}{
+		{
+			name: "claim",
+			in:   &TransferInput{Amt: 1, Preimage: preimage, Input: secp256k1fx.Input{SigIndices: []uint32{0}}},
+			out: &TransferOutput{
+				Amt:       1,
+				Hash:      hashing.ComputeHash256Array(preimage),
+				Timeout:   nowUnix + 1,
+				Recipient: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+				Sender:    secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{otherAddr}},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "claim after timeout",
+			in:   &TransferInput{Amt: 1, Preimage: preimage, Input: secp256k1fx.Input{SigIndices: []uint32{0}}},
+			out: &TransferOutput{
+				Amt:       1,
+				Hash:      hashing.ComputeHash256Array(preimage),
+				Timeout:   nowUnix,
+				Recipient: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+				Sender:    secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{otherAddr}},
+			},
+			expectedErr: ErrTimedOut,
+		},
+		{
+			name: "claim with wrong preimage",
+			in:   &TransferInput{Amt: 1, Preimage: []byte("wrong"), Input: secp256k1fx.Input{SigIndices: []uint32{0}}},
+			out: &TransferOutput{
+				Amt:       1,
+				Hash:      hashing.ComputeHash256Array(preimage),
+				Timeout:   nowUnix + 1,
+				Recipient: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+				Sender:    secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{otherAddr}},
+			},
+			expectedErr: ErrWrongPreimage,
+		},
+		{
+			name: "claim signed by sender",
+			in:   &TransferInput{Amt: 1, Preimage: preimage, Input: secp256k1fx.Input{SigIndices: []uint32{0}}},
+			out: &TransferOutput{
+				Amt:       1,
+				Hash:      hashing.ComputeHash256Array(preimage),
+				Timeout:   nowUnix + 1,
+				Recipient: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{otherAddr}},
+				Sender:    secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+			},
+			expectedErr: secp256k1fx.ErrWrongSig,
+		},
+		{
+			name: "refund",
+			in:   &TransferInput{Amt: 1, Input: secp256k1fx.Input{SigIndices: []uint32{0}}},
+			out: &TransferOutput{
+				Amt:       1,
+				Hash:      hashing.ComputeHash256Array(preimage),
+				Timeout:   nowUnix,
+				Recipient: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{otherAddr}},
+				Sender:    secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "refund before timeout",
+			in:   &TransferInput{Amt: 1, Input: secp256k1fx.Input{SigIndices: []uint32{0}}},
+			out: &TransferOutput{
+				Amt:       1,
+				Hash:      hashing.ComputeHash256Array(preimage),
+				Timeout:   nowUnix + 1,
+				Recipient: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{otherAddr}},
+				Sender:    secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+			},
+			expectedErr: ErrNotTimedOut,
+		},
+		{
+			name: "refund signed by recipient",
+			in:   &TransferInput{Amt: 1, Input: secp256k1fx.Input{SigIndices: []uint32{0}}},
+			out: &TransferOutput{
+				Amt:       1,
+				Hash:      hashing.ComputeHash256Array(preimage),
+				Timeout:   nowUnix,
+				Recipient: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+				Sender:    secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{otherAddr}},
+			},
+			expectedErr: secp256k1fx.ErrWrongSig,
+		},
+		{
+			name: "mismatched amounts",
+			in:   &TransferInput{Amt: 2, Preimage: preimage, Input: secp256k1fx.Input{SigIndices: []uint32{0}}},
+			out: &TransferOutput{
+				Amt:       1,
+				Hash:      hashing.ComputeHash256Array(preimage),
+				Timeout:   nowUnix + 1,
+				Recipient: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+				Sender:    secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{otherAddr}},
+			},
+			expectedErr: secp256k1fx.ErrMismatchedAmounts,
+		},
+		{
+			name: "unresolved relative timeout",
+			in:   &TransferInput{Amt: 1, Input: secp256k1fx.Input{SigIndices: []uint32{0}}},
+			out: &TransferOutput{
+				Amt:             1,
+				Hash:            hashing.ComputeHash256Array(preimage),
+				RelativeTimeout: 1,
+				Recipient:       secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{otherAddr}},
+				Sender:          secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+			},
+			expectedErr: ErrUnresolvedTimeout,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			vm := secp256k1fx.TestVM{
+				Codec: linearcodec.NewDefault(),
+				Log:   logging.NoLog{},
+			}
+			vm.Clk.Set(now)
+
+			fx := Fx{}
+			require.NoError(fx.Initialize(&vm))
+			require.NoError(fx.Bootstrapped())
+
+			tx := &secp256k1fx.TestTx{
+				UnsignedBytes: txBytes,
+			}
+			cred := &Credential{Credential: secp256k1fx.Credential{
+				Sigs: [][secp256k1.SignatureLen]byte{
+					sigBytes,
+				},
+			}}
+			err := fx.VerifyTransfer(tx, test.in, cred, test.out)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestFxVerifyTransferWrongTypes(t *testing.T) {
+	require := require.New(t)
+
+	vm := secp256k1fx.TestVM{
+		Codec: linearcodec.NewDefault(),
+		Log:   logging.NoLog{},
+	}
+	fx := Fx{}
+	require.NoError(fx.Initialize(&vm))
+
+	tx := &secp256k1fx.TestTx{
+		UnsignedBytes: txBytes,
+	}
+	in := &TransferInput{}
+	cred := &Credential{}
+	out := &TransferOutput{}
+
+	err := fx.VerifyTransfer(nil, in, cred, out)
+	require.ErrorIs(err, secp256k1fx.ErrWrongTxType)
+
+	err = fx.VerifyTransfer(tx, &secp256k1fx.TransferInput{}, cred, out)
+	require.ErrorIs(err, secp256k1fx.ErrWrongInputType)
+
+	err = fx.VerifyTransfer(tx, in, &secp256k1fx.Credential{}, out)
+	require.ErrorIs(err, secp256k1fx.ErrWrongCredentialType)
+
+	err = fx.VerifyTransfer(tx, in, cred, &secp256k1fx.TransferOutput{})
+	require.ErrorIs(err, secp256k1fx.ErrWrongUTXOType)
+}
+
+func TestFxVerifyOperation(t *testing.T) {
+	require := require.New(t)
+
+	vm := secp256k1fx.TestVM{
+		Codec: linearcodec.NewDefault(),
+		Log:   logging.NoLog{},
+	}
+	fx := Fx{}
+	require.NoError(fx.Initialize(&vm))
+
+	err := fx.VerifyOperation(nil, nil, nil, nil)
+	require.ErrorIs(err, errCantOperate)
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package htlcfx
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+	"github.com/DioneProtocol/odysseygo/vms/types"
+)
+
+// MaxPreimageSize is the maximum size of the preimage an input can reveal
+const MaxPreimageSize = 256
+
+var errPreimageTooLarge = errors.New("preimage too large")
+
+// TransferInput spends a [TransferOutput]. If [Preimage] is provided, the
+// output is claimed by its recipient. Otherwise, the output is refunded to its
+// sender.
+type TransferInput struct {
+	Amt               uint64              `serialize:"true" json:"amount"`
+	Preimage          types.JSONByteSlice `serialize:"true" json:"preimage"`
+	secp256k1fx.Input `serialize:"true"`
+}
+
+func (*TransferInput) InitCtx(*snow.Context) {}
+
+// Amount returns the quantity of the asset this input produces
+func (in *TransferInput) Amount() uint64 {
+	return in.Amt
+}
+
+// IsClaim returns true if this input spends the output on behalf of its
+// recipient, rather than its sender
+func (in *TransferInput) IsClaim() bool {
+	return len(in.Preimage) != 0
+}
+
+// Verify this input is syntactically valid
+func (in *TransferInput) Verify() error {
+	switch {
+	case in == nil:
+		return secp256k1fx.ErrNilInput
+	case in.Amt == 0:
+		return secp256k1fx.ErrNoValueInput
+	case len(in.Preimage) > MaxPreimageSize:
+		return errPreimageTooLarge
+	default:
+		return in.Input.Verify()
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package htlcfx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestTransferInputAmount(t *testing.T) {
+	in := TransferInput{
+		Amt: 1,
+	}
+	require.Equal(t, uint64(1), in.Amount())
+}
+
+func TestTransferInputIsClaim(t *testing.T) {
+	require := require.New(t)
+
+	in := TransferInput{}
+	require.False(in.IsClaim())
+
+	in.Preimage = preimage
+	require.True(in.IsClaim())
+}
+
+func TestTransferInputVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          *TransferInput
+		expectedErr error
+	}{
+		{
+			name:        "nil",
+			in:          nil,
+			expectedErr: secp256k1fx.ErrNilInput,
+		},
+		{
+			name: "no value",
+			in: &TransferInput{
+				Preimage: preimage,
+			},
+			expectedErr: secp256k1fx.ErrNoValueInput,
+		},
+		{
+			name: "preimage too large",
+			in: &TransferInput{
+				Amt:      1,
+				Preimage: make([]byte, MaxPreimageSize+1),
+			},
+			expectedErr: errPreimageTooLarge,
+		},
+		{
+			name: "unsorted signature indices",
+			in: &TransferInput{
+				Amt: 1,
+				Input: secp256k1fx.Input{
+					SigIndices: []uint32{1, 0},
+				},
+			},
+			expectedErr: secp256k1fx.ErrInputIndicesNotSortedUnique,
+		},
+		{
+			name: "claim",
+			in: &TransferInput{
+				Amt:      1,
+				Preimage: preimage,
+				Input: secp256k1fx.Input{
+					SigIndices: []uint32{0},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "refund",
+			in: &TransferInput{
+				Amt: 1,
+				Input: secp256k1fx.Input{
+					SigIndices: []uint32{0},
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.in.Verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestTransferInputState(t *testing.T) {
+	intf := interface{}(&TransferInput{})
+	_, ok := intf.(verify.State)
+	require.False(t, ok)
+}
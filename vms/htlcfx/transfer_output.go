@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package htlcfx
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
+	"github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+	"github.com/DioneProtocol/odysseygo/vms/types"
+)
+
+var (
+	_ verify.State = (*TransferOutput)(nil)
+
+	errNilTransferOutput = errors.New("nil transfer output")
+	errNoTimeout         = errors.New("output has no timeout")
+	errBothTimeouts      = errors.New("output has both an absolute and a relative timeout")
+)
+
+// TransferOutput locks [Amt] units of an asset until either:
+//   - [Recipient] spends it by revealing the preimage of [Hash] before
+//     [Timeout], or
+//   - [Sender] spends it back once [Timeout] is reached.
+//
+// Rather than an absolute [Timeout], the output may specify a
+// [RelativeTimeout], counted from the time the output is accepted. The chain
+// resolves it into an absolute [Timeout] when accepting the output.
+type TransferOutput struct {
+	verify.IsState `json:"-"`
+
+	Amt uint64 `serialize:"true" json:"amount"`
+	// SHA-256 hash of the preimage the recipient must reveal
+	Hash [hashing.HashLen]byte `serialize:"true" json:"hash"`
+	// Unix time from which the sender can spend the output back
+	Timeout uint64 `serialize:"true" json:"timeout"`
+	// Seconds after the output is accepted from which the sender can spend
+	// the output back
+	RelativeTimeout uint64                   `serialize:"true" json:"relativeTimeout"`
+	Recipient       secp256k1fx.OutputOwners `serialize:"true" json:"recipient"`
+	Sender          secp256k1fx.OutputOwners `serialize:"true" json:"sender"`
+}
+
+func (out *TransferOutput) InitCtx(ctx *snow.Context) {
+	out.Recipient.InitCtx(ctx)
+	out.Sender.InitCtx(ctx)
+}
+
+// MarshalJSON marshals the output into a JSON readable format, with the hash
+// encoded in hex.
+// If the owners cannot be serialized then this will return error
+func (out *TransferOutput) MarshalJSON() ([]byte, error) {
+	recipient, err := out.Recipient.Fields()
+	if err != nil {
+		return nil, err
+	}
+	sender, err := out.Sender.Fields()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"amount":          out.Amt,
+		"hash":            types.JSONByteSlice(out.Hash[:]),
+		"timeout":         out.Timeout,
+		"relativeTimeout": out.RelativeTimeout,
+		"recipient":       recipient,
+		"sender":          sender,
+	})
+}
+
+// Resolve returns the output with its relative timeout replaced by the
+// absolute timeout it reaches when the output is accepted at the Unix time
+// [acceptedAt]. Outputs with an absolute timeout are returned as is.
+func (out *TransferOutput) Resolve(acceptedAt uint64) (*TransferOutput, error) {
+	if out.RelativeTimeout == 0 {
+		return out, nil
+	}
+	timeout, err := math.Add64(acceptedAt, out.RelativeTimeout)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *out
+	resolved.Timeout = timeout
+	resolved.RelativeTimeout = 0
+	return &resolved, nil
+}
+
+// Amount returns the quantity of the asset this output consumes
+func (out *TransferOutput) Amount() uint64 {
+	return out.Amt
+}
+
+// Addresses returns the addresses of both the recipient and the sender, as
+// either of them may spend this output
+func (out *TransferOutput) Addresses() [][]byte {
+	addrs := set.Of(out.Recipient.Addrs...)
+	addrs.Add(out.Sender.Addrs...)
+
+	addrsBytes := make([][]byte, 0, addrs.Len())
+	for addr := range addrs {
+		addrsBytes = append(addrsBytes, addr.Bytes())
+	}
+	return addrsBytes
+}
+
+func (out *TransferOutput) Verify() error {
+	switch {
+	case out == nil:
+		return errNilTransferOutput
+	case out.Amt == 0:
+		return secp256k1fx.ErrNoValueOutput
+	case out.Timeout == 0 && out.RelativeTimeout == 0:
+		return errNoTimeout
+	case out.Timeout != 0 && out.RelativeTimeout != 0:
+		return errBothTimeouts
+	}
+	return verify.All(&out.Recipient, &out.Sender)
+}
@@ -0,0 +1,167 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package htlcfx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	safemath "github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestTransferOutputAmount(t *testing.T) {
+	out := TransferOutput{
+		Amt: 1,
+	}
+	require.Equal(t, uint64(1), out.Amount())
+}
+
+func TestTransferOutputAddresses(t *testing.T) {
+	out := TransferOutput{
+		Recipient: secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{addr},
+		},
+		Sender: secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{addr, otherAddr},
+		},
+	}
+	require.ElementsMatch(t, [][]byte{addr[:], otherAddr[:]}, out.Addresses())
+}
+
+func TestTransferOutputVerify(t *testing.T) {
+	owners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}
+	tests := []struct {
+		name        string
+		out         *TransferOutput
+		expectedErr error
+	}{
+		{
+			name:        "nil",
+			out:         nil,
+			expectedErr: errNilTransferOutput,
+		},
+		{
+			name: "no value",
+			out: &TransferOutput{
+				Timeout:   1,
+				Recipient: owners,
+				Sender:    owners,
+			},
+			expectedErr: secp256k1fx.ErrNoValueOutput,
+		},
+		{
+			name: "no timeout",
+			out: &TransferOutput{
+				Amt:       1,
+				Recipient: owners,
+				Sender:    owners,
+			},
+			expectedErr: errNoTimeout,
+		},
+		{
+			name: "both timeouts",
+			out: &TransferOutput{
+				Amt:             1,
+				Timeout:         1,
+				RelativeTimeout: 1,
+				Recipient:       owners,
+				Sender:          owners,
+			},
+			expectedErr: errBothTimeouts,
+		},
+		{
+			name: "unspendable recipient",
+			out: &TransferOutput{
+				Amt:     1,
+				Timeout: 1,
+				Recipient: secp256k1fx.OutputOwners{
+					Threshold: 1,
+				},
+				Sender: owners,
+			},
+			expectedErr: secp256k1fx.ErrOutputUnspendable,
+		},
+		{
+			name: "unspendable sender",
+			out: &TransferOutput{
+				Amt:       1,
+				Timeout:   1,
+				Recipient: owners,
+				Sender: secp256k1fx.OutputOwners{
+					Threshold: 1,
+				},
+			},
+			expectedErr: secp256k1fx.ErrOutputUnspendable,
+		},
+		{
+			name: "valid",
+			out: &TransferOutput{
+				Amt:       1,
+				Timeout:   1,
+				Recipient: owners,
+				Sender:    owners,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "valid relative timeout",
+			out: &TransferOutput{
+				Amt:             1,
+				RelativeTimeout: 1,
+				Recipient:       owners,
+				Sender:          owners,
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.out.Verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestTransferOutputResolve(t *testing.T) {
+	require := require.New(t)
+
+	out := &TransferOutput{
+		Amt:     1,
+		Timeout: 5,
+	}
+	resolved, err := out.Resolve(10)
+	require.NoError(err)
+	require.Equal(out, resolved)
+
+	out = &TransferOutput{
+		Amt:             1,
+		RelativeTimeout: 5,
+	}
+	resolved, err = out.Resolve(10)
+	require.NoError(err)
+	require.Equal(&TransferOutput{
+		Amt:     1,
+		Timeout: 15,
+	}, resolved)
+	require.Equal(uint64(5), out.RelativeTimeout)
+
+	_, err = out.Resolve(math.MaxUint64)
+	require.ErrorIs(err, safemath.ErrOverflow)
+}
+
+func TestTransferOutputState(t *testing.T) {
+	intf := interface{}(&TransferOutput{})
+	_, ok := intf.(verify.State)
+	require.True(t, ok)
+}
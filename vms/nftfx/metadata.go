@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package nftfx
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/DioneProtocol/odysseygo/utils/set"
+)
+
+var (
+	ErrNotMetadata          = errors.New("payload doesn't follow the metadata convention")
+	ErrInvalidMetadata      = errors.New("invalid metadata")
+	ErrMissingMetadataName  = errors.New("metadata is missing a name")
+	ErrInvalidMetadataImage = errors.New("metadata image isn't an absolute URI")
+	ErrMissingTraitType     = errors.New("metadata attribute is missing a trait type")
+	ErrDuplicateTraitType   = errors.New("duplicate metadata trait type")
+)
+
+// Metadata is the optional structured content of an NFT payload.
+//
+// A payload follows the convention if it's a JSON object, such as:
+//
+//	{
+//		"name": "Golden Ticket",
+//		"description": "Grants a tour of the factory",
+//		"image": "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+//		"attributes": [{"trait_type": "color", "value": "gold"}]
+//	}
+//
+// Payloads that aren't JSON objects are left opaque. Unknown fields are
+// allowed so the convention can be extended.
+type Metadata struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Image       string      `json:"image,omitempty"`
+	Attributes  []Attribute `json:"attributes,omitempty"`
+}
+
+// Attribute is a trait of an NFT
+type Attribute struct {
+	TraitType string `json:"trait_type"`
+	Value     string `json:"value"`
+}
+
+// IsMetadata returns true if [payload] opts into the metadata convention
+func IsMetadata(payload []byte) bool {
+	trimmed := bytes.TrimLeft(payload, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '{'
+}
+
+// ParseMetadata returns the metadata in [payload]. Returns [ErrNotMetadata] if
+// [payload] doesn't opt into the metadata convention.
+func ParseMetadata(payload []byte) (*Metadata, error) {
+	if !IsMetadata(payload) {
+		return nil, ErrNotMetadata
+	}
+	if len(payload) > MaxPayloadSize {
+		return nil, errPayloadTooLarge
+	}
+
+	metadata := &Metadata{}
+	if err := json.Unmarshal(payload, metadata); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+	}
+	if err := metadata.Verify(); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// VerifyPayload returns an error if [payload] opts into the metadata
+// convention but isn't valid metadata. Opaque payloads are always valid.
+func VerifyPayload(payload []byte) error {
+	_, err := ParseMetadata(payload)
+	if err == ErrNotMetadata {
+		return nil
+	}
+	return err
+}
+
+func (m *Metadata) Verify() error {
+	if m.Name == "" {
+		return ErrMissingMetadataName
+	}
+	if m.Image != "" {
+		image, err := url.Parse(m.Image)
+		if err != nil || !image.IsAbs() {
+			return fmt.Errorf("%w: %q", ErrInvalidMetadataImage, m.Image)
+		}
+	}
+
+	traitTypes := set.NewSet[string](len(m.Attributes))
+	for _, attribute := range m.Attributes {
+		if attribute.TraitType == "" {
+			return ErrMissingTraitType
+		}
+		if traitTypes.Contains(attribute.TraitType) {
+			return fmt.Errorf("%w: %q", ErrDuplicateTraitType, attribute.TraitType)
+		}
+		traitTypes.Add(attribute.TraitType)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package nftfx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMetadata(t *testing.T) {
+	tests := []struct {
+		name             string
+		payload          string
+		expectedMetadata *Metadata
+		expectedErr      error
+	}{
+		{
+			name: "full",
+			payload: `{
+				"name": "Golden Ticket",
+				"description": "Grants a tour of the factory",
+				"image": "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+				"attributes": [{"trait_type": "color", "value": "gold"}],
+				"edition": 3
+			}`,
+			expectedMetadata: &Metadata{
+				Name:        "Golden Ticket",
+				Description: "Grants a tour of the factory",
+				Image:       "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+				Attributes: []Attribute{{
+					TraitType: "color",
+					Value:     "gold",
+				}},
+			},
+		},
+		{
+			name:             "name only",
+			payload:          ` {"name": "ticket"}`,
+			expectedMetadata: &Metadata{Name: "ticket"},
+		},
+		{
+			name:        "opaque",
+			payload:     "hello",
+			expectedErr: ErrNotMetadata,
+		},
+		{
+			name:        "empty",
+			expectedErr: ErrNotMetadata,
+		},
+		{
+			name:        "malformed",
+			payload:     `{"name": "ticket"`,
+			expectedErr: ErrInvalidMetadata,
+		},
+		{
+			name:        "wrong field type",
+			payload:     `{"name": 5}`,
+			expectedErr: ErrInvalidMetadata,
+		},
+		{
+			name:        "missing name",
+			payload:     `{"description": "ticket"}`,
+			expectedErr: ErrMissingMetadataName,
+		},
+		{
+			name:        "relative image",
+			payload:     `{"name": "ticket", "image": "ticket.png"}`,
+			expectedErr: ErrInvalidMetadataImage,
+		},
+		{
+			name:        "missing trait type",
+			payload:     `{"name": "ticket", "attributes": [{"value": "gold"}]}`,
+			expectedErr: ErrMissingTraitType,
+		},
+		{
+			name:        "duplicate trait type",
+			payload:     `{"name": "ticket", "attributes": [{"trait_type": "color", "value": "gold"}, {"trait_type": "color", "value": "red"}]}`,
+			expectedErr: ErrDuplicateTraitType,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			metadata, err := ParseMetadata([]byte(test.payload))
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedMetadata, metadata)
+		})
+	}
+}
+
+func TestVerifyPayload(t *testing.T) {
+	require := require.New(t)
+
+	require.NoError(VerifyPayload([]byte("hello")))
+	require.NoError(VerifyPayload([]byte(`{"name": "ticket"}`)))
+	require.ErrorIs(VerifyPayload([]byte(`{"name": ""}`)), ErrMissingMetadataName)
+}
@@ -103,6 +103,8 @@ type Owner struct {
 	Locktime  json.Uint64 `json:"locktime"`
 	Threshold json.Uint32 `json:"threshold"`
 	Addresses []string    `json:"addresses"`
+	// Weights of the addresses, if the owner is weighted
+	Weights []json.Uint32 `json:"weights,omitempty"`
 }
 
 // PermissionlessValidator is the repr. of a permissionless validator sent over
@@ -14,7 +14,6 @@ import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
-	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/timer"
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/utils/units"
@@ -137,11 +136,17 @@ func (b *builder) AddUnverifiedTx(tx *txs.Tx) error {
 		return nil
 	}
 
+	preferredBlk, err := b.blkManager.GetStatelessBlock(b.preferredBlockID)
+	if err != nil {
+		return err
+	}
+
 	verifier := txexecutor.MempoolTxVerifier{
 		Backend:       b.txExecutorBackend,
 		ParentID:      b.preferredBlockID, // We want to build off of the preferred block
 		StateVersions: b.blkManager,
 		Tx:            tx,
+		Height:        preferredBlk.Height() + 1,
 	}
 	if err := tx.Unsigned.Visit(&verifier); err != nil {
 		b.MarkDropped(txID, err)
@@ -368,11 +373,11 @@ func buildBlock(
 			return nil, fmt.Errorf("couldn't find staking tx: %w", err)
 		}
 
-		addValidatorTx, ok := stakerTx.Unsigned.(txs.ValidatorTx)
 		var orionFee uint64
-		if ok && addValidatorTx.SubnetID() == constants.PrimaryNetworkID {
-			nodeID := addValidatorTx.NodeID()
-			orionFee = builder.txExecutorBackend.Ctx.FeeCollector.GetOrionValue(nodeID)
+		if builder.txExecutorBackend.Config.IsOrionFeeActivated(timestamp) {
+			if nodeID, ok := txexecutor.OrionFeeNodeID(stakerTx.Unsigned); ok {
+				orionFee = builder.txExecutorBackend.Ctx.FeeCollector.GetOrionValue(nodeID)
+			}
 		}
 
 		rewardValidatorTx, err := builder.txBuilder.NewRewardValidatorTxWithFee(stakerTxID, orionFee)
@@ -423,6 +428,21 @@ func buildBlock(
 	}
 
 	// Issue a block with as many transactions as possible.
+	if builder.txExecutorBackend.Config.IsStateSyncActivated(timestamp) {
+		parentStateRoot, err := builder.blkManager.GetStateRoot(parentID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get parent state root: %w", err)
+		}
+		return blocks.NewStateSyncStandardBlock(
+			timestamp,
+			parentID,
+			height,
+			parentStateRoot,
+			txs,
+			feeFromAChain,
+			feeFromDChain,
+		)
+	}
 	return blocks.NewBanffStandardBlockWithFee(
 		timestamp,
 		parentID,
@@ -22,6 +22,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
@@ -370,6 +371,7 @@ func TestBuildBlock(t *testing.T) {
 					Mempool:   mempool,
 					txBuilder: txBuilder,
 					txExecutorBackend: &txexecutor.Backend{
+						Config: &config.Config{},
 						Ctx: &snow.Context{
 							FeeCollector: feeCollector,
 						},
@@ -428,6 +430,7 @@ func TestBuildBlock(t *testing.T) {
 				return &builder{
 					Mempool: mempool,
 					txExecutorBackend: &txexecutor.Backend{
+						Config: &config.Config{StateSyncTime: mockable.MaxTime},
 						Ctx: &snow.Context{
 							FeeCollector: feeCollector,
 						},
@@ -535,7 +538,8 @@ func TestBuildBlock(t *testing.T) {
 				return &builder{
 					Mempool: mempool,
 					txExecutorBackend: &txexecutor.Backend{
-						Clk: clk,
+						Config: &config.Config{StateSyncTime: mockable.MaxTime},
+						Clk:    clk,
 						Ctx: &snow.Context{
 							FeeCollector: feeCollector,
 						},
@@ -597,7 +601,8 @@ func TestBuildBlock(t *testing.T) {
 				return &builder{
 					Mempool: mempool,
 					txExecutorBackend: &txexecutor.Backend{
-						Clk: clk,
+						Config: &config.Config{StateSyncTime: mockable.MaxTime},
+						Clk:    clk,
 						Ctx: &snow.Context{
 							FeeCollector: feeCollector,
 						},
@@ -658,7 +663,8 @@ func TestBuildBlock(t *testing.T) {
 				return &builder{
 					Mempool: mempool,
 					txExecutorBackend: &txexecutor.Backend{
-						Clk: clk,
+						Config: &config.Config{StateSyncTime: mockable.MaxTime},
+						Clk:    clk,
 						Ctx: &snow.Context{
 							FeeCollector: feeCollector,
 						},
@@ -42,9 +42,11 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/api"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/index"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/statesync"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/mempool"
@@ -172,6 +174,8 @@ func newEnvironment(t *testing.T) *environment {
 		res.state,
 		&res.backend,
 		ovalidators.TestManager,
+		index.NewNoIndexer(),
+		statesync.NewNoSummaryIndexer(),
 	)
 
 	res.Builder = New(
@@ -318,6 +322,7 @@ func defaultConfig() *config.Config {
 		ApricotPhase3Time: defaultValidateEndTime,
 		ApricotPhase5Time: defaultValidateEndTime,
 		BanffTime:         time.Time{}, // neglecting fork ordering this for package tests
+		StateSyncTime:     mockable.MaxTime,
 	}
 }
 
@@ -36,6 +36,17 @@ func init() {
 			RegisterApricotBlockTypes(c),
 			txs.RegisterUnsignedTxsTypes(c),
 			RegisterBanffBlockTypes(c),
+			txs.RegisterBaseTxTypes(c),
+			txs.RegisterTransferSubnetOwnershipTypes(c),
+			RegisterStateSyncBlockTypes(c),
+			txs.RegisterBLSKeyRotationTypes(c),
+			txs.RegisterAutoRestakeTypes(c),
+			txs.RegisterEarlyUnstakeTypes(c),
+			txs.RegisterRedelegateTypes(c),
+			txs.RegisterUptimeAttestationTypes(c),
+			txs.RegisterSubnetFeesTypes(c),
+			txs.RegisterCancelPendingStakerTypes(c),
+			txs.RegisterWeightedOwnersTypes(c),
 		)
 	}
 	errs.Add(
@@ -73,3 +84,10 @@ func RegisterBanffBlockTypes(targetCodec codec.Registry) error {
 	)
 	return errs.Err
 }
+
+// RegisterStateSyncBlockTypes registers the types introduced by the StateSync
+// network upgrade. They must be registered after the TransferSubnetOwnership
+// types.
+func RegisterStateSyncBlockTypes(targetCodec codec.Registry) error {
+	return targetCodec.RegisterType(&StateSyncStandardBlock{})
+}
@@ -6,15 +6,22 @@ package executor
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/DioneProtocol/odysseygo/chains/atomic"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/index"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/metrics"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/statesync"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/executor"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/validators"
 )
 
@@ -29,9 +36,16 @@ var (
 // being shutdown.
 type acceptor struct {
 	*backend
-	metrics      metrics.Metrics
-	validators   validators.Manager
-	bootstrapped *utils.Atomic[bool]
+	metrics        metrics.Metrics
+	validators     validators.Manager
+	rewardIndexer  index.RewardIndexer
+	summaryIndexer statesync.SummaryIndexer
+	uRewardRecycle reward.URewardRecycleConfig
+	bootstrapped   *utils.Atomic[bool]
+}
+
+func (a *acceptor) StateSyncStandardBlock(b *blocks.StateSyncStandardBlock) error {
+	return a.standardBlock(b, "state sync standard")
 }
 
 func (a *acceptor) BanffAbortBlock(b *blocks.BanffAbortBlock) error {
@@ -72,7 +86,8 @@ func (a *acceptor) ApricotAtomicBlock(b *blocks.ApricotAtomicBlock) error {
 	blkID := b.ID()
 	defer a.free(blkID)
 
-	if err := a.commonAccept(b); err != nil {
+	feeDiff := a.ctx.FeeCollector.NewDiff(a.ctx.ChainID)
+	if err := a.commonAccept(b, feeDiff); err != nil {
 		return err
 	}
 
@@ -81,6 +96,16 @@ func (a *acceptor) ApricotAtomicBlock(b *blocks.ApricotAtomicBlock) error {
 		return fmt.Errorf("%w %s", errMissingBlockState, blkID)
 	}
 
+	defer a.rewardIndexer.Abort()
+	if err := a.indexBlock(b, blkState.timestamp, nil, 0); err != nil {
+		return err
+	}
+
+	recycled, err := a.recycleUndistributedReward(b, blkState, feeDiff)
+	if err != nil {
+		return err
+	}
+
 	// Update the state to reflect the changes made in [onAcceptState].
 	if err := blkState.onAcceptState.Apply(a.state); err != nil {
 		return err
@@ -96,8 +121,27 @@ func (a *acceptor) ApricotAtomicBlock(b *blocks.ApricotAtomicBlock) error {
 		)
 	}
 
-	// Note that this method writes [batch] to the database.
-	if err := a.ctx.SharedMemory.Apply(blkState.atomicRequests, batch); err != nil {
+	feeBatch, err := feeDiff.CommitBatch(blkID, b.Height())
+	if err != nil {
+		return fmt.Errorf(
+			"failed to commit fee collector changes for block %s: %w",
+			blkID,
+			err,
+		)
+	}
+
+	indexBatch, err := a.rewardIndexer.CommitBatch()
+	if err != nil {
+		return fmt.Errorf(
+			"failed to commit reward index for block %s: %w",
+			blkID,
+			err,
+		)
+	}
+
+	// Note that this method writes [batch], [feeBatch] and [indexBatch] to the
+	// database.
+	if err := a.ctx.SharedMemory.Apply(blkState.atomicRequests, batch, feeBatch, indexBatch); err != nil {
 		return fmt.Errorf(
 			"failed to atomically accept tx %s in block %s: %w",
 			b.Tx.ID(),
@@ -105,6 +149,12 @@ func (a *acceptor) ApricotAtomicBlock(b *blocks.ApricotAtomicBlock) error {
 			err,
 		)
 	}
+	feeDiff.Apply()
+	a.updateUndistributedRewardMetrics(recycled)
+
+	if err := a.summaryIndexer.IndexSummary(b); err != nil {
+		return fmt.Errorf("failed to index state summary of block %s: %w", blkID, err)
+	}
 
 	a.ctx.Log.Trace(
 		"accepted block",
@@ -125,9 +175,15 @@ func (a *acceptor) abortBlock(b blocks.Block, blockType string) error {
 		return fmt.Errorf("%w: %s", state.ErrMissingParentState, parentID)
 	}
 
-	_, ok = parentState.statelessBlock.(*blocks.ApricotProposalBlock)
-	if ok {
-		a.updateUndistributedReward(parentState)
+	feeDiff := a.ctx.FeeCollector.NewDiff(a.ctx.ChainID)
+	undistributedReward := parentState.undistributedReward
+	if err := a.updateUndistributedReward(parentState, feeDiff); err != nil {
+		return fmt.Errorf("failed to add undistributed reward: %w", err)
+	}
+
+	defer a.rewardIndexer.Abort()
+	if err := a.indexOptionBlock(b, parentState, false /*=rewarded*/, undistributedReward); err != nil {
+		return err
 	}
 
 	if a.bootstrapped.Get() {
@@ -138,7 +194,7 @@ func (a *acceptor) abortBlock(b blocks.Block, blockType string) error {
 		}
 	}
 
-	return a.optionBlock(b, parentState.statelessBlock, blockType)
+	return a.optionBlock(b, parentState.statelessBlock, feeDiff, blockType)
 }
 
 func (a *acceptor) commitBlock(b blocks.Block, blockType string) error {
@@ -156,10 +212,16 @@ func (a *acceptor) commitBlock(b blocks.Block, blockType string) error {
 		}
 	}
 
-	return a.optionBlock(b, parentState.statelessBlock, blockType)
+	defer a.rewardIndexer.Abort()
+	if err := a.indexOptionBlock(b, parentState, true /*=rewarded*/, 0); err != nil {
+		return err
+	}
+
+	feeDiff := a.ctx.FeeCollector.NewDiff(a.ctx.ChainID)
+	return a.optionBlock(b, parentState.statelessBlock, feeDiff, blockType)
 }
 
-func (a *acceptor) optionBlock(b, parent blocks.Block, blockType string) error {
+func (a *acceptor) optionBlock(b, parent blocks.Block, feeDiff feecollector.Diff, blockType string) error {
 	blkID := b.ID()
 	parentID := parent.ID()
 
@@ -170,17 +232,22 @@ func (a *acceptor) optionBlock(b, parent blocks.Block, blockType string) error {
 		a.free(blkID)
 	}()
 
-	proposalBlock, ok := parent.(*blocks.ApricotProposalBlock)
-	if ok {
-		a.updateOrionFee(proposalBlock)
+	if proposalBlock, ok := parent.(*blocks.ApricotProposalBlock); ok {
+		parentState, ok := a.blkIDToState[parentID]
+		if !ok {
+			return fmt.Errorf("%w: %s", state.ErrMissingParentState, parentID)
+		}
+		if err := a.updateOrionFee(proposalBlock, parentState, feeDiff); err != nil {
+			return err
+		}
 	}
 
 	// Note that the parent must be accepted first.
-	if err := a.commonAccept(parent); err != nil {
+	if err := a.commonAccept(parent, feeDiff); err != nil {
 		return err
 	}
 
-	if err := a.commonAccept(b); err != nil {
+	if err := a.commonAccept(b, feeDiff); err != nil {
 		return err
 	}
 
@@ -188,14 +255,56 @@ func (a *acceptor) optionBlock(b, parent blocks.Block, blockType string) error {
 	if !ok {
 		return fmt.Errorf("%w %s", errMissingBlockState, blkID)
 	}
-	if err := blkState.onAcceptState.Apply(a.state); err != nil {
+
+	recycled, err := a.recycleUndistributedReward(b, blkState, feeDiff)
+	if err != nil {
 		return err
 	}
 
-	if err := a.state.Commit(); err != nil {
+	if err := blkState.onAcceptState.Apply(a.state); err != nil {
 		return err
 	}
 
+	defer a.state.Abort()
+	batch, err := a.state.CommitBatch()
+	if err != nil {
+		return fmt.Errorf(
+			"failed to commit VM's database for block %s: %w",
+			blkID,
+			err,
+		)
+	}
+
+	feeBatch, err := feeDiff.CommitBatch(blkID, b.Height())
+	if err != nil {
+		return fmt.Errorf(
+			"failed to commit fee collector changes for block %s: %w",
+			blkID,
+			err,
+		)
+	}
+
+	indexBatch, err := a.rewardIndexer.CommitBatch()
+	if err != nil {
+		return fmt.Errorf(
+			"failed to commit reward index for block %s: %w",
+			blkID,
+			err,
+		)
+	}
+
+	// Write the fee collector changes and the reward index atomically with
+	// the block.
+	if err := atomic.WriteAll(batch, feeBatch, indexBatch); err != nil {
+		return fmt.Errorf("failed to write block %s: %w", blkID, err)
+	}
+	feeDiff.Apply()
+	a.updateUndistributedRewardMetrics(recycled)
+
+	if err := a.summaryIndexer.IndexSummary(b); err != nil {
+		return fmt.Errorf("failed to index state summary of block %s: %w", blkID, err)
+	}
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
@@ -242,7 +351,8 @@ func (a *acceptor) standardBlock(b blocks.Block, blockType string) error {
 	blkID := b.ID()
 	defer a.free(blkID)
 
-	if err := a.commonAccept(b); err != nil {
+	feeDiff := a.ctx.FeeCollector.NewDiff(a.ctx.ChainID)
+	if err := a.commonAccept(b, feeDiff); err != nil {
 		return err
 	}
 
@@ -251,6 +361,27 @@ func (a *acceptor) standardBlock(b blocks.Block, blockType string) error {
 		return fmt.Errorf("%w %s", errMissingBlockState, blkID)
 	}
 
+	defer a.rewardIndexer.Abort()
+	if err := a.indexBlock(b, blkState.timestamp, nil, 0); err != nil {
+		return err
+	}
+
+	// The stake and reward forfeited by the stakers unstaking early are moved
+	// to the undistributed reward pool.
+	if blkState.earlyUnstakePenalty > 0 {
+		if err := feeDiff.AddURewardValue(blkState.earlyUnstakePenalty); err != nil {
+			return fmt.Errorf("failed to add early unstake penalty: %w", err)
+		}
+	}
+	if err := a.forfeitOrionFees(blkState.forfeitedOrionFees, feeDiff); err != nil {
+		return err
+	}
+
+	recycled, err := a.recycleUndistributedReward(b, blkState, feeDiff)
+	if err != nil {
+		return err
+	}
+
 	// Update the state to reflect the changes made in [onAcceptState].
 	if err := blkState.onAcceptState.Apply(a.state); err != nil {
 		return err
@@ -266,10 +397,35 @@ func (a *acceptor) standardBlock(b blocks.Block, blockType string) error {
 		)
 	}
 
-	// Note that this method writes [batch] to the database.
-	if err := a.ctx.SharedMemory.Apply(blkState.atomicRequests, batch); err != nil {
+	feeBatch, err := feeDiff.CommitBatch(blkID, b.Height())
+	if err != nil {
+		return fmt.Errorf(
+			"failed to commit fee collector changes for block %s: %w",
+			blkID,
+			err,
+		)
+	}
+
+	indexBatch, err := a.rewardIndexer.CommitBatch()
+	if err != nil {
+		return fmt.Errorf(
+			"failed to commit reward index for block %s: %w",
+			blkID,
+			err,
+		)
+	}
+
+	// Note that this method writes [batch], [feeBatch] and [indexBatch] to the
+	// database.
+	if err := a.ctx.SharedMemory.Apply(blkState.atomicRequests, batch, feeBatch, indexBatch); err != nil {
 		return fmt.Errorf("failed to apply vm's state to shared memory: %w", err)
 	}
+	feeDiff.Apply()
+	a.updateUndistributedRewardMetrics(recycled)
+
+	if err := a.summaryIndexer.IndexSummary(b); err != nil {
+		return fmt.Errorf("failed to index state summary of block %s: %w", blkID, err)
+	}
 
 	if onAcceptFunc := blkState.onAcceptFunc; onAcceptFunc != nil {
 		onAcceptFunc()
@@ -287,44 +443,130 @@ func (a *acceptor) standardBlock(b blocks.Block, blockType string) error {
 	return nil
 }
 
-func (a *acceptor) updateOrionFee(b *blocks.ApricotProposalBlock) error {
+// updateOrionFee settles the orion fee paid out by the proposal block [b], if
+// any. The fee is removed from the orion pool of the node whether the staker
+// is rewarded or not, as an unpaid fee is moved to the undistributed reward
+// pool along with the rest of the staker's reward.
+//
+// The fee settled is the one accepted by the verification of [b], which is
+// zero before the orion fee upgrade.
+func (a *acceptor) updateOrionFee(b *blocks.ApricotProposalBlock, blkState *blockState, feeDiff feecollector.Diff) error {
 	rewardValidatorTx, ok := b.Tx.Unsigned.(*txs.RewardValidatorTx)
 	if !ok {
-		return fmt.Errorf("block %s doesn't have reward validator tx", b.Tx.ID())
+		// Only RewardValidatorTxs pay out orion fees.
+		return nil
 	}
 
-	orionFee := rewardValidatorTx.OrionFee
+	orionFee := blkState.orionFee
 	if orionFee == 0 {
 		return nil
 	}
 
 	stakerTx, _, err := a.state.GetTx(rewardValidatorTx.TxID)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to get staker tx %s: %w", rewardValidatorTx.TxID, err)
 	}
 
-	addValidatorTx, ok := stakerTx.Unsigned.(*txs.AddValidatorTx)
+	nodeID, ok := executor.OrionFeeNodeID(stakerTx.Unsigned)
 	if !ok {
-		return nil
+		return fmt.Errorf("%w: %s", executor.ErrUnexpectedOrionFee, rewardValidatorTx.TxID)
 	}
 
-	nodes := []ids.NodeID{addValidatorTx.NodeID()}
-	if err := a.ctx.FeeCollector.SubOrionsValue(nodes, orionFee); err != nil {
+	nodes := []ids.NodeID{nodeID}
+	if err := feeDiff.SubOrionsValue(nodes, orionFee); err != nil {
 		return fmt.Errorf("failed to subtract orion fee: %w", err)
 	}
 
+	a.state.SetLastOrionFeePayout(nodeID, &state.OrionFeePayout{
+		Height: b.Height(),
+		Fee:    orionFee,
+	})
+	return nil
+}
+
+// forfeitOrionFees moves the orion fees accrued by [nodeIDs], whose validators
+// unstaked early, to the undistributed reward pool.
+func (a *acceptor) forfeitOrionFees(nodeIDs []ids.NodeID, feeDiff feecollector.Diff) error {
+	for _, nodeID := range nodeIDs {
+		orionFee := a.ctx.FeeCollector.GetOrionValue(nodeID)
+		if orionFee == 0 {
+			continue
+		}
+		if err := feeDiff.SubOrionsValue([]ids.NodeID{nodeID}, orionFee); err != nil {
+			return fmt.Errorf("failed to subtract orion fee: %w", err)
+		}
+		if err := feeDiff.AddURewardValue(orionFee); err != nil {
+			return fmt.Errorf("failed to add forfeited orion fee: %w", err)
+		}
+	}
 	return nil
 }
 
-func (a *acceptor) updateUndistributedReward(ps *blockState) error {
+func (a *acceptor) updateUndistributedReward(ps *blockState, feeDiff feecollector.Diff) error {
 	if ps.undistributedReward > 0 {
-		a.ctx.FeeCollector.AddURewardValue(ps.undistributedReward)
+		return feeDiff.AddURewardValue(ps.undistributedReward)
 	}
 
 	return nil
 }
 
-func (a *acceptor) commonAccept(b blocks.Block) error {
+// recycleUndistributedReward stages the recycling of the undistributed reward
+// pool if [b], with [blkState], was verified to be the first block of a
+// recycle interval. The reward staged by [feeDiff] itself is recycled by the
+// next recycling. Returns the recycled amount.
+func (a *acceptor) recycleUndistributedReward(
+	b blocks.Block,
+	blkState *blockState,
+	feeDiff feecollector.Diff,
+) (uint64, error) {
+	if !blkState.recycleUndistributedReward {
+		return 0, nil
+	}
+
+	amount := a.ctx.FeeCollector.GetURewardValue()
+	if amount == 0 {
+		return 0, nil
+	}
+
+	if err := feeDiff.SubURewardValue(amount); err != nil {
+		return 0, fmt.Errorf("failed to subtract undistributed reward: %w", err)
+	}
+	if a.uRewardRecycle.Policy == reward.URewardToFees {
+		if err := feeDiff.AddAChainValue(amount); err != nil {
+			return 0, fmt.Errorf("failed to add undistributed reward to fees: %w", err)
+		}
+	}
+
+	err := a.rewardIndexer.RecordRecycle(&index.RecycleEvent{
+		Height:    b.Height(),
+		BlockID:   b.ID(),
+		Timestamp: blkState.timestamp.Unix(),
+		Policy:    string(a.uRewardRecycle.Policy),
+		Amount:    amount,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to index recycling of block %s: %w", b.ID(), err)
+	}
+
+	a.ctx.Log.Info("recycling undistributed reward",
+		zap.Stringer("blkID", b.ID()),
+		zap.Uint64("height", b.Height()),
+		zap.String("policy", string(a.uRewardRecycle.Policy)),
+		zap.Uint64("amount", amount),
+	)
+	return amount, nil
+}
+
+// updateUndistributedRewardMetrics updates the metrics of the undistributed
+// reward pool once the changes of an accepted block are applied.
+func (a *acceptor) updateUndistributedRewardMetrics(recycled uint64) {
+	if recycled > 0 {
+		a.metrics.AddRecycledUndistributedReward(string(a.uRewardRecycle.Policy), recycled)
+	}
+	a.metrics.SetUndistributedReward(a.ctx.FeeCollector.GetURewardValue())
+}
+
+func (a *acceptor) commonAccept(b blocks.Block, feeDiff feecollector.Diff) error {
 	blkID := b.ID()
 
 	if err := a.metrics.MarkAccepted(b); err != nil {
@@ -339,17 +581,84 @@ func (a *acceptor) commonAccept(b blocks.Block) error {
 
 	feeFromAChain := b.FeeFromAChain()
 	if feeFromAChain > 0 {
-		if err := a.ctx.FeeCollector.SubAChainValue(feeFromAChain); err != nil {
+		if err := feeDiff.SubAChainValue(feeFromAChain); err != nil {
 			return fmt.Errorf("failed to subtract fee: %w", err)
 		}
 	}
 
 	feeFromDChain := b.FeeFromDChain()
 	if feeFromDChain > 0 {
-		if err := a.ctx.FeeCollector.SubDChainValue(feeFromDChain); err != nil {
+		if err := feeDiff.SubDChainValue(feeFromDChain); err != nil {
 			return fmt.Errorf("failed to subtract fee: %w", err)
 		}
 	}
 
+	if feeFromAChain > 0 || feeFromDChain > 0 {
+		a.state.SetLastFeeSync(&state.FeeSync{
+			Height:    b.Height(),
+			AChainFee: feeFromAChain,
+			DChainFee: feeFromDChain,
+		})
+	}
+	return nil
+}
+
+// indexOptionBlock stages the fees and rewards of the option block [b] and of
+// its parent proposal block.
+func (a *acceptor) indexOptionBlock(
+	b blocks.Block,
+	parentState *blockState,
+	rewarded bool,
+	undistributedReward uint64,
+) error {
+	// Option blocks have the same timestamp as their parent.
+	if err := a.indexBlock(parentState.statelessBlock, parentState.timestamp, nil, 0); err != nil {
+		return err
+	}
+
+	// Banff proposal blocks are stored as their embedded Apricot proposal
+	// block by the verifier.
+	var stakerReward *index.StakerReward
+	if parent, ok := parentState.statelessBlock.(*blocks.ApricotProposalBlock); ok {
+		if rewardValidatorTx, ok := parent.Tx.Unsigned.(*txs.RewardValidatorTx); ok {
+			stakerTx, _, err := a.state.GetTx(rewardValidatorTx.TxID)
+			if err != nil {
+				return fmt.Errorf("failed to get staker tx %s: %w", rewardValidatorTx.TxID, err)
+			}
+			staker, ok := stakerTx.Unsigned.(txs.Staker)
+			if !ok {
+				return fmt.Errorf("%w: %T", executor.ErrWrongTxType, stakerTx.Unsigned)
+			}
+
+			stakerReward = &index.StakerReward{
+				TxID:       rewardValidatorTx.TxID,
+				NodeID:     staker.NodeID(),
+				SubnetID:   staker.SubnetID(),
+				Height:     b.Height(),
+				BlockID:    b.ID(),
+				Rewarded:   rewarded,
+				MintReward: parentState.mintReward,
+				FeeReward:  parentState.feeReward,
+				OrionFee:   parentState.orionFee,
+
+				DelegationShares: parentState.delegationShares,
+				DelegationFee:    parentState.delegationFee,
+				DelegateeReward:  parentState.delegateeReward,
+			}
+		}
+	}
+	return a.indexBlock(b, parentState.timestamp, stakerReward, undistributedReward)
+}
+
+// indexBlock stages the fees collected by [b] and the reward decided by it.
+func (a *acceptor) indexBlock(
+	b blocks.Block,
+	timestamp time.Time,
+	stakerReward *index.StakerReward,
+	undistributedReward uint64,
+) error {
+	if err := a.rewardIndexer.Accept(b, timestamp, stakerReward, undistributedReward); err != nil {
+		return fmt.Errorf("failed to index block %s: %w", b.ID(), err)
+	}
 	return nil
 }
@@ -5,6 +5,7 @@ package executor
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -12,15 +13,21 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/chains/atomic"
 	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/index"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/metrics"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/statesync"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/validators"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
@@ -60,8 +67,10 @@ func TestAcceptorVisitProposalBlock(t *testing.T) {
 			},
 			state: s,
 		},
-		metrics:    metrics.Noop,
-		validators: validators.TestManager,
+		metrics:        metrics.Noop,
+		validators:     validators.TestManager,
+		rewardIndexer:  index.NewNoIndexer(),
+		summaryIndexer: statesync.NewNoSummaryIndexer(),
 	}
 
 	require.NoError(acceptor.ApricotProposalBlock(blk))
@@ -83,6 +92,8 @@ func TestAcceptorVisitAtomicBlock(t *testing.T) {
 
 	s := state.NewMockState(ctrl)
 	sharedMemory := atomic.NewMockSharedMemory(ctrl)
+	feeCollector, err := feecollector.New(memdb.New())
+	require.NoError(err)
 
 	parentID := ids.GenerateTestID()
 	acceptor := &acceptor{
@@ -93,10 +104,13 @@ func TestAcceptorVisitAtomicBlock(t *testing.T) {
 			ctx: &snow.Context{
 				Log:          logging.NoLog{},
 				SharedMemory: sharedMemory,
+				FeeCollector: feeCollector,
 			},
 		},
-		metrics:    metrics.Noop,
-		validators: validators.TestManager,
+		metrics:        metrics.Noop,
+		validators:     validators.TestManager,
+		rewardIndexer:  index.NewNoIndexer(),
+		summaryIndexer: statesync.NewNoSummaryIndexer(),
 	}
 
 	blk, err := blocks.NewApricotAtomicBlock(
@@ -150,7 +164,7 @@ func TestAcceptorVisitAtomicBlock(t *testing.T) {
 	s.EXPECT().CommitBatch().Return(batch, nil).Times(1)
 	s.EXPECT().Abort().Times(1)
 	onAcceptState.EXPECT().Apply(s).Times(1)
-	sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1)
+	sharedMemory.EXPECT().Apply(atomicRequests, batch, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	s.EXPECT().Checksum().Return(ids.Empty).Times(1)
 
 	require.NoError(acceptor.ApricotAtomicBlock(blk))
@@ -162,6 +176,8 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 
 	s := state.NewMockState(ctrl)
 	sharedMemory := atomic.NewMockSharedMemory(ctrl)
+	feeCollector, err := feecollector.New(memdb.New())
+	require.NoError(err)
 
 	parentID := ids.GenerateTestID()
 	clk := &mockable.Clock{}
@@ -173,10 +189,13 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 			ctx: &snow.Context{
 				Log:          logging.NoLog{},
 				SharedMemory: sharedMemory,
+				FeeCollector: feeCollector,
 			},
 		},
-		metrics:    metrics.Noop,
-		validators: validators.TestManager,
+		metrics:        metrics.Noop,
+		validators:     validators.TestManager,
+		rewardIndexer:  index.NewNoIndexer(),
+		summaryIndexer: statesync.NewNoSummaryIndexer(),
 	}
 
 	blk, err := blocks.NewBanffStandardBlock(
@@ -209,6 +228,8 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 	childID := ids.GenerateTestID()
 	atomicRequests := map[ids.ID]*atomic.Requests{ids.GenerateTestID(): nil}
 	calledOnAcceptFunc := false
+	forfeitedNodeID := ids.GenerateTestNodeID()
+	require.NoError(feeCollector.AddOrionsValue([]ids.NodeID{forfeitedNodeID}, 50))
 	acceptor.backend.blkIDToState[blk.ID()] = &blockState{
 		onAcceptState:  onAcceptState,
 		atomicRequests: atomicRequests,
@@ -216,6 +237,8 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 			onAcceptFunc: func() {
 				calledOnAcceptFunc = true
 			},
+			earlyUnstakePenalty: 100,
+			forfeitedOrionFees:  []ids.NodeID{forfeitedNodeID},
 		},
 	}
 	// Give [blk] a child.
@@ -239,12 +262,17 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 	s.EXPECT().CommitBatch().Return(batch, nil).Times(1)
 	s.EXPECT().Abort().Times(1)
 	onAcceptState.EXPECT().Apply(s).Times(1)
-	sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1)
+	sharedMemory.EXPECT().Apply(atomicRequests, batch, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	s.EXPECT().Checksum().Return(ids.Empty).Times(1)
 
 	require.NoError(acceptor.BanffStandardBlock(blk))
 	require.True(calledOnAcceptFunc)
 	require.Equal(blk.ID(), acceptor.backend.lastAccepted)
+
+	// The early unstake penalties and the forfeited orion fees are moved to
+	// the undistributed reward pool.
+	require.Equal(uint64(150), feeCollector.GetURewardValue())
+	require.Zero(feeCollector.GetOrionValue(forfeitedNodeID))
 }
 
 func TestAcceptorVisitCommitBlock(t *testing.T) {
@@ -253,6 +281,8 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 
 	s := state.NewMockState(ctrl)
 	sharedMemory := atomic.NewMockSharedMemory(ctrl)
+	feeCollector, err := feecollector.New(memdb.New())
+	require.NoError(err)
 
 	parentID := ids.GenerateTestID()
 	acceptor := &acceptor{
@@ -263,11 +293,14 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 			ctx: &snow.Context{
 				Log:          logging.NoLog{},
 				SharedMemory: sharedMemory,
+				FeeCollector: feeCollector,
 			},
 		},
-		metrics:      metrics.Noop,
-		validators:   validators.TestManager,
-		bootstrapped: &utils.Atomic[bool]{},
+		metrics:        metrics.Noop,
+		validators:     validators.TestManager,
+		rewardIndexer:  index.NewNoIndexer(),
+		summaryIndexer: statesync.NewNoSummaryIndexer(),
+		bootstrapped:   &utils.Atomic[bool]{},
 	}
 
 	blk, err := blocks.NewApricotCommitBlock(parentID, 1 /*height*/)
@@ -336,8 +369,9 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 		s.EXPECT().AddStatelessBlock(blk).Times(1),
 
 		onAcceptState.EXPECT().Apply(s).Times(1),
-		s.EXPECT().Commit().Return(nil).Times(1),
+		s.EXPECT().CommitBatch().Return(memdb.New().NewBatch(), nil).Times(1),
 		s.EXPECT().Checksum().Return(ids.Empty).Times(1),
+		s.EXPECT().Abort().Times(1),
 	)
 
 	require.NoError(acceptor.ApricotCommitBlock(blk))
@@ -350,6 +384,8 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 
 	s := state.NewMockState(ctrl)
 	sharedMemory := atomic.NewMockSharedMemory(ctrl)
+	feeCollector, err := feecollector.New(memdb.New())
+	require.NoError(err)
 
 	parentID := ids.GenerateTestID()
 	acceptor := &acceptor{
@@ -360,11 +396,14 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 			ctx: &snow.Context{
 				Log:          logging.NoLog{},
 				SharedMemory: sharedMemory,
+				FeeCollector: feeCollector,
 			},
 		},
-		metrics:      metrics.Noop,
-		validators:   validators.TestManager,
-		bootstrapped: &utils.Atomic[bool]{},
+		metrics:        metrics.Noop,
+		validators:     validators.TestManager,
+		rewardIndexer:  index.NewNoIndexer(),
+		summaryIndexer: statesync.NewNoSummaryIndexer(),
+		bootstrapped:   &utils.Atomic[bool]{},
 	}
 
 	blk, err := blocks.NewApricotAbortBlock(parentID, 1 /*height*/)
@@ -434,10 +473,128 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 		s.EXPECT().AddStatelessBlock(blk).Times(1),
 
 		onAcceptState.EXPECT().Apply(s).Times(1),
-		s.EXPECT().Commit().Return(nil).Times(1),
+		s.EXPECT().CommitBatch().Return(memdb.New().NewBatch(), nil).Times(1),
 		s.EXPECT().Checksum().Return(ids.Empty).Times(1),
+		s.EXPECT().Abort().Times(1),
 	)
 
 	require.NoError(acceptor.ApricotAbortBlock(blk))
 	require.Equal(blk.ID(), acceptor.backend.lastAccepted)
 }
+
+func TestAcceptorRecycleUndistributedReward(t *testing.T) {
+	parentTime := time.Unix(23*60*60, 0)
+	tests := []struct {
+		name                string
+		policy              reward.URewardRecyclePolicy
+		recycleTime         time.Time
+		timestamp           time.Time
+		expectedRecycled    uint64
+		expectedUReward     uint64
+		expectedAChainValue uint64
+	}{
+		{
+			name:            "keep",
+			policy:          reward.URewardKeep,
+			timestamp:       parentTime.Add(2 * time.Hour),
+			expectedUReward: 100,
+		},
+		{
+			name:            "same interval",
+			policy:          reward.URewardToFees,
+			timestamp:       parentTime.Add(time.Minute),
+			expectedUReward: 100,
+		},
+		{
+			name:            "not activated",
+			policy:          reward.URewardToFees,
+			recycleTime:     parentTime.Add(3 * time.Hour),
+			timestamp:       parentTime.Add(2 * time.Hour),
+			expectedUReward: 100,
+		},
+		{
+			name:                "to fees",
+			policy:              reward.URewardToFees,
+			timestamp:           parentTime.Add(2 * time.Hour),
+			expectedRecycled:    100,
+			expectedAChainValue: 100,
+		},
+		{
+			name:             "burn",
+			policy:           reward.URewardBurn,
+			timestamp:        parentTime.Add(2 * time.Hour),
+			expectedRecycled: 100,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			s := state.NewMockState(ctrl)
+
+			feeCollector, err := feecollector.New(memdb.New())
+			require.NoError(err)
+			require.NoError(feeCollector.AddURewardValue(100))
+
+			cfg := &config.Config{
+				URewardRecycleConfig: reward.URewardRecycleConfig{
+					Policy:   test.policy,
+					Interval: 24 * time.Hour,
+				},
+				URewardRecycleTime: test.recycleTime,
+			}
+
+			rewardIndexer := index.NewIndexer(memdb.New())
+			acceptor := &acceptor{
+				backend: &backend{
+					state: s,
+					ctx: &snow.Context{
+						Log:          logging.NoLog{},
+						FeeCollector: feeCollector,
+					},
+				},
+				metrics:        metrics.Noop,
+				validators:     validators.TestManager,
+				rewardIndexer:  rewardIndexer,
+				summaryIndexer: statesync.NewNoSummaryIndexer(),
+				uRewardRecycle: cfg.URewardRecycleConfig,
+			}
+
+			blk, err := blocks.NewBanffStandardBlock(test.timestamp, ids.GenerateTestID(), 5, nil)
+			require.NoError(err)
+
+			// The recycling is decided when the block is verified.
+			blkState := &blockState{
+				statelessBlock:             blk,
+				timestamp:                  test.timestamp,
+				recycleUndistributedReward: recyclesUndistributedReward(cfg, parentTime, test.timestamp),
+			}
+
+			feeDiff := feeCollector.NewDiff(ids.GenerateTestID())
+			recycled, err := acceptor.recycleUndistributedReward(blk, blkState, feeDiff)
+			require.NoError(err)
+			require.Equal(test.expectedRecycled, recycled)
+
+			_, err = feeDiff.CommitBatch(blk.ID(), blk.Height())
+			require.NoError(err)
+			feeDiff.Apply()
+			require.Equal(test.expectedUReward, feeCollector.GetURewardValue())
+			require.Equal(test.expectedAChainValue, feeCollector.GetAChainValue())
+
+			events, err := rewardIndexer.GetRecycleEvents(0, 10)
+			require.NoError(err)
+			if test.expectedRecycled == 0 {
+				require.Empty(events)
+				return
+			}
+			require.Equal([]*index.RecycleEvent{{
+				Height:    blk.Height(),
+				BlockID:   blk.ID(),
+				Timestamp: test.timestamp.Unix(),
+				Policy:    string(test.policy),
+				Amount:    test.expectedRecycled,
+			}}, events)
+		})
+	}
+}
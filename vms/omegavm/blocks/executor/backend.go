@@ -4,6 +4,8 @@
 package executor
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/DioneProtocol/odysseygo/ids"
@@ -11,6 +13,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/mempool"
+	"github.com/DioneProtocol/odysseygo/x/merkledb"
 )
 
 // Shared fields used by visitors.
@@ -29,6 +32,13 @@ type backend struct {
 	blkIDToState map[ids.ID]*blockState
 	state        state.State
 
+	// merkleViews is a map from a processing block's ID to the merkle state
+	// after its acceptance. Committing to the merkle state invalidates the
+	// views, so they are only valid while [merkleViewsBase] is the last
+	// accepted block of [state].
+	merkleViews     map[ids.ID]merkledb.TrieView
+	merkleViewsBase ids.ID
+
 	ctx *snow.Context
 }
 
@@ -79,6 +89,58 @@ func (b *backend) LastAccepted() ids.ID {
 
 func (b *backend) free(blkID ids.ID) {
 	delete(b.blkIDToState, blkID)
+	delete(b.merkleViews, blkID)
+}
+
+// GetStateRoot returns the root of the merkle state after the acceptance of
+// the decision block [blkID].
+func (b *backend) GetStateRoot(blkID ids.ID) (ids.ID, error) {
+	view, err := b.getMerkleView(blkID)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return view.GetMerkleRoot(context.TODO())
+}
+
+func (b *backend) getMerkleView(blkID ids.ID) (merkledb.Trie, error) {
+	lastAccepted := b.state.GetLastAccepted()
+	if b.merkleViewsBase != lastAccepted {
+		b.merkleViews = make(map[ids.ID]merkledb.TrieView)
+		b.merkleViewsBase = lastAccepted
+	}
+	if blkID == lastAccepted {
+		return b.state.GetMerkleDB(), nil
+	}
+	if view, ok := b.merkleViews[blkID]; ok {
+		return view, nil
+	}
+
+	blkState, ok := b.blkIDToState[blkID]
+	if !ok || blkState.onAcceptState == nil {
+		return nil, fmt.Errorf("%w: %s", state.ErrMissingParentState, blkID)
+	}
+
+	// The state of an option block is built on top of the state of the parent
+	// of its proposal block.
+	parentID := blkState.statelessBlock.Parent()
+	if parentState, ok := b.blkIDToState[parentID]; ok && parentState.onAcceptState == nil {
+		parentID = parentState.statelessBlock.Parent()
+	}
+	parentView, err := b.getMerkleView(parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	ops, err := blkState.onAcceptState.MerkleOps()
+	if err != nil {
+		return nil, err
+	}
+	view, err := parentView.NewView(context.TODO(), merkledb.ViewChanges{BatchOps: ops})
+	if err != nil {
+		return nil, err
+	}
+	b.merkleViews[blkID] = view
+	return view, nil
 }
 
 func (b *backend) getTimestamp(blkID ids.ID) time.Time {
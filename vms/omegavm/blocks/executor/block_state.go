@@ -14,13 +14,21 @@ import (
 )
 
 type standardBlockState struct {
-	onAcceptFunc func()
-	inputs       set.Set[ids.ID]
+	onAcceptFunc        func()
+	inputs              set.Set[ids.ID]
+	earlyUnstakePenalty uint64
+	forfeitedOrionFees  []ids.NodeID
 }
 
 type proposalBlockState struct {
 	initiallyPreferCommit bool
 	undistributedReward   uint64
+	mintReward            uint64
+	feeReward             uint64
+	orionFee              uint64
+	delegationShares      uint32
+	delegationFee         uint64
+	delegateeReward       uint64
 	onCommitState         state.Diff
 	onAbortState          state.Diff
 }
@@ -35,4 +43,9 @@ type blockState struct {
 
 	timestamp      time.Time
 	atomicRequests map[ids.ID]*atomic.Requests
+
+	// recycleUndistributedReward is true if the undistributed reward pool is
+	// recycled when this block is accepted. Option blocks inherit it from
+	// their proposal block.
+	recycleUndistributedReward bool
 }
@@ -0,0 +1,25 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
+)
+
+// SplitAccumulatedFee returns the part of the fees, in [assetID], collected by
+// [b] that is distributed to the stakers and the part that is burned.
+func SplitAccumulatedFee(cfg *config.Config, b blocks.BanffBlock, assetID ids.ID) (uint64, uint64) {
+	accumulatedFee := b.AccumulatedFee(assetID)
+	if !cfg.IsFeeBurnActivated(b.Timestamp()) {
+		return accumulatedFee, 0
+	}
+
+	feeFromAChain := b.FeeFromAChain()
+	feeFromDChain := b.FeeFromDChain()
+	feeFromOChain := accumulatedFee - feeFromAChain - feeFromDChain
+	burned := cfg.FeeBurnConfig.Burned(feeFromOChain, feeFromAChain, feeFromDChain)
+	return accumulatedFee - burned, burned
+}
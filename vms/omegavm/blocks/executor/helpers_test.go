@@ -42,9 +42,11 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/api"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/index"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/statesync"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/executor"
@@ -207,6 +209,8 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller) *environment {
 			res.state,
 			res.backend,
 			ovalidators.TestManager,
+			index.NewNoIndexer(),
+			statesync.NewNoSummaryIndexer(),
 		)
 		addSubnet(res)
 	} else {
@@ -216,6 +220,8 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller) *environment {
 			res.mockedState,
 			res.backend,
 			ovalidators.TestManager,
+			index.NewNoIndexer(),
+			statesync.NewNoSummaryIndexer(),
 		)
 		// we do not add any subnet to state, since we can mock
 		// whatever we need
@@ -355,6 +361,7 @@ func defaultConfig() *config.Config {
 		ApricotPhase3Time: defaultValidateEndTime,
 		ApricotPhase5Time: defaultValidateEndTime,
 		BanffTime:         mockable.MaxTime,
+		StateSyncTime:     mockable.MaxTime,
 	}
 }
 
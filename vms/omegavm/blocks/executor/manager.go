@@ -7,11 +7,14 @@ import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/index"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/statesync"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/executor"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/mempool"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/validators"
+	"github.com/DioneProtocol/odysseygo/x/merkledb"
 )
 
 var _ Manager = (*manager)(nil)
@@ -24,6 +27,14 @@ type Manager interface {
 	GetBlock(blkID ids.ID) (snowman.Block, error)
 	GetStatelessBlock(blkID ids.ID) (blocks.Block, error)
 	NewBlock(blocks.Block) snowman.Block
+
+	// GetStateRoot returns the root of the merkle state after the acceptance
+	// of the decision block [blkID].
+	GetStateRoot(blkID ids.ID) (ids.ID, error)
+
+	// SetLastAccepted marks [blkID], whose state was synced, as the most
+	// recently accepted block.
+	SetLastAccepted(blkID ids.ID)
 }
 
 func NewManager(
@@ -32,6 +43,8 @@ func NewManager(
 	s state.State,
 	txExecutorBackend *executor.Backend,
 	validatorManager validators.Manager,
+	rewardIndexer index.RewardIndexer,
+	summaryIndexer statesync.SummaryIndexer,
 ) Manager {
 	backend := &backend{
 		Mempool:      mempool,
@@ -39,6 +52,7 @@ func NewManager(
 		state:        s,
 		ctx:          txExecutorBackend.Ctx,
 		blkIDToState: map[ids.ID]*blockState{},
+		merkleViews:  map[ids.ID]merkledb.TrieView{},
 	}
 
 	return &manager{
@@ -48,10 +62,13 @@ func NewManager(
 			txExecutorBackend: txExecutorBackend,
 		},
 		acceptor: &acceptor{
-			backend:      backend,
-			metrics:      metrics,
-			validators:   validatorManager,
-			bootstrapped: txExecutorBackend.Bootstrapped,
+			backend:        backend,
+			metrics:        metrics,
+			validators:     validatorManager,
+			rewardIndexer:  rewardIndexer,
+			summaryIndexer: summaryIndexer,
+			uRewardRecycle: txExecutorBackend.Config.URewardRecycleConfig,
+			bootstrapped:   txExecutorBackend.Bootstrapped,
 		},
 		rejector: &rejector{
 			backend:         backend,
@@ -85,3 +102,7 @@ func (m *manager) NewBlock(blk blocks.Block) snowman.Block {
 		Block:   blk,
 	}
 }
+
+func (m *manager) SetLastAccepted(blkID ids.ID) {
+	m.backend.lastAccepted = blkID
+}
@@ -70,6 +70,21 @@ func (mr *MockManagerMockRecorder) GetState(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockManager)(nil).GetState), arg0)
 }
 
+// GetStateRoot mocks base method.
+func (m *MockManager) GetStateRoot(arg0 ids.ID) (ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStateRoot", arg0)
+	ret0, _ := ret[0].(ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateRoot indicates an expected call of GetStateRoot.
+func (mr *MockManagerMockRecorder) GetStateRoot(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateRoot", reflect.TypeOf((*MockManager)(nil).GetStateRoot), arg0)
+}
+
 // GetStatelessBlock mocks base method.
 func (m *MockManager) GetStatelessBlock(arg0 ids.ID) (blocks.Block, error) {
 	m.ctrl.T.Helper()
@@ -112,3 +127,15 @@ func (mr *MockManagerMockRecorder) NewBlock(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewBlock", reflect.TypeOf((*MockManager)(nil).NewBlock), arg0)
 }
+
+// SetLastAccepted mocks base method.
+func (m *MockManager) SetLastAccepted(arg0 ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLastAccepted", arg0)
+}
+
+// SetLastAccepted indicates an expected call of SetLastAccepted.
+func (mr *MockManagerMockRecorder) SetLastAccepted(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastAccepted", reflect.TypeOf((*MockManager)(nil).SetLastAccepted), arg0)
+}
@@ -51,6 +51,10 @@ func (o *options) BanffProposalBlock(b *blocks.BanffProposalBlock) error {
 	return nil
 }
 
+func (*options) StateSyncStandardBlock(*blocks.StateSyncStandardBlock) error {
+	return snowman.ErrNotOracle
+}
+
 func (*options) BanffStandardBlock(*blocks.BanffStandardBlock) error {
 	return snowman.ErrNotOracle
 }
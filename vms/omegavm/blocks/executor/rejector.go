@@ -19,6 +19,10 @@ type rejector struct {
 	addTxsToMempool bool
 }
 
+func (r *rejector) StateSyncStandardBlock(b *blocks.StateSyncStandardBlock) error {
+	return r.rejectBlock(b, "state sync standard")
+}
+
 func (r *rejector) BanffAbortBlock(b *blocks.BanffAbortBlock) error {
 	return r.rejectBlock(b, "banff abort")
 }
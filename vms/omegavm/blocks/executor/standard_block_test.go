@@ -19,8 +19,11 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow/validators"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
@@ -61,6 +64,7 @@ func TestApricotStandardBlockTimeVerification(t *testing.T) {
 	env.mockedState.EXPECT().GetLastAccepted().Return(parentID).AnyTimes()
 	env.mockedState.EXPECT().GetTimestamp().Return(chainTime).AnyTimes()
 	onParentAccept.EXPECT().GetTimestamp().Return(chainTime).AnyTimes()
+	onParentAccept.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator)).AnyTimes()
 
 	// wrong height
 	apricotChildBlk, err := blocks.NewApricotStandardBlock(
@@ -153,6 +157,7 @@ func TestBanffStandardBlockTimeVerification(t *testing.T) {
 	onParentAccept.EXPECT().GetPendingStakerIterator().Return(pendingIt, nil).AnyTimes()
 
 	onParentAccept.EXPECT().GetTimestamp().Return(chainTime).AnyTimes()
+	onParentAccept.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator)).AnyTimes()
 
 	txID := ids.GenerateTestID()
 	utxo := &dione.UTXO{
@@ -855,3 +860,277 @@ func TestBanffStandardBlockDelegatorStakerWeight(t *testing.T) {
 	vdrWeight = primarySet.GetWeight(nodeID)
 	require.Equal(env.config.MinDelegatorStake+env.config.MinValidatorStake, vdrWeight)
 }
+
+func TestBanffStandardBlockBurnsFees(t *testing.T) {
+	tests := []struct {
+		name                string
+		feeBurnActivated    bool
+		expectedDistributed uint64
+		expectedBurned      uint64
+	}{
+		{
+			name:                "before fee burn activation",
+			feeBurnActivated:    false,
+			expectedDistributed: 3_000,
+			expectedBurned:      0,
+		},
+		{
+			name:                "after fee burn activation",
+			feeBurnActivated:    true,
+			expectedDistributed: 500 + 1_500,
+			expectedBurned:      500 + 500,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, nil)
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.BanffTime = time.Time{} // activate Banff
+			env.config.FeeBurnConfig = reward.FeeBurnConfig{
+				AChainBurnRate: reward.PercentDenominator / 2,
+				DChainBurnRate: reward.PercentDenominator / 4,
+			}
+			env.config.FeeBurnTime = mockable.MaxTime
+			if test.feeBurnActivated {
+				env.config.FeeBurnTime = time.Time{}
+			}
+
+			// Add a pending validator so that the block performs changes
+			pendingValidatorStartTime := defaultGenesisTime.Add(1 * time.Second)
+			pendingValidatorEndTime := pendingValidatorStartTime.Add(defaultMinValidatorStakingDuration)
+			_, err := addPendingValidator(
+				env,
+				pendingValidatorStartTime,
+				pendingValidatorEndTime,
+				ids.GenerateTestNodeID(),
+				ids.GenerateTestShortID(),
+				[]*secp256k1.PrivateKey{preFundedKeys[0]},
+			)
+			require.NoError(err)
+
+			supply, err := env.state.GetCurrentSupply(constants.PrimaryNetworkID)
+			require.NoError(err)
+			accumulatedFee, err := env.state.GetCurrentAccumulatedFee()
+			require.NoError(err)
+			burnedFees, err := env.state.GetBurnedFees()
+			require.NoError(err)
+
+			preferredID := env.state.GetLastAccepted()
+			parentBlk, err := env.state.GetStatelessBlock(preferredID)
+			require.NoError(err)
+			statelessStandardBlock, err := blocks.NewBanffStandardBlockWithFee(
+				pendingValidatorStartTime,
+				parentBlk.ID(),
+				parentBlk.Height()+1,
+				nil, // txs nulled to simplify test
+				1_000,
+				2_000,
+			)
+			require.NoError(err)
+
+			distributed, burned := SplitAccumulatedFee(env.config, statelessStandardBlock, env.ctx.DIONEAssetID)
+			require.Equal(test.expectedDistributed, distributed)
+			require.Equal(test.expectedBurned, burned)
+
+			block := env.blkManager.NewBlock(statelessStandardBlock)
+			require.NoError(block.Verify(context.Background()))
+
+			onAcceptState := env.blkManager.(*manager).blkIDToState[block.ID()].onAcceptState
+			newSupply, err := onAcceptState.GetCurrentSupply(constants.PrimaryNetworkID)
+			require.NoError(err)
+			require.Equal(supply-test.expectedBurned, newSupply)
+
+			newAccumulatedFee, err := onAcceptState.GetCurrentAccumulatedFee()
+			require.NoError(err)
+			require.Equal(accumulatedFee+test.expectedDistributed, newAccumulatedFee)
+
+			newBurnedFees, err := onAcceptState.GetBurnedFees()
+			require.NoError(err)
+			require.Equal(burnedFees+test.expectedBurned, newBurnedFees)
+		})
+	}
+}
+
+func TestBanffStandardBlockUpdatesFeeRate(t *testing.T) {
+	tests := []struct {
+		name                string
+		dynamicFeeActivated bool
+	}{
+		{
+			name:                "before dynamic fee activation",
+			dynamicFeeActivated: false,
+		},
+		{
+			name:                "after dynamic fee activation",
+			dynamicFeeActivated: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, nil)
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.BanffTime = time.Time{} // activate Banff
+			env.config.DynamicFeeConfig = fees.DynamicFeeConfig{
+				TargetBlockSize:  1,
+				AdjustmentWindow: 8,
+				MinFeeRate:       1,
+				MaxFeeRate:       2 * fees.RateDenominator,
+			}
+			env.config.DynamicFeeTime = mockable.MaxTime
+			if test.dynamicFeeActivated {
+				env.config.DynamicFeeTime = time.Time{}
+			}
+
+			// Add a pending validator so that the block performs changes
+			pendingValidatorStartTime := defaultGenesisTime.Add(1 * time.Second)
+			pendingValidatorEndTime := pendingValidatorStartTime.Add(defaultMinValidatorStakingDuration)
+			_, err := addPendingValidator(
+				env,
+				pendingValidatorStartTime,
+				pendingValidatorEndTime,
+				ids.GenerateTestNodeID(),
+				ids.GenerateTestShortID(),
+				[]*secp256k1.PrivateKey{preFundedKeys[0]},
+			)
+			require.NoError(err)
+
+			feeRate := env.state.GetFeeRate()
+			require.Equal(uint64(fees.RateDenominator), feeRate)
+
+			preferredID := env.state.GetLastAccepted()
+			parentBlk, err := env.state.GetStatelessBlock(preferredID)
+			require.NoError(err)
+			statelessStandardBlock, err := blocks.NewBanffStandardBlock(
+				pendingValidatorStartTime,
+				parentBlk.ID(),
+				parentBlk.Height()+1,
+				nil, // txs nulled to simplify test
+			)
+			require.NoError(err)
+
+			block := env.blkManager.NewBlock(statelessStandardBlock)
+			require.NoError(block.Verify(context.Background()))
+
+			expectedFeeRate := feeRate
+			if test.dynamicFeeActivated {
+				// The block is larger than the target size
+				expectedFeeRate = env.config.DynamicFeeConfig.NextFeeRate(feeRate, len(block.Bytes()))
+				require.Greater(expectedFeeRate, feeRate)
+			}
+
+			onAcceptState := env.blkManager.(*manager).blkIDToState[block.ID()].onAcceptState
+			require.Equal(expectedFeeRate, onAcceptState.GetFeeRate())
+		})
+	}
+}
+
+func TestStateSyncStandardBlockParentStateRoot(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, nil)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+	env.config.BanffTime = time.Time{}     // activate Banff
+	env.config.StateSyncTime = time.Time{} // activate state sync
+
+	// Add two pending validators so that two blocks can advance the chain time.
+	firstStartTime := defaultGenesisTime.Add(1 * time.Second)
+	secondStartTime := firstStartTime.Add(1 * time.Second)
+	for _, startTime := range []time.Time{firstStartTime, secondStartTime} {
+		_, err := addPendingValidator(
+			env,
+			startTime,
+			startTime.Add(defaultMinValidatorStakingDuration),
+			ids.GenerateTestNodeID(),
+			ids.GenerateTestShortID(),
+			[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		)
+		require.NoError(err)
+	}
+
+	lastAcceptedID := env.state.GetLastAccepted()
+	lastAccepted, err := env.state.GetStatelessBlock(lastAcceptedID)
+	require.NoError(err)
+
+	// Banff standard blocks are rejected once state sync is activated.
+	banffBlk, err := blocks.NewBanffStandardBlock(
+		firstStartTime,
+		lastAcceptedID,
+		lastAccepted.Height()+1,
+		nil,
+	)
+	require.NoError(err)
+	block := env.blkManager.NewBlock(banffBlk)
+	err = block.Verify(context.Background())
+	require.ErrorIs(err, errBanffStandardBlockIssuedAfterFork)
+
+	// The parent state root must match the merkle state of the parent.
+	wrongRootBlk, err := blocks.NewStateSyncStandardBlock(
+		firstStartTime,
+		lastAcceptedID,
+		lastAccepted.Height()+1,
+		ids.GenerateTestID(),
+		nil,
+		0,
+		0,
+	)
+	require.NoError(err)
+	block = env.blkManager.NewBlock(wrongRootBlk)
+	err = block.Verify(context.Background())
+	require.ErrorIs(err, errIncorrectParentStateRoot)
+
+	lastAcceptedRoot, err := env.state.GetMerkleDB().GetMerkleRoot(context.Background())
+	require.NoError(err)
+	parentStateRoot, err := env.blkManager.GetStateRoot(lastAcceptedID)
+	require.NoError(err)
+	require.Equal(lastAcceptedRoot, parentStateRoot)
+
+	firstBlk, err := blocks.NewStateSyncStandardBlock(
+		firstStartTime,
+		lastAcceptedID,
+		lastAccepted.Height()+1,
+		parentStateRoot,
+		nil,
+		0,
+		0,
+	)
+	require.NoError(err)
+	firstBlock := env.blkManager.NewBlock(firstBlk)
+	require.NoError(firstBlock.Verify(context.Background()))
+
+	// The state root of a processing block is computed from its changes.
+	firstStateRoot, err := env.blkManager.GetStateRoot(firstBlk.ID())
+	require.NoError(err)
+	require.NotEqual(parentStateRoot, firstStateRoot)
+
+	secondBlk, err := blocks.NewStateSyncStandardBlock(
+		secondStartTime,
+		firstBlk.ID(),
+		firstBlk.Height()+1,
+		firstStateRoot,
+		nil,
+		0,
+		0,
+	)
+	require.NoError(err)
+	secondBlock := env.blkManager.NewBlock(secondBlk)
+	require.NoError(secondBlock.Verify(context.Background()))
+
+	require.NoError(firstBlock.Accept(context.Background()))
+	root, err := env.state.GetMerkleDB().GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(firstStateRoot, root)
+
+	secondStateRoot, err := env.blkManager.GetStateRoot(secondBlk.ID())
+	require.NoError(err)
+	require.NoError(secondBlock.Accept(context.Background()))
+	root, err = env.state.GetMerkleDB().GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(secondStateRoot, root)
+}
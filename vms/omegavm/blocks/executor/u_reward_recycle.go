@@ -0,0 +1,18 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
+)
+
+// recyclesUndistributedReward returns true if the undistributed reward pool
+// is recycled by the acceptance of a block with [timestamp] whose parent has
+// [parentTimestamp].
+func recyclesUndistributedReward(cfg *config.Config, parentTimestamp, timestamp time.Time) bool {
+	return cfg.IsURewardRecycleActivated(timestamp) &&
+		cfg.URewardRecycleConfig.IsRecycleTime(parentTimestamp, timestamp)
+}
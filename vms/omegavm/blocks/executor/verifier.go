@@ -9,6 +9,8 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/chains/atomic"
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/math"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
@@ -23,6 +25,9 @@ var (
 	errApricotBlockIssuedAfterFork                = errors.New("apricot block issued after fork")
 	errBanffProposalBlockWithMultipleTransactions = errors.New("BanffProposalBlock contains multiple transactions")
 	errBanffStandardBlockWithoutChanges           = errors.New("BanffStandardBlock performs no state changes")
+	errBanffStandardBlockIssuedAfterFork          = errors.New("BanffStandardBlock issued after fork")
+	errStateSyncBlockIssuedBeforeFork             = errors.New("StateSyncStandardBlock issued before fork")
+	errIncorrectParentStateRoot                   = errors.New("incorrect parent state root")
 	errIncorrectBlockHeight                       = errors.New("incorrect block height")
 	errChildBlockEarlierThanParent                = errors.New("proposed timestamp before current chain time")
 	errConflictingBatchTxs                        = errors.New("block contains conflicting transactions")
@@ -69,10 +74,8 @@ func (v *verifier) BanffProposalBlock(b *blocks.BanffProposalBlock) error {
 		return err
 	}
 
-	accumulatedFee := b.AccumulatedFee(v.ctx.DIONEAssetID)
-	if accumulatedFee > 0 {
-		onCommitState.AddCurrentAccumulatedFee(accumulatedFee)
-		onAbortState.AddCurrentAccumulatedFee(accumulatedFee)
+	if err := v.accumulateFee(b, onCommitState, onAbortState); err != nil {
+		return err
 	}
 
 	// Apply the changes, if any, from advancing the chain time.
@@ -92,10 +95,43 @@ func (v *verifier) BanffProposalBlock(b *blocks.BanffProposalBlock) error {
 	onAbortState.SetTimestamp(nextChainTime)
 	changes.Apply(onAbortState)
 
-	return v.proposalBlock(&b.ApricotProposalBlock, onCommitState, onAbortState)
+	if err := v.proposalBlock(&b.ApricotProposalBlock, onCommitState, onAbortState); err != nil {
+		return err
+	}
+	v.updateFeeRate(b, onCommitState, onAbortState)
+	return nil
+}
+
+func (v *verifier) StateSyncStandardBlock(b *blocks.StateSyncStandardBlock) error {
+	timestamp := b.Timestamp()
+	if !v.txExecutorBackend.Config.IsStateSyncActivated(timestamp) {
+		return fmt.Errorf("%w: timestamp = %s", errStateSyncBlockIssuedBeforeFork, timestamp)
+	}
+
+	parentStateRoot, err := v.GetStateRoot(b.Parent())
+	if err != nil {
+		return err
+	}
+	if parentStateRoot != b.ParentStateRoot {
+		return fmt.Errorf(
+			"%w expected %s, but found %s",
+			errIncorrectParentStateRoot,
+			parentStateRoot,
+			b.ParentStateRoot,
+		)
+	}
+	return v.banffStandardBlock(&b.BanffStandardBlock)
 }
 
 func (v *verifier) BanffStandardBlock(b *blocks.BanffStandardBlock) error {
+	timestamp := b.Timestamp()
+	if v.txExecutorBackend.Config.IsStateSyncActivated(timestamp) {
+		return fmt.Errorf("%w: timestamp = %s", errBanffStandardBlockIssuedAfterFork, timestamp)
+	}
+	return v.banffStandardBlock(b)
+}
+
+func (v *verifier) banffStandardBlock(b *blocks.BanffStandardBlock) error {
 	if err := v.banffNonOptionBlock(b); err != nil {
 		return err
 	}
@@ -106,9 +142,8 @@ func (v *verifier) BanffStandardBlock(b *blocks.BanffStandardBlock) error {
 		return err
 	}
 
-	accumulatedFee := b.AccumulatedFee(v.ctx.DIONEAssetID)
-	if accumulatedFee > 0 {
-		onAcceptState.AddCurrentAccumulatedFee(accumulatedFee)
+	if err := v.accumulateFee(b, onAcceptState); err != nil {
+		return err
 	}
 
 	// Apply the changes, if any, from advancing the chain time.
@@ -131,7 +166,53 @@ func (v *verifier) BanffStandardBlock(b *blocks.BanffStandardBlock) error {
 	onAcceptState.SetTimestamp(nextChainTime)
 	changes.Apply(onAcceptState)
 
-	return v.standardBlock(&b.ApricotStandardBlock, onAcceptState)
+	if err := v.standardBlock(&b.ApricotStandardBlock, onAcceptState); err != nil {
+		return err
+	}
+	v.updateFeeRate(b, onAcceptState)
+	return nil
+}
+
+// accumulateFee adds the fees collected by [b] that are distributed to the
+// stakers to the accumulated fee of [onAcceptStates] and removes the fees that
+// are burned from the primary network supply.
+func (v *verifier) accumulateFee(b blocks.BanffBlock, onAcceptStates ...state.Diff) error {
+	distributed, burned := SplitAccumulatedFee(v.txExecutorBackend.Config, b, v.ctx.DIONEAssetID)
+	for _, onAcceptState := range onAcceptStates {
+		if distributed > 0 {
+			onAcceptState.AddCurrentAccumulatedFee(distributed)
+		}
+		if burned == 0 {
+			continue
+		}
+
+		currentSupply, err := onAcceptState.GetCurrentSupply(constants.PrimaryNetworkID)
+		if err != nil {
+			return err
+		}
+		newSupply, err := math.Sub(currentSupply, burned)
+		if err != nil {
+			return fmt.Errorf("failed to burn %d fees: %w", burned, err)
+		}
+		onAcceptState.SetCurrentSupply(constants.PrimaryNetworkID, newSupply)
+		onAcceptState.AddBurnedFees(burned)
+	}
+	return nil
+}
+
+// updateFeeRate sets the fee rate of [onAcceptStates] following [b] once its
+// txs were charged the fee rate of its parent.
+func (v *verifier) updateFeeRate(b blocks.BanffBlock, onAcceptStates ...state.Diff) {
+	cfg := v.txExecutorBackend.Config
+	if !cfg.DynamicFeeConfig.IsEnabled() || !cfg.IsDynamicFeeActivated(b.Timestamp()) {
+		return
+	}
+
+	blockSize := len(b.Bytes())
+	for _, onAcceptState := range onAcceptStates {
+		feeRate := onAcceptState.GetFeeRate()
+		onAcceptState.SetFeeRate(cfg.DynamicFeeConfig.NextFeeRate(feeRate, blockSize))
+	}
 }
 
 func (v *verifier) ApricotAbortBlock(b *blocks.ApricotAbortBlock) error {
@@ -219,14 +300,16 @@ func (v *verifier) ApricotAtomicBlock(b *blocks.ApricotAtomicBlock) error {
 	}
 
 	blkID := b.ID()
+	timestamp := atomicExecutor.OnAccept.GetTimestamp()
 	v.blkIDToState[blkID] = &blockState{
 		standardBlockState: standardBlockState{
 			inputs: atomicExecutor.Inputs,
 		},
-		statelessBlock: b,
-		onAcceptState:  atomicExecutor.OnAccept,
-		timestamp:      atomicExecutor.OnAccept.GetTimestamp(),
-		atomicRequests: atomicExecutor.AtomicRequests,
+		statelessBlock:             b,
+		onAcceptState:              atomicExecutor.OnAccept,
+		timestamp:                  timestamp,
+		atomicRequests:             atomicExecutor.AtomicRequests,
+		recycleUndistributedReward: recyclesUndistributedReward(cfg, currentTimestamp, timestamp),
 	}
 
 	v.Mempool.Remove([]*txs.Tx{b.Tx})
@@ -338,9 +421,10 @@ func (v *verifier) abortBlock(b blocks.Block) error {
 
 	blkID := b.ID()
 	v.blkIDToState[blkID] = &blockState{
-		statelessBlock: b,
-		onAcceptState:  onAcceptState,
-		timestamp:      onAcceptState.GetTimestamp(),
+		statelessBlock:             b,
+		onAcceptState:              onAcceptState,
+		timestamp:                  onAcceptState.GetTimestamp(),
+		recycleUndistributedReward: v.blkIDToState[parentID].recycleUndistributedReward,
 	}
 	return nil
 }
@@ -355,9 +439,10 @@ func (v *verifier) commitBlock(b blocks.Block) error {
 
 	blkID := b.ID()
 	v.blkIDToState[blkID] = &blockState{
-		statelessBlock: b,
-		onAcceptState:  onAcceptState,
-		timestamp:      onAcceptState.GetTimestamp(),
+		statelessBlock:             b,
+		onAcceptState:              onAcceptState,
+		timestamp:                  onAcceptState.GetTimestamp(),
+		recycleUndistributedReward: v.blkIDToState[parentID].recycleUndistributedReward,
 	}
 	return nil
 }
@@ -384,6 +469,12 @@ func (v *verifier) proposalBlock(
 	onCommitState.AddTx(b.Tx, status.Committed)
 	onAbortState.AddTx(b.Tx, status.Aborted)
 
+	// It is safe to use [b.onAbortState] here because the timestamp will never
+	// be modified by an Apricot Abort block and the timestamp will always be
+	// the same as the Banff Proposal Block.
+	timestamp := onAbortState.GetTimestamp()
+	parentTimestamp := v.getTimestamp(b.Parent())
+
 	blkID := b.ID()
 	v.blkIDToState[blkID] = &blockState{
 		proposalBlockState: proposalBlockState{
@@ -391,12 +482,16 @@ func (v *verifier) proposalBlock(
 			onAbortState:          onAbortState,
 			initiallyPreferCommit: txExecutor.PrefersCommit,
 			undistributedReward:   txExecutor.UndistributedReward,
+			mintReward:            txExecutor.MintReward,
+			feeReward:             txExecutor.FeeReward,
+			orionFee:              txExecutor.OrionFee,
+			delegationShares:      txExecutor.DelegationShares,
+			delegationFee:         txExecutor.DelegationFee,
+			delegateeReward:       txExecutor.DelegateeReward,
 		},
-		statelessBlock: b,
-		// It is safe to use [b.onAbortState] here because the timestamp will
-		// never be modified by an Apricot Abort block and the timestamp will
-		// always be the same as the Banff Proposal Block.
-		timestamp: onAbortState.GetTimestamp(),
+		statelessBlock:             b,
+		timestamp:                  timestamp,
+		recycleUndistributedReward: recyclesUndistributedReward(v.txExecutorBackend.Config, parentTimestamp, timestamp),
 	}
 
 	v.Mempool.Remove([]*txs.Tx{b.Tx})
@@ -408,11 +503,14 @@ func (v *verifier) standardBlock(
 	b *blocks.ApricotStandardBlock,
 	onAcceptState state.Diff,
 ) error {
+	timestamp := onAcceptState.GetTimestamp()
+	parentTimestamp := v.getTimestamp(b.Parent())
 	blkState := &blockState{
-		statelessBlock: b,
-		onAcceptState:  onAcceptState,
-		timestamp:      onAcceptState.GetTimestamp(),
-		atomicRequests: make(map[ids.ID]*atomic.Requests),
+		statelessBlock:             b,
+		onAcceptState:              onAcceptState,
+		timestamp:                  timestamp,
+		atomicRequests:             make(map[ids.ID]*atomic.Requests),
+		recycleUndistributedReward: recyclesUndistributedReward(v.txExecutorBackend.Config, parentTimestamp, timestamp),
 	}
 
 	// Finally we process the transactions
@@ -422,6 +520,7 @@ func (v *verifier) standardBlock(
 			Backend: v.txExecutorBackend,
 			State:   onAcceptState,
 			Tx:      tx,
+			Height:  b.Height(),
 		}
 		if err := tx.Unsigned.Visit(&txExecutor); err != nil {
 			txID := tx.ID()
@@ -440,6 +539,11 @@ func (v *verifier) standardBlock(
 			funcs = append(funcs, txExecutor.OnAccept)
 		}
 
+		// Invariant: The forfeited amounts are bounded by the supply, so
+		//            their sum can't overflow.
+		blkState.earlyUnstakePenalty += txExecutor.UndistributedReward
+		blkState.forfeitedOrionFees = append(blkState.forfeitedOrionFees, txExecutor.ForfeitedOrionFees...)
+
 		for chainID, txRequests := range txExecutor.AtomicRequests {
 			// Add/merge in the atomic requests represented by [tx]
 			chainRequests, exists := blkState.atomicRequests[chainID]
@@ -19,6 +19,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
@@ -41,6 +42,7 @@ func TestVerifierVisitProposalBlock(t *testing.T) {
 	timestamp := time.Now()
 	// One call for each of onCommitState and onAbortState.
 	parentOnAcceptState.EXPECT().GetTimestamp().Return(timestamp).Times(2)
+	parentOnAcceptState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator)).Times(2)
 
 	backend := &backend{
 		lastAccepted: parentID,
@@ -59,7 +61,8 @@ func TestVerifierVisitProposalBlock(t *testing.T) {
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
-				BanffTime: mockable.MaxTime, // banff is not activated
+				BanffTime:     mockable.MaxTime, // banff is not activated
+				StateSyncTime: mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -145,6 +148,7 @@ func TestVerifierVisitAtomicBlock(t *testing.T) {
 			Config: &config.Config{
 				ApricotPhase5Time: time.Now().Add(time.Hour),
 				BanffTime:         mockable.MaxTime, // banff is not activated
+				StateSyncTime:     mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -233,6 +237,7 @@ func TestVerifierVisitStandardBlock(t *testing.T) {
 			Config: &config.Config{
 				ApricotPhase5Time: time.Now().Add(time.Hour),
 				BanffTime:         mockable.MaxTime, // banff is not activated
+				StateSyncTime:     mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -286,6 +291,7 @@ func TestVerifierVisitStandardBlock(t *testing.T) {
 	// Set expectations for dependencies.
 	timestamp := time.Now()
 	parentState.EXPECT().GetTimestamp().Return(timestamp).Times(1)
+	parentState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator)).Times(1)
 	parentStatelessBlk.EXPECT().Height().Return(uint64(1)).Times(1)
 	mempool.EXPECT().Remove(apricotBlk.Txs()).Times(1)
 
@@ -335,7 +341,8 @@ func TestVerifierVisitCommitBlock(t *testing.T) {
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
-				BanffTime: mockable.MaxTime, // banff is not activated
+				BanffTime:     mockable.MaxTime, // banff is not activated
+				StateSyncTime: mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -405,7 +412,8 @@ func TestVerifierVisitAbortBlock(t *testing.T) {
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
-				BanffTime: mockable.MaxTime, // banff is not activated
+				BanffTime:     mockable.MaxTime, // banff is not activated
+				StateSyncTime: mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -464,7 +472,8 @@ func TestVerifyUnverifiedParent(t *testing.T) {
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
-				BanffTime: mockable.MaxTime, // banff is not activated
+				BanffTime:     mockable.MaxTime, // banff is not activated
+				StateSyncTime: mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -536,7 +545,8 @@ func TestBanffAbortBlockTimestampChecks(t *testing.T) {
 			verifier := &verifier{
 				txExecutorBackend: &executor.Backend{
 					Config: &config.Config{
-						BanffTime: time.Time{}, // banff is activated
+						BanffTime:     time.Time{}, // banff is activated
+						StateSyncTime: mockable.MaxTime,
 					},
 					Clk: &mockable.Clock{},
 				},
@@ -552,6 +562,7 @@ func TestBanffAbortBlockTimestampChecks(t *testing.T) {
 			parentTime := defaultGenesisTime
 			s.EXPECT().GetLastAccepted().Return(parentID).Times(2)
 			s.EXPECT().GetTimestamp().Return(parentTime).Times(2)
+			s.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator)).Times(2)
 
 			onCommitState, err := state.NewDiff(parentID, backend)
 			require.NoError(err)
@@ -629,7 +640,8 @@ func TestBanffCommitBlockTimestampChecks(t *testing.T) {
 			verifier := &verifier{
 				txExecutorBackend: &executor.Backend{
 					Config: &config.Config{
-						BanffTime: time.Time{}, // banff is activated
+						BanffTime:     time.Time{}, // banff is activated
+						StateSyncTime: mockable.MaxTime,
 					},
 					Clk: &mockable.Clock{},
 				},
@@ -645,6 +657,7 @@ func TestBanffCommitBlockTimestampChecks(t *testing.T) {
 			parentTime := defaultGenesisTime
 			s.EXPECT().GetLastAccepted().Return(parentID).Times(2)
 			s.EXPECT().GetTimestamp().Return(parentTime).Times(2)
+			s.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator)).Times(2)
 
 			onCommitState, err := state.NewDiff(parentID, backend)
 			require.NoError(err)
@@ -710,6 +723,7 @@ func TestVerifierVisitStandardBlockWithDuplicateInputs(t *testing.T) {
 			Config: &config.Config{
 				ApricotPhase5Time: time.Now().Add(time.Hour),
 				BanffTime:         mockable.MaxTime, // banff is not activated
+				StateSyncTime:     mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -760,6 +774,7 @@ func TestVerifierVisitStandardBlockWithDuplicateInputs(t *testing.T) {
 	timestamp := time.Now()
 	parentStatelessBlk.EXPECT().Height().Return(uint64(1)).Times(1)
 	parentState.EXPECT().GetTimestamp().Return(timestamp).Times(1)
+	parentState.EXPECT().GetFeeRate().Return(uint64(fees.RateDenominator)).Times(1)
 	parentStatelessBlk.EXPECT().Parent().Return(grandParentID).Times(1)
 
 	err = verifier.ApricotStandardBlock(blk)
@@ -798,7 +813,8 @@ func TestVerifierVisitApricotStandardBlockWithProposalBlockParent(t *testing.T)
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
-				BanffTime: mockable.MaxTime, // banff is not activated
+				BanffTime:     mockable.MaxTime, // banff is not activated
+				StateSyncTime: mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -856,7 +872,8 @@ func TestVerifierVisitBanffStandardBlockWithProposalBlockParent(t *testing.T) {
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
-				BanffTime: time.Time{}, // banff is activated
+				BanffTime:     time.Time{}, // banff is activated
+				StateSyncTime: mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -893,7 +910,8 @@ func TestVerifierVisitApricotCommitBlockUnexpectedParentState(t *testing.T) {
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
-				BanffTime: mockable.MaxTime, // banff is not activated
+				BanffTime:     mockable.MaxTime, // banff is not activated
+				StateSyncTime: mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -936,7 +954,8 @@ func TestVerifierVisitBanffCommitBlockUnexpectedParentState(t *testing.T) {
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
-				BanffTime: time.Time{}, // banff is activated
+				BanffTime:     time.Time{}, // banff is activated
+				StateSyncTime: mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -980,7 +999,8 @@ func TestVerifierVisitApricotAbortBlockUnexpectedParentState(t *testing.T) {
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
-				BanffTime: mockable.MaxTime, // banff is not activated
+				BanffTime:     mockable.MaxTime, // banff is not activated
+				StateSyncTime: mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -1023,7 +1043,8 @@ func TestVerifierVisitBanffAbortBlockUnexpectedParentState(t *testing.T) {
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
-				BanffTime: time.Time{}, // banff is activated
+				BanffTime:     time.Time{}, // banff is activated
+				StateSyncTime: mockable.MaxTime,
 			},
 			Clk: &mockable.Clock{},
 		},
@@ -13,10 +13,49 @@ import (
 )
 
 var (
+	_ BanffBlock = (*StateSyncStandardBlock)(nil)
 	_ BanffBlock = (*BanffStandardBlock)(nil)
 	_ Block      = (*ApricotStandardBlock)(nil)
 )
 
+// StateSyncStandardBlock is a standard block that commits to the merkle state
+// resulting from the acceptance of its parent.
+type StateSyncStandardBlock struct {
+	BanffStandardBlock `serialize:"true"`
+	ParentStateRoot    ids.ID `serialize:"true" json:"parentStateRoot"`
+}
+
+func (b *StateSyncStandardBlock) Visit(v Visitor) error {
+	return v.StateSyncStandardBlock(b)
+}
+
+func NewStateSyncStandardBlock(
+	timestamp time.Time,
+	parentID ids.ID,
+	height uint64,
+	parentStateRoot ids.ID,
+	txs []*txs.Tx,
+	feeFromAChain uint64,
+	feeFromDChain uint64,
+) (*StateSyncStandardBlock, error) {
+	blk := &StateSyncStandardBlock{
+		BanffStandardBlock: BanffStandardBlock{
+			Time: uint64(timestamp.Unix()),
+			ApricotStandardBlock: ApricotStandardBlock{
+				CommonBlock: CommonBlock{
+					PrntID: parentID,
+					Hght:   height,
+				},
+				FeeAChain:    feeFromAChain,
+				FeeDChain:    feeFromDChain,
+				Transactions: txs,
+			},
+		},
+		ParentStateRoot: parentStateRoot,
+	}
+	return blk, initialize(blk)
+}
+
 type BanffStandardBlock struct {
 	Time                 uint64 `serialize:"true" json:"time"`
 	ApricotStandardBlock `serialize:"true"`
@@ -4,6 +4,8 @@
 package blocks
 
 type Visitor interface {
+	StateSyncStandardBlock(*StateSyncStandardBlock) error
+
 	BanffAbortBlock(*BanffAbortBlock) error
 	BanffCommitBlock(*BanffCommitBlock) error
 	BanffProposalBlock(*BanffProposalBlock) error
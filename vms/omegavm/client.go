@@ -80,6 +80,44 @@ type Client interface {
 	GetPendingValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]interface{}, []interface{}, error)
 	// GetCurrentSupply returns an upper bound on the supply of DIONE in the system along with the O-chain height
 	GetCurrentSupply(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, uint64, error)
+	// GetSupplyBreakdown returns how the DIONE supply of the primary network is split
+	// as of the last accepted block
+	GetSupplyBreakdown(ctx context.Context, options ...rpc.Option) (*GetSupplyBreakdownReply, error)
+	// GetSupplyBreakdownAt returns how the DIONE supply of the primary network is
+	// split as of the accepted block at [height]
+	GetSupplyBreakdownAt(ctx context.Context, height uint64, options ...rpc.Option) (*GetSupplyBreakdownReply, error)
+	// GetFeePools returns the balances of the fee collector along with the
+	// values that were last synced into an accepted block
+	GetFeePools(ctx context.Context, options ...rpc.Option) (*GetFeePoolsReply, error)
+	// GetOrionFee returns the orion fee accrued by [nodeID] along with the
+	// value that was last paid out to it in an accepted block
+	GetOrionFee(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) (*GetOrionFeeReply, error)
+	// GetRewardHistory returns the fees collected and the rewards decided by
+	// the accepted blocks with a height in [startHeight, endHeight]. Requires
+	// reward indexing to be enabled.
+	GetRewardHistory(ctx context.Context, startHeight, endHeight uint64, options ...rpc.Option) (*GetRewardHistoryReply, error)
+	// GetStakerRewardBreakdown returns the mint, fee and orion fee components
+	// of the reward decided for the staker added by [txID]. Requires reward
+	// indexing to be enabled.
+	GetStakerRewardBreakdown(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetStakerRewardBreakdownReply, error)
+	// GetUndistributedReward returns the balance of the undistributed reward
+	// pool, the recycle policy of the node and, if reward indexing is
+	// enabled, the recycling events of the accepted blocks with a height in
+	// [startHeight, endHeight].
+	GetUndistributedReward(ctx context.Context, startHeight, endHeight uint64, options ...rpc.Option) (*GetUndistributedRewardReply, error)
+	// EstimateReward returns the projected reward of staking [amount] on the
+	// primary network from [startTime] to [endTime]. If [nodeID] is not empty,
+	// the estimate is for a delegation to [nodeID] with a delegation fee rate
+	// of [delegationFeeRate] percent.
+	EstimateReward(
+		ctx context.Context,
+		amount uint64,
+		startTime time.Time,
+		endTime time.Time,
+		nodeID ids.NodeID,
+		delegationFeeRate float32,
+		options ...rpc.Option,
+	) (*EstimateRewardReply, error)
 	// SampleValidators returns the nodeIDs of a sample of [sampleSize] validators from the current validator set for subnet with ID [subnetID]
 	SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error)
 	// AddValidator issues a transaction to add a validator to the primary network
@@ -161,6 +199,27 @@ type Client interface {
 		amount uint64,
 		options ...rpc.Option,
 	) (ids.ID, error)
+	// Send issues a BaseTx transferring [amount] of DIONE to [to] and returns
+	// the txID
+	Send(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		to ids.ShortID,
+		amount uint64,
+		options ...rpc.Option,
+	) (ids.ID, error)
+	// CancelPendingStaker issues a CancelPendingStakerTx removing the pending
+	// staker added by [stakerTxID] and returns the txID
+	CancelPendingStaker(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		stakerTxID ids.ID,
+		options ...rpc.Option,
+	) (ids.ID, error)
 	// ImportDIONE issues an ImportTx transaction and returns the txID
 	//
 	// Deprecated: Transactions should be issued using the
@@ -251,6 +310,14 @@ type Client interface {
 	GetRewardUTXOs(context.Context, *api.GetTxArgs, ...rpc.Option) ([][]byte, error)
 	// GetTimestamp returns the current chain timestamp
 	GetTimestamp(ctx context.Context, options ...rpc.Option) (time.Time, error)
+	// GetFeeRates returns the fees charged to the txs of the next block
+	GetFeeRates(ctx context.Context, options ...rpc.Option) (*GetFeeRatesReply, error)
+	// GetValidatorUptimeScore returns the attested uptime of the current
+	// primary network validator [nodeID]
+	GetValidatorUptimeScore(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) (*GetValidatorUptimeScoreReply, error)
+	// SignUptimeAttestation returns the signature by the node of the
+	// attestation of [uptimes]
+	SignUptimeAttestation(ctx context.Context, uptimes []APIValidatorUptime, options ...rpc.Option) ([]byte, error)
 	// GetValidatorsAt returns the weights of the validator set of a provided
 	// subnet at the specified height.
 	GetValidatorsAt(
@@ -390,6 +457,8 @@ type ClientSubnet struct {
 	// signatures from [Threshold] of these keys to be valid.
 	ControlKeys []ids.ShortID
 	Threshold   uint32
+	// Weights of the control keys, if the subnet owner is weighted
+	Weights []uint32
 }
 
 func (c *client) GetSubnets(ctx context.Context, ids []ids.ID, options ...rpc.Option) ([]ClientSubnet, error) {
@@ -411,11 +480,23 @@ func (c *client) GetSubnets(ctx context.Context, ids []ids.ID, options ...rpc.Op
 			ID:          apiSubnet.ID,
 			ControlKeys: controlKeys,
 			Threshold:   uint32(apiSubnet.Threshold),
+			Weights:     apiWeightsToClientWeights(apiSubnet.Weights),
 		}
 	}
 	return subnets, nil
 }
 
+func apiWeightsToClientWeights(apiWeights []json.Uint32) []uint32 {
+	if len(apiWeights) == 0 {
+		return nil
+	}
+	weights := make([]uint32, len(apiWeights))
+	for i, weight := range apiWeights {
+		weights[i] = uint32(weight)
+	}
+	return weights
+}
+
 func (c *client) GetStakingAssetID(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (ids.ID, error) {
 	res := &GetStakingAssetIDResponse{}
 	err := c.requester.SendRequest(ctx, "omega.getStakingAssetID", &GetStakingAssetIDArgs{
@@ -463,6 +544,81 @@ func (c *client) GetCurrentSupply(ctx context.Context, subnetID ids.ID, options
 	return uint64(res.Supply), uint64(res.Height), err
 }
 
+func (c *client) GetSupplyBreakdown(ctx context.Context, options ...rpc.Option) (*GetSupplyBreakdownReply, error) {
+	res := &GetSupplyBreakdownReply{}
+	err := c.requester.SendRequest(ctx, "omega.getSupplyBreakdown", &GetSupplyBreakdownArgs{}, res, options...)
+	return res, err
+}
+
+func (c *client) GetSupplyBreakdownAt(ctx context.Context, height uint64, options ...rpc.Option) (*GetSupplyBreakdownReply, error) {
+	res := &GetSupplyBreakdownReply{}
+	jsonHeight := json.Uint64(height)
+	err := c.requester.SendRequest(ctx, "omega.getSupplyBreakdown", &GetSupplyBreakdownArgs{
+		Height: &jsonHeight,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetFeePools(ctx context.Context, options ...rpc.Option) (*GetFeePoolsReply, error) {
+	res := &GetFeePoolsReply{}
+	err := c.requester.SendRequest(ctx, "omega.getFeePools", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) GetOrionFee(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) (*GetOrionFeeReply, error) {
+	res := &GetOrionFeeReply{}
+	err := c.requester.SendRequest(ctx, "omega.getOrionFee", &GetOrionFeeArgs{
+		NodeID: nodeID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetRewardHistory(ctx context.Context, startHeight, endHeight uint64, options ...rpc.Option) (*GetRewardHistoryReply, error) {
+	res := &GetRewardHistoryReply{}
+	err := c.requester.SendRequest(ctx, "omega.getRewardHistory", &GetRewardHistoryArgs{
+		StartHeight: json.Uint64(startHeight),
+		EndHeight:   json.Uint64(endHeight),
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetStakerRewardBreakdown(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetStakerRewardBreakdownReply, error) {
+	res := &GetStakerRewardBreakdownReply{}
+	err := c.requester.SendRequest(ctx, "omega.getStakerRewardBreakdown", &api.GetTxArgs{
+		TxID: txID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetUndistributedReward(ctx context.Context, startHeight, endHeight uint64, options ...rpc.Option) (*GetUndistributedRewardReply, error) {
+	res := &GetUndistributedRewardReply{}
+	err := c.requester.SendRequest(ctx, "omega.getUndistributedReward", &GetUndistributedRewardArgs{
+		StartHeight: json.Uint64(startHeight),
+		EndHeight:   json.Uint64(endHeight),
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) EstimateReward(
+	ctx context.Context,
+	amount uint64,
+	startTime time.Time,
+	endTime time.Time,
+	nodeID ids.NodeID,
+	delegationFeeRate float32,
+	options ...rpc.Option,
+) (*EstimateRewardReply, error) {
+	res := &EstimateRewardReply{}
+	err := c.requester.SendRequest(ctx, "omega.estimateReward", &EstimateRewardArgs{
+		Amount:            json.Uint64(amount),
+		StartTime:         json.Uint64(startTime.Unix()),
+		EndTime:           json.Uint64(endTime.Unix()),
+		NodeID:            nodeID,
+		DelegationFeeRate: json.Float32(delegationFeeRate),
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error) {
 	res := &SampleValidatorsReply{}
 	err := c.requester.SendRequest(ctx, "omega.sampleValidators", &SampleValidatorsArgs{
@@ -618,6 +774,48 @@ func (c *client) ExportDIONE(
 	return res.TxID, err
 }
 
+func (c *client) Send(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	to ids.ShortID,
+	amount uint64,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	res := &api.JSONTxID{}
+	err := c.requester.SendRequest(ctx, "omega.send", &SendArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		To:     to.String(),
+		Amount: json.Uint64(amount),
+	}, res, options...)
+	return res.TxID, err
+}
+
+func (c *client) CancelPendingStaker(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	stakerTxID ids.ID,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	res := &api.JSONTxID{}
+	err := c.requester.SendRequest(ctx, "omega.cancelPendingStaker", &CancelPendingStakerArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		TxID: stakerTxID,
+	}, res, options...)
+	return res.TxID, err
+}
+
 func (c *client) ImportDIONE(
 	ctx context.Context,
 	user api.UserPass,
@@ -855,6 +1053,32 @@ func (c *client) GetTimestamp(ctx context.Context, options ...rpc.Option) (time.
 	return res.Timestamp, err
 }
 
+func (c *client) GetFeeRates(ctx context.Context, options ...rpc.Option) (*GetFeeRatesReply, error) {
+	res := &GetFeeRatesReply{}
+	err := c.requester.SendRequest(ctx, "omega.getFeeRates", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) GetValidatorUptimeScore(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) (*GetValidatorUptimeScoreReply, error) {
+	res := &GetValidatorUptimeScoreReply{}
+	err := c.requester.SendRequest(ctx, "omega.getValidatorUptimeScore", &GetValidatorUptimeScoreArgs{
+		NodeID: nodeID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) SignUptimeAttestation(ctx context.Context, uptimes []APIValidatorUptime, options ...rpc.Option) ([]byte, error) {
+	res := &SignUptimeAttestationReply{}
+	err := c.requester.SendRequest(ctx, "omega.signUptimeAttestation", &SignUptimeAttestationArgs{
+		Uptimes:  uptimes,
+		Encoding: formatting.HexNC,
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
+	return formatting.Decode(res.Encoding, res.Signature)
+}
+
 func (c *client) GetValidatorsAt(
 	ctx context.Context,
 	subnetID ids.ID,
@@ -33,6 +33,8 @@ type ClientOwner struct {
 	Locktime  uint64
 	Threshold uint32
 	Addresses []ids.ShortID
+	// Weights of the addresses, if the owner is weighted
+	Weights []uint32
 }
 
 // ClientPermissionlessValidator is the repr. of a permissionless validator sent
@@ -81,6 +83,7 @@ func apiOwnerToClientOwner(rewardOwner *api.Owner) (*ClientOwner, error) {
 		Locktime:  uint64(rewardOwner.Locktime),
 		Threshold: uint32(rewardOwner.Threshold),
 		Addresses: addrs,
+		Weights:   apiWeightsToClientWeights(rewardOwner.Weights),
 	}, err
 }
 
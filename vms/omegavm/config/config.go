@@ -12,6 +12,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow/validators"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 )
@@ -68,6 +69,14 @@ type Config struct {
 	// Transaction fee for adding a subnet delegator
 	AddSubnetDelegatorFee uint64
 
+	// Share of the fees collected from each chain that is burned once the fee
+	// burn network upgrade is activated
+	FeeBurnConfig reward.FeeBurnConfig
+
+	// Adjustment of the fees from the block sizes once the dynamic fee network
+	// upgrade is activated
+	DynamicFeeConfig fees.DynamicFeeConfig
+
 	// The minimum amount of tokens one must bond to be a validator
 	MinValidatorStake uint64
 
@@ -83,6 +92,11 @@ type Config struct {
 	// UptimePercentage is the minimum uptime required to be rewarded for staking
 	UptimePercentage float64
 
+	// UptimeScoresEnabled specifies whether the uptime of a primary network
+	// validator is read from its attested uptime score, if any, rather than
+	// from the local view of this node
+	UptimeScoresEnabled bool
+
 	// Minimum amount of time to allow a validator to stake
 	MinValidatorStakeDuration time.Duration
 
@@ -95,11 +109,20 @@ type Config struct {
 	// Maximum amount of time to allow a delegator to stake
 	MaxDelegatorStakeDuration time.Duration
 
+	// Share, in the range [0, 1000000], of the stake and accrued reward
+	// forfeited by a primary network staker that stops staking before its end
+	// time
+	EarlyUnstakePenalty uint64
+
+	// Recycling of the undistributed reward pool once the undistributed reward
+	// recycle network upgrade is activated
+	URewardRecycleConfig reward.URewardRecycleConfig
+
 	// Config for the minting function
 	RewardConfig reward.Config
 
-	// Config for the minting function
-	MintConfig reward.MintConfig
+	// Configs of the minting function over time
+	MintSchedule reward.MintSchedule
 
 	// Time of the AP3 network upgrade
 	ApricotPhase3Time time.Time
@@ -113,6 +136,53 @@ type Config struct {
 	// Time of the Cortina network upgrade
 	CortinaTime time.Time
 
+	// Time of the fee burn network upgrade
+	FeeBurnTime time.Time
+
+	// Time of the dynamic fee network upgrade
+	DynamicFeeTime time.Time
+
+	// Time of the network upgrade introducing the BaseTx
+	BaseTxTime time.Time
+
+	// Time of the network upgrade introducing the TransferSubnetOwnershipTx
+	TransferSubnetOwnershipTime time.Time
+
+	// Time of the network upgrade committing the state root in blocks
+	StateSyncTime time.Time
+
+	// Time of the network upgrade introducing the RotateBLSKeyTx
+	BLSKeyRotationTime time.Time
+
+	// Time of the network upgrade introducing the SetAutoRestakeTx
+	AutoRestakeTime time.Time
+
+	// Time of the network upgrade introducing the EarlyUnstakeTx
+	EarlyUnstakeTime time.Time
+
+	// Time of the network upgrade introducing the RedelegateTx
+	RedelegateTime time.Time
+
+	// Time of the network upgrade introducing the UptimeAttestationTx
+	UptimeAttestationTime time.Time
+
+	// Time of the network upgrade introducing the SetSubnetFeeConfigTx
+	SubnetFeesTime time.Time
+
+	// Time of the network upgrade introducing the CancelPendingStakerTx
+	CancelPendingStakerTime time.Time
+
+	// Time of the undistributed reward recycle network upgrade
+	URewardRecycleTime time.Time
+
+	// Time of the network upgrade paying out the orion fees of validators in
+	// the RewardValidatorTx
+	OrionFeeTime time.Time
+
+	// Time of the network upgrade allowing the secp256k1fx weighted owners to
+	// be used
+	WeightedOwnersTime time.Time
+
 	// UseCurrentHeight forces [GetMinimumHeight] to return the current height
 	// of the O-Chain instead of the oldest block in the [recentlyAccepted]
 	// window.
@@ -139,6 +209,75 @@ func (c *Config) IsCortinaActivated(timestamp time.Time) bool {
 	return !timestamp.Before(c.CortinaTime)
 }
 
+func (c *Config) IsFeeBurnActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.FeeBurnTime)
+}
+
+func (c *Config) IsDynamicFeeActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.DynamicFeeTime)
+}
+
+func (c *Config) IsBaseTxActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.BaseTxTime)
+}
+
+func (c *Config) IsTransferSubnetOwnershipActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.TransferSubnetOwnershipTime)
+}
+
+func (c *Config) IsStateSyncActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.StateSyncTime)
+}
+
+func (c *Config) IsBLSKeyRotationActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.BLSKeyRotationTime)
+}
+
+func (c *Config) IsAutoRestakeActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.AutoRestakeTime)
+}
+
+func (c *Config) IsEarlyUnstakeActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.EarlyUnstakeTime)
+}
+
+func (c *Config) IsRedelegateActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.RedelegateTime)
+}
+
+func (c *Config) IsUptimeAttestationActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.UptimeAttestationTime)
+}
+
+func (c *Config) IsSubnetFeesActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.SubnetFeesTime)
+}
+
+func (c *Config) IsCancelPendingStakerActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.CancelPendingStakerTime)
+}
+
+func (c *Config) IsURewardRecycleActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.URewardRecycleTime)
+}
+
+func (c *Config) IsOrionFeeActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.OrionFeeTime)
+}
+
+func (c *Config) IsWeightedOwnersActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.WeightedOwnersTime)
+}
+
+// GetFeeRate returns the rate the static fees are charged at on top of a state
+// with [feeRate] and [timestamp].
+func (c *Config) GetFeeRate(feeRate uint64, timestamp time.Time) uint64 {
+	if !c.DynamicFeeConfig.IsEnabled() || !c.IsDynamicFeeActivated(timestamp) {
+		return fees.RateDenominator
+	}
+	return feeRate
+}
+
 func (c *Config) GetCreateBlockchainTxFee(timestamp time.Time) uint64 {
 	if c.IsApricotPhase3Activated(timestamp) {
 		return c.CreateBlockchainTxFee
@@ -18,6 +18,8 @@ var DefaultExecutionConfig = ExecutionConfig{
 	ChainDBCacheSize:             2048,
 	BlockIDCacheSize:             8192,
 	ChecksumsEnabled:             false,
+	RewardIndexEnabled:           false,
+	StateSyncEnabled:             false,
 }
 
 // ExecutionConfig provides execution parameters of OmegaVM
@@ -30,6 +32,8 @@ type ExecutionConfig struct {
 	ChainDBCacheSize             int  `json:"chain-db-cache-size"`
 	BlockIDCacheSize             int  `json:"block-id-cache-size"`
 	ChecksumsEnabled             bool `json:"checksums-enabled"`
+	RewardIndexEnabled           bool `json:"reward-index-enabled"`
+	StateSyncEnabled             bool `json:"state-sync-enabled"`
 }
 
 // GetExecutionConfig returns an ExecutionConfig
@@ -46,7 +46,9 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			"chain-cache-size": 6,
 			"chain-db-cache-size": 7,
 			"block-id-cache-size": 8,
-			"checksums-enabled": true
+			"checksums-enabled": true,
+			"reward-index-enabled": true,
+			"state-sync-enabled": true
 		}`)
 		ec, err := GetExecutionConfig(b)
 		require.NoError(err)
@@ -59,6 +61,8 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			ChainDBCacheSize:             7,
 			BlockIDCacheSize:             8,
 			ChecksumsEnabled:             true,
+			RewardIndexEnabled:           true,
+			StateSyncEnabled:             true,
 		}
 		require.Equal(expected, ec)
 	})
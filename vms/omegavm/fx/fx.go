@@ -13,6 +13,8 @@ var (
 	_ Fx    = (*secp256k1fx.Fx)(nil)
 	_ Owner = (*secp256k1fx.OutputOwners)(nil)
 	_ Owned = (*secp256k1fx.TransferOutput)(nil)
+	_ Owner = (*secp256k1fx.WeightedOutputOwners)(nil)
+	_ Owned = (*secp256k1fx.WeightedTransferOutput)(nil)
 )
 
 // Fx is the interface a feature extension must implement to support the
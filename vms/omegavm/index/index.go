@@ -0,0 +1,262 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package index
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
+	"github.com/DioneProtocol/odysseygo/database/versiondb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+)
+
+var (
+	_ RewardIndexer = (*indexer)(nil)
+	_ RewardIndexer = (*noIndexer)(nil)
+
+	ErrIndexingDisabled = errors.New("reward indexing is disabled")
+
+	blockPrefix    = []byte("block")
+	stakerPrefix   = []byte("staker")
+	recyclePrefix  = []byte("recycle")
+	startHeightKey = []byte("startHeight")
+)
+
+// RewardIndexer records the fees collected and the rewards paid out by every
+// accepted block.
+//
+// Changes are staged by [Accept] and persisted by writing the batch returned
+// by [CommitBatch], which should be written atomically with the block.
+type RewardIndexer interface {
+	// Accept stages the fees collected by the accepted block [blk] and the
+	// staker reward decided by it, if any. [undistributedReward] is the reward
+	// [blk] moved to the undistributed reward pool.
+	Accept(
+		blk blocks.Block,
+		timestamp time.Time,
+		stakerReward *StakerReward,
+		undistributedReward uint64,
+	) error
+
+	// CommitBatch returns a batch that persists the staged changes.
+	CommitBatch() (database.Batch, error)
+
+	// Abort discards the staged changes.
+	Abort()
+
+	// StartHeight returns the height of the first block that was indexed.
+	StartHeight() (uint64, error)
+
+	// GetBlockRewards returns the indexed blocks with a height in
+	// [startHeight, endHeight], in order of increasing height. Blocks that
+	// neither collected fees nor decided a reward are not indexed.
+	GetBlockRewards(startHeight, endHeight uint64) ([]*BlockRewards, error)
+
+	// GetStakerReward returns the reward decided for the staker added by
+	// [txID].
+	GetStakerReward(txID ids.ID) (*StakerReward, error)
+
+	// RecordRecycle stages the recycling of the undistributed reward pool.
+	RecordRecycle(event *RecycleEvent) error
+
+	// GetRecycleEvents returns the recycling events of the blocks with a
+	// height in [startHeight, endHeight], in order of increasing height.
+	GetRecycleEvents(startHeight, endHeight uint64) ([]*RecycleEvent, error)
+}
+
+/*
+ * DB
+ * |-- startHeight -> height of the first indexed block
+ * |-. block
+ * | '-- height -> BlockRewards
+ * |-. staker
+ * | '-- txID -> StakerReward
+ * '-. recycle
+ *   '-- height -> RecycleEvent
+ */
+type indexer struct {
+	baseDB    *versiondb.Database
+	blockDB   database.Database
+	stakerDB  database.Database
+	recycleDB database.Database
+}
+
+// NewIndexer returns a new RewardIndexer persisting to [db].
+func NewIndexer(db database.Database) RewardIndexer {
+	baseDB := versiondb.New(db)
+	return &indexer{
+		baseDB:    baseDB,
+		blockDB:   prefixdb.New(blockPrefix, baseDB),
+		stakerDB:  prefixdb.New(stakerPrefix, baseDB),
+		recycleDB: prefixdb.New(recyclePrefix, baseDB),
+	}
+}
+
+func (i *indexer) Accept(
+	blk blocks.Block,
+	timestamp time.Time,
+	stakerReward *StakerReward,
+	undistributedReward uint64,
+) error {
+	height := blk.Height()
+	has, err := i.baseDB.Has(startHeightKey)
+	if err != nil {
+		return err
+	}
+	if !has {
+		if err := database.PutUInt64(i.baseDB, startHeightKey, height); err != nil {
+			return err
+		}
+	}
+
+	blockRewards := &BlockRewards{
+		Height:              height,
+		BlockID:             blk.ID(),
+		Timestamp:           timestamp.Unix(),
+		AChainFee:           blk.FeeFromAChain(),
+		DChainFee:           blk.FeeFromDChain(),
+		UndistributedReward: undistributedReward,
+	}
+	if stakerReward != nil {
+		if stakerReward.Rewarded {
+			blockRewards.MintReward = stakerReward.MintReward
+			blockRewards.FeeReward = stakerReward.FeeReward
+			blockRewards.OrionFee = stakerReward.OrionFee
+		}
+
+		stakerRewardBytes, err := Codec.Marshal(CodecVersion, stakerReward)
+		if err != nil {
+			return err
+		}
+		if err := i.stakerDB.Put(stakerReward.TxID[:], stakerRewardBytes); err != nil {
+			return err
+		}
+	}
+
+	if blockRewards.isEmpty() {
+		return nil
+	}
+	blockRewardsBytes, err := Codec.Marshal(CodecVersion, blockRewards)
+	if err != nil {
+		return err
+	}
+	return i.blockDB.Put(database.PackUInt64(blockRewards.Height), blockRewardsBytes)
+}
+
+func (i *indexer) CommitBatch() (database.Batch, error) {
+	return i.baseDB.CommitBatch()
+}
+
+func (i *indexer) Abort() {
+	i.baseDB.Abort()
+}
+
+func (i *indexer) StartHeight() (uint64, error) {
+	return database.GetUInt64(i.baseDB, startHeightKey)
+}
+
+func (i *indexer) GetBlockRewards(startHeight, endHeight uint64) ([]*BlockRewards, error) {
+	iter := i.blockDB.NewIteratorWithStart(database.PackUInt64(startHeight))
+	defer iter.Release()
+
+	var blockRewards []*BlockRewards
+	for iter.Next() {
+		height, err := database.ParseUInt64(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if height > endHeight {
+			break
+		}
+
+		rewards := &BlockRewards{}
+		if _, err := Codec.Unmarshal(iter.Value(), rewards); err != nil {
+			return nil, err
+		}
+		blockRewards = append(blockRewards, rewards)
+	}
+	return blockRewards, iter.Error()
+}
+
+func (i *indexer) GetStakerReward(txID ids.ID) (*StakerReward, error) {
+	stakerRewardBytes, err := i.stakerDB.Get(txID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	stakerReward := &StakerReward{}
+	_, err = Codec.Unmarshal(stakerRewardBytes, stakerReward)
+	return stakerReward, err
+}
+
+func (i *indexer) RecordRecycle(event *RecycleEvent) error {
+	eventBytes, err := Codec.Marshal(CodecVersion, event)
+	if err != nil {
+		return err
+	}
+	return i.recycleDB.Put(database.PackUInt64(event.Height), eventBytes)
+}
+
+func (i *indexer) GetRecycleEvents(startHeight, endHeight uint64) ([]*RecycleEvent, error) {
+	iter := i.recycleDB.NewIteratorWithStart(database.PackUInt64(startHeight))
+	defer iter.Release()
+
+	var events []*RecycleEvent
+	for iter.Next() {
+		height, err := database.ParseUInt64(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if height > endHeight {
+			break
+		}
+
+		event := &RecycleEvent{}
+		if _, err := Codec.Unmarshal(iter.Value(), event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, iter.Error()
+}
+
+type noIndexer struct{}
+
+func NewNoIndexer() RewardIndexer {
+	return &noIndexer{}
+}
+
+func (*noIndexer) Accept(blocks.Block, time.Time, *StakerReward, uint64) error {
+	return nil
+}
+
+func (*noIndexer) CommitBatch() (database.Batch, error) {
+	return memdb.New().NewBatch(), nil
+}
+
+func (*noIndexer) Abort() {}
+
+func (*noIndexer) StartHeight() (uint64, error) {
+	return 0, ErrIndexingDisabled
+}
+
+func (*noIndexer) GetBlockRewards(uint64, uint64) ([]*BlockRewards, error) {
+	return nil, ErrIndexingDisabled
+}
+
+func (*noIndexer) GetStakerReward(ids.ID) (*StakerReward, error) {
+	return nil, ErrIndexingDisabled
+}
+
+func (*noIndexer) RecordRecycle(*RecycleEvent) error {
+	return nil
+}
+
+func (*noIndexer) GetRecycleEvents(uint64, uint64) ([]*RecycleEvent, error) {
+	return nil, ErrIndexingDisabled
+}
@@ -0,0 +1,215 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package index
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+)
+
+func newStandardBlock(t *testing.T, height, feeFromAChain, feeFromDChain uint64) blocks.BanffBlock {
+	blk, err := blocks.NewBanffStandardBlockWithFee(
+		time.Unix(int64(height), 0),
+		ids.GenerateTestID(),
+		height,
+		nil,
+		feeFromAChain,
+		feeFromDChain,
+	)
+	require.NoError(t, err)
+	return blk
+}
+
+func TestIndexer(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	indexer := NewIndexer(db)
+
+	_, err := indexer.StartHeight()
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// Blocks that neither collect fees nor decide a reward aren't indexed.
+	emptyBlk := newStandardBlock(t, 5, 0, 0)
+	require.NoError(indexer.Accept(emptyBlk, emptyBlk.Timestamp(), nil, 0))
+
+	feeBlk := newStandardBlock(t, 6, 10, 20)
+	require.NoError(indexer.Accept(feeBlk, feeBlk.Timestamp(), nil, 0))
+
+	rewardedBlk := newStandardBlock(t, 7, 0, 0)
+	rewarded := &StakerReward{
+		TxID:       ids.GenerateTestID(),
+		NodeID:     ids.GenerateTestNodeID(),
+		Height:     rewardedBlk.Height(),
+		BlockID:    rewardedBlk.ID(),
+		Rewarded:   true,
+		MintReward: 100,
+		FeeReward:  30,
+		OrionFee:   5,
+	}
+	require.NoError(indexer.Accept(rewardedBlk, rewardedBlk.Timestamp(), rewarded, 0))
+
+	notRewardedBlk := newStandardBlock(t, 8, 0, 0)
+	notRewarded := &StakerReward{
+		TxID:       ids.GenerateTestID(),
+		NodeID:     ids.GenerateTestNodeID(),
+		Height:     notRewardedBlk.Height(),
+		BlockID:    notRewardedBlk.ID(),
+		MintReward: 50,
+		FeeReward:  10,
+	}
+	require.NoError(indexer.Accept(notRewardedBlk, notRewardedBlk.Timestamp(), notRewarded, 60))
+
+	batch, err := indexer.CommitBatch()
+	require.NoError(err)
+	require.NoError(batch.Write())
+	indexer.Abort()
+
+	// Reload the index to make sure everything was persisted.
+	indexer = NewIndexer(db)
+
+	startHeight, err := indexer.StartHeight()
+	require.NoError(err)
+	require.Equal(emptyBlk.Height(), startHeight)
+
+	blockRewards, err := indexer.GetBlockRewards(0, 100)
+	require.NoError(err)
+	require.Equal([]*BlockRewards{
+		{
+			Height:    feeBlk.Height(),
+			BlockID:   feeBlk.ID(),
+			Timestamp: feeBlk.Timestamp().Unix(),
+			AChainFee: 10,
+			DChainFee: 20,
+		},
+		{
+			Height:     rewardedBlk.Height(),
+			BlockID:    rewardedBlk.ID(),
+			Timestamp:  rewardedBlk.Timestamp().Unix(),
+			MintReward: 100,
+			FeeReward:  30,
+			OrionFee:   5,
+		},
+		{
+			Height:              notRewardedBlk.Height(),
+			BlockID:             notRewardedBlk.ID(),
+			Timestamp:           notRewardedBlk.Timestamp().Unix(),
+			UndistributedReward: 60,
+		},
+	}, blockRewards)
+
+	blockRewards, err = indexer.GetBlockRewards(7, 7)
+	require.NoError(err)
+	require.Len(blockRewards, 1)
+	require.Equal(rewardedBlk.ID(), blockRewards[0].BlockID)
+
+	stakerReward, err := indexer.GetStakerReward(rewarded.TxID)
+	require.NoError(err)
+	require.Equal(rewarded, stakerReward)
+
+	stakerReward, err = indexer.GetStakerReward(notRewarded.TxID)
+	require.NoError(err)
+	require.Equal(notRewarded, stakerReward)
+
+	_, err = indexer.GetStakerReward(ids.GenerateTestID())
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestIndexerRecycleEvents(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	indexer := NewIndexer(db)
+
+	events := []*RecycleEvent{
+		{
+			Height:    3,
+			BlockID:   ids.GenerateTestID(),
+			Timestamp: 3,
+			Policy:    "fees",
+			Amount:    100,
+		},
+		{
+			Height:    9,
+			BlockID:   ids.GenerateTestID(),
+			Timestamp: 9,
+			Policy:    "burn",
+			Amount:    40,
+		},
+	}
+	for _, event := range events {
+		require.NoError(indexer.RecordRecycle(event))
+	}
+
+	batch, err := indexer.CommitBatch()
+	require.NoError(err)
+	require.NoError(batch.Write())
+	indexer.Abort()
+
+	indexer = NewIndexer(db)
+
+	recycleEvents, err := indexer.GetRecycleEvents(0, 100)
+	require.NoError(err)
+	require.Equal(events, recycleEvents)
+
+	recycleEvents, err = indexer.GetRecycleEvents(4, 100)
+	require.NoError(err)
+	require.Equal(events[1:], recycleEvents)
+
+	recycleEvents, err = indexer.GetRecycleEvents(0, 8)
+	require.NoError(err)
+	require.Equal(events[:1], recycleEvents)
+}
+
+func TestIndexerAbort(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	indexer := NewIndexer(db)
+
+	blk := newStandardBlock(t, 1, 10, 20)
+	require.NoError(indexer.Accept(blk, blk.Timestamp(), nil, 0))
+	indexer.Abort()
+
+	blockRewards, err := indexer.GetBlockRewards(0, 100)
+	require.NoError(err)
+	require.Empty(blockRewards)
+
+	_, err = NewIndexer(db).StartHeight()
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestNoIndexer(t *testing.T) {
+	require := require.New(t)
+
+	indexer := NewNoIndexer()
+
+	blk := newStandardBlock(t, 1, 10, 20)
+	require.NoError(indexer.Accept(blk, blk.Timestamp(), nil, 0))
+
+	batch, err := indexer.CommitBatch()
+	require.NoError(err)
+	require.Zero(batch.Size())
+
+	_, err = indexer.StartHeight()
+	require.ErrorIs(err, ErrIndexingDisabled)
+
+	_, err = indexer.GetBlockRewards(0, 100)
+	require.ErrorIs(err, ErrIndexingDisabled)
+
+	_, err = indexer.GetStakerReward(ids.GenerateTestID())
+	require.ErrorIs(err, ErrIndexingDisabled)
+
+	require.NoError(indexer.RecordRecycle(&RecycleEvent{Height: 1}))
+
+	_, err = indexer.GetRecycleEvents(0, 100)
+	require.ErrorIs(err, ErrIndexingDisabled)
+}
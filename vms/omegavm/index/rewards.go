@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package index
+
+import (
+	"github.com/DioneProtocol/odysseygo/codec"
+	"github.com/DioneProtocol/odysseygo/codec/linearcodec"
+	"github.com/DioneProtocol/odysseygo/ids"
+)
+
+// CodecVersion is the current default codec version
+const CodecVersion = 0
+
+// Codec is used to serialize the indexed rewards
+var Codec codec.Manager
+
+func init() {
+	c := linearcodec.NewDefault()
+	Codec = codec.NewDefaultManager()
+
+	if err := Codec.RegisterCodec(CodecVersion, c); err != nil {
+		panic(err)
+	}
+}
+
+// BlockRewards are the fees collected and the rewards decided by a block.
+type BlockRewards struct {
+	Height    uint64 `serialize:"true"`
+	BlockID   ids.ID `serialize:"true"`
+	Timestamp int64  `serialize:"true"`
+
+	// Fees moved from the A-chain and D-chain fee pools by the block
+	AChainFee uint64 `serialize:"true"`
+	DChainFee uint64 `serialize:"true"`
+
+	// Rewards paid out by the block
+	MintReward uint64 `serialize:"true"`
+	FeeReward  uint64 `serialize:"true"`
+	OrionFee   uint64 `serialize:"true"`
+
+	// Reward that was not paid out by the block and was moved to the
+	// undistributed reward pool instead
+	UndistributedReward uint64 `serialize:"true"`
+}
+
+func (r *BlockRewards) isEmpty() bool {
+	return r.AChainFee == 0 &&
+		r.DChainFee == 0 &&
+		r.MintReward == 0 &&
+		r.FeeReward == 0 &&
+		r.OrionFee == 0 &&
+		r.UndistributedReward == 0
+}
+
+// StakerReward is the reward decided for a staker once its staking period
+// ended.
+type StakerReward struct {
+	// ID of the tx that added the staker
+	TxID     ids.ID     `serialize:"true"`
+	NodeID   ids.NodeID `serialize:"true"`
+	SubnetID ids.ID     `serialize:"true"`
+
+	// Block that decided the reward
+	Height  uint64 `serialize:"true"`
+	BlockID ids.ID `serialize:"true"`
+
+	// Rewarded is true if the reward was paid out to the staker
+	Rewarded bool `serialize:"true"`
+
+	// Components of the staker's reward
+	MintReward uint64 `serialize:"true"`
+	FeeReward  uint64 `serialize:"true"`
+	OrionFee   uint64 `serialize:"true"`
+
+	// Shares of the validator and part of the reward paid to it, if the staker
+	// is a delegator
+	DelegationShares uint32 `serialize:"true"`
+	DelegationFee    uint64 `serialize:"true"`
+
+	// Reward accrued from delegations, if the staker is a validator
+	DelegateeReward uint64 `serialize:"true"`
+}
+
+// RecycleEvent is the recycling of the undistributed reward pool by a block.
+type RecycleEvent struct {
+	Height    uint64 `serialize:"true"`
+	BlockID   ids.ID `serialize:"true"`
+	Timestamp int64  `serialize:"true"`
+
+	// Policy the pool was recycled with
+	Policy string `serialize:"true"`
+
+	// Amount removed from the undistributed reward pool
+	Amount uint64 `serialize:"true"`
+}
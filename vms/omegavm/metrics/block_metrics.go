@@ -86,6 +86,10 @@ func (m *blockMetrics) BanffStandardBlock(b *blocks.BanffStandardBlock) error {
 	return nil
 }
 
+func (m *blockMetrics) StateSyncStandardBlock(b *blocks.StateSyncStandardBlock) error {
+	return m.BanffStandardBlock(&b.BanffStandardBlock)
+}
+
 func (m *blockMetrics) ApricotAbortBlock(*blocks.ApricotAbortBlock) error {
 	m.numAbortBlocks.Inc()
 	return nil
@@ -42,6 +42,10 @@ type Metrics interface {
 	SetTimeUntilUnstake(time.Duration)
 	// Mark when this node will unstake from a subnet.
 	SetTimeUntilSubnetUnstake(subnetID ids.ID, timeUntilUnstake time.Duration)
+	// Mark that this much reward is in the undistributed reward pool.
+	SetUndistributedReward(uint64)
+	// Mark that this much undistributed reward was recycled with [policy].
+	AddRecycledUndistributedReward(policy string, amount uint64)
 }
 
 func New(
@@ -74,6 +78,27 @@ func New(
 			Name:      "total_staked",
 			Help:      "Amount (in nDIONE) of DIONE staked on the Primary Network",
 		}),
+		undistributedReward: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "undistributed_reward",
+			Help:      "Amount (in nDIONE) of DIONE in the undistributed reward pool",
+		}),
+		recycledUndistributedReward: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "recycled_undistributed_reward",
+				Help:      "Total amount (in nDIONE) of undistributed reward recycled",
+			},
+			[]string{"policy"},
+		),
+		undistributedRewardRecycles: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "undistributed_reward_recycles",
+				Help:      "Total number of times the undistributed reward pool was recycled",
+			},
+			[]string{"policy"},
+		),
 
 		numVotesWon: prometheus.NewCounter(prometheus.CounterOpts{
 			Namespace: namespace,
@@ -117,6 +142,9 @@ func New(
 		registerer.Register(m.timeUntilSubnetUnstake),
 		registerer.Register(m.localStake),
 		registerer.Register(m.totalStake),
+		registerer.Register(m.undistributedReward),
+		registerer.Register(m.recycledUndistributedReward),
+		registerer.Register(m.undistributedRewardRecycles),
 
 		registerer.Register(m.numVotesWon),
 		registerer.Register(m.numVotesLost),
@@ -140,6 +168,10 @@ type metrics struct {
 	localStake             prometheus.Gauge
 	totalStake             prometheus.Gauge
 
+	undistributedReward         prometheus.Gauge
+	recycledUndistributedReward *prometheus.CounterVec
+	undistributedRewardRecycles *prometheus.CounterVec
+
 	numVotesWon, numVotesLost prometheus.Counter
 
 	validatorSetsCached     prometheus.Counter
@@ -191,3 +223,12 @@ func (m *metrics) SetTimeUntilUnstake(timeUntilUnstake time.Duration) {
 func (m *metrics) SetTimeUntilSubnetUnstake(subnetID ids.ID, timeUntilUnstake time.Duration) {
 	m.timeUntilSubnetUnstake.WithLabelValues(subnetID.String()).Set(float64(timeUntilUnstake))
 }
+
+func (m *metrics) SetUndistributedReward(r uint64) {
+	m.undistributedReward.Set(float64(r))
+}
+
+func (m *metrics) AddRecycledUndistributedReward(policy string, amount uint64) {
+	m.recycledUndistributedReward.WithLabelValues(policy).Add(float64(amount))
+	m.undistributedRewardRecycles.WithLabelValues(policy).Inc()
+}
@@ -27,7 +27,16 @@ type txMetrics struct {
 	numRemoveSubnetValidatorTxs,
 	numTransformSubnetTxs,
 	numAddPermissionlessValidatorTxs,
-	numAddPermissionlessDelegatorTxs prometheus.Counter
+	numAddPermissionlessDelegatorTxs,
+	numBaseTxs,
+	numTransferSubnetOwnershipTxs,
+	numRotateBLSKeyTxs,
+	numSetAutoRestakeTxs,
+	numEarlyUnstakeTxs,
+	numRedelegateTxs,
+	numUptimeAttestationTxs,
+	numSetSubnetFeeConfigTxs,
+	numCancelPendingStakerTxs prometheus.Counter
 }
 
 func newTxMetrics(
@@ -49,6 +58,15 @@ func newTxMetrics(
 		numTransformSubnetTxs:            newTxMetric(namespace, "transform_subnet", registerer, &errs),
 		numAddPermissionlessValidatorTxs: newTxMetric(namespace, "add_permissionless_validator", registerer, &errs),
 		numAddPermissionlessDelegatorTxs: newTxMetric(namespace, "add_permissionless_delegator", registerer, &errs),
+		numBaseTxs:                       newTxMetric(namespace, "base", registerer, &errs),
+		numTransferSubnetOwnershipTxs:    newTxMetric(namespace, "transfer_subnet_ownership", registerer, &errs),
+		numRotateBLSKeyTxs:               newTxMetric(namespace, "rotate_bls_key", registerer, &errs),
+		numSetAutoRestakeTxs:             newTxMetric(namespace, "set_auto_restake", registerer, &errs),
+		numEarlyUnstakeTxs:               newTxMetric(namespace, "early_unstake", registerer, &errs),
+		numRedelegateTxs:                 newTxMetric(namespace, "redelegate", registerer, &errs),
+		numUptimeAttestationTxs:          newTxMetric(namespace, "uptime_attestation", registerer, &errs),
+		numSetSubnetFeeConfigTxs:         newTxMetric(namespace, "set_subnet_fee_config", registerer, &errs),
+		numCancelPendingStakerTxs:        newTxMetric(namespace, "cancel_pending_staker", registerer, &errs),
 	}
 	return m, errs.Err
 }
@@ -132,3 +150,48 @@ func (m *txMetrics) AddPermissionlessDelegatorTx(*txs.AddPermissionlessDelegator
 	m.numAddPermissionlessDelegatorTxs.Inc()
 	return nil
 }
+
+func (m *txMetrics) BaseTx(*txs.BaseTx) error {
+	m.numBaseTxs.Inc()
+	return nil
+}
+
+func (m *txMetrics) TransferSubnetOwnershipTx(*txs.TransferSubnetOwnershipTx) error {
+	m.numTransferSubnetOwnershipTxs.Inc()
+	return nil
+}
+
+func (m *txMetrics) RotateBLSKeyTx(*txs.RotateBLSKeyTx) error {
+	m.numRotateBLSKeyTxs.Inc()
+	return nil
+}
+
+func (m *txMetrics) SetAutoRestakeTx(*txs.SetAutoRestakeTx) error {
+	m.numSetAutoRestakeTxs.Inc()
+	return nil
+}
+
+func (m *txMetrics) EarlyUnstakeTx(*txs.EarlyUnstakeTx) error {
+	m.numEarlyUnstakeTxs.Inc()
+	return nil
+}
+
+func (m *txMetrics) RedelegateTx(*txs.RedelegateTx) error {
+	m.numRedelegateTxs.Inc()
+	return nil
+}
+
+func (m *txMetrics) UptimeAttestationTx(*txs.UptimeAttestationTx) error {
+	m.numUptimeAttestationTxs.Inc()
+	return nil
+}
+
+func (m *txMetrics) SetSubnetFeeConfigTx(*txs.SetSubnetFeeConfigTx) error {
+	m.numSetSubnetFeeConfigTxs.Inc()
+	return nil
+}
+
+func (m *txMetrics) CancelPendingStakerTx(*txs.CancelPendingStakerTx) error {
+	m.numCancelPendingStakerTxs.Inc()
+	return nil
+}
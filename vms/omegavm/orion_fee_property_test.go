@@ -0,0 +1,280 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+
+	blockexecutor "github.com/DioneProtocol/odysseygo/vms/omegavm/blocks/executor"
+	txexecutor "github.com/DioneProtocol/odysseygo/vms/omegavm/txs/executor"
+)
+
+const (
+	orionFeeValidator uint8 = iota
+	orionFeePermissionlessValidator
+	orionFeeDelegator
+)
+
+var errNoRewardValidatorTx = errors.New("proposal block doesn't have a reward validator tx")
+
+// for a given node, the test stakes multiple times with every kind of primary
+// network staker tx while orion fees accrue to the node. Every staker is either
+// rewarded or not. We test that the fees accrued by the node are always either
+// still in its orion pool, paid out to a validator of the node or moved to the
+// undistributed reward pool, and that the pools always match the rewards that
+// were paid out.
+func TestOrionFeeConservationProperty(t *testing.T) {
+	properties := gopter.NewProperties(nil)
+
+	// to reproduce a given scenario do something like this:
+	// parameters := gopter.DefaultTestParametersWithSeed(1685887576153675816)
+	// properties := gopter.NewProperties(parameters)
+
+	properties.Property("orion fees are conserved", prop.ForAll(
+		func(stakerTypes []uint8, fees []uint64, commits []bool) string {
+			vm, _, err := buildVM(t)
+			if err != nil {
+				return fmt.Sprintf("failed building vm: %s", err.Error())
+			}
+			vm.ctx.Lock.Lock()
+			defer func() {
+				_ = vm.Shutdown(context.Background())
+				vm.ctx.Lock.Unlock()
+			}()
+			nodeID := ids.GenerateTestNodeID()
+			feeCollector := vm.ctx.FeeCollector
+
+			var (
+				// orion fees accrued by the node
+				accrued uint64
+				// orion fees paid out to rewarded validators
+				paid uint64
+				// orion fees moved to the undistributed reward pool
+				undistributed uint64
+				// rewards, including orion fees, moved to the undistributed
+				// reward pool
+				undistributedRewards uint64
+			)
+			accrue := func(fee uint64) error {
+				accrued += fee
+				return feeCollector.AddOrionsValue([]ids.NodeID{nodeID}, fee)
+			}
+
+			for i, stakerType := range stakerTypes {
+				startTime := vm.clock.Time().Add(txexecutor.SyncBound)
+				data := &validatorInputData{
+					startTime: startTime,
+					endTime:   startTime.Add(defaultMinValidatorStakingDuration + 2*txexecutor.SyncBound),
+					nodeID:    nodeID,
+				}
+
+				switch stakerType {
+				case orionFeeValidator, orionFeeDelegator:
+					_, err = addPrimaryValidatorWithoutBLSKey(vm, data)
+				case orionFeePermissionlessValidator:
+					_, err = addPrimaryValidatorWithBLSKey(vm, data)
+				default:
+					return fmt.Sprintf("unexpected staker type: %v", stakerType)
+				}
+				if err != nil {
+					return fmt.Sprintf("could not add validator: %s", err.Error())
+				}
+
+				// reward the validator accrues from its delegator
+				var delegateeReward uint64
+				if stakerType == orionFeeDelegator {
+					if err := addOrionFeeDelegator(vm, data); err != nil {
+						return fmt.Sprintf("could not add delegator: %s", err.Error())
+					}
+
+					// fees accrued while the delegator stakes are paid out
+					// to the validator of the node
+					if err := accrue(fees[i] / 2); err != nil {
+						return fmt.Sprintf("could not accrue orion fee: %s", err.Error())
+					}
+
+					result, err := terminateOrionFeeStaker(vm, commits[i])
+					if err != nil {
+						return fmt.Sprintf("could not terminate delegator: %s", err.Error())
+					}
+					if result.orionFee != 0 {
+						return fmt.Sprintf("delegator was paid an orion fee of %d", result.orionFee)
+					}
+					if commits[i] {
+						// the validator takes all the delegation rewards
+						delegateeReward = result.potentialReward
+					} else {
+						undistributedRewards += result.potentialReward
+					}
+				}
+
+				if err := accrue(fees[i] - fees[i]/2); err != nil {
+					return fmt.Sprintf("could not accrue orion fee: %s", err.Error())
+				}
+
+				orionPool := feeCollector.GetOrionValue(nodeID)
+				result, err := terminateOrionFeeStaker(vm, commits[i])
+				if err != nil {
+					return fmt.Sprintf("could not terminate validator: %s", err.Error())
+				}
+				if result.orionFee != orionPool {
+					return fmt.Sprintf("validator was paid an orion fee of %d but %d accrued", result.orionFee, orionPool)
+				}
+				if orionPool := feeCollector.GetOrionValue(nodeID); orionPool != 0 {
+					return fmt.Sprintf("orion pool wasn't settled, %d left", orionPool)
+				}
+
+				if commits[i] {
+					paid += result.orionFee
+
+					rewardUTXOs, err := vm.state.GetRewardUTXOs(result.txID)
+					if err != nil {
+						return fmt.Sprintf("could not get reward UTXOs: %s", err.Error())
+					}
+					var rewarded uint64
+					for _, utxo := range rewardUTXOs {
+						rewarded += utxo.Out.(dione.Amounter).Amount()
+					}
+					expected := result.potentialReward + result.orionFee + delegateeReward
+					if rewarded != expected {
+						return fmt.Sprintf("validator was rewarded %d instead of %d", rewarded, expected)
+					}
+				} else {
+					undistributed += result.orionFee
+					undistributedRewards += result.potentialReward + result.orionFee
+				}
+
+				if uReward := feeCollector.GetURewardValue(); uReward != undistributedRewards {
+					return fmt.Sprintf("undistributed reward pool is %d instead of %d", uReward, undistributedRewards)
+				}
+				if accrued != paid+undistributed {
+					return fmt.Sprintf(
+						"accrued %d orion fees but paid %d and left %d undistributed",
+						accrued,
+						paid,
+						undistributed,
+					)
+				}
+			}
+			return ""
+		},
+		gen.SliceOfN(5, gen.OneConstOf(
+			orionFeeValidator,
+			orionFeePermissionlessValidator,
+			orionFeeDelegator,
+		)),
+		gen.SliceOfN(5, gen.UInt64Range(0, units.Dione)),
+		gen.SliceOfN(5, gen.Bool()),
+	))
+
+	properties.TestingRun(t)
+}
+
+// addOrionFeeDelegator adds a delegator to the validator described by [data]
+// that stops staking right before it.
+func addOrionFeeDelegator(vm *VM, data *validatorInputData) error {
+	addr := keys[0].PublicKey().Address()
+	startTime := vm.clock.Time().Add(txexecutor.SyncBound)
+	signedTx, err := vm.txBuilder.NewAddDelegatorTx(
+		vm.Config.MinDelegatorStake,
+		uint64(startTime.Unix()),
+		uint64(data.endTime.Unix())-1,
+		data.nodeID,
+		addr,
+		[]*secp256k1.PrivateKey{keys[0], keys[1]},
+		addr,
+	)
+	if err != nil {
+		return fmt.Errorf("could not create AddDelegatorTx: %w", err)
+	}
+	_, err = internalAddValidator(vm, signedTx)
+	return err
+}
+
+type orionFeeStakerResult struct {
+	txID            ids.ID
+	potentialReward uint64
+	orionFee        uint64
+}
+
+// terminateOrionFeeStaker removes the next staker to be removed, rewarding it
+// if [commit] is true.
+func terminateOrionFeeStaker(vm *VM, commit bool) (*orionFeeStakerResult, error) {
+	stakerIterator, err := vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return nil, err
+	}
+	if !stakerIterator.Next() {
+		stakerIterator.Release()
+		return nil, errors.New("no staker to terminate")
+	}
+	staker := stakerIterator.Value()
+	stakerIterator.Release()
+	result := &orionFeeStakerResult{
+		txID:            staker.TxID,
+		potentialReward: staker.PotentialReward,
+	}
+
+	vm.clock.Set(staker.EndTime)
+	vm.state.SetTimestamp(staker.EndTime)
+
+	blk, err := vm.Builder.BuildBlock(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed building block: %w", err)
+	}
+	if err := blk.Verify(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed verifying block: %w", err)
+	}
+
+	proposalBlk, ok := blk.(*blockexecutor.Block).Block.(*blocks.BanffProposalBlock)
+	if !ok {
+		return nil, fmt.Errorf("expected proposal block but got %T", blk.(*blockexecutor.Block).Block)
+	}
+	rewardValidatorTx, ok := proposalBlk.Tx.Unsigned.(*txs.RewardValidatorTx)
+	if !ok || rewardValidatorTx.TxID != staker.TxID {
+		return nil, errNoRewardValidatorTx
+	}
+	result.orionFee = rewardValidatorTx.OrionFee
+
+	options, err := blk.(snowman.OracleBlock).Options(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed retrieving options: %w", err)
+	}
+	option := options[1]
+	if commit {
+		option = options[0]
+	}
+	if _, ok := option.(*blockexecutor.Block).Block.(*blocks.BanffCommitBlock); ok != commit {
+		return nil, errors.New("failed retrieving option")
+	}
+
+	if err := blk.Accept(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed accepting block: %w", err)
+	}
+	if err := option.Verify(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed verifying option block: %w", err)
+	}
+	if err := option.Accept(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed accepting option block: %w", err)
+	}
+	if err := vm.SetPreference(context.Background(), vm.manager.LastAccepted()); err != nil {
+		return nil, fmt.Errorf("failed setting preference: %w", err)
+	}
+	return result, nil
+}
@@ -4,6 +4,8 @@
 package reward
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 	"time"
 )
@@ -11,6 +13,8 @@ import (
 // PercentDenominator is the denominator used to calculate percentages
 const PercentDenominator = 1_000_000
 
+var ErrInvalidMintSchedule = errors.New("invalid mint schedule")
+
 // consumptionRateDenominator is the magnitude offset used to emulate
 // floating point fractions.
 var consumptionRateDenominator = new(big.Int).SetUint64(PercentDenominator)
@@ -51,3 +55,49 @@ type MintConfig struct {
 	// period
 	MaxMintAmount uint64 `json:"maxMintAmount"`
 }
+
+// MintSchedule is a list of mint configs ordered by increasing [MintSince].
+// Each config is in effect from its [MintSince] until the [MintSince] of the
+// next config, so that the minting parameters can be changed by a network
+// upgrade.
+type MintSchedule []MintConfig
+
+// Verify returns an error if the configs aren't ordered by strictly increasing
+// [MintSince] or mint more than the supply each period.
+func (s MintSchedule) Verify() error {
+	for i, config := range s {
+		switch {
+		case config.MintRate > PercentDenominator:
+			return fmt.Errorf("%w: mint config %d has a mint rate of %d", ErrInvalidMintSchedule, i, config.MintRate)
+		case config.MintingPeriod < 0:
+			return fmt.Errorf("%w: mint config %d has a negative minting period", ErrInvalidMintSchedule, i)
+		case i > 0 && config.MintSince <= s[i-1].MintSince:
+			return fmt.Errorf("%w: mint config %d isn't activated after mint config %d", ErrInvalidMintSchedule, i, i-1)
+		}
+	}
+	return nil
+}
+
+// IsSet returns true if any config of the schedule mints.
+func (s MintSchedule) IsSet() bool {
+	for _, config := range s {
+		if config.MintingPeriod != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigAt returns the config in effect at [timestamp]. Nothing is minted
+// before the first config of the schedule is activated.
+func (s MintSchedule) ConfigAt(timestamp time.Time) MintConfig {
+	timestampUnix := timestamp.Unix()
+	config := MintConfig{}
+	for _, c := range s {
+		if c.MintSince > timestampUnix {
+			break
+		}
+		config = c
+	}
+	return config
+}
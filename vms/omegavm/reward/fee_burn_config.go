@@ -0,0 +1,52 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reward
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidFeeBurnConfig = errors.New("invalid fee burn config")
+
+// FeeBurnConfig sets the share of the fees collected from each chain that is
+// burned. The rest of the fees is distributed to the stakers.
+type FeeBurnConfig struct {
+	// OChainBurnRate is the percent of the O-chain fees that is burned
+	OChainBurnRate uint64 `json:"oChainBurnRate"`
+
+	// AChainBurnRate is the percent of the A-chain fees that is burned
+	AChainBurnRate uint64 `json:"aChainBurnRate"`
+
+	// DChainBurnRate is the percent of the D-chain fees that is burned
+	DChainBurnRate uint64 `json:"dChainBurnRate"`
+}
+
+// Verify returns an error if a burn rate is larger than 100%.
+func (c FeeBurnConfig) Verify() error {
+	switch {
+	case c.OChainBurnRate > PercentDenominator:
+		return fmt.Errorf("%w: O-chain burn rate of %d", ErrInvalidFeeBurnConfig, c.OChainBurnRate)
+	case c.AChainBurnRate > PercentDenominator:
+		return fmt.Errorf("%w: A-chain burn rate of %d", ErrInvalidFeeBurnConfig, c.AChainBurnRate)
+	case c.DChainBurnRate > PercentDenominator:
+		return fmt.Errorf("%w: D-chain burn rate of %d", ErrInvalidFeeBurnConfig, c.DChainBurnRate)
+	}
+	return nil
+}
+
+// Burned returns the amount of the fees collected from the O, A and D chains
+// that is burned.
+//
+// Invariant: the burn rates are at most [PercentDenominator].
+func (c FeeBurnConfig) Burned(oChainFee, aChainFee, dChainFee uint64) uint64 {
+	return burned(oChainFee, c.OChainBurnRate) +
+		burned(aChainFee, c.AChainBurnRate) +
+		burned(dChainFee, c.DChainBurnRate)
+}
+
+// burned returns [rate] percent of [fee] without overflowing
+func burned(fee, rate uint64) uint64 {
+	return fee/PercentDenominator*rate + fee%PercentDenominator*rate/PercentDenominator
+}
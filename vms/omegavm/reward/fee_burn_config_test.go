@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reward
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeBurnConfigVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      FeeBurnConfig
+		expectedErr error
+	}{
+		{
+			name: "no burn",
+		},
+		{
+			name: "full burn",
+			config: FeeBurnConfig{
+				OChainBurnRate: PercentDenominator,
+				AChainBurnRate: PercentDenominator,
+				DChainBurnRate: PercentDenominator,
+			},
+		},
+		{
+			name: "invalid O-chain burn rate",
+			config: FeeBurnConfig{
+				OChainBurnRate: PercentDenominator + 1,
+			},
+			expectedErr: ErrInvalidFeeBurnConfig,
+		},
+		{
+			name: "invalid A-chain burn rate",
+			config: FeeBurnConfig{
+				AChainBurnRate: PercentDenominator + 1,
+			},
+			expectedErr: ErrInvalidFeeBurnConfig,
+		},
+		{
+			name: "invalid D-chain burn rate",
+			config: FeeBurnConfig{
+				DChainBurnRate: PercentDenominator + 1,
+			},
+			expectedErr: ErrInvalidFeeBurnConfig,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestFeeBurnConfigBurned(t *testing.T) {
+	require := require.New(t)
+
+	config := FeeBurnConfig{
+		OChainBurnRate: PercentDenominator / 2,
+		AChainBurnRate: PercentDenominator / 4,
+		DChainBurnRate: 0,
+	}
+	require.Equal(uint64(500+250), config.Burned(1_000, 1_000, 1_000))
+	require.Equal(uint64(0), config.Burned(1, 0, 1_000))
+
+	fullBurn := FeeBurnConfig{
+		OChainBurnRate: PercentDenominator,
+	}
+	require.Equal(uint64(math.MaxUint64), fullBurn.Burned(math.MaxUint64, 0, 0))
+	require.Equal(uint64(math.MaxUint64/2), config.Burned(math.MaxUint64, 0, 0))
+}
@@ -4,6 +4,7 @@
 package reward
 
 import (
+	"math"
 	"math/big"
 	"time"
 )
@@ -13,6 +14,8 @@ var (
 
 	// 32 bits for unix time + 64 bits for a weight
 	mintShift uint = 96
+
+	percentDenominatorBigInt = new(big.Int).SetUint64(PercentDenominator)
 )
 
 type MintCalculator interface {
@@ -20,26 +23,59 @@ type MintCalculator interface {
 }
 
 type mintCalculator struct {
-	mintSince          int64
-	mintPeriod         int64
-	mintPeriodBigInt   *big.Int
-	maxMintAmount      *big.Int
-	mintRate           *big.Int
-	initialSupply      *big.Int
-	percentDenominator *big.Int
+	// Phases of the mint schedule, ordered by increasing [since]
+	phases []*mintPhase
+}
+
+// mintPhase mints according to a single config of the mint schedule
+type mintPhase struct {
+	// Phase is in effect in [since, until)
+	since int64
+	until int64
+
+	mintPeriod       int64
+	mintPeriodBigInt *big.Int
+	maxMintAmount    *big.Int
+	mintRate         *big.Int
+
+	// Supply the minting of the phase is based on
+	initialSupply *big.Int
 }
 
 func NewMintCalculator(config MintConfig, initialSupply uint64) *mintCalculator {
-	mintPeriod := uint64(config.MintingPeriod.Seconds())
-	return &mintCalculator{
-		mintPeriod:         int64(mintPeriod),
-		mintSince:          config.MintSince,
-		mintPeriodBigInt:   new(big.Int).SetUint64(mintPeriod),
-		maxMintAmount:      new(big.Int).SetUint64(config.MaxMintAmount),
-		mintRate:           new(big.Int).SetUint64(config.MintRate),
-		initialSupply:      new(big.Int).SetUint64(initialSupply),
-		percentDenominator: new(big.Int).SetUint64(PercentDenominator),
+	return NewScheduledMintCalculator(MintSchedule{config}, initialSupply)
+}
+
+// NewScheduledMintCalculator returns a calculator minting according to
+// [schedule], which is assumed to be valid.
+//
+// The first config of [schedule] mints based on [initialSupply]. Every
+// following config mints based on the supply the previous config reached at
+// the time it was replaced.
+func NewScheduledMintCalculator(schedule MintSchedule, initialSupply uint64) *mintCalculator {
+	c := &mintCalculator{
+		phases: make([]*mintPhase, len(schedule)),
 	}
+	supply := new(big.Int).SetUint64(initialSupply)
+	for i, config := range schedule {
+		mintPeriod := int64(config.MintingPeriod.Seconds())
+		phase := &mintPhase{
+			since:            config.MintSince,
+			until:            math.MaxInt64,
+			mintPeriod:       mintPeriod,
+			mintPeriodBigInt: new(big.Int).SetInt64(mintPeriod),
+			maxMintAmount:    new(big.Int).SetUint64(config.MaxMintAmount),
+			mintRate:         new(big.Int).SetUint64(config.MintRate),
+			initialSupply:    supply,
+		}
+		if i > 0 {
+			previous := c.phases[i-1]
+			previous.until = phase.since
+			phase.initialSupply = previous.supplyAt(phase.since)
+		}
+		c.phases[i] = phase
+	}
+	return c
 }
 
 func CalculateMintReward(weight uint64, stakerMintRate, accumulatedMintRate *big.Int) uint64 {
@@ -53,23 +89,40 @@ func CalculateMintReward(weight uint64, stakerMintRate, accumulatedMintRate *big
 
 func (c *mintCalculator) CalculateMintRate(totalWeight uint64, lastSyncTime, newChainTime time.Time) *big.Int {
 	lastSyncTimeUnix := lastSyncTime.Unix()
-	if lastSyncTimeUnix < c.mintSince {
-		lastSyncTimeUnix = c.mintSince
+	newChainTimeUnix := newChainTime.Unix()
+
+	result := new(big.Int)
+	for _, phase := range c.phases {
+		start := lastSyncTimeUnix
+		if start < phase.since {
+			start = phase.since
+		}
+		end := newChainTimeUnix
+		if end > phase.until {
+			end = phase.until
+		}
+		if end <= start {
+			continue
+		}
+		result.Add(result, phase.calculateMintRate(totalWeight, start, end))
 	}
+	return result
+}
 
-	newChainTimeUnix := newChainTime.Unix()
-	if newChainTimeUnix <= lastSyncTimeUnix {
+func (p *mintPhase) calculateMintRate(totalWeight uint64, lastSyncTimeUnix, newChainTimeUnix int64) *big.Int {
+	// Minting is disabled during this phase
+	if p.mintPeriod == 0 {
 		return new(big.Int)
 	}
 
-	lastSyncTimePeriod := (lastSyncTimeUnix - c.mintSince) / c.mintPeriod
-	newChainTimePeriod := (newChainTimeUnix - c.mintSince) / c.mintPeriod
+	lastSyncTimePeriod := (lastSyncTimeUnix - p.since) / p.mintPeriod
+	newChainTimePeriod := (newChainTimeUnix - p.since) / p.mintPeriod
 
 	if lastSyncTimePeriod != newChainTimePeriod {
-		newPeriodTimestamp := c.mintSince + c.mintPeriod*lastSyncTimePeriod + c.mintPeriod
+		newPeriodTimestamp := p.since + p.mintPeriod*lastSyncTimePeriod + p.mintPeriod
 		if newPeriodTimestamp != newChainTimeUnix {
-			mintRateBeforeNewPeriod := c.CalculateMintRate(totalWeight, lastSyncTime, time.Unix(newPeriodTimestamp, 0))
-			mintRateAfterNewPeriod := c.CalculateMintRate(totalWeight, time.Unix(newPeriodTimestamp, 0), newChainTime)
+			mintRateBeforeNewPeriod := p.calculateMintRate(totalWeight, lastSyncTimeUnix, newPeriodTimestamp)
+			mintRateAfterNewPeriod := p.calculateMintRate(totalWeight, newPeriodTimestamp, newChainTimeUnix)
 			return new(big.Int).Add(mintRateBeforeNewPeriod, mintRateAfterNewPeriod)
 		}
 	}
@@ -77,32 +130,56 @@ func (c *mintCalculator) CalculateMintRate(totalWeight uint64, lastSyncTime, new
 	elapsed := new(big.Int).SetInt64(newChainTimeUnix - lastSyncTimeUnix)
 	totalWeightBigInt := new(big.Int).SetUint64(totalWeight)
 
-	period := lastSyncTimePeriod
+	mintAmount := p.periodMintAmount(lastSyncTimePeriod)
+	if mintAmount.Cmp(p.maxMintAmount) > 0 {
+		mintAmount.Set(p.maxMintAmount)
+	}
 
+	result := elapsed
+	result.Mul(result, mintAmount)
+	result.Lsh(result, mintShift)
+	result.Div(result, p.mintPeriodBigInt)
+	result.Div(result, totalWeightBigInt)
+
+	return result
+}
+
+// periodSupply returns the supply at the start of the [period]-th minting
+// period of the phase
+func (p *mintPhase) periodSupply(period int64) *big.Int {
 	periodBigInt := new(big.Int).SetInt64(period)
-	startPeriodSupply := new(big.Int).Set(c.percentDenominator)
-	startPeriodSupply.Add(startPeriodSupply, c.mintRate)
+	startPeriodSupply := new(big.Int).Set(percentDenominatorBigInt)
+	startPeriodSupply.Add(startPeriodSupply, p.mintRate)
 	startPeriodSupply.Exp(startPeriodSupply, periodBigInt, nil)
-	startPeriodSupply.Mul(startPeriodSupply, c.initialSupply)
+	startPeriodSupply.Mul(startPeriodSupply, p.initialSupply)
 
-	supplyDenominator := new(big.Int).Set(c.percentDenominator)
+	supplyDenominator := new(big.Int).Set(percentDenominatorBigInt)
 	supplyDenominator.Exp(supplyDenominator, periodBigInt, nil)
 
-	startPeriodSupply.Div(startPeriodSupply, supplyDenominator)
+	return startPeriodSupply.Div(startPeriodSupply, supplyDenominator)
+}
 
-	mintAmount := new(big.Int).Set(startPeriodSupply)
-	mintAmount.Mul(mintAmount, c.mintRate)
-	mintAmount.Div(mintAmount, c.percentDenominator)
+// periodMintAmount returns the amount minted during the [period]-th minting
+// period of the phase, ignoring [maxMintAmount]
+func (p *mintPhase) periodMintAmount(period int64) *big.Int {
+	mintAmount := p.periodSupply(period)
+	mintAmount.Mul(mintAmount, p.mintRate)
+	return mintAmount.Div(mintAmount, percentDenominatorBigInt)
+}
 
-	if mintAmount.Cmp(c.maxMintAmount) > 0 {
-		mintAmount.Set(c.maxMintAmount)
+// supplyAt returns the supply reached by the phase at [timestamp], ignoring
+// [maxMintAmount] like the start of period supplies do
+func (p *mintPhase) supplyAt(timestamp int64) *big.Int {
+	if p.mintPeriod == 0 || timestamp <= p.since {
+		return new(big.Int).Set(p.initialSupply)
 	}
 
-	result := elapsed
-	result.Mul(result, mintAmount)
-	result.Lsh(result, mintShift)
-	result.Div(result, c.mintPeriodBigInt)
-	result.Div(result, totalWeightBigInt)
+	period := (timestamp - p.since) / p.mintPeriod
+	elapsed := timestamp - p.since - period*p.mintPeriod
 
-	return result
+	supply := p.periodSupply(period)
+	minted := p.periodMintAmount(period)
+	minted.Mul(minted, new(big.Int).SetInt64(elapsed))
+	minted.Div(minted, p.mintPeriodBigInt)
+	return supply.Add(supply, minted)
 }
@@ -196,3 +196,213 @@ func TestMintWithInflationRate(t *testing.T) {
 		}
 	}
 }
+
+func TestMintWithSchedule(t *testing.T) {
+	initialSupply := uint64(1_000_000)
+	maxMintAmount := uint64(1_000_000_000_000_000)
+	mintingPeriod := 100 * time.Second
+
+	tests := []struct {
+		name     string
+		schedule MintSchedule
+		// Expected mint amounts between the consecutive pairs of timestamps
+		timestamps          []int64
+		expectedMintAmounts []uint64
+	}{
+		{
+			name: "change at period boundary",
+			schedule: MintSchedule{
+				{
+					MintSince:     50,
+					MintingPeriod: mintingPeriod,
+					MintRate:      PercentDenominator * 0.1,
+					MaxMintAmount: maxMintAmount,
+				},
+				{
+					MintSince:     250,
+					MintingPeriod: mintingPeriod,
+					MintRate:      PercentDenominator * 0.2,
+					MaxMintAmount: maxMintAmount,
+				},
+			},
+			timestamps: []int64{0, 150, 250, 350, 450},
+			expectedMintAmounts: []uint64{
+				100_000, // 10% of 1_000_000
+				110_000, // 10% of 1_100_000
+				242_000, // 20% of 1_210_000
+				290_400, // 20% of 1_452_000
+			},
+		},
+		{
+			name: "period spanning change at period boundary",
+			schedule: MintSchedule{
+				{
+					MintSince:     50,
+					MintingPeriod: mintingPeriod,
+					MintRate:      PercentDenominator * 0.1,
+					MaxMintAmount: maxMintAmount,
+				},
+				{
+					MintSince:     250,
+					MintingPeriod: mintingPeriod,
+					MintRate:      PercentDenominator * 0.2,
+					MaxMintAmount: maxMintAmount,
+				},
+			},
+			timestamps: []int64{200, 300},
+			expectedMintAmounts: []uint64{
+				55_000 + 121_000, // half of 10% of 1_100_000 + half of 20% of 1_210_000
+			},
+		},
+		{
+			name: "change during period",
+			schedule: MintSchedule{
+				{
+					MintSince:     50,
+					MintingPeriod: mintingPeriod,
+					MintRate:      PercentDenominator * 0.1,
+					MaxMintAmount: maxMintAmount,
+				},
+				{
+					MintSince:     200,
+					MintingPeriod: mintingPeriod,
+					MintRate:      PercentDenominator * 0.2,
+					MaxMintAmount: maxMintAmount,
+				},
+			},
+			timestamps: []int64{150, 250, 350},
+			expectedMintAmounts: []uint64{
+				// The first config reached a supply of 1_155_000 when it was
+				// replaced.
+				55_000 + 115_500,  // half of 10% of 1_100_000 + half of 20% of 1_155_000
+				115_500 + 138_600, // half of 20% of 1_155_000 + half of 20% of 1_386_000
+			},
+		},
+		{
+			name: "minting disabled then re-enabled",
+			schedule: MintSchedule{
+				{
+					MintSince:     50,
+					MintingPeriod: mintingPeriod,
+					MintRate:      PercentDenominator * 0.1,
+					MaxMintAmount: maxMintAmount,
+				},
+				{
+					MintSince: 250,
+				},
+				{
+					MintSince:     350,
+					MintingPeriod: mintingPeriod,
+					MintRate:      PercentDenominator * 0.1,
+					MaxMintAmount: maxMintAmount,
+				},
+			},
+			timestamps: []int64{200, 300, 450},
+			expectedMintAmounts: []uint64{
+				55_000,  // half of 10% of 1_100_000
+				121_000, // 10% of 1_210_000
+			},
+		},
+		{
+			name: "max mint amount change",
+			schedule: MintSchedule{
+				{
+					MintSince:     50,
+					MintingPeriod: mintingPeriod,
+					MintRate:      PercentDenominator * 0.1,
+					MaxMintAmount: maxMintAmount,
+				},
+				{
+					MintSince:     150,
+					MintingPeriod: mintingPeriod,
+					MintRate:      PercentDenominator * 0.1,
+					MaxMintAmount: 50_000,
+				},
+			},
+			timestamps: []int64{50, 150, 250},
+			expectedMintAmounts: []uint64{
+				100_000,
+				50_000,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			require.NoError(test.schedule.Verify())
+			c := NewScheduledMintCalculator(test.schedule, initialSupply)
+			for i, expectedMintAmount := range test.expectedMintAmounts {
+				mintRate := c.CalculateMintRate(
+					1,
+					time.Unix(test.timestamps[i], 0),
+					time.Unix(test.timestamps[i+1], 0),
+				)
+				reward := CalculateMintReward(1, new(big.Int), mintRate)
+
+				// might happen roundoff error
+				require.LessOrEqual(expectedMintAmount-reward, uint64(1), "%d != %d", expectedMintAmount, reward)
+			}
+		})
+	}
+}
+
+func TestMintScheduleVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		schedule    MintSchedule
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			schedule: MintSchedule{
+				{MintSince: 1, MintingPeriod: time.Second, MintRate: PercentDenominator},
+				{MintSince: 2},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "mint rate too large",
+			schedule: MintSchedule{
+				{MintSince: 1, MintingPeriod: time.Second, MintRate: PercentDenominator + 1},
+			},
+			expectedErr: ErrInvalidMintSchedule,
+		},
+		{
+			name: "negative minting period",
+			schedule: MintSchedule{
+				{MintSince: 1, MintingPeriod: -time.Second},
+			},
+			expectedErr: ErrInvalidMintSchedule,
+		},
+		{
+			name: "not ordered",
+			schedule: MintSchedule{
+				{MintSince: 2},
+				{MintSince: 2},
+			},
+			expectedErr: ErrInvalidMintSchedule,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.schedule.Verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestMintScheduleConfigAt(t *testing.T) {
+	require := require.New(t)
+
+	schedule := MintSchedule{
+		{MintSince: 10, MintRate: 1},
+		{MintSince: 20, MintRate: 2},
+	}
+	require.Equal(MintConfig{}, schedule.ConfigAt(time.Unix(9, 0)))
+	require.Equal(schedule[0], schedule.ConfigAt(time.Unix(10, 0)))
+	require.Equal(schedule[0], schedule.ConfigAt(time.Unix(19, 0)))
+	require.Equal(schedule[1], schedule.ConfigAt(time.Unix(20, 0)))
+	require.False(schedule.IsSet())
+	require.True(MintSchedule{{MintingPeriod: time.Second}}.IsSet())
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reward
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// URewardKeep keeps the undistributed reward in its pool.
+	URewardKeep URewardRecyclePolicy = "keep"
+
+	// URewardToFees folds the undistributed reward into the fees distributed
+	// to the stakers. The pool is moved to the A-chain fee pool, which is paid
+	// out as fee rewards once it is synced by the O-chain.
+	URewardToFees URewardRecyclePolicy = "fees"
+
+	// URewardBurn burns the undistributed reward, so that it is never paid
+	// out. The reward isn't sent to any address, so there is no policy that
+	// burns it to a designated address.
+	URewardBurn URewardRecyclePolicy = "burn"
+)
+
+var (
+	errUnknownURewardRecyclePolicy   = errors.New("unknown undistributed reward recycle policy")
+	errInvalidURewardRecycleInterval = errors.New("undistributed reward recycle interval must be at least a second")
+)
+
+// URewardRecyclePolicy is how the undistributed reward pool is recycled
+type URewardRecyclePolicy string
+
+// URewardRecycleConfig configures the recycling of the undistributed reward
+// pool. It must be the same for every node of a network.
+type URewardRecycleConfig struct {
+	Policy URewardRecyclePolicy `json:"policy"`
+
+	// The pool is recycled by the first accepted block of every interval,
+	// where intervals are aligned to the Unix epoch.
+	Interval time.Duration `json:"interval"`
+}
+
+func (c *URewardRecycleConfig) Verify() error {
+	switch c.Policy {
+	case URewardKeep:
+		return nil
+	case URewardToFees, URewardBurn:
+	default:
+		return fmt.Errorf("%w: %q", errUnknownURewardRecyclePolicy, c.Policy)
+	}
+	if c.Interval < time.Second {
+		return errInvalidURewardRecycleInterval
+	}
+	return nil
+}
+
+// IsEnabled returns true if the pool is ever recycled.
+func (c *URewardRecycleConfig) IsEnabled() bool {
+	return (c.Policy == URewardToFees || c.Policy == URewardBurn) && c.Interval >= time.Second
+}
+
+// IsRecycleTime returns true if the pool should be recycled by a block with
+// [timestamp] whose parent has [parentTimestamp].
+func (c *URewardRecycleConfig) IsRecycleTime(parentTimestamp, timestamp time.Time) bool {
+	if !c.IsEnabled() {
+		return false
+	}
+	interval := int64(c.Interval / time.Second)
+	return parentTimestamp.Unix()/interval != timestamp.Unix()/interval
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reward
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestURewardRecycleConfigVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      URewardRecycleConfig
+		expectedErr error
+	}{
+		{
+			name: "keep",
+			config: URewardRecycleConfig{
+				Policy: URewardKeep,
+			},
+		},
+		{
+			name: "fees",
+			config: URewardRecycleConfig{
+				Policy:   URewardToFees,
+				Interval: time.Hour,
+			},
+		},
+		{
+			name: "burn",
+			config: URewardRecycleConfig{
+				Policy:   URewardBurn,
+				Interval: time.Second,
+			},
+		},
+		{
+			name: "unknown policy",
+			config: URewardRecycleConfig{
+				Policy:   "mint",
+				Interval: time.Hour,
+			},
+			expectedErr: errUnknownURewardRecyclePolicy,
+		},
+		{
+			name: "burn to address",
+			config: URewardRecycleConfig{
+				Policy:   "address",
+				Interval: time.Hour,
+			},
+			expectedErr: errUnknownURewardRecyclePolicy,
+		},
+		{
+			name: "interval too short",
+			config: URewardRecycleConfig{
+				Policy:   URewardBurn,
+				Interval: time.Millisecond,
+			},
+			expectedErr: errInvalidURewardRecycleInterval,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestURewardRecycleConfigIsRecycleTime(t *testing.T) {
+	require := require.New(t)
+
+	config := URewardRecycleConfig{
+		Policy:   URewardToFees,
+		Interval: time.Hour,
+	}
+	require.False(config.IsRecycleTime(time.Unix(3600, 0), time.Unix(7199, 0)))
+	require.True(config.IsRecycleTime(time.Unix(7199, 0), time.Unix(7200, 0)))
+	require.True(config.IsRecycleTime(time.Unix(3599, 0), time.Unix(4*3600, 0)))
+
+	config.Policy = URewardKeep
+	require.False(config.IsRecycleTime(time.Unix(3599, 0), time.Unix(4*3600, 0)))
+
+	require.False((&URewardRecycleConfig{}).IsRecycleTime(time.Unix(3599, 0), time.Unix(4*3600, 0)))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"time"
 
@@ -33,8 +34,11 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/utils/wrappers"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/components/keystore"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/index"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
@@ -46,6 +50,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 
 	omegaapi "github.com/DioneProtocol/odysseygo/vms/omegavm/api"
+	blockexecutor "github.com/DioneProtocol/odysseygo/vms/omegavm/blocks/executor"
 )
 
 const (
@@ -62,11 +67,23 @@ const (
 	// Note: Staker attributes cache should be large enough so that no evictions
 	// happen when the API loops through all stakers.
 	stakerAttributesCacheSize = 100_000
+
+	// Max number of accepted blocks to look through when searching for the
+	// last fee sync
+	maxFeeSyncLookback = 4096
+
+	// Max number of blocks that can be queried by GetRewardHistory
+	maxRewardHistoryRange = 4096
+
+	// Period of accepted blocks used by EstimateReward to measure the recent
+	// fee intake
+	feeIntakeWindow = 24 * time.Hour
 )
 
 var (
 	errMissingDecisionBlock     = errors.New("should have a decision block within the past two blocks")
 	errNoSubnetID               = errors.New("argument 'subnetID' not provided")
+	errStartAfterEndHeight      = errors.New("start height must not be after end height")
 	errNoRewardAddress          = errors.New("argument 'rewardAddress' not provided")
 	errInvalidDelegationRate    = errors.New("argument 'delegationFeeRate' must be between 0 and 100, inclusive")
 	errNoAddresses              = errors.New("no addresses provided")
@@ -75,12 +92,20 @@ var (
 	errStartTimeTooLate         = errors.New("start time is too far in the future")
 	errNamedSubnetCantBePrimary = errors.New("subnet validator attempts to validate primary network")
 	errNoAmount                 = errors.New("argument 'amount' must be > 0")
+	errNoTxID                   = errors.New("argument 'txID' not provided")
 	errMissingName              = errors.New("argument 'name' not given")
 	errMissingVMID              = errors.New("argument 'vmID' not given")
 	errMissingBlockchainID      = errors.New("argument 'blockchainID' not given")
 	errMissingPrivateKey        = errors.New("argument 'privateKey' not given")
 	errStartAfterEndTime        = errors.New("start time must be before end time")
 	errStartTimeInThePast       = errors.New("start time in the past")
+	errNoNodeID                 = errors.New("argument 'nodeID' not provided")
+	errDelegationEndsAfterVdr   = errors.New("delegation would end after the validator's staking period")
+	errNoUptimeScore            = errors.New("validator has no attested uptime score")
+	errNoUptimes                = errors.New("no uptimes provided")
+	errUptimeNotObserved        = errors.New("attested up duration exceeds the observed up duration")
+	errNoSupplyBreakdown        = errors.New("supply breakdown isn't available")
+	errLookbackExceeded         = errors.New("exceeded the max number of accepted blocks to look through")
 )
 
 // Service defines the API calls that can be made to the omega chain
@@ -507,6 +532,9 @@ type APISubnet struct {
 	// signatures from [Threshold] of these keys to be valid.
 	ControlKeys []string    `json:"controlKeys"`
 	Threshold   json.Uint32 `json:"threshold"`
+	// If the subnet owner is weighted, Weights[i] is the weight of
+	// ControlKeys[i] and the signers must hold [Threshold] weight
+	Weights []json.Uint32 `json:"weights,omitempty"`
 }
 
 // GetSubnetsArgs are the arguments to GetSubnet
@@ -550,20 +578,19 @@ func (s *Service) GetSubnets(_ *http.Request, args *GetSubnetsArgs, response *Ge
 				continue
 			}
 
-			unsignedTx := subnet.Unsigned.(*txs.CreateSubnetTx)
-			owner := unsignedTx.Owner.(*secp256k1fx.OutputOwners)
-			controlAddrs := []string{}
-			for _, controlKeyID := range owner.Addrs {
-				addr, err := s.addrManager.FormatLocalAddress(controlKeyID)
-				if err != nil {
-					return fmt.Errorf("problem formatting address: %w", err)
-				}
-				controlAddrs = append(controlAddrs, addr)
+			subnetOwner, err := s.vm.state.GetSubnetOwner(subnetID)
+			if err != nil {
+				return err
+			}
+			owner, err := s.getAPIOwner(subnetOwner)
+			if err != nil {
+				return err
 			}
 			response.Subnets[i] = APISubnet{
 				ID:          subnetID,
-				ControlKeys: controlAddrs,
-				Threshold:   json.Uint32(owner.Threshold),
+				ControlKeys: owner.Addresses,
+				Threshold:   owner.Threshold,
+				Weights:     owner.Weights,
 			}
 		}
 		// Include primary network
@@ -610,24 +637,16 @@ func (s *Service) GetSubnets(_ *http.Request, args *GetSubnetsArgs, response *Ge
 			return err
 		}
 
-		owner, ok := subnetOwner.(*secp256k1fx.OutputOwners)
-		if !ok {
-			return fmt.Errorf("expected *secp256k1fx.OutputOwners but got %T", subnetOwner)
-		}
-
-		controlAddrs := make([]string, len(owner.Addrs))
-		for i, controlKeyID := range owner.Addrs {
-			addr, err := s.addrManager.FormatLocalAddress(controlKeyID)
-			if err != nil {
-				return fmt.Errorf("problem formatting address: %w", err)
-			}
-			controlAddrs[i] = addr
+		owner, err := s.getAPIOwner(subnetOwner)
+		if err != nil {
+			return err
 		}
 
 		response.Subnets = append(response.Subnets, APISubnet{
 			ID:          subnetID,
-			ControlKeys: controlAddrs,
-			Threshold:   json.Uint32(owner.Threshold),
+			ControlKeys: owner.Addresses,
+			Threshold:   owner.Threshold,
+			Weights:     owner.Weights,
 		})
 	}
 	return nil
@@ -841,16 +860,14 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 				validationRewardOwner *omegaapi.Owner
 				delegationRewardOwner *omegaapi.Owner
 			)
-			validationOwner, ok := attr.validationRewardsOwner.(*secp256k1fx.OutputOwners)
-			if ok {
-				validationRewardOwner, err = s.getAPIOwner(validationOwner)
+			if attr.validationRewardsOwner != nil {
+				validationRewardOwner, err = s.getAPIOwner(attr.validationRewardsOwner)
 				if err != nil {
 					return err
 				}
 			}
-			delegationOwner, ok := attr.delegationRewardsOwner.(*secp256k1fx.OutputOwners)
-			if ok {
-				delegationRewardOwner, err = s.getAPIOwner(delegationOwner)
+			if attr.delegationRewardsOwner != nil {
+				delegationRewardOwner, err = s.getAPIOwner(attr.delegationRewardsOwner)
 				if err != nil {
 					return err
 				}
@@ -879,9 +896,8 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 				if err != nil {
 					return err
 				}
-				owner, ok := attr.rewardsOwner.(*secp256k1fx.OutputOwners)
-				if ok {
-					rewardOwner, err = s.getAPIOwner(owner)
+				if attr.rewardsOwner != nil {
+					rewardOwner, err = s.getAPIOwner(attr.rewardsOwner)
 					if err != nil {
 						return err
 					}
@@ -1097,6 +1113,682 @@ func (s *Service) GetCurrentSupply(r *http.Request, args *GetCurrentSupplyArgs,
 	return nil
 }
 
+// GetSupplyBreakdownArgs are the arguments for calling GetSupplyBreakdown
+type GetSupplyBreakdownArgs struct {
+	// Height to get the breakdown at. Defaults to the last accepted height.
+	Height *json.Uint64 `json:"height,omitempty"`
+}
+
+// GetSupplyBreakdownReply is the response from calling GetSupplyBreakdown
+type GetSupplyBreakdownReply struct {
+	// Height of the accepted block the breakdown is given at
+	Height json.Uint64 `json:"height"`
+	// Supply of DIONE on the primary network
+	Supply json.Uint64 `json:"supply"`
+	// Rewards minted into the supply
+	Minted json.Uint64 `json:"minted"`
+	// Fees that were burned rather than distributed to the stakers
+	Burned json.Uint64 `json:"burned"`
+	// DIONE staked by the current and pending primary network stakers
+	Staked json.Uint64 `json:"staked"`
+	// DIONE held by UTXOs that are locked until a future time
+	Locked json.Uint64 `json:"locked"`
+	// Rewards accrued so far by the current primary network stakers
+	PendingRewards json.Uint64 `json:"pendingRewards"`
+}
+
+// GetSupplyBreakdown returns how the DIONE supply of the primary network is
+// split after the acceptance of the block at the requested height.
+//
+// The breakdown is recorded by the state as blocks are accepted, so heights
+// accepted before this node recorded breakdowns aren't supported.
+func (s *Service) GetSupplyBreakdown(r *http.Request, args *GetSupplyBreakdownArgs, reply *GetSupplyBreakdownReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getSupplyBreakdown"),
+	)
+
+	height, err := s.vm.GetCurrentHeight(r.Context())
+	if err != nil {
+		return fmt.Errorf("fetching current height failed: %w", err)
+	}
+	if args.Height != nil {
+		if uint64(*args.Height) > height {
+			return fmt.Errorf("%w: requested height %d but last accepted height is %d",
+				errNoSupplyBreakdown,
+				*args.Height,
+				height,
+			)
+		}
+		height = uint64(*args.Height)
+	}
+
+	breakdown, err := s.vm.state.GetSupplyBreakdown(height)
+	if err == database.ErrNotFound {
+		return fmt.Errorf("%w: no breakdown was recorded at height %d",
+			errNoSupplyBreakdown,
+			height,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("fetching supply breakdown failed: %w", err)
+	}
+
+	reply.Height = json.Uint64(height)
+	reply.Supply = json.Uint64(breakdown.Supply)
+	reply.Minted = json.Uint64(breakdown.Minted)
+	reply.Burned = json.Uint64(breakdown.Burned)
+	reply.Staked = json.Uint64(breakdown.Staked)
+	reply.Locked = json.Uint64(breakdown.Locked)
+	reply.PendingRewards = json.Uint64(breakdown.PendingRewards)
+	return nil
+}
+
+// GetFeePoolsReply is the response from calling GetFeePools
+type GetFeePoolsReply struct {
+	// Fees collected on the A-chain and D-chain that haven't been
+	// distributed to stakers yet
+	AChainFee json.Uint64 `json:"aChainFee"`
+	DChainFee json.Uint64 `json:"dChainFee"`
+
+	// Reward that stakers didn't receive, e.g. because of insufficient uptime
+	UndistributedReward json.Uint64 `json:"undistributedReward"`
+
+	// Fees recorded by the last accepted block that synced the fee pools.
+	// [LastSyncedHeight] is 0 if the fee pools were never synced.
+	LastSyncedAChainFee json.Uint64 `json:"lastSyncedAChainFee"`
+	LastSyncedDChainFee json.Uint64 `json:"lastSyncedDChainFee"`
+	LastSyncedHeight    json.Uint64 `json:"lastSyncedHeight"`
+
+	Height json.Uint64 `json:"height"`
+}
+
+// GetFeePools returns the current balances of the fee collector along with
+// the values that were last synced into an accepted block
+func (s *Service) GetFeePools(r *http.Request, _ *struct{}, reply *GetFeePoolsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getFeePools"),
+	)
+
+	feeCollector := s.vm.ctx.FeeCollector
+	reply.AChainFee = json.Uint64(feeCollector.GetAChainValue())
+	reply.DChainFee = json.Uint64(feeCollector.GetDChainValue())
+	reply.UndistributedReward = json.Uint64(feeCollector.GetURewardValue())
+
+	lastSync, err := s.vm.state.GetLastFeeSync()
+	switch err {
+	case nil:
+		reply.LastSyncedAChainFee = json.Uint64(lastSync.AChainFee)
+		reply.LastSyncedDChainFee = json.Uint64(lastSync.DChainFee)
+		reply.LastSyncedHeight = json.Uint64(lastSync.Height)
+	case database.ErrNotFound:
+		// The fee sync is only recorded by blocks accepted after it was
+		// introduced, so older blocks are looked through.
+		err = s.walkAcceptedBlocks(func(blk blocks.Block) bool {
+			feeFromAChain := blk.FeeFromAChain()
+			feeFromDChain := blk.FeeFromDChain()
+			if feeFromAChain == 0 && feeFromDChain == 0 {
+				return true
+			}
+			reply.LastSyncedAChainFee = json.Uint64(feeFromAChain)
+			reply.LastSyncedDChainFee = json.Uint64(feeFromDChain)
+			reply.LastSyncedHeight = json.Uint64(blk.Height())
+			return false
+		})
+		if err != nil {
+			return fmt.Errorf("couldn't find last fee sync: %w", err)
+		}
+	default:
+		return fmt.Errorf("couldn't get last fee sync: %w", err)
+	}
+
+	ctx := r.Context()
+	height, err := s.vm.GetCurrentHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching current height failed: %w", err)
+	}
+	reply.Height = json.Uint64(height)
+	return nil
+}
+
+// GetOrionFeeArgs are the arguments for calling GetOrionFee
+type GetOrionFeeArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+}
+
+// GetOrionFeeReply is the response from calling GetOrionFee
+type GetOrionFeeReply struct {
+	// Orion fee accrued by [NodeID] that hasn't been paid out yet
+	Fee json.Uint64 `json:"fee"`
+
+	// Orion fee paid out to [NodeID] by the last accepted RewardValidatorTx.
+	// [LastSyncedHeight] is 0 if no orion fee was ever paid out to [NodeID].
+	LastSyncedFee    json.Uint64 `json:"lastSyncedFee"`
+	LastSyncedHeight json.Uint64 `json:"lastSyncedHeight"`
+
+	Height json.Uint64 `json:"height"`
+}
+
+// GetOrionFee returns the orion fee accrued by the provided node along with
+// the value that was last paid out to it in an accepted block
+func (s *Service) GetOrionFee(r *http.Request, args *GetOrionFeeArgs, reply *GetOrionFeeReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getOrionFee"),
+		zap.Stringer("nodeID", args.NodeID),
+	)
+
+	if args.NodeID == ids.EmptyNodeID {
+		return errNoNodeID
+	}
+
+	reply.Fee = json.Uint64(s.vm.ctx.FeeCollector.GetOrionValue(args.NodeID))
+
+	lastPayout, err := s.vm.state.GetLastOrionFeePayout(args.NodeID)
+	switch err {
+	case nil:
+		reply.LastSyncedFee = json.Uint64(lastPayout.Fee)
+		reply.LastSyncedHeight = json.Uint64(lastPayout.Height)
+	case database.ErrNotFound:
+		// The payout is only recorded by blocks accepted after it was
+		// introduced, so older blocks are looked through.
+		if err := s.findLastOrionFeePayout(args.NodeID, reply); err != nil {
+			return fmt.Errorf("couldn't find last orion fee payout: %w", err)
+		}
+	default:
+		return fmt.Errorf("couldn't get last orion fee payout: %w", err)
+	}
+
+	ctx := r.Context()
+	height, err := s.vm.GetCurrentHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching current height failed: %w", err)
+	}
+	reply.Height = json.Uint64(height)
+	return nil
+}
+
+// findLastOrionFeePayout looks through the accepted blocks for the last
+// RewardValidatorTx that paid out an orion fee to [nodeID].
+func (s *Service) findLastOrionFeePayout(nodeID ids.NodeID, reply *GetOrionFeeReply) error {
+	var walkErr error
+	err := s.walkAcceptedBlocks(func(blk blocks.Block) bool {
+		for _, tx := range blk.Txs() {
+			rewardValidatorTx, ok := tx.Unsigned.(*txs.RewardValidatorTx)
+			if !ok || rewardValidatorTx.OrionFee == 0 {
+				continue
+			}
+
+			stakerTx, _, err := s.vm.state.GetTx(rewardValidatorTx.TxID)
+			if err != nil {
+				walkErr = err
+				return false
+			}
+			stakerNodeID, ok := executor.OrionFeeNodeID(stakerTx.Unsigned)
+			if !ok || stakerNodeID != nodeID {
+				continue
+			}
+
+			reply.LastSyncedFee = json.Uint64(rewardValidatorTx.OrionFee)
+			reply.LastSyncedHeight = json.Uint64(blk.Height())
+			return false
+		}
+		return true
+	})
+	if walkErr != nil {
+		return walkErr
+	}
+	return err
+}
+
+// walkAcceptedBlocks calls [f] on accepted blocks starting from the last
+// accepted block and going backwards, until [f] returns false or the genesis
+// block is reached. Returns [errLookbackExceeded] if [maxFeeSyncLookback]
+// blocks were visited before that.
+func (s *Service) walkAcceptedBlocks(f func(blocks.Block) bool) error {
+	blkID := s.vm.state.GetLastAccepted()
+	for i := 0; i < maxFeeSyncLookback; i++ {
+		blk, err := s.vm.manager.GetStatelessBlock(blkID)
+		if err != nil {
+			return err
+		}
+		if !f(blk) || blk.Height() == 0 {
+			return nil
+		}
+		blkID = blk.Parent()
+	}
+	return errLookbackExceeded
+}
+
+// GetRewardHistoryArgs are the arguments for calling GetRewardHistory
+type GetRewardHistoryArgs struct {
+	StartHeight json.Uint64 `json:"startHeight"`
+	EndHeight   json.Uint64 `json:"endHeight"`
+}
+
+// APIBlockRewards are the fees collected and the rewards decided by a block
+type APIBlockRewards struct {
+	Height    json.Uint64 `json:"height"`
+	BlockID   ids.ID      `json:"blockID"`
+	Timestamp json.Uint64 `json:"timestamp"`
+
+	// Fees moved from the A-chain and D-chain fee pools by the block
+	AChainFee json.Uint64 `json:"aChainFee"`
+	DChainFee json.Uint64 `json:"dChainFee"`
+
+	// Rewards paid out to stakers by the block
+	MintReward json.Uint64 `json:"mintReward"`
+	FeeReward  json.Uint64 `json:"feeReward"`
+	OrionFee   json.Uint64 `json:"orionFee"`
+
+	// Reward moved to the undistributed reward pool by the block
+	UndistributedReward json.Uint64 `json:"undistributedReward"`
+}
+
+// GetRewardHistoryReply is the response from calling GetRewardHistory
+type GetRewardHistoryReply struct {
+	// Blocks in the requested range that collected fees or decided a reward
+	Blocks []APIBlockRewards `json:"blocks"`
+
+	// Height of the first indexed block. Blocks below it are not indexed.
+	IndexStartHeight json.Uint64 `json:"indexStartHeight"`
+}
+
+// GetRewardHistory returns the fees collected and the rewards decided by the
+// accepted blocks in [StartHeight, EndHeight]
+func (s *Service) GetRewardHistory(_ *http.Request, args *GetRewardHistoryArgs, reply *GetRewardHistoryReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getRewardHistory"),
+		zap.Uint64("startHeight", uint64(args.StartHeight)),
+		zap.Uint64("endHeight", uint64(args.EndHeight)),
+	)
+
+	startHeight := uint64(args.StartHeight)
+	endHeight := uint64(args.EndHeight)
+	switch {
+	case startHeight > endHeight:
+		return errStartAfterEndHeight
+	case endHeight-startHeight >= maxRewardHistoryRange:
+		return fmt.Errorf("requested range exceeds the maximum of %d blocks", maxRewardHistoryRange)
+	}
+
+	indexStartHeight, err := s.vm.rewardIndexer.StartHeight()
+	if err == database.ErrNotFound {
+		// No block was indexed yet.
+		reply.Blocks = []APIBlockRewards{}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't get reward history: %w", err)
+	}
+	reply.IndexStartHeight = json.Uint64(indexStartHeight)
+
+	blockRewards, err := s.vm.rewardIndexer.GetBlockRewards(startHeight, endHeight)
+	if err != nil {
+		return fmt.Errorf("couldn't get reward history: %w", err)
+	}
+
+	reply.Blocks = make([]APIBlockRewards, len(blockRewards))
+	for i, rewards := range blockRewards {
+		reply.Blocks[i] = APIBlockRewards{
+			Height:              json.Uint64(rewards.Height),
+			BlockID:             rewards.BlockID,
+			Timestamp:           json.Uint64(rewards.Timestamp),
+			AChainFee:           json.Uint64(rewards.AChainFee),
+			DChainFee:           json.Uint64(rewards.DChainFee),
+			MintReward:          json.Uint64(rewards.MintReward),
+			FeeReward:           json.Uint64(rewards.FeeReward),
+			OrionFee:            json.Uint64(rewards.OrionFee),
+			UndistributedReward: json.Uint64(rewards.UndistributedReward),
+		}
+	}
+	return nil
+}
+
+// GetStakerRewardBreakdownReply is the response from calling
+// GetStakerRewardBreakdown
+type GetStakerRewardBreakdownReply struct {
+	NodeID   ids.NodeID `json:"nodeID"`
+	SubnetID ids.ID     `json:"subnetID"`
+
+	// Block that decided the reward
+	Height  json.Uint64 `json:"height"`
+	BlockID ids.ID      `json:"blockID"`
+
+	// True if the reward was paid out to the staker
+	Rewarded bool `json:"rewarded"`
+
+	// Components of the staker's reward. For delegators, this is the reward
+	// before it is split with the validator.
+	MintReward json.Uint64 `json:"mintReward"`
+	FeeReward  json.Uint64 `json:"feeReward"`
+	OrionFee   json.Uint64 `json:"orionFee"`
+
+	// For delegators, the shares of the validator and the part of the reward
+	// paid to it
+	DelegationShares json.Uint32 `json:"delegationShares"`
+	DelegationFee    json.Uint64 `json:"delegationFee"`
+
+	// For validators, the reward accrued from delegations
+	DelegateeReward json.Uint64 `json:"delegateeReward"`
+
+	// Reward paid to the staker after the delegation fee is split
+	Total json.Uint64 `json:"total"`
+}
+
+// GetStakerRewardBreakdown returns the components of the reward decided for
+// the staker added by the provided transaction
+func (s *Service) GetStakerRewardBreakdown(_ *http.Request, args *api.GetTxArgs, reply *GetStakerRewardBreakdownReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getStakerRewardBreakdown"),
+		zap.Stringer("txID", args.TxID),
+	)
+
+	stakerReward, err := s.vm.rewardIndexer.GetStakerReward(args.TxID)
+	if err != nil {
+		return fmt.Errorf("couldn't get reward of staker %s: %w", args.TxID, err)
+	}
+
+	reply.NodeID = stakerReward.NodeID
+	reply.SubnetID = stakerReward.SubnetID
+	reply.Height = json.Uint64(stakerReward.Height)
+	reply.BlockID = stakerReward.BlockID
+	reply.Rewarded = stakerReward.Rewarded
+	reply.MintReward = json.Uint64(stakerReward.MintReward)
+	reply.FeeReward = json.Uint64(stakerReward.FeeReward)
+	reply.OrionFee = json.Uint64(stakerReward.OrionFee)
+	reply.DelegationShares = json.Uint32(stakerReward.DelegationShares)
+	reply.DelegationFee = json.Uint64(stakerReward.DelegationFee)
+	reply.DelegateeReward = json.Uint64(stakerReward.DelegateeReward)
+	reply.Total = json.Uint64(stakerReward.MintReward + stakerReward.FeeReward + stakerReward.OrionFee +
+		stakerReward.DelegateeReward - stakerReward.DelegationFee)
+	return nil
+}
+
+// GetUndistributedRewardArgs are the arguments for calling
+// GetUndistributedReward
+type GetUndistributedRewardArgs struct {
+	// Range of heights to return the recycling events of
+	StartHeight json.Uint64 `json:"startHeight"`
+	EndHeight   json.Uint64 `json:"endHeight"`
+}
+
+// APIRecycleEvent is the recycling of the undistributed reward pool by a block
+type APIRecycleEvent struct {
+	Height    json.Uint64 `json:"height"`
+	BlockID   ids.ID      `json:"blockID"`
+	Timestamp json.Uint64 `json:"timestamp"`
+	Policy    string      `json:"policy"`
+	Amount    json.Uint64 `json:"amount"`
+}
+
+// GetUndistributedRewardReply is the response from calling
+// GetUndistributedReward
+type GetUndistributedRewardReply struct {
+	// Balance of the undistributed reward pool
+	Balance json.Uint64 `json:"balance"`
+
+	// Recycle policy of this node and its interval in seconds
+	Policy   string      `json:"policy"`
+	Interval json.Uint64 `json:"interval"`
+
+	// Recycling events in the requested range. Only populated if reward
+	// indexing is enabled.
+	Events []APIRecycleEvent `json:"events,omitempty"`
+}
+
+// GetUndistributedReward returns the balance of the undistributed reward pool
+// and the recycling events of the accepted blocks in [StartHeight, EndHeight]
+func (s *Service) GetUndistributedReward(_ *http.Request, args *GetUndistributedRewardArgs, reply *GetUndistributedRewardReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getUndistributedReward"),
+		zap.Uint64("startHeight", uint64(args.StartHeight)),
+		zap.Uint64("endHeight", uint64(args.EndHeight)),
+	)
+
+	startHeight := uint64(args.StartHeight)
+	endHeight := uint64(args.EndHeight)
+	switch {
+	case startHeight > endHeight:
+		return errStartAfterEndHeight
+	case endHeight-startHeight >= maxRewardHistoryRange:
+		return fmt.Errorf("requested range exceeds the maximum of %d blocks", maxRewardHistoryRange)
+	}
+
+	reply.Balance = json.Uint64(s.vm.ctx.FeeCollector.GetURewardValue())
+	reply.Policy = string(s.vm.URewardRecycleConfig.Policy)
+	reply.Interval = json.Uint64(s.vm.URewardRecycleConfig.Interval / time.Second)
+
+	events, err := s.vm.rewardIndexer.GetRecycleEvents(startHeight, endHeight)
+	if err == index.ErrIndexingDisabled {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't get recycling events: %w", err)
+	}
+
+	reply.Events = make([]APIRecycleEvent, len(events))
+	for i, event := range events {
+		reply.Events[i] = APIRecycleEvent{
+			Height:    json.Uint64(event.Height),
+			BlockID:   event.BlockID,
+			Timestamp: json.Uint64(event.Timestamp),
+			Policy:    event.Policy,
+			Amount:    json.Uint64(event.Amount),
+		}
+	}
+	return nil
+}
+
+// EstimateRewardArgs are the arguments for calling EstimateReward
+type EstimateRewardArgs struct {
+	// Amount of DIONE that would be staked
+	Amount json.Uint64 `json:"amount"`
+
+	// Unix timestamps of the staking period
+	StartTime json.Uint64 `json:"startTime"`
+	EndTime   json.Uint64 `json:"endTime"`
+
+	// Node to delegate to. If omitted, the estimate is for a new validator.
+	NodeID ids.NodeID `json:"nodeID"`
+
+	// Delegation fee rate of the validator, in percent, when delegating. If
+	// [NodeID] is a current primary network validator, its delegation fee rate
+	// is used instead.
+	DelegationFeeRate json.Float32 `json:"delegationFeeRate"`
+}
+
+// EstimateRewardReply is the response from calling EstimateReward
+type EstimateRewardReply struct {
+	// Projected components of the reward
+	MintReward json.Uint64 `json:"mintReward"`
+	FeeReward  json.Uint64 `json:"feeReward"`
+
+	// Part of the reward that would be paid to the validator as a delegation
+	// fee
+	DelegationFee json.Uint64 `json:"delegationFee"`
+
+	// Reward that would be paid to the staker
+	Reward json.Uint64 `json:"reward"`
+
+	// Inputs of the projection. The mint parameters are the ones in effect at
+	// the start of the staking period.
+	TotalWeight       json.Uint64  `json:"totalWeight"`
+	DelegationFeeRate json.Float32 `json:"delegationFeeRate"`
+	FeeIntake         json.Uint64  `json:"feeIntake"`
+	FeeIntakePeriod   json.Uint64  `json:"feeIntakePeriod"`
+	MintRate          json.Uint64  `json:"mintRate"`
+	MintingPeriod     json.Uint64  `json:"mintingPeriod"`
+	MaxMintAmount     json.Uint64  `json:"maxMintAmount"`
+
+	// Assumptions the projection relies on
+	Assumptions []string `json:"assumptions"`
+}
+
+// EstimateReward returns the projected reward of a primary network staker
+// using the current mint config, primary network weight and recent fee intake
+func (s *Service) EstimateReward(_ *http.Request, args *EstimateRewardArgs, reply *EstimateRewardReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "estimateReward"),
+		zap.Uint64("amount", uint64(args.Amount)),
+		zap.Stringer("nodeID", args.NodeID),
+	)
+
+	switch {
+	case args.Amount == 0:
+		return errNoAmount
+	case args.StartTime >= args.EndTime:
+		return errStartAfterEndTime
+	case args.DelegationFeeRate < 0 || args.DelegationFeeRate > 100:
+		return errInvalidDelegationRate
+	}
+
+	startTime := time.Unix(int64(args.StartTime), 0)
+	endTime := time.Unix(int64(args.EndTime), 0)
+	delegating := args.NodeID != ids.EmptyNodeID
+	shares := uint64(10000 * args.DelegationFeeRate)
+	if delegating {
+		vdr, err := s.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, args.NodeID)
+		switch {
+		case err == nil:
+			if endTime.After(vdr.EndTime) {
+				return errDelegationEndsAfterVdr
+			}
+			attr, err := s.loadStakerTxAttributes(vdr.TxID)
+			if err != nil {
+				return err
+			}
+			shares = uint64(attr.shares)
+		case err != database.ErrNotFound:
+			return fmt.Errorf("couldn't get validator %s: %w", args.NodeID, err)
+		}
+	}
+
+	vdrs, ok := s.vm.Validators.Get(constants.PrimaryNetworkID)
+	if !ok {
+		return errMissingValidatorSet
+	}
+	totalWeight, err := math.Add64(vdrs.Weight(), uint64(args.Amount))
+	if err != nil {
+		return err
+	}
+
+	mintReward := uint64(0)
+	if s.vm.MintSchedule.IsSet() {
+		mintRate := s.vm.mintCalculator.CalculateMintRate(totalWeight, startTime, endTime)
+		mintReward = reward.CalculateMintReward(uint64(args.Amount), new(big.Int), mintRate)
+	}
+
+	feeIntake, feeIntakePeriod, err := s.getRecentFeeIntake()
+	if err != nil {
+		return fmt.Errorf("couldn't measure recent fee intake: %w", err)
+	}
+	feeReward := uint64(0)
+	if feeIntakePeriod > 0 {
+		// Fees are assumed to keep accumulating at the recent rate and to be
+		// split among the stakers by weight.
+		projectedFees := new(big.Int).SetUint64(feeIntake)
+		projectedFees.Mul(projectedFees, new(big.Int).SetUint64(uint64(endTime.Sub(startTime))))
+		projectedFees.Div(projectedFees, new(big.Int).SetUint64(uint64(feeIntakePeriod)))
+
+		feePerWeight := projectedFees.Lsh(projectedFees, reward.BitShift)
+		feePerWeight.Div(feePerWeight, new(big.Int).SetUint64(totalWeight))
+		feeReward = reward.CalculateFeeReward(feePerWeight, uint64(args.Amount), new(big.Int))
+	}
+
+	totalReward, err := math.Add64(mintReward, feeReward)
+	if err != nil {
+		return err
+	}
+	delegationFee := uint64(0)
+	if delegating {
+		delegatorShares := reward.PercentDenominator - shares
+		delegatorReward := delegatorShares * (totalReward / reward.PercentDenominator)
+		if optimisticReward, err := math.Mul64(delegatorShares, totalReward); err == nil {
+			delegatorReward = optimisticReward / reward.PercentDenominator
+		}
+		delegationFee = totalReward - delegatorReward
+		reply.DelegationFeeRate = json.Float32(float32(shares) / 10000)
+	}
+
+	reply.MintReward = json.Uint64(mintReward)
+	reply.FeeReward = json.Uint64(feeReward)
+	reply.DelegationFee = json.Uint64(delegationFee)
+	reply.Reward = json.Uint64(totalReward - delegationFee)
+	reply.TotalWeight = json.Uint64(totalWeight)
+	reply.FeeIntake = json.Uint64(feeIntake)
+	reply.FeeIntakePeriod = json.Uint64(feeIntakePeriod / time.Second)
+	mintConfig := s.vm.MintSchedule.ConfigAt(startTime)
+	reply.MintRate = json.Uint64(mintConfig.MintRate)
+	reply.MintingPeriod = json.Uint64(mintConfig.MintingPeriod / time.Second)
+	reply.MaxMintAmount = json.Uint64(mintConfig.MaxMintAmount)
+	reply.Assumptions = []string{
+		"the primary network weight stays at totalWeight, which includes the staked amount, for the whole staking period",
+		fmt.Sprintf("fees keep accumulating at the rate of feeIntake per feeIntakePeriod seconds, measured over at most the last %s of accepted blocks", feeIntakeWindow),
+		"the mint schedule doesn't change during the staking period",
+		"the staker meets the uptime requirement and is rewarded",
+	}
+	if delegating {
+		reply.Assumptions = append(reply.Assumptions, "the validator's delegation fee rate is delegationFeeRate")
+	}
+	return nil
+}
+
+// getRecentFeeIntake returns the fees accumulated for stakers by the accepted
+// blocks over, at most, the last [feeIntakeWindow] and the period it was
+// accumulated over.
+func (s *Service) getRecentFeeIntake() (uint64, time.Duration, error) {
+	lastAccepted, err := s.vm.manager.GetStatelessBlock(s.vm.state.GetLastAccepted())
+	if err != nil {
+		return 0, 0, err
+	}
+	lastAcceptedBanff, ok := lastAccepted.(blocks.BanffBlock)
+	if !ok {
+		// Blocks before Banff don't have a timestamp.
+		return 0, 0, nil
+	}
+	lastAcceptedTime := lastAcceptedBanff.Timestamp()
+	windowStart := lastAcceptedTime.Add(-feeIntakeWindow)
+
+	var (
+		feeIntake   uint64
+		pendingFee  uint64
+		periodStart = lastAcceptedTime
+		walkErr     error
+	)
+	err = s.walkAcceptedBlocks(func(blk blocks.Block) bool {
+		banffBlk, ok := blk.(blocks.BanffBlock)
+		if !ok {
+			return false
+		}
+
+		// The fees of a block are accumulated in the period that ends with
+		// it, so they are only counted once the start of that period is
+		// known.
+		periodStart = banffBlk.Timestamp()
+		feeIntake, walkErr = math.Add64(feeIntake, pendingFee)
+		if walkErr != nil {
+			return false
+		}
+		pendingFee, _ = blockexecutor.SplitAccumulatedFee(&s.vm.Config, banffBlk, s.vm.ctx.DIONEAssetID)
+		return !periodStart.Before(windowStart)
+	})
+	if walkErr != nil {
+		err = walkErr
+	}
+	// The fee intake is measured over a shorter period if the window doesn't
+	// fit in the lookback.
+	if err != nil && err != errLookbackExceeded {
+		return 0, 0, err
+	}
+	return feeIntake, lastAcceptedTime.Sub(periodStart), nil
+}
+
 // SampleValidatorsArgs are the arguments for calling SampleValidators
 type SampleValidatorsArgs struct {
 	// Number of validators in the sample
@@ -1647,6 +2339,162 @@ func (s *Service) ExportDIONE(_ *http.Request, args *ExportDIONEArgs, response *
 	return errs.Err
 }
 
+// SendArgs are the arguments to Send
+type SendArgs struct {
+	// User, password, from addrs, change addr
+	api.JSONSpendHeader
+
+	// Amount of DIONE to send
+	Amount json.Uint64 `json:"amount"`
+
+	// O-Chain address that will receive the DIONE
+	To string `json:"to"`
+}
+
+// Send issues a BaseTx transferring DIONE to another O-Chain address
+func (s *Service) Send(_ *http.Request, args *SendArgs, response *api.JSONTxIDChangeAddr) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "send"),
+	)
+
+	if args.Amount == 0 {
+		return errNoAmount
+	}
+
+	to, err := dione.ParseServiceAddress(s.addrManager, args.To)
+	if err != nil {
+		return fmt.Errorf("couldn't parse to address: %w", err)
+	}
+
+	// Parse the from addresses
+	fromAddrs, err := dione.ParseServiceAddresses(s.addrManager, args.From)
+	if err != nil {
+		return err
+	}
+
+	user, err := keystore.NewUserFromKeystore(s.vm.ctx.Keystore, args.Username, args.Password)
+	if err != nil {
+		return err
+	}
+	defer user.Close()
+
+	privKeys, err := keystore.GetKeychain(user, fromAddrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
+	}
+
+	// Parse the change address. Assumes that if the user has no keys,
+	// this operation will fail so the change address can be anything.
+	if len(privKeys.Keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr := privKeys.Keys[0].PublicKey().Address() // By default, use a key controlled by the user
+	if args.ChangeAddr != "" {
+		changeAddr, err = dione.ParseServiceAddress(s.addrManager, args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewBaseTx(
+		uint64(args.Amount), // Amount
+		to,                  // Address
+		privKeys.Keys,       // Private keys
+		changeAddr,          // Change address
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	response.TxID = tx.ID()
+	response.ChangeAddr, err = s.addrManager.FormatLocalAddress(changeAddr)
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		err,
+		s.vm.Builder.AddUnverifiedTx(tx),
+		user.Close(),
+	)
+	return errs.Err
+}
+
+// CancelPendingStakerArgs are the arguments to CancelPendingStaker
+type CancelPendingStakerArgs struct {
+	// User, password, from addrs, change addr
+	api.JSONSpendHeader
+
+	// ID of the tx that added the pending validator or delegator
+	TxID ids.ID `json:"txID"`
+}
+
+// CancelPendingStaker issues a CancelPendingStakerTx removing a pending
+// validator or delegator before its start time and refunding its stake. The
+// user must control the owner of the stake of the staker.
+func (s *Service) CancelPendingStaker(_ *http.Request, args *CancelPendingStakerArgs, response *api.JSONTxIDChangeAddr) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "cancelPendingStaker"),
+		zap.Stringer("txID", args.TxID),
+	)
+
+	if args.TxID == ids.Empty {
+		return errNoTxID
+	}
+
+	// Parse the from addresses
+	fromAddrs, err := dione.ParseServiceAddresses(s.addrManager, args.From)
+	if err != nil {
+		return err
+	}
+
+	user, err := keystore.NewUserFromKeystore(s.vm.ctx.Keystore, args.Username, args.Password)
+	if err != nil {
+		return err
+	}
+	defer user.Close()
+
+	privKeys, err := keystore.GetKeychain(user, fromAddrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
+	}
+
+	// Parse the change address. Assumes that if the user has no keys,
+	// this operation will fail so the change address can be anything.
+	if len(privKeys.Keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr := privKeys.Keys[0].PublicKey().Address() // By default, use a key controlled by the user
+	if args.ChangeAddr != "" {
+		changeAddr, err = dione.ParseServiceAddress(s.addrManager, args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewCancelPendingStakerTx(
+		args.TxID,     // Staker tx ID
+		privKeys.Keys, // Private keys
+		changeAddr,    // Change address
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	response.TxID = tx.ID()
+	response.ChangeAddr, err = s.addrManager.FormatLocalAddress(changeAddr)
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		err,
+		s.vm.Builder.AddUnverifiedTx(tx),
+		user.Close(),
+	)
+	return errs.Err
+}
+
 // ImportDIONEArgs are the arguments to ImportDIONE
 type ImportDIONEArgs struct {
 	// User, password, from addrs, change addr
@@ -2534,6 +3382,185 @@ func (s *Service) GetTimestamp(_ *http.Request, _ *struct{}, reply *GetTimestamp
 	return nil
 }
 
+// GetFeeRatesReply is the response from GetFeeRates
+type GetFeeRatesReply struct {
+	// Rate, in parts of 1000000, the static fees are charged at
+	FeeRate                       json.Uint64 `json:"feeRate"`
+	TxFee                         json.Uint64 `json:"txFee"`
+	CreateSubnetTxFee             json.Uint64 `json:"createSubnetTxFee"`
+	TransformSubnetTxFee          json.Uint64 `json:"transformSubnetTxFee"`
+	CreateBlockchainTxFee         json.Uint64 `json:"createBlockchainTxFee"`
+	AddPrimaryNetworkValidatorFee json.Uint64 `json:"addPrimaryNetworkValidatorFee"`
+	AddPrimaryNetworkDelegatorFee json.Uint64 `json:"addPrimaryNetworkDelegatorFee"`
+	AddSubnetValidatorFee         json.Uint64 `json:"addSubnetValidatorFee"`
+	AddSubnetDelegatorFee         json.Uint64 `json:"addSubnetDelegatorFee"`
+}
+
+// GetFeeRates returns the fees charged to the txs of the next block.
+func (s *Service) GetFeeRates(_ *http.Request, _ *struct{}, reply *GetFeeRatesReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getFeeRates"),
+	)
+
+	timestamp := s.vm.state.GetTimestamp()
+	feeRate := s.vm.Config.GetFeeRate(s.vm.state.GetFeeRate(), timestamp)
+	dynamicFee := func(fee uint64) json.Uint64 {
+		return json.Uint64(fees.CalculateFee(fee, feeRate))
+	}
+
+	reply.FeeRate = json.Uint64(feeRate)
+	reply.TxFee = dynamicFee(s.vm.TxFee)
+	reply.CreateSubnetTxFee = dynamicFee(s.vm.GetCreateSubnetTxFee(timestamp))
+	reply.TransformSubnetTxFee = dynamicFee(s.vm.TransformSubnetTxFee)
+	reply.CreateBlockchainTxFee = dynamicFee(s.vm.GetCreateBlockchainTxFee(timestamp))
+	reply.AddPrimaryNetworkValidatorFee = dynamicFee(s.vm.AddPrimaryNetworkValidatorFee)
+	reply.AddPrimaryNetworkDelegatorFee = dynamicFee(s.vm.AddPrimaryNetworkDelegatorFee)
+	reply.AddSubnetValidatorFee = dynamicFee(s.vm.AddSubnetValidatorFee)
+	reply.AddSubnetDelegatorFee = dynamicFee(s.vm.AddSubnetDelegatorFee)
+	return nil
+}
+
+// GetValidatorUptimeScoreArgs are the arguments for calling
+// GetValidatorUptimeScore
+type GetValidatorUptimeScoreArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+}
+
+// GetValidatorUptimeScoreReply is the response from calling
+// GetValidatorUptimeScore
+type GetValidatorUptimeScoreReply struct {
+	// ID of the tx that added the validator
+	ValidatorTxID ids.ID `json:"validatorTxID"`
+	// Attested uptime, as a percentage (0-100), of the staking period of the
+	// validator so far
+	Uptime json.Float32 `json:"uptime"`
+	// Number of seconds the validator was attested to be up
+	UpDuration json.Uint64 `json:"upDuration"`
+	// Unix time at which the up duration was last increased
+	LastUpdated json.Uint64 `json:"lastUpdated"`
+}
+
+// GetValidatorUptimeScore returns the uptime of a current primary network
+// validator, as attested on chain by a quorum of the stake of the primary
+// network.
+func (s *Service) GetValidatorUptimeScore(_ *http.Request, args *GetValidatorUptimeScoreArgs, reply *GetValidatorUptimeScoreReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getValidatorUptimeScore"),
+		logging.UserString("nodeID", args.NodeID.String()),
+	)
+
+	if args.NodeID == ids.EmptyNodeID {
+		return errNoNodeID
+	}
+
+	validator, err := s.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, args.NodeID)
+	if err != nil {
+		return fmt.Errorf("failed to get validator %s: %w", args.NodeID, err)
+	}
+	score, err := s.vm.state.GetUptimeScore(args.NodeID)
+	if err == database.ErrNotFound || (err == nil && score.ValidatorTxID != validator.TxID) {
+		return fmt.Errorf("%s: %w", args.NodeID, errNoUptimeScore)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get uptime score of %s: %w", args.NodeID, err)
+	}
+
+	// Transform this to a percentage (0-100) to make it consistent with the
+	// uptimes reported by getCurrentValidators
+	uptime := score.Uptime(validator, s.vm.state.GetTimestamp())
+	reply.ValidatorTxID = score.ValidatorTxID
+	reply.Uptime = json.Float32(uptime * 100)
+	reply.UpDuration = json.Uint64(score.UpDuration / time.Second)
+	reply.LastUpdated = json.Uint64(score.LastUpdated.Unix())
+	return nil
+}
+
+// APIValidatorUptime is the uptime of a primary network validator to attest
+type APIValidatorUptime struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// ID of the tx that added the validator
+	ValidatorTxID ids.ID `json:"validatorTxID"`
+	// Number of seconds the validator was up since the start of its staking
+	// period
+	UpDuration json.Uint64 `json:"upDuration"`
+}
+
+// SignUptimeAttestationArgs are the arguments for calling
+// SignUptimeAttestation
+type SignUptimeAttestationArgs struct {
+	Uptimes  []APIValidatorUptime `json:"uptimes"`
+	Encoding formatting.Encoding  `json:"encoding"`
+}
+
+// SignUptimeAttestationReply is the response from calling
+// SignUptimeAttestation
+type SignUptimeAttestationReply struct {
+	// BLS signature of the warp message of the attestation
+	Signature string              `json:"signature"`
+	Encoding  formatting.Encoding `json:"encoding"`
+}
+
+// SignUptimeAttestation signs the attestation of the provided uptimes with the
+// BLS key of this node, if this node observed every validator being up for at
+// least the provided duration during its current staking period. The
+// signatures of the validators can be aggregated into an UptimeAttestationTx.
+func (s *Service) SignUptimeAttestation(_ *http.Request, args *SignUptimeAttestationArgs, reply *SignUptimeAttestationReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "signUptimeAttestation"),
+	)
+
+	if len(args.Uptimes) == 0 {
+		return errNoUptimes
+	}
+
+	attestation := txs.UptimeAttestation{
+		Uptimes: make([]*txs.ValidatorUptime, len(args.Uptimes)),
+	}
+	for i, apiUptime := range args.Uptimes {
+		validator, err := s.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, apiUptime.NodeID)
+		if err != nil {
+			return fmt.Errorf("failed to get validator %s: %w", apiUptime.NodeID, err)
+		}
+		if validator.TxID != apiUptime.ValidatorTxID {
+			return fmt.Errorf("validator %s was added by %s, not %s", apiUptime.NodeID, validator.TxID, apiUptime.ValidatorTxID)
+		}
+
+		upDuration, _, err := s.vm.uptimeManager.CalculateUptime(apiUptime.NodeID, constants.PrimaryNetworkID)
+		if err != nil {
+			return fmt.Errorf("failed to calculate uptime of %s: %w", apiUptime.NodeID, err)
+		}
+		if time.Duration(apiUptime.UpDuration)*time.Second > upDuration {
+			return fmt.Errorf("%w: %s was observed up for %s", errUptimeNotObserved, apiUptime.NodeID, upDuration)
+		}
+
+		attestation.Uptimes[i] = &txs.ValidatorUptime{
+			NodeID:        apiUptime.NodeID,
+			ValidatorTxID: apiUptime.ValidatorTxID,
+			UpDuration:    uint64(apiUptime.UpDuration),
+		}
+	}
+	utils.Sort(attestation.Uptimes)
+
+	msg, err := attestation.UnsignedMessage(s.vm.ctx.NetworkID, s.vm.ctx.ChainID)
+	if err != nil {
+		return fmt.Errorf("couldn't create warp message: %w", err)
+	}
+	sig, err := s.vm.ctx.WarpSigner.Sign(msg)
+	if err != nil {
+		return fmt.Errorf("couldn't sign uptime attestation: %w", err)
+	}
+
+	reply.Signature, err = formatting.Encode(args.Encoding, sig)
+	if err != nil {
+		return fmt.Errorf("couldn't encode signature as %s: %w", args.Encoding, err)
+	}
+	reply.Encoding = args.Encoding
+	return nil
+}
+
 // GetValidatorsAtArgs is the response from GetValidatorsAt
 type GetValidatorsAtArgs struct {
 	Height   json.Uint64 `json:"height"`
@@ -2706,13 +3733,34 @@ func (s *Service) getAPIUptime(staker *state.Staker) (*json.Float32, error) {
 	return &uptime, nil
 }
 
-func (s *Service) getAPIOwner(owner *secp256k1fx.OutputOwners) (*omegaapi.Owner, error) {
-	apiOwner := &omegaapi.Owner{
-		Locktime:  json.Uint64(owner.Locktime),
-		Threshold: json.Uint32(owner.Threshold),
-		Addresses: make([]string, 0, len(owner.Addrs)),
+func (s *Service) getAPIOwner(ownerIntf fx.Owner) (*omegaapi.Owner, error) {
+	var (
+		apiOwner *omegaapi.Owner
+		addrs    []ids.ShortID
+	)
+	switch owner := ownerIntf.(type) {
+	case *secp256k1fx.OutputOwners:
+		apiOwner = &omegaapi.Owner{
+			Locktime:  json.Uint64(owner.Locktime),
+			Threshold: json.Uint32(owner.Threshold),
+		}
+		addrs = owner.Addrs
+	case *secp256k1fx.WeightedOutputOwners:
+		apiOwner = &omegaapi.Owner{
+			Locktime:  json.Uint64(owner.Locktime),
+			Threshold: json.Uint32(owner.Threshold),
+			Weights:   make([]json.Uint32, len(owner.Weights)),
+		}
+		for i, weight := range owner.Weights {
+			apiOwner.Weights[i] = json.Uint32(weight)
+		}
+		addrs = owner.Addrs
+	default:
+		return nil, fmt.Errorf("expected *secp256k1fx.OutputOwners but got %T", ownerIntf)
 	}
-	for _, addr := range owner.Addrs {
+
+	apiOwner.Addresses = make([]string, 0, len(addrs))
+	for _, addr := range addrs {
 		addrStr, err := s.addrManager.FormatLocalAddress(addr)
 		if err != nil {
 			return nil, err
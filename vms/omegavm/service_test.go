@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"math/rand"
+	"net/http"
 	"testing"
 	"time"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/chains/atomic"
 	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
 	"github.com/DioneProtocol/odysseygo/database/prefixdb"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
@@ -35,12 +38,19 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/formatting"
 	"github.com/DioneProtocol/odysseygo/utils/json"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/units"
 	"github.com/DioneProtocol/odysseygo/version"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/index"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 
 	vmkeystore "github.com/DioneProtocol/odysseygo/vms/components/keystore"
@@ -165,6 +175,152 @@ func TestImportKey(t *testing.T) {
 }
 
 // Test issuing a tx and accepted
+func TestSend(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	defaultAddress(t, service)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	to := ids.GenerateTestShortID()
+	args := &SendArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass: api.UserPass{
+				Username: testUsername,
+				Password: testPassword,
+			},
+			JSONFromAddrs: api.JSONFromAddrs{
+				From: []string{keys[0].PublicKey().Address().String()},
+			},
+		},
+		Amount: 1234,
+		To:     to.String(),
+	}
+	reply := api.JSONTxIDChangeAddr{}
+	require.NoError(service.Send(nil, args, &reply))
+
+	tx := service.vm.Builder.Get(reply.TxID)
+	require.NotNil(tx)
+	require.IsType(&txs.BaseTx{}, tx.Unsigned)
+
+	var received uint64
+	for _, out := range tx.Unsigned.Outputs() {
+		owners := out.Out.(*secp256k1fx.TransferOutput).OutputOwners
+		if owners.Addrs[0] == to {
+			received += out.Out.Amount()
+		}
+	}
+	require.Equal(uint64(1234), received)
+
+	args.Amount = 0
+	err := service.Send(nil, args, &reply)
+	require.ErrorIs(err, errNoAmount)
+}
+
+func TestCancelPendingStaker(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	defaultAddress(t, service)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	// Add a pending validator whose stake is owned by the user
+	startTime := service.vm.state.GetTimestamp().Add(time.Hour)
+	stakerTx, err := service.vm.txBuilder.NewAddValidatorTx(
+		service.vm.MinValidatorStake,
+		uint64(startTime.Unix()),
+		uint64(startTime.Add(defaultMinValidatorStakingDuration).Unix()),
+		ids.GenerateTestNodeID(),
+		keys[1].PublicKey().Address(),
+		0,
+		[]*secp256k1.PrivateKey{keys[0]},
+		keys[0].PublicKey().Address(), // change addr
+	)
+	require.NoError(err)
+
+	staker, err := state.NewPendingStaker(
+		stakerTx.ID(),
+		stakerTx.Unsigned.(*txs.AddValidatorTx),
+	)
+	require.NoError(err)
+
+	service.vm.state.PutPendingValidator(staker)
+	service.vm.state.AddTx(stakerTx, status.Committed)
+	require.NoError(service.vm.state.Commit())
+
+	args := &CancelPendingStakerArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass: api.UserPass{
+				Username: testUsername,
+				Password: testPassword,
+			},
+		},
+		TxID: stakerTx.ID(),
+	}
+	reply := api.JSONTxIDChangeAddr{}
+	require.NoError(service.CancelPendingStaker(nil, args, &reply))
+
+	tx := service.vm.Builder.Get(reply.TxID)
+	require.NotNil(tx)
+	require.IsType(&txs.CancelPendingStakerTx{}, tx.Unsigned)
+	require.Equal(stakerTx.ID(), tx.Unsigned.(*txs.CancelPendingStakerTx).TxID)
+
+	args.TxID = ids.Empty
+	err = service.CancelPendingStaker(nil, args, &reply)
+	require.ErrorIs(err, errNoTxID)
+}
+
+func TestGetSubnetsAfterOwnershipTransfer(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	subnetID := testSubnet1.ID()
+	newOwnerAddr := keys[4].PublicKey().Address()
+	tx, err := service.vm.txBuilder.NewTransferSubnetOwnershipTx(
+		subnetID,
+		1,
+		[]ids.ShortID{newOwnerAddr},
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		keys[0].PublicKey().Address(), // change addr
+	)
+	require.NoError(err)
+	require.NoError(service.vm.Builder.AddUnverifiedTx(tx))
+
+	block, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(block.Verify(context.Background()))
+	require.NoError(block.Accept(context.Background()))
+
+	newOwnerAddrStr, err := service.addrManager.FormatLocalAddress(newOwnerAddr)
+	require.NoError(err)
+	expectedSubnet := APISubnet{
+		ID:          subnetID,
+		ControlKeys: []string{newOwnerAddrStr},
+		Threshold:   1,
+	}
+
+	reply := GetSubnetsResponse{}
+	require.NoError(service.GetSubnets(nil, &GetSubnetsArgs{
+		IDs: []ids.ID{subnetID},
+	}, &reply))
+	require.Equal([]APISubnet{expectedSubnet}, reply.Subnets)
+
+	reply = GetSubnetsResponse{}
+	require.NoError(service.GetSubnets(nil, &GetSubnetsArgs{}, &reply))
+	require.Contains(reply.Subnets, expectedSubnet)
+}
+
 func TestGetTxStatus(t *testing.T) {
 	require := require.New(t)
 	service, mutableSharedMemory := defaultService(t)
@@ -710,6 +866,132 @@ func TestGetTimestamp(t *testing.T) {
 	require.Equal(newTimestamp, reply.Timestamp)
 }
 
+func TestGetFeeRates(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	// Static fees are charged while dynamic fees are disabled
+	service.vm.state.SetFeeRate(2 * fees.RateDenominator)
+	reply := GetFeeRatesReply{}
+	require.NoError(service.GetFeeRates(nil, nil, &reply))
+	require.Equal(json.Uint64(fees.RateDenominator), reply.FeeRate)
+	require.Equal(json.Uint64(service.vm.TxFee), reply.TxFee)
+
+	service.vm.Config.DynamicFeeConfig = fees.DynamicFeeConfig{
+		TargetBlockSize:  1_000,
+		AdjustmentWindow: 8,
+		MinFeeRate:       1,
+		MaxFeeRate:       2 * fees.RateDenominator,
+	}
+	require.NoError(service.GetFeeRates(nil, nil, &reply))
+	require.Equal(json.Uint64(2*fees.RateDenominator), reply.FeeRate)
+	require.Equal(json.Uint64(2*service.vm.TxFee), reply.TxFee)
+	require.Equal(json.Uint64(2*service.vm.AddPrimaryNetworkValidatorFee), reply.AddPrimaryNetworkValidatorFee)
+}
+
+func TestGetValidatorUptimeScore(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	nodeID := ids.NodeID(keys[0].PublicKey().Address())
+	validator, err := service.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(err)
+
+	args := GetValidatorUptimeScoreArgs{
+		NodeID: nodeID,
+	}
+	reply := GetValidatorUptimeScoreReply{}
+	err = service.GetValidatorUptimeScore(nil, &args, &reply)
+	require.ErrorIs(err, errNoUptimeScore)
+
+	now := validator.StartTime.Add(4 * time.Hour)
+	service.vm.state.SetTimestamp(now)
+	service.vm.state.PutUptimeScore(&state.UptimeScore{
+		NodeID:        nodeID,
+		ValidatorTxID: validator.TxID,
+		UpDuration:    3 * time.Hour,
+		LastUpdated:   now,
+	})
+	require.NoError(service.GetValidatorUptimeScore(nil, &args, &reply))
+	require.Equal(validator.TxID, reply.ValidatorTxID)
+	require.Equal(json.Float32(75), reply.Uptime)
+	require.Equal(json.Uint64(3*time.Hour/time.Second), reply.UpDuration)
+	require.Equal(json.Uint64(now.Unix()), reply.LastUpdated)
+
+	// The score of a previous staking period of the node isn't reported.
+	service.vm.state.PutUptimeScore(&state.UptimeScore{
+		NodeID:        nodeID,
+		ValidatorTxID: ids.GenerateTestID(),
+		UpDuration:    3 * time.Hour,
+		LastUpdated:   now,
+	})
+	err = service.GetValidatorUptimeScore(nil, &args, &reply)
+	require.ErrorIs(err, errNoUptimeScore)
+}
+
+func TestSignUptimeAttestation(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+	service.vm.ctx.WarpSigner = warp.NewSigner(sk, service.vm.ctx.NetworkID, service.vm.ctx.ChainID)
+
+	nodeID := ids.NodeID(keys[0].PublicKey().Address())
+	validator, err := service.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(err)
+	upDuration, _, err := service.vm.uptimeManager.CalculateUptime(nodeID, constants.PrimaryNetworkID)
+	require.NoError(err)
+
+	apiUptime := APIValidatorUptime{
+		NodeID:        nodeID,
+		ValidatorTxID: validator.TxID,
+		UpDuration:    json.Uint64(upDuration/time.Second + 1),
+	}
+	args := SignUptimeAttestationArgs{
+		Uptimes:  []APIValidatorUptime{apiUptime},
+		Encoding: formatting.HexNC,
+	}
+	reply := SignUptimeAttestationReply{}
+
+	// The node doesn't attest a longer up duration than it observed.
+	err = service.SignUptimeAttestation(nil, &args, &reply)
+	require.ErrorIs(err, errUptimeNotObserved)
+
+	args.Uptimes[0].UpDuration = json.Uint64(upDuration / time.Second)
+	require.NoError(service.SignUptimeAttestation(nil, &args, &reply))
+
+	attestation := txs.UptimeAttestation{
+		Uptimes: []*txs.ValidatorUptime{{
+			NodeID:        nodeID,
+			ValidatorTxID: validator.TxID,
+			UpDuration:    uint64(upDuration / time.Second),
+		}},
+	}
+	msg, err := attestation.UnsignedMessage(service.vm.ctx.NetworkID, service.vm.ctx.ChainID)
+	require.NoError(err)
+	sigBytes, err := formatting.Decode(reply.Encoding, reply.Signature)
+	require.NoError(err)
+	sig, err := bls.SignatureFromBytes(sigBytes)
+	require.NoError(err)
+	require.True(bls.Verify(bls.PublicFromSecretKey(sk), sig, msg.Bytes()))
+}
+
 func TestGetBlock(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1006,3 +1288,550 @@ func TestServiceGetBlockByHeight(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFeePools(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	feeCollector, err := feecollector.New(memdb.New())
+	require.NoError(err)
+	service.vm.ctx.FeeCollector = feeCollector
+
+	require.NoError(feeCollector.AddAChainValue(100))
+	require.NoError(feeCollector.AddDChainValue(200))
+	require.NoError(feeCollector.AddURewardValue(300))
+
+	height, err := service.vm.GetCurrentHeight(context.Background())
+	require.NoError(err)
+
+	reply := GetFeePoolsReply{}
+	require.NoError(service.GetFeePools(&http.Request{}, nil, &reply))
+	require.Equal(GetFeePoolsReply{
+		AChainFee:           100,
+		DChainFee:           200,
+		UndistributedReward: 300,
+		Height:              json.Uint64(height),
+	}, reply)
+
+	tx, err := service.vm.txBuilder.NewCreateChainTx(
+		testSubnet1.ID(),
+		nil,
+		constants.AlphaID,
+		nil,
+		"chain name",
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		keys[0].PublicKey().Address(), // change addr
+	)
+	require.NoError(err)
+
+	preferred, err := service.vm.Builder.Preferred()
+	require.NoError(err)
+
+	statelessBlock, err := blocks.NewBanffStandardBlockWithFee(
+		preferred.Timestamp(),
+		preferred.ID(),
+		preferred.Height()+1,
+		[]*txs.Tx{tx},
+		60,
+		150,
+	)
+	require.NoError(err)
+
+	block := service.vm.manager.NewBlock(statelessBlock)
+	require.NoError(block.Verify(context.Background()))
+	require.NoError(block.Accept(context.Background()))
+
+	reply = GetFeePoolsReply{}
+	require.NoError(service.GetFeePools(&http.Request{}, nil, &reply))
+	require.Equal(GetFeePoolsReply{
+		AChainFee:           40,
+		DChainFee:           50,
+		UndistributedReward: 300,
+		LastSyncedAChainFee: 60,
+		LastSyncedDChainFee: 150,
+		LastSyncedHeight:    json.Uint64(statelessBlock.Height()),
+		Height:              json.Uint64(statelessBlock.Height()),
+	}, reply)
+
+	// The fee sync is recorded by the block, so it doesn't need to be found
+	// in the accepted blocks
+	lastSync, err := service.vm.state.GetLastFeeSync()
+	require.NoError(err)
+	require.Equal(&state.FeeSync{
+		Height:    statelessBlock.Height(),
+		AChainFee: 60,
+		DChainFee: 150,
+	}, lastSync)
+}
+
+func TestGetSupplyBreakdown(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	height, err := service.vm.GetCurrentHeight(context.Background())
+	require.NoError(err)
+	supply, err := service.vm.state.GetCurrentSupply(constants.PrimaryNetworkID)
+	require.NoError(err)
+	vdrs, ok := service.vm.Validators.Get(constants.PrimaryNetworkID)
+	require.True(ok)
+
+	reply := GetSupplyBreakdownReply{}
+	require.NoError(service.GetSupplyBreakdown(&http.Request{}, &GetSupplyBreakdownArgs{}, &reply))
+	require.Equal(json.Uint64(height), reply.Height)
+	require.Equal(json.Uint64(supply), reply.Supply)
+	require.Equal(json.Uint64(vdrs.Weight()), reply.Staked)
+	require.Zero(reply.Burned)
+	require.Zero(reply.Locked)
+
+	// The last accepted height can be requested explicitly
+	currentHeight := json.Uint64(height)
+	reply = GetSupplyBreakdownReply{}
+	require.NoError(service.GetSupplyBreakdown(&http.Request{}, &GetSupplyBreakdownArgs{
+		Height: &currentHeight,
+	}, &reply))
+	require.Equal(json.Uint64(height), reply.Height)
+
+	// But heights that weren't accepted yet can't
+	nextHeight := json.Uint64(height + 1)
+	err = service.GetSupplyBreakdown(&http.Request{}, &GetSupplyBreakdownArgs{
+		Height: &nextHeight,
+	}, &GetSupplyBreakdownReply{})
+	require.ErrorIs(err, errNoSupplyBreakdown)
+
+	// Lock some DIONE until after the current chain time
+	chainTime := service.vm.state.GetTimestamp()
+	lockedUTXO := &dione.UTXO{
+		UTXOID: dione.UTXOID{
+			TxID: ids.GenerateTestID(),
+		},
+		Asset: dione.Asset{ID: service.vm.ctx.DIONEAssetID},
+		Out: &stakeable.LockOut{
+			Locktime: uint64(chainTime.Add(time.Hour).Unix()),
+			TransferableOut: &secp256k1fx.TransferOutput{
+				Amt: 1234,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{keys[0].PublicKey().Address()},
+				},
+			},
+		},
+	}
+	service.vm.state.AddUTXO(lockedUTXO)
+	service.vm.state.AddBurnedFees(5678)
+	service.vm.state.AddMintedSupply(910)
+	service.vm.state.SetCurrentSupply(constants.PrimaryNetworkID, supply+910-5678)
+	require.NoError(service.vm.state.Commit())
+
+	reply = GetSupplyBreakdownReply{}
+	require.NoError(service.GetSupplyBreakdown(&http.Request{}, &GetSupplyBreakdownArgs{}, &reply))
+	require.Equal(currentHeight, reply.Height)
+	require.Equal(json.Uint64(supply+910-5678), reply.Supply)
+	require.Equal(json.Uint64(910), reply.Minted)
+	require.Equal(json.Uint64(1234), reply.Locked)
+	require.Equal(json.Uint64(5678), reply.Burned)
+
+	// The breakdown at a previous height is still available
+	genesisHeight := json.Uint64(0)
+	reply = GetSupplyBreakdownReply{}
+	require.NoError(service.GetSupplyBreakdown(&http.Request{}, &GetSupplyBreakdownArgs{
+		Height: &genesisHeight,
+	}, &reply))
+	require.Equal(genesisHeight, reply.Height)
+	require.Equal(json.Uint64(supply), reply.Supply)
+	require.Zero(reply.Minted)
+	require.Zero(reply.Locked)
+	require.Zero(reply.Burned)
+
+	// The amount is no longer locked once the locktime has passed
+	locked, err := service.vm.state.GetLockedAmount(chainTime.Add(time.Hour))
+	require.NoError(err)
+	require.Zero(locked)
+
+	service.vm.state.DeleteUTXO(lockedUTXO.InputID())
+	require.NoError(service.vm.state.Commit())
+
+	locked, err = service.vm.state.GetLockedAmount(chainTime)
+	require.NoError(err)
+	require.Zero(locked)
+}
+
+func TestGetOrionFee(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	feeCollector, err := feecollector.New(memdb.New())
+	require.NoError(err)
+	service.vm.ctx.FeeCollector = feeCollector
+
+	nodeID := ids.GenerateTestNodeID()
+	require.NoError(feeCollector.AddOrionsValue([]ids.NodeID{nodeID}, 100))
+
+	reply := GetOrionFeeReply{}
+	require.NoError(service.GetOrionFee(&http.Request{}, &GetOrionFeeArgs{NodeID: nodeID}, &reply))
+	require.Equal(json.Uint64(100), reply.Fee)
+	require.Zero(reply.LastSyncedFee)
+	require.Zero(reply.LastSyncedHeight)
+
+	service.vm.state.SetLastOrionFeePayout(nodeID, &state.OrionFeePayout{
+		Height: 5,
+		Fee:    40,
+	})
+	require.NoError(service.vm.state.Commit())
+
+	reply = GetOrionFeeReply{}
+	require.NoError(service.GetOrionFee(&http.Request{}, &GetOrionFeeArgs{NodeID: nodeID}, &reply))
+	require.Equal(json.Uint64(100), reply.Fee)
+	require.Equal(json.Uint64(40), reply.LastSyncedFee)
+	require.Equal(json.Uint64(5), reply.LastSyncedHeight)
+
+	err = service.GetOrionFee(&http.Request{}, &GetOrionFeeArgs{}, &reply)
+	require.ErrorIs(err, errNoNodeID)
+}
+
+func TestGetRewardHistory(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	reply := GetRewardHistoryReply{}
+	err := service.GetRewardHistory(&http.Request{}, &GetRewardHistoryArgs{}, &reply)
+	require.ErrorIs(err, index.ErrIndexingDisabled)
+
+	rewardIndexer := index.NewIndexer(memdb.New())
+	service.vm.rewardIndexer = rewardIndexer
+
+	reply = GetRewardHistoryReply{}
+	require.NoError(service.GetRewardHistory(&http.Request{}, &GetRewardHistoryArgs{EndHeight: 10}, &reply))
+	require.Empty(reply.Blocks)
+
+	blk, err := blocks.NewBanffStandardBlockWithFee(time.Unix(10, 0), ids.GenerateTestID(), 3, nil, 10, 20)
+	require.NoError(err)
+	require.NoError(rewardIndexer.Accept(blk, blk.Timestamp(), nil, 0))
+	batch, err := rewardIndexer.CommitBatch()
+	require.NoError(err)
+	require.NoError(batch.Write())
+	rewardIndexer.Abort()
+
+	reply = GetRewardHistoryReply{}
+	require.NoError(service.GetRewardHistory(&http.Request{}, &GetRewardHistoryArgs{EndHeight: 10}, &reply))
+	require.Equal(GetRewardHistoryReply{
+		Blocks: []APIBlockRewards{{
+			Height:    3,
+			BlockID:   blk.ID(),
+			Timestamp: 10,
+			AChainFee: 10,
+			DChainFee: 20,
+		}},
+		IndexStartHeight: 3,
+	}, reply)
+
+	err = service.GetRewardHistory(&http.Request{}, &GetRewardHistoryArgs{StartHeight: 2, EndHeight: 1}, &reply)
+	require.ErrorIs(err, errStartAfterEndHeight)
+}
+
+func TestGetStakerRewardBreakdown(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	rewardIndexer := index.NewIndexer(memdb.New())
+	service.vm.rewardIndexer = rewardIndexer
+
+	blk, err := blocks.NewBanffStandardBlockWithFee(time.Unix(10, 0), ids.GenerateTestID(), 3, nil, 0, 0)
+	require.NoError(err)
+	stakerReward := &index.StakerReward{
+		TxID:       ids.GenerateTestID(),
+		NodeID:     ids.GenerateTestNodeID(),
+		SubnetID:   constants.PrimaryNetworkID,
+		Height:     blk.Height(),
+		BlockID:    blk.ID(),
+		Rewarded:   true,
+		MintReward: 100,
+		FeeReward:  20,
+		OrionFee:   3,
+	}
+	require.NoError(rewardIndexer.Accept(blk, blk.Timestamp(), stakerReward, 0))
+	batch, err := rewardIndexer.CommitBatch()
+	require.NoError(err)
+	require.NoError(batch.Write())
+	rewardIndexer.Abort()
+
+	reply := GetStakerRewardBreakdownReply{}
+	require.NoError(service.GetStakerRewardBreakdown(&http.Request{}, &api.GetTxArgs{TxID: stakerReward.TxID}, &reply))
+	require.Equal(GetStakerRewardBreakdownReply{
+		NodeID:     stakerReward.NodeID,
+		SubnetID:   constants.PrimaryNetworkID,
+		Height:     3,
+		BlockID:    blk.ID(),
+		Rewarded:   true,
+		MintReward: 100,
+		FeeReward:  20,
+		OrionFee:   3,
+		Total:      123,
+	}, reply)
+
+	// The reward of a delegator is reported along with the part of it that
+	// was paid to its validator.
+	blk, err = blocks.NewBanffStandardBlockWithFee(time.Unix(20, 0), blk.ID(), 4, nil, 0, 0)
+	require.NoError(err)
+	delegatorReward := &index.StakerReward{
+		TxID:             ids.GenerateTestID(),
+		NodeID:           stakerReward.NodeID,
+		SubnetID:         constants.PrimaryNetworkID,
+		Height:           blk.Height(),
+		BlockID:          blk.ID(),
+		Rewarded:         true,
+		MintReward:       80,
+		FeeReward:        20,
+		DelegationShares: reward.PercentDenominator / 4,
+		DelegationFee:    25,
+	}
+	require.NoError(rewardIndexer.Accept(blk, blk.Timestamp(), delegatorReward, 0))
+	batch, err = rewardIndexer.CommitBatch()
+	require.NoError(err)
+	require.NoError(batch.Write())
+	rewardIndexer.Abort()
+
+	reply = GetStakerRewardBreakdownReply{}
+	require.NoError(service.GetStakerRewardBreakdown(&http.Request{}, &api.GetTxArgs{TxID: delegatorReward.TxID}, &reply))
+	require.Equal(GetStakerRewardBreakdownReply{
+		NodeID:           stakerReward.NodeID,
+		SubnetID:         constants.PrimaryNetworkID,
+		Height:           4,
+		BlockID:          blk.ID(),
+		Rewarded:         true,
+		MintReward:       80,
+		FeeReward:        20,
+		DelegationShares: reward.PercentDenominator / 4,
+		DelegationFee:    25,
+		Total:            75,
+	}, reply)
+
+	err = service.GetStakerRewardBreakdown(&http.Request{}, &api.GetTxArgs{TxID: ids.GenerateTestID()}, &reply)
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestGetUndistributedReward(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	service.vm.URewardRecycleConfig = reward.URewardRecycleConfig{
+		Policy:   reward.URewardBurn,
+		Interval: time.Hour,
+	}
+	require.NoError(service.vm.ctx.FeeCollector.AddURewardValue(25))
+
+	args := GetUndistributedRewardArgs{
+		StartHeight: 0,
+		EndHeight:   10,
+	}
+	reply := GetUndistributedRewardReply{}
+	require.NoError(service.GetUndistributedReward(&http.Request{}, &args, &reply))
+	require.Equal(GetUndistributedRewardReply{
+		Balance:  25,
+		Policy:   "burn",
+		Interval: 3600,
+	}, reply)
+
+	rewardIndexer := index.NewIndexer(memdb.New())
+	service.vm.rewardIndexer = rewardIndexer
+	event := &index.RecycleEvent{
+		Height:    4,
+		BlockID:   ids.GenerateTestID(),
+		Timestamp: 7200,
+		Policy:    "burn",
+		Amount:    40,
+	}
+	require.NoError(rewardIndexer.RecordRecycle(event))
+
+	reply = GetUndistributedRewardReply{}
+	require.NoError(service.GetUndistributedReward(&http.Request{}, &args, &reply))
+	require.Equal([]APIRecycleEvent{{
+		Height:    4,
+		BlockID:   event.BlockID,
+		Timestamp: 7200,
+		Policy:    "burn",
+		Amount:    40,
+	}}, reply.Events)
+
+	args.StartHeight = 11
+	err := service.GetUndistributedReward(&http.Request{}, &args, &reply)
+	require.ErrorIs(err, errStartAfterEndHeight)
+}
+
+func TestEstimateReward(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	service.vm.MintSchedule = reward.MintSchedule{{
+		MintSince:     defaultGenesisTime.Unix(),
+		MintingPeriod: 365 * 24 * time.Hour,
+		MintRate:      .1 * reward.PercentDenominator,
+		MaxMintAmount: 100 * units.MegaDione,
+	}}
+	service.vm.mintCalculator = reward.NewScheduledMintCalculator(service.vm.MintSchedule, 360*units.MegaDione)
+
+	vdrs, ok := service.vm.Validators.Get(constants.PrimaryNetworkID)
+	require.True(ok)
+
+	amount := defaultMinValidatorStake
+	startTime := defaultValidateStartTime.Add(time.Hour)
+	endTime := startTime.Add(defaultMinValidatorStakingDuration)
+	totalWeight := vdrs.Weight() + amount
+	mintRate := service.vm.mintCalculator.CalculateMintRate(totalWeight, startTime, endTime)
+	expectedMintReward := reward.CalculateMintReward(amount, new(big.Int), mintRate)
+	require.Positive(expectedMintReward)
+
+	args := EstimateRewardArgs{
+		Amount:    json.Uint64(amount),
+		StartTime: json.Uint64(startTime.Unix()),
+		EndTime:   json.Uint64(endTime.Unix()),
+	}
+	reply := EstimateRewardReply{}
+	require.NoError(service.EstimateReward(&http.Request{}, &args, &reply))
+	require.Equal(json.Uint64(expectedMintReward), reply.MintReward)
+	require.Zero(reply.FeeReward)
+	require.Zero(reply.DelegationFee)
+	require.Equal(json.Uint64(expectedMintReward), reply.Reward)
+	require.Equal(json.Uint64(totalWeight), reply.TotalWeight)
+	require.NotEmpty(reply.Assumptions)
+
+	// Delegating to a node that isn't validating uses the provided rate.
+	args.NodeID = ids.GenerateTestNodeID()
+	args.DelegationFeeRate = 10
+	reply = EstimateRewardReply{}
+	require.NoError(service.EstimateReward(&http.Request{}, &args, &reply))
+	require.Equal(json.Uint64(expectedMintReward), reply.DelegationFee+reply.Reward)
+	require.Equal(json.Uint64(900_000*(expectedMintReward/reward.PercentDenominator)), reply.Reward)
+	require.Equal(json.Float32(10), reply.DelegationFeeRate)
+
+	// Delegating to a current validator uses its delegation fee rate, which is
+	// 0 for the genesis validators.
+	args.NodeID = ids.NodeID(keys[0].PublicKey().Address())
+	reply = EstimateRewardReply{}
+	require.NoError(service.EstimateReward(&http.Request{}, &args, &reply))
+	require.Equal(json.Uint64(expectedMintReward), reply.DelegationFee+reply.Reward)
+	require.Equal(json.Uint64(reward.PercentDenominator*(expectedMintReward/reward.PercentDenominator)), reply.Reward)
+	require.Zero(reply.DelegationFeeRate)
+
+	args.EndTime = json.Uint64(defaultValidateEndTime.Add(time.Second).Unix())
+	err := service.EstimateReward(&http.Request{}, &args, &reply)
+	require.ErrorIs(err, errDelegationEndsAfterVdr)
+
+	args.EndTime = args.StartTime
+	err = service.EstimateReward(&http.Request{}, &args, &reply)
+	require.ErrorIs(err, errStartAfterEndTime)
+
+	args.Amount = 0
+	err = service.EstimateReward(&http.Request{}, &args, &reply)
+	require.ErrorIs(err, errNoAmount)
+}
+
+func TestEstimateRewardFeeIntake(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	feeCollector, err := feecollector.New(memdb.New())
+	require.NoError(err)
+	service.vm.ctx.FeeCollector = feeCollector
+	require.NoError(feeCollector.AddAChainValue(1000))
+
+	preferred, err := service.vm.Builder.Preferred()
+	require.NoError(err)
+	startTime := preferred.Timestamp()
+
+	var blkFees uint64
+	for i, blkFee := range []uint64{100, 300} {
+		preferred, err := service.vm.Builder.Preferred()
+		require.NoError(err)
+
+		tx, err := service.vm.txBuilder.NewCreateChainTx(
+			testSubnet1.ID(),
+			nil,
+			constants.AlphaID,
+			nil,
+			fmt.Sprintf("chain %d", i),
+			[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+			keys[0].PublicKey().Address(), // change addr
+		)
+		require.NoError(err)
+
+		blkTime := startTime.Add(time.Duration(i+1) * time.Minute)
+		service.vm.clock.Set(blkTime)
+		statelessBlock, err := blocks.NewBanffStandardBlockWithFee(
+			blkTime,
+			preferred.ID(),
+			preferred.Height()+1,
+			[]*txs.Tx{tx},
+			blkFee,
+			0,
+		)
+		require.NoError(err)
+		blkFees += statelessBlock.AccumulatedFee(service.vm.ctx.DIONEAssetID)
+
+		block := service.vm.manager.NewBlock(statelessBlock)
+		require.NoError(block.Verify(context.Background()))
+		require.NoError(block.Accept(context.Background()))
+		require.NoError(service.vm.SetPreference(context.Background(), block.ID()))
+	}
+
+	feeIntake, feeIntakePeriod, err := service.getRecentFeeIntake()
+	require.NoError(err)
+	require.Equal(blkFees, feeIntake)
+	require.Equal(2*time.Minute, feeIntakePeriod)
+
+	vdrs, ok := service.vm.Validators.Get(constants.PrimaryNetworkID)
+	require.True(ok)
+
+	// The staker would hold half of the weight over twice the measured period.
+	amount := vdrs.Weight()
+	reply := EstimateRewardReply{}
+	require.NoError(service.EstimateReward(&http.Request{}, &EstimateRewardArgs{
+		Amount:    json.Uint64(amount),
+		StartTime: json.Uint64(startTime.Unix()),
+		EndTime:   json.Uint64(startTime.Add(4 * time.Minute).Unix()),
+	}, &reply))
+
+	require.Zero(reply.MintReward)
+	require.InDelta(blkFees, uint64(reply.FeeReward), 1) // rounding
+	require.Equal(json.Uint64(blkFees), reply.FeeIntake)
+	require.Equal(json.Uint64(120), reply.FeeIntakePeriod)
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+)
+
+// BLSKeyRotation is the latest rotation of the BLS key of a primary network
+// validator. It is removed once the validator is removed.
+type BLSKeyRotation struct {
+	// ID of the RotateBLSKeyTx
+	TxID   ids.ID
+	NodeID ids.NodeID
+	// ID of the tx that added the validator whose key is rotated
+	ValidatorTxID ids.ID
+	// Key of the validator before [Height]. May be nil.
+	PreviousPublicKey *bls.PublicKey
+	// Key of the validator starting at [Height]
+	PublicKey *bls.PublicKey
+	// Height of the first block at which [PublicKey] is used
+	Height uint64
+}
+
+// PublicKeyAt returns the key of the validator at [height].
+func (r *BLSKeyRotation) PublicKeyAt(height uint64) *bls.PublicKey {
+	if height >= r.Height {
+		return r.PublicKey
+	}
+	return r.PreviousPublicKey
+}
+
+// blsKeyRotationMetadata is the persisted form of a [BLSKeyRotation], keyed by
+// node ID.
+type blsKeyRotationMetadata struct {
+	TxID              ids.ID `serialize:"true"`
+	ValidatorTxID     ids.ID `serialize:"true"`
+	PreviousPublicKey []byte `serialize:"true"`
+	PublicKey         []byte `serialize:"true"`
+	Height            uint64 `serialize:"true"`
+}
+
+func marshalBLSKeyRotation(r *BLSKeyRotation) ([]byte, error) {
+	metadata := &blsKeyRotationMetadata{
+		TxID:          r.TxID,
+		ValidatorTxID: r.ValidatorTxID,
+		PublicKey:     bls.PublicKeyToBytes(r.PublicKey),
+		Height:        r.Height,
+	}
+	if r.PreviousPublicKey != nil {
+		metadata.PreviousPublicKey = bls.PublicKeyToBytes(r.PreviousPublicKey)
+	}
+	return blocks.GenesisCodec.Marshal(blocks.Version, metadata)
+}
+
+func parseBLSKeyRotation(nodeID ids.NodeID, bytes []byte) (*BLSKeyRotation, error) {
+	metadata := blsKeyRotationMetadata{}
+	if _, err := blocks.GenesisCodec.Unmarshal(bytes, &metadata); err != nil {
+		return nil, err
+	}
+	publicKey, err := bls.PublicKeyFromBytes(metadata.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	r := &BLSKeyRotation{
+		TxID:          metadata.TxID,
+		NodeID:        nodeID,
+		ValidatorTxID: metadata.ValidatorTxID,
+		PublicKey:     publicKey,
+		Height:        metadata.Height,
+	}
+	if len(metadata.PreviousPublicKey) != 0 {
+		r.PreviousPublicKey, err = bls.PublicKeyFromBytes(metadata.PreviousPublicKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
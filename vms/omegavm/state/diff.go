@@ -27,6 +27,10 @@ type Diff interface {
 	Chain
 
 	Apply(State) error
+
+	// MerkleOps returns the changes to the merkle state of the parent state
+	// that result from applying the diff.
+	MerkleOps() ([]database.BatchOp, error)
 }
 
 type diff struct {
@@ -35,6 +39,7 @@ type diff struct {
 
 	timestamp          time.Time
 	stakeSyncTimestamp time.Time
+	feeRate            uint64
 
 	// Subnet ID --> supply of native asset of the subnet
 	currentSupply map[ids.ID]uint64
@@ -51,6 +56,20 @@ type diff struct {
 	transformedSubnets map[ids.ID]*txs.Tx
 	cachedSubnets      []*txs.Tx
 
+	// Subnet ID --> Fee schedule of the subnet
+	subnetFeeConfigs map[ids.ID]*SubnetFeeConfig
+	// Subnet ID --> Fees collected by the subnet
+	subnetFeePools map[ids.ID]*SubnetFeePool
+
+	// Node ID --> Latest rotation of the BLS key of the validator
+	blsKeyRotations map[ids.NodeID]*BLSKeyRotation
+
+	// Validator txID --> Whether the validator is auto-restaked
+	autoRestakes map[ids.ID]bool
+
+	// Node ID --> Attested uptime of the validator
+	uptimeScores map[ids.NodeID]*UptimeScore
+
 	addedChains  map[ids.ID][]*txs.Tx
 	cachedChains map[ids.ID][]*txs.Tx
 
@@ -67,6 +86,12 @@ type diff struct {
 	addAccumulatedFee          uint64
 	currentAccumulatedFeeCache *uint64
 	lastAccumulatedFee         *uint64
+
+	addBurnedFees   uint64
+	burnedFeesCache *uint64
+
+	addMintedSupply   uint64
+	mintedSupplyCache *uint64
 }
 
 func NewDiff(
@@ -81,6 +106,7 @@ func NewDiff(
 		parentID:      parentID,
 		stateVersions: stateVersions,
 		timestamp:     parentState.GetTimestamp(),
+		feeRate:       parentState.GetFeeRate(),
 	}, nil
 }
 
@@ -92,6 +118,14 @@ func (d *diff) SetTimestamp(timestamp time.Time) {
 	d.timestamp = timestamp
 }
 
+func (d *diff) GetFeeRate() uint64 {
+	return d.feeRate
+}
+
+func (d *diff) SetFeeRate(feeRate uint64) {
+	d.feeRate = feeRate
+}
+
 func (d *diff) GetStakeSyncTimestamp() (time.Time, error) {
 	if d.stakeSyncTimestamp.Compare(time.Time{}) == 0 {
 		parentState, ok := d.stateVersions.GetState(d.parentID)
@@ -254,6 +288,18 @@ func (d *diff) GetCurrentStakersLen() (uint64, error) {
 	return parentState.GetCurrentStakersLen()
 }
 
+func (d *diff) GetCurrentStakedWeight(subnetID ids.ID) (uint64, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+	parentWeight, err := parentState.GetCurrentStakedWeight(subnetID)
+	if err != nil {
+		return 0, err
+	}
+	return d.currentStakerDiffs.GetWeight(subnetID, parentWeight)
+}
+
 func (d *diff) GetPendingValidator(subnetID ids.ID, nodeID ids.NodeID) (*Staker, error) {
 	// If the validator was modified in this diff, return the modified
 	// validator.
@@ -386,6 +432,79 @@ func (d *diff) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
 	return parentState.GetSubnetOwner(subnetID)
 }
 
+func (d *diff) SetSubnetOwner(subnetID ids.ID, owner fx.Owner) {
+	if d.subnetOwners == nil {
+		d.subnetOwners = map[ids.ID]fx.Owner{
+			subnetID: owner,
+		}
+	} else {
+		d.subnetOwners[subnetID] = owner
+	}
+}
+
+func (d *diff) GetBLSKeyRotation(nodeID ids.NodeID) (*BLSKeyRotation, error) {
+	rotation, exists := d.blsKeyRotations[nodeID]
+	if exists {
+		return rotation, nil
+	}
+
+	// If the key wasn't rotated in this diff, ask the parent state.
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+	return parentState.GetBLSKeyRotation(nodeID)
+}
+
+func (d *diff) PutBLSKeyRotation(rotation *BLSKeyRotation) {
+	if d.blsKeyRotations == nil {
+		d.blsKeyRotations = make(map[ids.NodeID]*BLSKeyRotation)
+	}
+	d.blsKeyRotations[rotation.NodeID] = rotation
+}
+
+func (d *diff) GetAutoRestake(validatorTxID ids.ID) (bool, error) {
+	autoRestake, exists := d.autoRestakes[validatorTxID]
+	if exists {
+		return autoRestake, nil
+	}
+
+	// If the auto-restake wasn't modified in this diff, ask the parent state.
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return false, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+	return parentState.GetAutoRestake(validatorTxID)
+}
+
+func (d *diff) SetAutoRestake(validatorTxID ids.ID, autoRestake bool) {
+	if d.autoRestakes == nil {
+		d.autoRestakes = make(map[ids.ID]bool)
+	}
+	d.autoRestakes[validatorTxID] = autoRestake
+}
+
+func (d *diff) GetUptimeScore(nodeID ids.NodeID) (*UptimeScore, error) {
+	score, exists := d.uptimeScores[nodeID]
+	if exists {
+		return score, nil
+	}
+
+	// If the uptime wasn't attested in this diff, ask the parent state.
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+	return parentState.GetUptimeScore(nodeID)
+}
+
+func (d *diff) PutUptimeScore(score *UptimeScore) {
+	if d.uptimeScores == nil {
+		d.uptimeScores = make(map[ids.NodeID]*UptimeScore)
+	}
+	d.uptimeScores[score.NodeID] = score
+}
+
 func (d *diff) GetSubnetTransformation(subnetID ids.ID) (*txs.Tx, error) {
 	tx, exists := d.transformedSubnets[subnetID]
 	if exists {
@@ -411,6 +530,48 @@ func (d *diff) AddSubnetTransformation(transformSubnetTxIntf *txs.Tx) {
 	}
 }
 
+func (d *diff) GetSubnetFeeConfig(subnetID ids.ID) (*SubnetFeeConfig, error) {
+	config, exists := d.subnetFeeConfigs[subnetID]
+	if exists {
+		return config, nil
+	}
+
+	// If the fee schedule wasn't set in this diff, ask the parent state.
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+	return parentState.GetSubnetFeeConfig(subnetID)
+}
+
+func (d *diff) SetSubnetFeeConfig(subnetID ids.ID, config *SubnetFeeConfig) {
+	if d.subnetFeeConfigs == nil {
+		d.subnetFeeConfigs = make(map[ids.ID]*SubnetFeeConfig)
+	}
+	d.subnetFeeConfigs[subnetID] = config
+}
+
+func (d *diff) GetSubnetFeePool(subnetID ids.ID) (*SubnetFeePool, error) {
+	pool, exists := d.subnetFeePools[subnetID]
+	if exists {
+		return pool, nil
+	}
+
+	// If no fee was collected in this diff, ask the parent state.
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+	return parentState.GetSubnetFeePool(subnetID)
+}
+
+func (d *diff) SetSubnetFeePool(subnetID ids.ID, pool *SubnetFeePool) {
+	if d.subnetFeePools == nil {
+		d.subnetFeePools = make(map[ids.ID]*SubnetFeePool)
+	}
+	d.subnetFeePools[subnetID] = pool
+}
+
 func (d *diff) GetChains(subnetID ids.ID) ([]*txs.Tx, error) {
 	addedChains := d.addedChains[subnetID]
 	if len(addedChains) == 0 {
@@ -614,8 +775,47 @@ func (d *diff) SetLastAccumulatedFee(f uint64) {
 	d.lastAccumulatedFee = &f
 }
 
+func (d *diff) GetBurnedFees() (uint64, error) {
+	if d.burnedFeesCache == nil {
+		parentState, ok := d.stateVersions.GetState(d.parentID)
+		if !ok {
+			return 0, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+		}
+		burnedFees, err := parentState.GetBurnedFees()
+		if err != nil {
+			return 0, err
+		}
+		d.burnedFeesCache = &burnedFees
+	}
+	return *d.burnedFeesCache + d.addBurnedFees, nil
+}
+
+func (d *diff) AddBurnedFees(f uint64) {
+	d.addBurnedFees += f
+}
+
+func (d *diff) GetMintedSupply() (uint64, error) {
+	if d.mintedSupplyCache == nil {
+		parentState, ok := d.stateVersions.GetState(d.parentID)
+		if !ok {
+			return 0, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+		}
+		mintedSupply, err := parentState.GetMintedSupply()
+		if err != nil {
+			return 0, err
+		}
+		d.mintedSupplyCache = &mintedSupply
+	}
+	return *d.mintedSupplyCache + d.addMintedSupply, nil
+}
+
+func (d *diff) AddMintedSupply(m uint64) {
+	d.addMintedSupply += m
+}
+
 func (d *diff) Apply(baseState State) error {
 	baseState.SetTimestamp(d.timestamp)
+	baseState.SetFeeRate(d.feeRate)
 	if d.stakeSyncTimestamp.Compare(time.Time{}) != 0 {
 		baseState.SetStakeSyncTimestamp(d.stakeSyncTimestamp)
 	}
@@ -631,6 +831,12 @@ func (d *diff) Apply(baseState State) error {
 	if d.addAccumulatedFee > 0 {
 		baseState.AddCurrentAccumulatedFee(d.addAccumulatedFee)
 	}
+	if d.addBurnedFees > 0 {
+		baseState.AddBurnedFees(d.addBurnedFees)
+	}
+	if d.addMintedSupply > 0 {
+		baseState.AddMintedSupply(d.addMintedSupply)
+	}
 	for subnetID, supply := range d.currentSupply {
 		baseState.SetCurrentSupply(subnetID, supply)
 	}
@@ -684,9 +890,27 @@ func (d *diff) Apply(baseState State) error {
 	for _, subnet := range d.addedSubnets {
 		baseState.AddSubnet(subnet)
 	}
+	for subnetID, owner := range d.subnetOwners {
+		baseState.SetSubnetOwner(subnetID, owner)
+	}
 	for _, tx := range d.transformedSubnets {
 		baseState.AddSubnetTransformation(tx)
 	}
+	for subnetID, config := range d.subnetFeeConfigs {
+		baseState.SetSubnetFeeConfig(subnetID, config)
+	}
+	for subnetID, pool := range d.subnetFeePools {
+		baseState.SetSubnetFeePool(subnetID, pool)
+	}
+	for _, rotation := range d.blsKeyRotations {
+		baseState.PutBLSKeyRotation(rotation)
+	}
+	for validatorTxID, autoRestake := range d.autoRestakes {
+		baseState.SetAutoRestake(validatorTxID, autoRestake)
+	}
+	for _, score := range d.uptimeScores {
+		baseState.PutUptimeScore(score)
+	}
 	for _, chains := range d.addedChains {
 		for _, chain := range chains {
 			baseState.AddChain(chain)
@@ -0,0 +1,43 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import "github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+
+// FeeSync is the sync of the A-chain and D-chain fee pools into an accepted
+// block.
+type FeeSync struct {
+	// Height of the block that synced the fees
+	Height    uint64 `serialize:"true"`
+	AChainFee uint64 `serialize:"true"`
+	DChainFee uint64 `serialize:"true"`
+}
+
+// OrionFeePayout is the payout of the orion fee of a node by an accepted
+// RewardValidatorTx.
+type OrionFeePayout struct {
+	// Height of the block that paid out the fee
+	Height uint64 `serialize:"true"`
+	Fee    uint64 `serialize:"true"`
+}
+
+func marshalFeeSync(sync *FeeSync) ([]byte, error) {
+	return blocks.GenesisCodec.Marshal(blocks.Version, sync)
+}
+
+func parseFeeSync(bytes []byte) (*FeeSync, error) {
+	sync := &FeeSync{}
+	_, err := blocks.GenesisCodec.Unmarshal(bytes, sync)
+	return sync, err
+}
+
+func marshalOrionFeePayout(payout *OrionFeePayout) ([]byte, error) {
+	return blocks.GenesisCodec.Marshal(blocks.Version, payout)
+}
+
+func parseOrionFeePayout(bytes []byte) (*OrionFeePayout, error) {
+	payout := &OrionFeePayout{}
+	_, err := blocks.GenesisCodec.Unmarshal(bytes, payout)
+	return payout, err
+}
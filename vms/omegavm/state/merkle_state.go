@@ -0,0 +1,1241 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/x/merkledb"
+)
+
+// MerkleHistoryLength is the number of commits to the merkle state that are
+// kept in memory to serve proofs of previous merkle roots.
+const MerkleHistoryLength = 8192
+
+const (
+	merkleEvictionBatchSize         = units.MiB
+	merkleValueNodeCacheSize        = 16 * units.MiB
+	merkleIntermediateNodeCacheSize = 16 * units.MiB
+
+	// merkleBuildBatchSize is the number of entries written with a single
+	// commit when the merkle state is built from the current state.
+	merkleBuildBatchSize = 4096
+)
+
+// Every key of the merkle state starts with one of these prefixes.
+const (
+	merkleUTXOPrefix byte = iota
+	merkleCurrentStakerPrefix
+	merklePendingStakerPrefix
+	merkleDelegateeRewardPrefix
+	merkleSubnetPrefix
+	merkleSubnetOwnerPrefix
+	merkleTransformedSubnetPrefix
+	merkleSupplyPrefix
+	merkleChainPrefix
+	merkleMetadataPrefix
+	merkleBLSKeyRotationPrefix
+	merkleAutoRestakePrefix
+	merkleUptimeScorePrefix
+	merkleSubnetFeeConfigPrefix
+	merkleSubnetFeePoolPrefix
+)
+
+var (
+	errUnexpectedMerkleKey = errors.New("unexpected merkle state key")
+	errIrreversibleRemoval = errors.New("synced state removes an entry that can't be removed")
+	errUnexpectedStateRoot = errors.New("unexpected state root")
+)
+
+// merkleStaker is the merkle state value of a current staker.
+type merkleStaker struct {
+	Tx               []byte `serialize:"true"`
+	PotentialReward  uint64 `serialize:"true"`
+	MintRate         []byte `serialize:"true"`
+	FeePerWeightPaid []byte `serialize:"true"`
+}
+
+func merkleKey(prefix byte, parts ...[]byte) []byte {
+	key := []byte{prefix}
+	for _, part := range parts {
+		key = append(key, part...)
+	}
+	return key
+}
+
+func bigIntBytes(i *big.Int) []byte {
+	if i == nil {
+		return nil
+	}
+	return i.Bytes()
+}
+
+func packTime(t time.Time) []byte {
+	return database.PackUInt64(uint64(t.Unix()))
+}
+
+func currentStakerMerkleOp(chain Chain, staker *Staker) (database.BatchOp, error) {
+	tx, _, err := chain.GetTx(staker.TxID)
+	if err != nil {
+		return database.BatchOp{}, fmt.Errorf("failed to get tx of staker %s: %w", staker.TxID, err)
+	}
+	value := &merkleStaker{
+		Tx:               tx.Bytes(),
+		PotentialReward:  staker.PotentialReward,
+		MintRate:         bigIntBytes(staker.MintRate),
+		FeePerWeightPaid: bigIntBytes(staker.FeePerWeightPaid),
+	}
+	valueBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, value)
+	if err != nil {
+		return database.BatchOp{}, fmt.Errorf("failed to serialize current staker: %w", err)
+	}
+	return database.BatchOp{
+		Key:   merkleKey(merkleCurrentStakerPrefix, staker.TxID[:]),
+		Value: valueBytes,
+	}, nil
+}
+
+func pendingStakerMerkleOp(chain Chain, staker *Staker) (database.BatchOp, error) {
+	tx, _, err := chain.GetTx(staker.TxID)
+	if err != nil {
+		return database.BatchOp{}, fmt.Errorf("failed to get tx of staker %s: %w", staker.TxID, err)
+	}
+	return database.BatchOp{
+		Key:   merkleKey(merklePendingStakerPrefix, staker.TxID[:]),
+		Value: tx.Bytes(),
+	}, nil
+}
+
+func delegateeRewardMerkleOp(subnetID ids.ID, nodeID ids.NodeID, amount uint64) database.BatchOp {
+	return database.BatchOp{
+		Key:   merkleKey(merkleDelegateeRewardPrefix, subnetID[:], nodeID[:]),
+		Value: database.PackUInt64(amount),
+	}
+}
+
+func utxoMerkleOp(utxo *dione.UTXO) (database.BatchOp, error) {
+	utxoID := utxo.InputID()
+	utxoBytes, err := txs.GenesisCodec.Marshal(txs.Version, utxo)
+	if err != nil {
+		return database.BatchOp{}, fmt.Errorf("failed to serialize UTXO: %w", err)
+	}
+	return database.BatchOp{
+		Key:   merkleKey(merkleUTXOPrefix, utxoID[:]),
+		Value: utxoBytes,
+	}, nil
+}
+
+func subnetOwnerMerkleOp(subnetID ids.ID, owner fx.Owner) (database.BatchOp, error) {
+	ownerBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, &owner)
+	if err != nil {
+		return database.BatchOp{}, fmt.Errorf("failed to marshal subnet owner: %w", err)
+	}
+	return database.BatchOp{
+		Key:   merkleKey(merkleSubnetOwnerPrefix, subnetID[:]),
+		Value: ownerBytes,
+	}, nil
+}
+
+func blsKeyRotationMerkleOp(rotation *BLSKeyRotation) (database.BatchOp, error) {
+	rotationBytes, err := marshalBLSKeyRotation(rotation)
+	if err != nil {
+		return database.BatchOp{}, fmt.Errorf("failed to serialize BLS key rotation: %w", err)
+	}
+	return database.BatchOp{
+		Key:   merkleKey(merkleBLSKeyRotationPrefix, rotation.NodeID[:]),
+		Value: rotationBytes,
+	}, nil
+}
+
+func autoRestakeMerkleOp(validatorTxID ids.ID, autoRestake bool) database.BatchOp {
+	return database.BatchOp{
+		Key:    merkleKey(merkleAutoRestakePrefix, validatorTxID[:]),
+		Delete: !autoRestake,
+	}
+}
+
+func uptimeScoreMerkleOp(score *UptimeScore) (database.BatchOp, error) {
+	scoreBytes, err := marshalUptimeScore(score)
+	if err != nil {
+		return database.BatchOp{}, fmt.Errorf("failed to serialize uptime score: %w", err)
+	}
+	return database.BatchOp{
+		Key:   merkleKey(merkleUptimeScorePrefix, score.NodeID[:]),
+		Value: scoreBytes,
+	}, nil
+}
+
+func subnetFeeConfigMerkleOp(subnetID ids.ID, config *SubnetFeeConfig) (database.BatchOp, error) {
+	configBytes, err := marshalSubnetFeeConfig(config)
+	if err != nil {
+		return database.BatchOp{}, fmt.Errorf("failed to serialize subnet fee config: %w", err)
+	}
+	return database.BatchOp{
+		Key:   merkleKey(merkleSubnetFeeConfigPrefix, subnetID[:]),
+		Value: configBytes,
+	}, nil
+}
+
+func subnetFeePoolMerkleOp(subnetID ids.ID, pool *SubnetFeePool) (database.BatchOp, error) {
+	poolBytes, err := marshalSubnetFeePool(pool)
+	if err != nil {
+		return database.BatchOp{}, fmt.Errorf("failed to serialize subnet fee pool: %w", err)
+	}
+	return database.BatchOp{
+		Key:   merkleKey(merkleSubnetFeePoolPrefix, subnetID[:]),
+		Value: poolBytes,
+	}, nil
+}
+
+func chainMerkleOp(createChainTx *txs.Tx) database.BatchOp {
+	subnetID := createChainTx.Unsigned.(*txs.CreateChainTx).SubnetID
+	chainID := createChainTx.ID()
+	return database.BatchOp{
+		Key:   merkleKey(merkleChainPrefix, subnetID[:], chainID[:]),
+		Value: createChainTx.Bytes(),
+	}
+}
+
+// metadataMerkleOps returns the merkle state entries of the singleton values
+// of [chain], including the supply of the primary network.
+func metadataMerkleOps(chain Chain) ([]database.BatchOp, error) {
+	stakeSyncTimestamp, err := chain.GetStakeSyncTimestamp()
+	if err != nil {
+		return nil, err
+	}
+	stakerMintRate, err := chain.GetStakerAccumulatedMintRate()
+	if err != nil {
+		return nil, err
+	}
+	feePerWeightStored, err := chain.GetFeePerWeightStored()
+	if err != nil {
+		return nil, err
+	}
+	currentAccumulatedFee, err := chain.GetCurrentAccumulatedFee()
+	if err != nil {
+		return nil, err
+	}
+	lastAccumulatedFee, err := chain.GetLastAccumulatedFee()
+	if err != nil {
+		return nil, err
+	}
+	burnedFees, err := chain.GetBurnedFees()
+	if err != nil {
+		return nil, err
+	}
+	mintedSupply, err := chain.GetMintedSupply()
+	if err != nil {
+		return nil, err
+	}
+	currentSupply, err := chain.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return nil, err
+	}
+	return []database.BatchOp{
+		{
+			Key:   merkleKey(merkleMetadataPrefix, timestampKey),
+			Value: packTime(chain.GetTimestamp()),
+		},
+		{
+			Key:   merkleKey(merkleMetadataPrefix, feeRateKey),
+			Value: database.PackUInt64(chain.GetFeeRate()),
+		},
+		{
+			Key:   merkleKey(merkleMetadataPrefix, stakeSyncTimestampKey),
+			Value: packTime(stakeSyncTimestamp),
+		},
+		{
+			Key:   merkleKey(merkleMetadataPrefix, stakerMintRateKey),
+			Value: bigIntBytes(stakerMintRate),
+		},
+		{
+			Key:   merkleKey(merkleMetadataPrefix, feePerWeightStoredKey),
+			Value: bigIntBytes(feePerWeightStored),
+		},
+		{
+			Key:   merkleKey(merkleMetadataPrefix, currentAccumulatedFeeKey),
+			Value: database.PackUInt64(currentAccumulatedFee),
+		},
+		{
+			Key:   merkleKey(merkleMetadataPrefix, lastAccumulatedFeeKey),
+			Value: database.PackUInt64(lastAccumulatedFee),
+		},
+		{
+			Key:   merkleKey(merkleMetadataPrefix, burnedFeesKey),
+			Value: database.PackUInt64(burnedFees),
+		},
+		{
+			Key:   merkleKey(merkleMetadataPrefix, mintedSupplyKey),
+			Value: database.PackUInt64(mintedSupply),
+		},
+		{
+			Key:   merkleKey(merkleSupplyPrefix, constants.PrimaryNetworkID[:]),
+			Value: database.PackUInt64(currentSupply),
+		},
+	}, nil
+}
+
+// merkleChanges are the changes to the state of a chain, other than its
+// singleton values, that are reflected in the merkle state.
+type merkleChanges struct {
+	chain Chain
+
+	modifiedUTXOs         map[ids.ID]*dione.UTXO
+	currentValidatorDiffs map[ids.ID]map[ids.NodeID]*diffValidator
+	delegateeRewards      map[ids.ID]map[ids.NodeID]uint64
+	pendingValidatorDiffs map[ids.ID]map[ids.NodeID]*diffValidator
+	addedSubnets          []*txs.Tx
+	subnetOwners          map[ids.ID]fx.Owner
+	transformedSubnets    map[ids.ID]*txs.Tx
+	supplies              map[ids.ID]uint64
+	addedChains           map[ids.ID][]*txs.Tx
+	blsKeyRotations       map[ids.NodeID]*BLSKeyRotation
+	autoRestakes          map[ids.ID]bool
+	uptimeScores          map[ids.NodeID]*UptimeScore
+	subnetFeeConfigs      map[ids.ID]*SubnetFeeConfig
+	subnetFeePools        map[ids.ID]*SubnetFeePool
+}
+
+func (c *merkleChanges) ops() ([]database.BatchOp, error) {
+	ops := []database.BatchOp(nil)
+	for utxoID, utxo := range c.modifiedUTXOs {
+		if utxo == nil {
+			ops = append(ops, database.BatchOp{
+				Key:    merkleKey(merkleUTXOPrefix, utxoID[:]),
+				Delete: true,
+			})
+			continue
+		}
+		op, err := utxoMerkleOp(utxo)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+
+	// The modified rewards are written before the validators, so that the
+	// reward of a removed validator is removed with it.
+	for subnetID, nodes := range c.delegateeRewards {
+		for nodeID, amount := range nodes {
+			ops = append(ops, delegateeRewardMerkleOp(subnetID, nodeID, amount))
+		}
+	}
+	for subnetID, validatorDiffs := range c.currentValidatorDiffs {
+		for nodeID, validatorDiff := range validatorDiffs {
+			switch validatorDiff.validatorStatus {
+			case added:
+				op, err := currentStakerMerkleOp(c.chain, validatorDiff.validator)
+				if err != nil {
+					return nil, err
+				}
+				ops = append(ops, op)
+
+				// A validator is added without any accrued delegatee reward.
+				if _, modified := c.delegateeRewards[subnetID][nodeID]; !modified {
+					ops = append(ops, delegateeRewardMerkleOp(subnetID, nodeID, 0))
+				}
+			case deleted:
+				ops = append(ops,
+					database.BatchOp{
+						Key:    merkleKey(merkleCurrentStakerPrefix, validatorDiff.validator.TxID[:]),
+						Delete: true,
+					},
+					database.BatchOp{
+						Key:    merkleKey(merkleDelegateeRewardPrefix, subnetID[:], nodeID[:]),
+						Delete: true,
+					},
+				)
+
+				// The rotations of the key of a validator are removed with
+				// the validator.
+				if subnetID == constants.PrimaryNetworkID {
+					rotation, err := c.chain.GetBLSKeyRotation(nodeID)
+					switch {
+					case err == database.ErrNotFound:
+					case err != nil:
+						return nil, err
+					case rotation.ValidatorTxID == validatorDiff.validator.TxID:
+						ops = append(ops, database.BatchOp{
+							Key:    merkleKey(merkleBLSKeyRotationPrefix, nodeID[:]),
+							Delete: true,
+						})
+					}
+
+					// So is its attested uptime.
+					score, err := c.chain.GetUptimeScore(nodeID)
+					switch {
+					case err == database.ErrNotFound:
+					case err != nil:
+						return nil, err
+					case score.ValidatorTxID == validatorDiff.validator.TxID:
+						ops = append(ops, database.BatchOp{
+							Key:    merkleKey(merkleUptimeScorePrefix, nodeID[:]),
+							Delete: true,
+						})
+					}
+				}
+			}
+
+			var err error
+			ops, err = appendDelegatorMerkleOps(ops, validatorDiff, merkleCurrentStakerPrefix, func(staker *Staker) (database.BatchOp, error) {
+				return currentStakerMerkleOp(c.chain, staker)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, validatorDiffs := range c.pendingValidatorDiffs {
+		for _, validatorDiff := range validatorDiffs {
+			switch validatorDiff.validatorStatus {
+			case added:
+				op, err := pendingStakerMerkleOp(c.chain, validatorDiff.validator)
+				if err != nil {
+					return nil, err
+				}
+				ops = append(ops, op)
+			case deleted:
+				ops = append(ops, database.BatchOp{
+					Key:    merkleKey(merklePendingStakerPrefix, validatorDiff.validator.TxID[:]),
+					Delete: true,
+				})
+			}
+
+			var err error
+			ops, err = appendDelegatorMerkleOps(ops, validatorDiff, merklePendingStakerPrefix, func(staker *Staker) (database.BatchOp, error) {
+				return pendingStakerMerkleOp(c.chain, staker)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, subnet := range c.addedSubnets {
+		subnetID := subnet.ID()
+		ops = append(ops, database.BatchOp{
+			Key:   merkleKey(merkleSubnetPrefix, subnetID[:]),
+			Value: subnet.Bytes(),
+		})
+	}
+	for subnetID, owner := range c.subnetOwners {
+		op, err := subnetOwnerMerkleOp(subnetID, owner)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	for subnetID, tx := range c.transformedSubnets {
+		subnetID := subnetID
+		ops = append(ops, database.BatchOp{
+			Key:   merkleKey(merkleTransformedSubnetPrefix, subnetID[:]),
+			Value: tx.Bytes(),
+		})
+	}
+	for subnetID, supply := range c.supplies {
+		subnetID := subnetID
+		ops = append(ops, database.BatchOp{
+			Key:   merkleKey(merkleSupplyPrefix, subnetID[:]),
+			Value: database.PackUInt64(supply),
+		})
+	}
+	for _, chains := range c.addedChains {
+		for _, chain := range chains {
+			ops = append(ops, chainMerkleOp(chain))
+		}
+	}
+	for _, rotation := range c.blsKeyRotations {
+		op, err := blsKeyRotationMerkleOp(rotation)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	for validatorTxID, autoRestake := range c.autoRestakes {
+		ops = append(ops, autoRestakeMerkleOp(validatorTxID, autoRestake))
+	}
+	for _, score := range c.uptimeScores {
+		op, err := uptimeScoreMerkleOp(score)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	for subnetID, config := range c.subnetFeeConfigs {
+		op, err := subnetFeeConfigMerkleOp(subnetID, config)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	for subnetID, pool := range c.subnetFeePools {
+		op, err := subnetFeePoolMerkleOp(subnetID, pool)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// appendDelegatorMerkleOps appends the changes to the delegators of
+// [validatorDiff]. Deletions are appended last, as a delegator may have been
+// added and removed by the same diff.
+func appendDelegatorMerkleOps(
+	ops []database.BatchOp,
+	validatorDiff *diffValidator,
+	prefix byte,
+	putOp func(*Staker) (database.BatchOp, error),
+) ([]database.BatchOp, error) {
+	addedDelegatorIterator := NewTreeIterator(validatorDiff.addedDelegators)
+	defer addedDelegatorIterator.Release()
+	for addedDelegatorIterator.Next() {
+		op, err := putOp(addedDelegatorIterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	for txID := range validatorDiff.deletedDelegators {
+		txID := txID
+		ops = append(ops, database.BatchOp{
+			Key:    merkleKey(prefix, txID[:]),
+			Delete: true,
+		})
+	}
+	return ops, nil
+}
+
+func (d *diff) MerkleOps() ([]database.BatchOp, error) {
+	changes := merkleChanges{
+		chain:                 d,
+		modifiedUTXOs:         d.modifiedUTXOs,
+		currentValidatorDiffs: d.currentStakerDiffs.validatorDiffs,
+		delegateeRewards:      d.modifiedDelegateeRewards,
+		pendingValidatorDiffs: d.pendingStakerDiffs.validatorDiffs,
+		addedSubnets:          d.addedSubnets,
+		subnetOwners:          d.subnetOwners,
+		transformedSubnets:    d.transformedSubnets,
+		supplies:              d.currentSupply,
+		addedChains:           d.addedChains,
+		blsKeyRotations:       d.blsKeyRotations,
+		autoRestakes:          d.autoRestakes,
+		uptimeScores:          d.uptimeScores,
+		subnetFeeConfigs:      d.subnetFeeConfigs,
+		subnetFeePools:        d.subnetFeePools,
+	}
+	ops, err := changes.ops()
+	if err != nil {
+		return nil, err
+	}
+	metadataOps, err := metadataMerkleOps(d)
+	if err != nil {
+		return nil, err
+	}
+	return append(ops, metadataOps...), nil
+}
+
+func (s *state) GetMerkleDB() merkledb.MerkleDB {
+	return s.merkleDB
+}
+
+func (s *state) SetDelegateeReward(subnetID ids.ID, nodeID ids.NodeID, amount uint64) error {
+	if err := s.validatorState.SetDelegateeReward(subnetID, nodeID, amount); err != nil {
+		return err
+	}
+	nodes, ok := s.modifiedDelegateeRewards[subnetID]
+	if !ok {
+		nodes = make(map[ids.NodeID]uint64)
+		s.modifiedDelegateeRewards[subnetID] = nodes
+	}
+	nodes[nodeID] = amount
+	return nil
+}
+
+// writeMerkleState commits the changes that are about to be written to the
+// merkle state. It must be called before the changes are written, as writing
+// them clears them.
+func (s *state) writeMerkleState() error {
+	changes := merkleChanges{
+		chain:                 s,
+		modifiedUTXOs:         s.modifiedUTXOs,
+		currentValidatorDiffs: s.currentStakers.validatorDiffs,
+		delegateeRewards:      s.modifiedDelegateeRewards,
+		pendingValidatorDiffs: s.pendingStakers.validatorDiffs,
+		addedSubnets:          s.addedSubnets,
+		subnetOwners:          s.subnetOwners,
+		transformedSubnets:    s.transformedSubnets,
+		supplies:              s.modifiedSupplies,
+		addedChains:           s.addedChains,
+		blsKeyRotations:       s.addedBLSKeyRotations,
+		autoRestakes:          s.modifiedAutoRestakes,
+		uptimeScores:          s.addedUptimeScores,
+		subnetFeeConfigs:      s.modifiedSubnetFeeConfigs,
+		subnetFeePools:        s.modifiedSubnetFeePools,
+	}
+	ops, err := changes.ops()
+	if err != nil {
+		return err
+	}
+	s.modifiedDelegateeRewards = make(map[ids.ID]map[ids.NodeID]uint64)
+
+	metadataOps, err := metadataMerkleOps(s)
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+	for _, op := range metadataOps {
+		value, err := s.merkleDB.GetValue(ctx, op.Key)
+		switch {
+		case err == database.ErrNotFound:
+		case err != nil:
+			return err
+		case bytes.Equal(value, op.Value):
+			continue
+		}
+		ops = append(ops, op)
+	}
+
+	// Committing a view invalidates all the other views of the merkle state,
+	// so nothing is committed if nothing changed.
+	if len(ops) == 0 {
+		return nil
+	}
+	view, err := s.merkleDB.NewView(ctx, merkledb.ViewChanges{BatchOps: ops})
+	if err != nil {
+		return err
+	}
+	return view.CommitToDB(ctx)
+}
+
+// loadMerkleState builds the merkle state from the current state if it wasn't
+// built yet. The merkle state is marked as built with the next commit.
+func (s *state) loadMerkleState() error {
+	indexed, err := s.singletonDB.Has(merkleStateIndexedKey)
+	if err != nil || indexed {
+		return err
+	}
+
+	ctx := context.TODO()
+	ops := make([]database.BatchOp, 0, merkleBuildBatchSize)
+	commit := func() error {
+		view, err := s.merkleDB.NewView(ctx, merkledb.ViewChanges{BatchOps: ops})
+		if err != nil {
+			return err
+		}
+		ops = ops[:0]
+		return view.CommitToDB(ctx)
+	}
+	err = s.iterateMerkleState(func(key, value []byte) error {
+		ops = append(ops, database.BatchOp{
+			Key:   key,
+			Value: value,
+		})
+		if len(ops) < merkleBuildBatchSize {
+			return nil
+		}
+		return commit()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build merkle state: %w", err)
+	}
+	if err := commit(); err != nil {
+		return fmt.Errorf("failed to build merkle state: %w", err)
+	}
+	return s.singletonDB.Put(merkleStateIndexedKey, nil)
+}
+
+// iterateMerkleState calls [f] with every entry of the merkle state of the
+// persisted state.
+func (s *state) iterateMerkleState(f func(key, value []byte) error) error {
+	err := s.utxoState.IterateUTXOs(func(utxo *dione.UTXO) error {
+		op, err := utxoMerkleOp(utxo)
+		if err != nil {
+			return err
+		}
+		return f(op.Key, op.Value)
+	})
+	if err != nil {
+		return err
+	}
+
+	currentStakerIterator := s.currentStakers.GetStakerIterator()
+	defer currentStakerIterator.Release()
+	for currentStakerIterator.Next() {
+		staker := currentStakerIterator.Value()
+		op, err := currentStakerMerkleOp(s, staker)
+		if err != nil {
+			return err
+		}
+		if err := f(op.Key, op.Value); err != nil {
+			return err
+		}
+		if !staker.Priority.IsCurrentValidator() {
+			continue
+		}
+
+		amount, err := s.GetDelegateeReward(staker.SubnetID, staker.NodeID)
+		if err != nil && err != database.ErrNotFound {
+			return err
+		}
+		op = delegateeRewardMerkleOp(staker.SubnetID, staker.NodeID, amount)
+		if err := f(op.Key, op.Value); err != nil {
+			return err
+		}
+	}
+
+	pendingStakerIterator := s.pendingStakers.GetStakerIterator()
+	defer pendingStakerIterator.Release()
+	for pendingStakerIterator.Next() {
+		op, err := pendingStakerMerkleOp(s, pendingStakerIterator.Value())
+		if err != nil {
+			return err
+		}
+		if err := f(op.Key, op.Value); err != nil {
+			return err
+		}
+	}
+
+	subnets, err := s.GetSubnets()
+	if err != nil {
+		return err
+	}
+	subnetIDs := []ids.ID{constants.PrimaryNetworkID}
+	for _, subnet := range subnets {
+		subnetID := subnet.ID()
+		subnetIDs = append(subnetIDs, subnetID)
+		if err := f(merkleKey(merkleSubnetPrefix, subnetID[:]), subnet.Bytes()); err != nil {
+			return err
+		}
+
+		// Only owners that were transferred are part of the merkle state.
+		ownerBytes, err := s.subnetOwnerDB.Get(subnetID[:])
+		switch err {
+		case nil:
+			if err := f(merkleKey(merkleSubnetOwnerPrefix, subnetID[:]), ownerBytes); err != nil {
+				return err
+			}
+		case database.ErrNotFound:
+		default:
+			return err
+		}
+
+		transformSubnetTx, err := s.GetSubnetTransformation(subnetID)
+		switch err {
+		case nil:
+			if err := f(merkleKey(merkleTransformedSubnetPrefix, subnetID[:]), transformSubnetTx.Bytes()); err != nil {
+				return err
+			}
+		case database.ErrNotFound:
+		default:
+			return err
+		}
+
+		supply, err := s.GetCurrentSupply(subnetID)
+		switch err {
+		case nil:
+			if err := f(merkleKey(merkleSupplyPrefix, subnetID[:]), database.PackUInt64(supply)); err != nil {
+				return err
+			}
+		case database.ErrNotFound:
+		default:
+			return err
+		}
+	}
+	for _, subnetID := range subnetIDs {
+		chains, err := s.GetChains(subnetID)
+		if err != nil {
+			return err
+		}
+		for _, chain := range chains {
+			op := chainMerkleOp(chain)
+			if err := f(op.Key, op.Value); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, rotation := range s.blsKeyRotations {
+		op, err := blsKeyRotationMerkleOp(rotation)
+		if err != nil {
+			return err
+		}
+		if err := f(op.Key, op.Value); err != nil {
+			return err
+		}
+	}
+
+	for validatorTxID := range s.autoRestakes {
+		op := autoRestakeMerkleOp(validatorTxID, true)
+		if err := f(op.Key, op.Value); err != nil {
+			return err
+		}
+	}
+
+	for _, score := range s.uptimeScores {
+		op, err := uptimeScoreMerkleOp(score)
+		if err != nil {
+			return err
+		}
+		if err := f(op.Key, op.Value); err != nil {
+			return err
+		}
+	}
+
+	for subnetID, config := range s.subnetFeeConfigs {
+		op, err := subnetFeeConfigMerkleOp(subnetID, config)
+		if err != nil {
+			return err
+		}
+		if err := f(op.Key, op.Value); err != nil {
+			return err
+		}
+	}
+
+	for subnetID, pool := range s.subnetFeePools {
+		op, err := subnetFeePoolMerkleOp(subnetID, pool)
+		if err != nil {
+			return err
+		}
+		if err := f(op.Key, op.Value); err != nil {
+			return err
+		}
+	}
+
+	metadataOps, err := metadataMerkleOps(s)
+	if err != nil {
+		return err
+	}
+	for _, op := range metadataOps {
+		if err := f(op.Key, op.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type syncedDelegateeReward struct {
+	subnetID ids.ID
+	nodeID   ids.NodeID
+	amount   uint64
+}
+
+func (s *state) ApplySyncedState(ctx context.Context, synced database.Iteratee, root ids.ID, blk blocks.Block) error {
+	current := make(map[string][]byte)
+	err := s.iterateMerkleState(func(key, value []byte) error {
+		current[string(key)] = value
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// The validator diffs of the applied changes are recorded at the height of
+	// [blk], as the diffs of the previous heights aren't known.
+	height := blk.Height()
+	s.indexedHeights = &heightRange{
+		LowerBound: height,
+	}
+	s.SetHeight(height)
+
+	type syncedEntry struct {
+		key, value []byte
+	}
+	var (
+		puts     []syncedEntry
+		removals []syncedEntry
+	)
+	it := synced.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		key := it.Key()
+		value := it.Value()
+		if currentValue, ok := current[string(key)]; ok {
+			delete(current, string(key))
+			if bytes.Equal(currentValue, value) {
+				continue
+			}
+			removals = append(removals, syncedEntry{
+				key:   key,
+				value: currentValue,
+			})
+		}
+		puts = append(puts, syncedEntry{
+			key:   key,
+			value: value,
+		})
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	for key, value := range current {
+		removals = append(removals, syncedEntry{
+			key:   []byte(key),
+			value: value,
+		})
+	}
+
+	// Removals are committed first, as the state can't remove and add a
+	// validator of the same node in a single commit.
+	for _, entry := range removals {
+		if err := s.removeSyncedEntry(entry.key, entry.value); err != nil {
+			return err
+		}
+	}
+	if err := s.Commit(); err != nil {
+		return err
+	}
+
+	// Delegatee rewards can only be set once the validators are written.
+	var delegateeRewards []syncedDelegateeReward
+	for _, entry := range puts {
+		reward, err := s.putSyncedEntry(entry.key, entry.value)
+		if err != nil {
+			return err
+		}
+		if reward != nil {
+			delegateeRewards = append(delegateeRewards, *reward)
+		}
+	}
+	if err := s.Commit(); err != nil {
+		return err
+	}
+
+	for _, reward := range delegateeRewards {
+		if err := s.SetDelegateeReward(reward.subnetID, reward.nodeID, reward.amount); err != nil {
+			return err
+		}
+	}
+	s.SetLastAccepted(blk.ID())
+	s.AddStatelessBlock(blk)
+	if err := s.Commit(); err != nil {
+		return err
+	}
+
+	stateRoot, err := s.merkleDB.GetMerkleRoot(ctx)
+	if err != nil {
+		return err
+	}
+	if stateRoot != root {
+		return fmt.Errorf("%w: expected %s but got %s", errUnexpectedStateRoot, root, stateRoot)
+	}
+	return nil
+}
+
+func parseSyncedStaker(txBytes []byte) (*txs.Tx, txs.Staker, error) {
+	tx, err := txs.Parse(txs.GenesisCodec, txBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	stakerTx, ok := tx.Unsigned.(txs.Staker)
+	if !ok {
+		return nil, nil, fmt.Errorf("expected tx type txs.Staker but got %T", tx.Unsigned)
+	}
+	return tx, stakerTx, nil
+}
+
+func parseSyncedCurrentStaker(value []byte) (*txs.Tx, *Staker, error) {
+	stakerValue := merkleStaker{}
+	if _, err := blocks.GenesisCodec.Unmarshal(value, &stakerValue); err != nil {
+		return nil, nil, err
+	}
+	tx, stakerTx, err := parseSyncedStaker(stakerValue.Tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	staker, err := NewCurrentStakerWithRewardRate(
+		tx.ID(),
+		stakerTx,
+		stakerValue.PotentialReward,
+		new(big.Int).SetBytes(stakerValue.MintRate),
+		new(big.Int).SetBytes(stakerValue.FeePerWeightPaid),
+	)
+	return tx, staker, err
+}
+
+func parseSyncedPendingStaker(value []byte) (*txs.Tx, *Staker, error) {
+	tx, stakerTx, err := parseSyncedStaker(value)
+	if err != nil {
+		return nil, nil, err
+	}
+	staker, err := NewPendingStaker(tx.ID(), stakerTx)
+	return tx, staker, err
+}
+
+func parseSyncedID(key []byte) (ids.ID, error) {
+	if len(key) != 1+ids.IDLen {
+		return ids.Empty, fmt.Errorf("%w: %x", errUnexpectedMerkleKey, key)
+	}
+	return ids.ToID(key[1:])
+}
+
+// putSyncedEntry applies the synced merkle state entry [key] -> [value] to the
+// state. The synced delegatee rewards are returned rather than applied.
+func (s *state) putSyncedEntry(key, value []byte) (*syncedDelegateeReward, error) {
+	switch key[0] {
+	case merkleUTXOPrefix:
+		utxo := &dione.UTXO{}
+		if _, err := txs.GenesisCodec.Unmarshal(value, utxo); err != nil {
+			return nil, err
+		}
+		s.AddUTXO(utxo)
+	case merkleCurrentStakerPrefix:
+		tx, staker, err := parseSyncedCurrentStaker(value)
+		if err != nil {
+			return nil, err
+		}
+		s.AddTx(tx, status.Committed)
+		if staker.Priority.IsCurrentValidator() {
+			s.PutCurrentValidator(staker)
+		} else {
+			s.PutCurrentDelegator(staker)
+		}
+	case merklePendingStakerPrefix:
+		tx, staker, err := parseSyncedPendingStaker(value)
+		if err != nil {
+			return nil, err
+		}
+		s.AddTx(tx, status.Committed)
+		if staker.Priority.IsPendingValidator() {
+			s.PutPendingValidator(staker)
+		} else {
+			s.PutPendingDelegator(staker)
+		}
+	case merkleDelegateeRewardPrefix:
+		if len(key) != 1+ids.IDLen+ids.NodeIDLen {
+			return nil, fmt.Errorf("%w: %x", errUnexpectedMerkleKey, key)
+		}
+		amount, err := database.ParseUInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		subnetID, err := ids.ToID(key[1 : 1+ids.IDLen])
+		if err != nil {
+			return nil, err
+		}
+		nodeID, err := ids.ToNodeID(key[1+ids.IDLen:])
+		if err != nil {
+			return nil, err
+		}
+		return &syncedDelegateeReward{
+			subnetID: subnetID,
+			nodeID:   nodeID,
+			amount:   amount,
+		}, nil
+	case merkleSubnetPrefix:
+		tx, err := txs.Parse(txs.GenesisCodec, value)
+		if err != nil {
+			return nil, err
+		}
+		s.AddSubnet(tx)
+		s.AddTx(tx, status.Committed)
+	case merkleSubnetOwnerPrefix:
+		subnetID, err := parseSyncedID(key)
+		if err != nil {
+			return nil, err
+		}
+		var owner fx.Owner
+		if _, err := blocks.GenesisCodec.Unmarshal(value, &owner); err != nil {
+			return nil, err
+		}
+		s.SetSubnetOwner(subnetID, owner)
+	case merkleTransformedSubnetPrefix:
+		tx, err := txs.Parse(txs.GenesisCodec, value)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := tx.Unsigned.(*txs.TransformSubnetTx); !ok {
+			return nil, fmt.Errorf("expected tx type *txs.TransformSubnetTx but got %T", tx.Unsigned)
+		}
+		s.AddSubnetTransformation(tx)
+		s.AddTx(tx, status.Committed)
+	case merkleSupplyPrefix:
+		subnetID, err := parseSyncedID(key)
+		if err != nil {
+			return nil, err
+		}
+		supply, err := database.ParseUInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		s.SetCurrentSupply(subnetID, supply)
+	case merkleChainPrefix:
+		tx, err := txs.Parse(txs.GenesisCodec, value)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := tx.Unsigned.(*txs.CreateChainTx); !ok {
+			return nil, fmt.Errorf("expected tx type *txs.CreateChainTx but got %T", tx.Unsigned)
+		}
+		s.AddChain(tx)
+		s.AddTx(tx, status.Committed)
+	case merkleBLSKeyRotationPrefix:
+		if len(key) != 1+ids.NodeIDLen {
+			return nil, fmt.Errorf("%w: %x", errUnexpectedMerkleKey, key)
+		}
+		nodeID, err := ids.ToNodeID(key[1:])
+		if err != nil {
+			return nil, err
+		}
+		rotation, err := parseBLSKeyRotation(nodeID, value)
+		if err != nil {
+			return nil, err
+		}
+		s.PutBLSKeyRotation(rotation)
+
+		// The stakers, whose entries come first, were synced with the key
+		// registered by their tx.
+		publicKey := rotation.PublicKeyAt(s.currentHeight)
+		if staker, err := s.GetCurrentValidator(constants.PrimaryNetworkID, nodeID); err == nil && staker.TxID == rotation.ValidatorTxID {
+			staker.PublicKey = publicKey
+		}
+		if staker, err := s.GetPendingValidator(constants.PrimaryNetworkID, nodeID); err == nil && staker.TxID == rotation.ValidatorTxID {
+			staker.PublicKey = publicKey
+		}
+	case merkleAutoRestakePrefix:
+		if len(key) != 1+ids.IDLen {
+			return nil, fmt.Errorf("%w: %x", errUnexpectedMerkleKey, key)
+		}
+		validatorTxID, err := ids.ToID(key[1:])
+		if err != nil {
+			return nil, err
+		}
+		s.SetAutoRestake(validatorTxID, true)
+	case merkleUptimeScorePrefix:
+		if len(key) != 1+ids.NodeIDLen {
+			return nil, fmt.Errorf("%w: %x", errUnexpectedMerkleKey, key)
+		}
+		nodeID, err := ids.ToNodeID(key[1:])
+		if err != nil {
+			return nil, err
+		}
+		score, err := parseUptimeScore(nodeID, value)
+		if err != nil {
+			return nil, err
+		}
+		s.PutUptimeScore(score)
+	case merkleSubnetFeeConfigPrefix:
+		subnetID, err := parseSyncedID(key)
+		if err != nil {
+			return nil, err
+		}
+		config, err := parseSubnetFeeConfig(value)
+		if err != nil {
+			return nil, err
+		}
+		s.SetSubnetFeeConfig(subnetID, config)
+	case merkleSubnetFeePoolPrefix:
+		subnetID, err := parseSyncedID(key)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := parseSubnetFeePool(value)
+		if err != nil {
+			return nil, err
+		}
+		s.SetSubnetFeePool(subnetID, pool)
+	case merkleMetadataPrefix:
+		return nil, s.putSyncedMetadata(key[1:], value)
+	default:
+		return nil, fmt.Errorf("%w: %x", errUnexpectedMerkleKey, key)
+	}
+	return nil, nil
+}
+
+func (s *state) putSyncedMetadata(name, value []byte) error {
+	switch {
+	case bytes.Equal(name, stakerMintRateKey):
+		s.SetStakerAccumulatedMintRate(new(big.Int).SetBytes(value))
+		return nil
+	case bytes.Equal(name, feePerWeightStoredKey):
+		s.SetFeePerWeightStored(new(big.Int).SetBytes(value))
+		return nil
+	}
+
+	intValue, err := database.ParseUInt64(value)
+	if err != nil {
+		return err
+	}
+	switch {
+	case bytes.Equal(name, timestampKey):
+		s.SetTimestamp(time.Unix(int64(intValue), 0))
+	case bytes.Equal(name, feeRateKey):
+		s.SetFeeRate(intValue)
+	case bytes.Equal(name, stakeSyncTimestampKey):
+		s.SetStakeSyncTimestamp(time.Unix(int64(intValue), 0))
+	case bytes.Equal(name, currentAccumulatedFeeKey):
+		s.currentAccumulatedFee = intValue
+	case bytes.Equal(name, lastAccumulatedFeeKey):
+		s.SetLastAccumulatedFee(intValue)
+	case bytes.Equal(name, burnedFeesKey):
+		s.burnedFees = intValue
+	case bytes.Equal(name, mintedSupplyKey):
+		s.mintedSupply = intValue
+	default:
+		return fmt.Errorf("%w: unknown metadata %q", errUnexpectedMerkleKey, name)
+	}
+	return nil
+}
+
+// removeSyncedEntry removes the merkle state entry [key] -> [value], that isn't
+// part of the synced state, from the state.
+func (s *state) removeSyncedEntry(key, value []byte) error {
+	switch key[0] {
+	case merkleUTXOPrefix:
+		utxoID, err := parseSyncedID(key)
+		if err != nil {
+			return err
+		}
+		s.DeleteUTXO(utxoID)
+	case merkleCurrentStakerPrefix:
+		_, staker, err := parseSyncedCurrentStaker(value)
+		if err != nil {
+			return err
+		}
+		if staker.Priority.IsCurrentValidator() {
+			s.DeleteCurrentValidator(staker)
+		} else {
+			s.DeleteCurrentDelegator(staker)
+		}
+	case merklePendingStakerPrefix:
+		_, staker, err := parseSyncedPendingStaker(value)
+		if err != nil {
+			return err
+		}
+		if staker.Priority.IsPendingValidator() {
+			s.DeletePendingValidator(staker)
+		} else {
+			s.DeletePendingDelegator(staker)
+		}
+	case merkleAutoRestakePrefix:
+		validatorTxID, err := ids.ToID(key[1:])
+		if err != nil {
+			return err
+		}
+		s.SetAutoRestake(validatorTxID, false)
+	case merkleDelegateeRewardPrefix, merkleBLSKeyRotationPrefix, merkleUptimeScorePrefix, merkleSupplyPrefix, merkleMetadataPrefix:
+		// Delegatee rewards, BLS key rotations and uptime scores are removed
+		// with their validators. Supplies and metadata are overwritten by the synced
+		// values.
+	default:
+		// Subnets, their owners, transformations and fees, and chains are
+		// never removed.
+		return fmt.Errorf("%w: %x", errIrreversibleRemoval, key)
+	}
+	return nil
+}
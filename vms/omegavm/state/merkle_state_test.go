@@ -0,0 +1,254 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/trace"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+	"github.com/DioneProtocol/odysseygo/x/merkledb"
+)
+
+// builtMerkleRoot returns the root of the merkle state built from scratch from
+// the persisted state of [s].
+func builtMerkleRoot(require *require.Assertions, s State) ids.ID {
+	ctx := context.Background()
+	db, err := merkledb.New(ctx, memdb.New(), merkledb.Config{
+		EvictionBatchSize:         units.KiB,
+		HistoryLength:             1,
+		ValueNodeCacheSize:        units.KiB,
+		IntermediateNodeCacheSize: units.KiB,
+		Tracer:                    trace.Noop,
+	})
+	require.NoError(err)
+
+	ops := []database.BatchOp(nil)
+	require.NoError(s.(*state).iterateMerkleState(func(key, value []byte) error {
+		ops = append(ops, database.BatchOp{
+			Key:   key,
+			Value: value,
+		})
+		return nil
+	}))
+	view, err := db.NewView(ctx, merkledb.ViewChanges{BatchOps: ops})
+	require.NoError(err)
+	root, err := view.GetMerkleRoot(ctx)
+	require.NoError(err)
+	return root
+}
+
+// addMerkleTestChanges adds a UTXO, a validator with a rotated BLS key and an
+// uptime score, a subnet with its owner and a chain to [chain].
+func addMerkleTestChanges(require *require.Assertions, chain Chain) {
+	chain.AddUTXO(&dione.UTXO{
+		UTXOID: dione.UTXOID{
+			TxID: ids.GenerateTestID(),
+		},
+		Asset: dione.Asset{ID: initialTxID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: units.Dione,
+		},
+	})
+
+	validatorTx := &txs.Tx{Unsigned: &txs.AddValidatorTx{
+		Validator: txs.Validator{
+			NodeID: ids.GenerateTestNodeID(),
+			Start:  uint64(initialTime.Unix()),
+			End:    uint64(initialValidatorEndTime.Unix()),
+			Wght:   units.Dione,
+		},
+		StakeOuts: []*dione.TransferableOutput{
+			{
+				Asset: dione.Asset{ID: initialTxID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: units.Dione,
+				},
+			},
+		},
+		RewardsOwner:     &secp256k1fx.OutputOwners{},
+		DelegationShares: reward.PercentDenominator,
+	}}
+	require.NoError(validatorTx.Initialize(txs.Codec))
+	staker, err := NewCurrentStaker(validatorTx.ID(), validatorTx.Unsigned.(txs.Staker), 1)
+	require.NoError(err)
+	chain.AddTx(validatorTx, status.Committed)
+	chain.PutCurrentValidator(staker)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+	chain.PutBLSKeyRotation(&BLSKeyRotation{
+		TxID:          ids.GenerateTestID(),
+		NodeID:        staker.NodeID,
+		ValidatorTxID: staker.TxID,
+		PublicKey:     bls.PublicFromSecretKey(sk),
+		Height:        1,
+	})
+	chain.SetAutoRestake(staker.TxID, true)
+	chain.PutUptimeScore(&UptimeScore{
+		NodeID:        staker.NodeID,
+		ValidatorTxID: staker.TxID,
+		UpDuration:    time.Second,
+		LastUpdated:   initialTime,
+	})
+
+	subnetTx := &txs.Tx{Unsigned: &txs.CreateSubnetTx{
+		Owner: &secp256k1fx.OutputOwners{},
+	}}
+	require.NoError(subnetTx.Initialize(txs.Codec))
+	chain.AddSubnet(subnetTx)
+	chain.AddTx(subnetTx, status.Committed)
+	chain.SetSubnetOwner(subnetTx.ID(), &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	})
+
+	chainTx := &txs.Tx{Unsigned: &txs.CreateChainTx{
+		SubnetID:   subnetTx.ID(),
+		ChainName:  "b",
+		VMID:       constants.AlphaID,
+		SubnetAuth: &secp256k1fx.Input{},
+	}}
+	require.NoError(chainTx.Initialize(txs.Codec))
+	chain.AddChain(chainTx)
+	chain.AddTx(chainTx, status.Committed)
+
+	chain.SetTimestamp(initialTime.Add(time.Second))
+	chain.AddCurrentAccumulatedFee(units.MilliDione)
+}
+
+func TestMerkleStateGenesis(t *testing.T) {
+	require := require.New(t)
+
+	s, _ := newInitializedState(require)
+
+	root, err := s.GetMerkleDB().GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(builtMerkleRoot(require, s), root)
+}
+
+func TestMerkleStateRebuild(t *testing.T) {
+	require := require.New(t)
+
+	s, db := newInitializedState(require)
+	addMerkleTestChanges(require, s)
+	require.NoError(s.Commit())
+
+	root, err := s.GetMerkleDB().GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(builtMerkleRoot(require, s), root)
+
+	// Dropping the merkle state results in it being rebuilt on the next load.
+	require.NoError(s.Close())
+	require.NoError(prefixdb.New(singletonPrefix, db).Delete(merkleStateIndexedKey))
+	merkleDB := prefixdb.New(merklePrefix, db)
+	merkleIt := merkleDB.NewIterator()
+	for merkleIt.Next() {
+		require.NoError(merkleDB.Delete(merkleIt.Key()))
+	}
+	merkleIt.Release()
+
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).load())
+	rebuiltRoot, err := s.GetMerkleDB().GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(root, rebuiltRoot)
+}
+
+func TestDiffMerkleOps(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	s, _ := newInitializedState(require)
+	lastAcceptedID := ids.GenerateTestID()
+	versions := NewMockVersions(ctrl)
+	versions.EXPECT().GetState(lastAcceptedID).AnyTimes().Return(s, true)
+
+	d, err := NewDiff(lastAcceptedID, versions)
+	require.NoError(err)
+	addMerkleTestChanges(require, d)
+
+	ops, err := d.MerkleOps()
+	require.NoError(err)
+	ctx := context.Background()
+	view, err := s.GetMerkleDB().NewView(ctx, merkledb.ViewChanges{BatchOps: ops})
+	require.NoError(err)
+	expectedRoot, err := view.GetMerkleRoot(ctx)
+	require.NoError(err)
+
+	require.NoError(d.Apply(s))
+	require.NoError(s.Commit())
+
+	root, err := s.GetMerkleDB().GetMerkleRoot(ctx)
+	require.NoError(err)
+	require.Equal(expectedRoot, root)
+	require.Equal(builtMerkleRoot(require, s), root)
+}
+
+func TestApplySyncedState(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	source, _ := newInitializedState(require)
+	addMerkleTestChanges(require, source)
+	require.NoError(source.Commit())
+	root, err := source.GetMerkleDB().GetMerkleRoot(ctx)
+	require.NoError(err)
+
+	// The UTXO only known by [target] must be removed by the sync.
+	target, _ := newInitializedState(require)
+	removedUTXO := &dione.UTXO{
+		UTXOID: dione.UTXOID{
+			TxID: ids.GenerateTestID(),
+		},
+		Asset: dione.Asset{ID: initialTxID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: units.Dione,
+		},
+	}
+	target.AddUTXO(removedUTXO)
+	require.NoError(target.Commit())
+
+	blk, err := blocks.NewBanffStandardBlock(source.GetTimestamp(), ids.GenerateTestID(), 10, nil)
+	require.NoError(err)
+	require.NoError(target.ApplySyncedState(ctx, source.GetMerkleDB(), root, blk))
+
+	require.Equal(blk.ID(), target.GetLastAccepted())
+	require.Equal(source.GetTimestamp(), target.GetTimestamp())
+	_, err = target.GetUTXO(removedUTXO.InputID())
+	require.ErrorIs(err, database.ErrNotFound)
+
+	sourceSubnets, err := source.GetSubnets()
+	require.NoError(err)
+	targetSubnets, err := target.GetSubnets()
+	require.NoError(err)
+	require.Equal(sourceSubnets, targetSubnets)
+
+	blkID, err := target.GetBlockIDAtHeight(10)
+	require.NoError(err)
+	require.Equal(blk.ID(), blkID)
+	require.Equal(builtMerkleRoot(require, target), root)
+
+	// Applying a state that doesn't match the root fails.
+	err = target.ApplySyncedState(ctx, source.GetMerkleDB(), ids.GenerateTestID(), blk)
+	require.ErrorIs(err, errUnexpectedStateRoot)
+}
@@ -43,6 +43,18 @@ func (m *MockChain) EXPECT() *MockChainMockRecorder {
 	return m.recorder
 }
 
+// AddBurnedFees mocks base method.
+func (m *MockChain) AddBurnedFees(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddBurnedFees", arg0)
+}
+
+// AddBurnedFees indicates an expected call of AddBurnedFees.
+func (mr *MockChainMockRecorder) AddBurnedFees(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBurnedFees", reflect.TypeOf((*MockChain)(nil).AddBurnedFees), arg0)
+}
+
 // AddChain mocks base method.
 func (m *MockChain) AddChain(arg0 *txs.Tx) {
 	m.ctrl.T.Helper()
@@ -67,6 +79,18 @@ func (mr *MockChainMockRecorder) AddCurrentAccumulatedFee(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCurrentAccumulatedFee", reflect.TypeOf((*MockChain)(nil).AddCurrentAccumulatedFee), arg0)
 }
 
+// AddMintedSupply mocks base method.
+func (m *MockChain) AddMintedSupply(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddMintedSupply", arg0)
+}
+
+// AddMintedSupply indicates an expected call of AddMintedSupply.
+func (mr *MockChainMockRecorder) AddMintedSupply(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMintedSupply", reflect.TypeOf((*MockChain)(nil).AddMintedSupply), arg0)
+}
+
 // AddRewardUTXO mocks base method.
 func (m *MockChain) AddRewardUTXO(arg0 ids.ID, arg1 *dione.UTXO) {
 	m.ctrl.T.Helper()
@@ -187,6 +211,51 @@ func (mr *MockChainMockRecorder) DeleteUTXO(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUTXO", reflect.TypeOf((*MockChain)(nil).DeleteUTXO), arg0)
 }
 
+// GetAutoRestake mocks base method.
+func (m *MockChain) GetAutoRestake(arg0 ids.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutoRestake", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutoRestake indicates an expected call of GetAutoRestake.
+func (mr *MockChainMockRecorder) GetAutoRestake(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoRestake", reflect.TypeOf((*MockChain)(nil).GetAutoRestake), arg0)
+}
+
+// GetBLSKeyRotation mocks base method.
+func (m *MockChain) GetBLSKeyRotation(arg0 ids.NodeID) (*BLSKeyRotation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBLSKeyRotation", arg0)
+	ret0, _ := ret[0].(*BLSKeyRotation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBLSKeyRotation indicates an expected call of GetBLSKeyRotation.
+func (mr *MockChainMockRecorder) GetBLSKeyRotation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBLSKeyRotation", reflect.TypeOf((*MockChain)(nil).GetBLSKeyRotation), arg0)
+}
+
+// GetBurnedFees mocks base method.
+func (m *MockChain) GetBurnedFees() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBurnedFees")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBurnedFees indicates an expected call of GetBurnedFees.
+func (mr *MockChainMockRecorder) GetBurnedFees() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBurnedFees", reflect.TypeOf((*MockChain)(nil).GetBurnedFees))
+}
+
 // GetChains mocks base method.
 func (m *MockChain) GetChains(arg0 ids.ID) ([]*txs.Tx, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +301,21 @@ func (mr *MockChainMockRecorder) GetCurrentDelegatorIterator(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentDelegatorIterator", reflect.TypeOf((*MockChain)(nil).GetCurrentDelegatorIterator), arg0, arg1)
 }
 
+// GetCurrentStakedWeight mocks base method.
+func (m *MockChain) GetCurrentStakedWeight(arg0 ids.ID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentStakedWeight", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentStakedWeight indicates an expected call of GetCurrentStakedWeight.
+func (mr *MockChainMockRecorder) GetCurrentStakedWeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentStakedWeight", reflect.TypeOf((*MockChain)(nil).GetCurrentStakedWeight), arg0)
+}
+
 // GetCurrentStakerIterator mocks base method.
 func (m *MockChain) GetCurrentStakerIterator() (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -322,6 +406,20 @@ func (mr *MockChainMockRecorder) GetFeePerWeightStored() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeePerWeightStored", reflect.TypeOf((*MockChain)(nil).GetFeePerWeightStored))
 }
 
+// GetFeeRate mocks base method.
+func (m *MockChain) GetFeeRate() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeRate")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetFeeRate indicates an expected call of GetFeeRate.
+func (mr *MockChainMockRecorder) GetFeeRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeRate", reflect.TypeOf((*MockChain)(nil).GetFeeRate))
+}
+
 // GetLastAccumulatedFee mocks base method.
 func (m *MockChain) GetLastAccumulatedFee() (uint64, error) {
 	m.ctrl.T.Helper()
@@ -337,6 +435,21 @@ func (mr *MockChainMockRecorder) GetLastAccumulatedFee() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastAccumulatedFee", reflect.TypeOf((*MockChain)(nil).GetLastAccumulatedFee))
 }
 
+// GetMintedSupply mocks base method.
+func (m *MockChain) GetMintedSupply() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMintedSupply")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMintedSupply indicates an expected call of GetMintedSupply.
+func (mr *MockChainMockRecorder) GetMintedSupply() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMintedSupply", reflect.TypeOf((*MockChain)(nil).GetMintedSupply))
+}
+
 // GetPendingDelegatorIterator mocks base method.
 func (m *MockChain) GetPendingDelegatorIterator(arg0 ids.ID, arg1 ids.NodeID) (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -442,6 +555,36 @@ func (mr *MockChainMockRecorder) GetStakerAccumulatedMintRate() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakerAccumulatedMintRate", reflect.TypeOf((*MockChain)(nil).GetStakerAccumulatedMintRate))
 }
 
+// GetSubnetFeeConfig mocks base method.
+func (m *MockChain) GetSubnetFeeConfig(arg0 ids.ID) (*SubnetFeeConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetFeeConfig", arg0)
+	ret0, _ := ret[0].(*SubnetFeeConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetFeeConfig indicates an expected call of GetSubnetFeeConfig.
+func (mr *MockChainMockRecorder) GetSubnetFeeConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetFeeConfig", reflect.TypeOf((*MockChain)(nil).GetSubnetFeeConfig), arg0)
+}
+
+// GetSubnetFeePool mocks base method.
+func (m *MockChain) GetSubnetFeePool(arg0 ids.ID) (*SubnetFeePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetFeePool", arg0)
+	ret0, _ := ret[0].(*SubnetFeePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetFeePool indicates an expected call of GetSubnetFeePool.
+func (mr *MockChainMockRecorder) GetSubnetFeePool(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetFeePool", reflect.TypeOf((*MockChain)(nil).GetSubnetFeePool), arg0)
+}
+
 // GetSubnetOwner mocks base method.
 func (m *MockChain) GetSubnetOwner(arg0 ids.ID) (fx.Owner, error) {
 	m.ctrl.T.Helper()
@@ -532,6 +675,33 @@ func (mr *MockChainMockRecorder) GetUTXO(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXO", reflect.TypeOf((*MockChain)(nil).GetUTXO), arg0)
 }
 
+// GetUptimeScore mocks base method.
+func (m *MockChain) GetUptimeScore(arg0 ids.NodeID) (*UptimeScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUptimeScore", arg0)
+	ret0, _ := ret[0].(*UptimeScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUptimeScore indicates an expected call of GetUptimeScore.
+func (mr *MockChainMockRecorder) GetUptimeScore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUptimeScore", reflect.TypeOf((*MockChain)(nil).GetUptimeScore), arg0)
+}
+
+// PutBLSKeyRotation mocks base method.
+func (m *MockChain) PutBLSKeyRotation(arg0 *BLSKeyRotation) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PutBLSKeyRotation", arg0)
+}
+
+// PutBLSKeyRotation indicates an expected call of PutBLSKeyRotation.
+func (mr *MockChainMockRecorder) PutBLSKeyRotation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutBLSKeyRotation", reflect.TypeOf((*MockChain)(nil).PutBLSKeyRotation), arg0)
+}
+
 // PutCurrentDelegator mocks base method.
 func (m *MockChain) PutCurrentDelegator(arg0 *Staker) {
 	m.ctrl.T.Helper()
@@ -580,6 +750,30 @@ func (mr *MockChainMockRecorder) PutPendingValidator(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPendingValidator", reflect.TypeOf((*MockChain)(nil).PutPendingValidator), arg0)
 }
 
+// PutUptimeScore mocks base method.
+func (m *MockChain) PutUptimeScore(arg0 *UptimeScore) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PutUptimeScore", arg0)
+}
+
+// PutUptimeScore indicates an expected call of PutUptimeScore.
+func (mr *MockChainMockRecorder) PutUptimeScore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUptimeScore", reflect.TypeOf((*MockChain)(nil).PutUptimeScore), arg0)
+}
+
+// SetAutoRestake mocks base method.
+func (m *MockChain) SetAutoRestake(arg0 ids.ID, arg1 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAutoRestake", arg0, arg1)
+}
+
+// SetAutoRestake indicates an expected call of SetAutoRestake.
+func (mr *MockChainMockRecorder) SetAutoRestake(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutoRestake", reflect.TypeOf((*MockChain)(nil).SetAutoRestake), arg0, arg1)
+}
+
 // SetCurrentSupply mocks base method.
 func (m *MockChain) SetCurrentSupply(arg0 ids.ID, arg1 uint64) {
 	m.ctrl.T.Helper()
@@ -618,6 +812,18 @@ func (mr *MockChainMockRecorder) SetFeePerWeightStored(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeePerWeightStored", reflect.TypeOf((*MockChain)(nil).SetFeePerWeightStored), arg0)
 }
 
+// SetFeeRate mocks base method.
+func (m *MockChain) SetFeeRate(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFeeRate", arg0)
+}
+
+// SetFeeRate indicates an expected call of SetFeeRate.
+func (mr *MockChainMockRecorder) SetFeeRate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeeRate", reflect.TypeOf((*MockChain)(nil).SetFeeRate), arg0)
+}
+
 // SetLastAccumulatedFee mocks base method.
 func (m *MockChain) SetLastAccumulatedFee(arg0 uint64) {
 	m.ctrl.T.Helper()
@@ -654,6 +860,42 @@ func (mr *MockChainMockRecorder) SetStakerAccumulatedMintRate(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStakerAccumulatedMintRate", reflect.TypeOf((*MockChain)(nil).SetStakerAccumulatedMintRate), arg0)
 }
 
+// SetSubnetFeeConfig mocks base method.
+func (m *MockChain) SetSubnetFeeConfig(arg0 ids.ID, arg1 *SubnetFeeConfig) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetFeeConfig", arg0, arg1)
+}
+
+// SetSubnetFeeConfig indicates an expected call of SetSubnetFeeConfig.
+func (mr *MockChainMockRecorder) SetSubnetFeeConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetFeeConfig", reflect.TypeOf((*MockChain)(nil).SetSubnetFeeConfig), arg0, arg1)
+}
+
+// SetSubnetFeePool mocks base method.
+func (m *MockChain) SetSubnetFeePool(arg0 ids.ID, arg1 *SubnetFeePool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetFeePool", arg0, arg1)
+}
+
+// SetSubnetFeePool indicates an expected call of SetSubnetFeePool.
+func (mr *MockChainMockRecorder) SetSubnetFeePool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetFeePool", reflect.TypeOf((*MockChain)(nil).SetSubnetFeePool), arg0, arg1)
+}
+
+// SetSubnetOwner mocks base method.
+func (m *MockChain) SetSubnetOwner(arg0 ids.ID, arg1 fx.Owner) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetOwner", arg0, arg1)
+}
+
+// SetSubnetOwner indicates an expected call of SetSubnetOwner.
+func (mr *MockChainMockRecorder) SetSubnetOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetOwner", reflect.TypeOf((*MockChain)(nil).SetSubnetOwner), arg0, arg1)
+}
+
 // SetTimestamp mocks base method.
 func (m *MockChain) SetTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	database "github.com/DioneProtocol/odysseygo/database"
 	ids "github.com/DioneProtocol/odysseygo/ids"
 	dione "github.com/DioneProtocol/odysseygo/vms/components/dione"
 	fx "github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
@@ -43,6 +44,18 @@ func (m *MockDiff) EXPECT() *MockDiffMockRecorder {
 	return m.recorder
 }
 
+// AddBurnedFees mocks base method.
+func (m *MockDiff) AddBurnedFees(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddBurnedFees", arg0)
+}
+
+// AddBurnedFees indicates an expected call of AddBurnedFees.
+func (mr *MockDiffMockRecorder) AddBurnedFees(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBurnedFees", reflect.TypeOf((*MockDiff)(nil).AddBurnedFees), arg0)
+}
+
 // AddChain mocks base method.
 func (m *MockDiff) AddChain(arg0 *txs.Tx) {
 	m.ctrl.T.Helper()
@@ -67,6 +80,18 @@ func (mr *MockDiffMockRecorder) AddCurrentAccumulatedFee(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCurrentAccumulatedFee", reflect.TypeOf((*MockDiff)(nil).AddCurrentAccumulatedFee), arg0)
 }
 
+// AddMintedSupply mocks base method.
+func (m *MockDiff) AddMintedSupply(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddMintedSupply", arg0)
+}
+
+// AddMintedSupply indicates an expected call of AddMintedSupply.
+func (mr *MockDiffMockRecorder) AddMintedSupply(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMintedSupply", reflect.TypeOf((*MockDiff)(nil).AddMintedSupply), arg0)
+}
+
 // AddRewardUTXO mocks base method.
 func (m *MockDiff) AddRewardUTXO(arg0 ids.ID, arg1 *dione.UTXO) {
 	m.ctrl.T.Helper()
@@ -201,6 +226,51 @@ func (mr *MockDiffMockRecorder) DeleteUTXO(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUTXO", reflect.TypeOf((*MockDiff)(nil).DeleteUTXO), arg0)
 }
 
+// GetAutoRestake mocks base method.
+func (m *MockDiff) GetAutoRestake(arg0 ids.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutoRestake", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutoRestake indicates an expected call of GetAutoRestake.
+func (mr *MockDiffMockRecorder) GetAutoRestake(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoRestake", reflect.TypeOf((*MockDiff)(nil).GetAutoRestake), arg0)
+}
+
+// GetBLSKeyRotation mocks base method.
+func (m *MockDiff) GetBLSKeyRotation(arg0 ids.NodeID) (*BLSKeyRotation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBLSKeyRotation", arg0)
+	ret0, _ := ret[0].(*BLSKeyRotation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBLSKeyRotation indicates an expected call of GetBLSKeyRotation.
+func (mr *MockDiffMockRecorder) GetBLSKeyRotation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBLSKeyRotation", reflect.TypeOf((*MockDiff)(nil).GetBLSKeyRotation), arg0)
+}
+
+// GetBurnedFees mocks base method.
+func (m *MockDiff) GetBurnedFees() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBurnedFees")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBurnedFees indicates an expected call of GetBurnedFees.
+func (mr *MockDiffMockRecorder) GetBurnedFees() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBurnedFees", reflect.TypeOf((*MockDiff)(nil).GetBurnedFees))
+}
+
 // GetChains mocks base method.
 func (m *MockDiff) GetChains(arg0 ids.ID) ([]*txs.Tx, error) {
 	m.ctrl.T.Helper()
@@ -246,6 +316,21 @@ func (mr *MockDiffMockRecorder) GetCurrentDelegatorIterator(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentDelegatorIterator", reflect.TypeOf((*MockDiff)(nil).GetCurrentDelegatorIterator), arg0, arg1)
 }
 
+// GetCurrentStakedWeight mocks base method.
+func (m *MockDiff) GetCurrentStakedWeight(arg0 ids.ID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentStakedWeight", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentStakedWeight indicates an expected call of GetCurrentStakedWeight.
+func (mr *MockDiffMockRecorder) GetCurrentStakedWeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentStakedWeight", reflect.TypeOf((*MockDiff)(nil).GetCurrentStakedWeight), arg0)
+}
+
 // GetCurrentStakerIterator mocks base method.
 func (m *MockDiff) GetCurrentStakerIterator() (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -336,6 +421,20 @@ func (mr *MockDiffMockRecorder) GetFeePerWeightStored() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeePerWeightStored", reflect.TypeOf((*MockDiff)(nil).GetFeePerWeightStored))
 }
 
+// GetFeeRate mocks base method.
+func (m *MockDiff) GetFeeRate() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeRate")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetFeeRate indicates an expected call of GetFeeRate.
+func (mr *MockDiffMockRecorder) GetFeeRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeRate", reflect.TypeOf((*MockDiff)(nil).GetFeeRate))
+}
+
 // GetLastAccumulatedFee mocks base method.
 func (m *MockDiff) GetLastAccumulatedFee() (uint64, error) {
 	m.ctrl.T.Helper()
@@ -351,6 +450,21 @@ func (mr *MockDiffMockRecorder) GetLastAccumulatedFee() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastAccumulatedFee", reflect.TypeOf((*MockDiff)(nil).GetLastAccumulatedFee))
 }
 
+// GetMintedSupply mocks base method.
+func (m *MockDiff) GetMintedSupply() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMintedSupply")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMintedSupply indicates an expected call of GetMintedSupply.
+func (mr *MockDiffMockRecorder) GetMintedSupply() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMintedSupply", reflect.TypeOf((*MockDiff)(nil).GetMintedSupply))
+}
+
 // GetPendingDelegatorIterator mocks base method.
 func (m *MockDiff) GetPendingDelegatorIterator(arg0 ids.ID, arg1 ids.NodeID) (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -456,6 +570,36 @@ func (mr *MockDiffMockRecorder) GetStakerAccumulatedMintRate() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakerAccumulatedMintRate", reflect.TypeOf((*MockDiff)(nil).GetStakerAccumulatedMintRate))
 }
 
+// GetSubnetFeeConfig mocks base method.
+func (m *MockDiff) GetSubnetFeeConfig(arg0 ids.ID) (*SubnetFeeConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetFeeConfig", arg0)
+	ret0, _ := ret[0].(*SubnetFeeConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetFeeConfig indicates an expected call of GetSubnetFeeConfig.
+func (mr *MockDiffMockRecorder) GetSubnetFeeConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetFeeConfig", reflect.TypeOf((*MockDiff)(nil).GetSubnetFeeConfig), arg0)
+}
+
+// GetSubnetFeePool mocks base method.
+func (m *MockDiff) GetSubnetFeePool(arg0 ids.ID) (*SubnetFeePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetFeePool", arg0)
+	ret0, _ := ret[0].(*SubnetFeePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetFeePool indicates an expected call of GetSubnetFeePool.
+func (mr *MockDiffMockRecorder) GetSubnetFeePool(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetFeePool", reflect.TypeOf((*MockDiff)(nil).GetSubnetFeePool), arg0)
+}
+
 // GetSubnetOwner mocks base method.
 func (m *MockDiff) GetSubnetOwner(arg0 ids.ID) (fx.Owner, error) {
 	m.ctrl.T.Helper()
@@ -546,6 +690,48 @@ func (mr *MockDiffMockRecorder) GetUTXO(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXO", reflect.TypeOf((*MockDiff)(nil).GetUTXO), arg0)
 }
 
+// GetUptimeScore mocks base method.
+func (m *MockDiff) GetUptimeScore(arg0 ids.NodeID) (*UptimeScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUptimeScore", arg0)
+	ret0, _ := ret[0].(*UptimeScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUptimeScore indicates an expected call of GetUptimeScore.
+func (mr *MockDiffMockRecorder) GetUptimeScore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUptimeScore", reflect.TypeOf((*MockDiff)(nil).GetUptimeScore), arg0)
+}
+
+// MerkleOps mocks base method.
+func (m *MockDiff) MerkleOps() ([]database.BatchOp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MerkleOps")
+	ret0, _ := ret[0].([]database.BatchOp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MerkleOps indicates an expected call of MerkleOps.
+func (mr *MockDiffMockRecorder) MerkleOps() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MerkleOps", reflect.TypeOf((*MockDiff)(nil).MerkleOps))
+}
+
+// PutBLSKeyRotation mocks base method.
+func (m *MockDiff) PutBLSKeyRotation(arg0 *BLSKeyRotation) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PutBLSKeyRotation", arg0)
+}
+
+// PutBLSKeyRotation indicates an expected call of PutBLSKeyRotation.
+func (mr *MockDiffMockRecorder) PutBLSKeyRotation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutBLSKeyRotation", reflect.TypeOf((*MockDiff)(nil).PutBLSKeyRotation), arg0)
+}
+
 // PutCurrentDelegator mocks base method.
 func (m *MockDiff) PutCurrentDelegator(arg0 *Staker) {
 	m.ctrl.T.Helper()
@@ -594,6 +780,30 @@ func (mr *MockDiffMockRecorder) PutPendingValidator(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPendingValidator", reflect.TypeOf((*MockDiff)(nil).PutPendingValidator), arg0)
 }
 
+// PutUptimeScore mocks base method.
+func (m *MockDiff) PutUptimeScore(arg0 *UptimeScore) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PutUptimeScore", arg0)
+}
+
+// PutUptimeScore indicates an expected call of PutUptimeScore.
+func (mr *MockDiffMockRecorder) PutUptimeScore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUptimeScore", reflect.TypeOf((*MockDiff)(nil).PutUptimeScore), arg0)
+}
+
+// SetAutoRestake mocks base method.
+func (m *MockDiff) SetAutoRestake(arg0 ids.ID, arg1 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAutoRestake", arg0, arg1)
+}
+
+// SetAutoRestake indicates an expected call of SetAutoRestake.
+func (mr *MockDiffMockRecorder) SetAutoRestake(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutoRestake", reflect.TypeOf((*MockDiff)(nil).SetAutoRestake), arg0, arg1)
+}
+
 // SetCurrentSupply mocks base method.
 func (m *MockDiff) SetCurrentSupply(arg0 ids.ID, arg1 uint64) {
 	m.ctrl.T.Helper()
@@ -632,6 +842,18 @@ func (mr *MockDiffMockRecorder) SetFeePerWeightStored(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeePerWeightStored", reflect.TypeOf((*MockDiff)(nil).SetFeePerWeightStored), arg0)
 }
 
+// SetFeeRate mocks base method.
+func (m *MockDiff) SetFeeRate(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFeeRate", arg0)
+}
+
+// SetFeeRate indicates an expected call of SetFeeRate.
+func (mr *MockDiffMockRecorder) SetFeeRate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeeRate", reflect.TypeOf((*MockDiff)(nil).SetFeeRate), arg0)
+}
+
 // SetLastAccumulatedFee mocks base method.
 func (m *MockDiff) SetLastAccumulatedFee(arg0 uint64) {
 	m.ctrl.T.Helper()
@@ -668,6 +890,42 @@ func (mr *MockDiffMockRecorder) SetStakerAccumulatedMintRate(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStakerAccumulatedMintRate", reflect.TypeOf((*MockDiff)(nil).SetStakerAccumulatedMintRate), arg0)
 }
 
+// SetSubnetFeeConfig mocks base method.
+func (m *MockDiff) SetSubnetFeeConfig(arg0 ids.ID, arg1 *SubnetFeeConfig) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetFeeConfig", arg0, arg1)
+}
+
+// SetSubnetFeeConfig indicates an expected call of SetSubnetFeeConfig.
+func (mr *MockDiffMockRecorder) SetSubnetFeeConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetFeeConfig", reflect.TypeOf((*MockDiff)(nil).SetSubnetFeeConfig), arg0, arg1)
+}
+
+// SetSubnetFeePool mocks base method.
+func (m *MockDiff) SetSubnetFeePool(arg0 ids.ID, arg1 *SubnetFeePool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetFeePool", arg0, arg1)
+}
+
+// SetSubnetFeePool indicates an expected call of SetSubnetFeePool.
+func (mr *MockDiffMockRecorder) SetSubnetFeePool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetFeePool", reflect.TypeOf((*MockDiff)(nil).SetSubnetFeePool), arg0, arg1)
+}
+
+// SetSubnetOwner mocks base method.
+func (m *MockDiff) SetSubnetOwner(arg0 ids.ID, arg1 fx.Owner) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetOwner", arg0, arg1)
+}
+
+// SetSubnetOwner indicates an expected call of SetSubnetOwner.
+func (mr *MockDiffMockRecorder) SetSubnetOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetOwner", reflect.TypeOf((*MockDiff)(nil).SetSubnetOwner), arg0, arg1)
+}
+
 // SetTimestamp mocks base method.
 func (m *MockDiff) SetTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
@@ -23,6 +23,7 @@ import (
 	fx "github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	status "github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	txs "github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	merkledb "github.com/DioneProtocol/odysseygo/x/merkledb"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -61,6 +62,18 @@ func (mr *MockStateMockRecorder) Abort() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Abort", reflect.TypeOf((*MockState)(nil).Abort))
 }
 
+// AddBurnedFees mocks base method.
+func (m *MockState) AddBurnedFees(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddBurnedFees", arg0)
+}
+
+// AddBurnedFees indicates an expected call of AddBurnedFees.
+func (mr *MockStateMockRecorder) AddBurnedFees(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBurnedFees", reflect.TypeOf((*MockState)(nil).AddBurnedFees), arg0)
+}
+
 // AddChain mocks base method.
 func (m *MockState) AddChain(arg0 *txs.Tx) {
 	m.ctrl.T.Helper()
@@ -85,6 +98,18 @@ func (mr *MockStateMockRecorder) AddCurrentAccumulatedFee(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCurrentAccumulatedFee", reflect.TypeOf((*MockState)(nil).AddCurrentAccumulatedFee), arg0)
 }
 
+// AddMintedSupply mocks base method.
+func (m *MockState) AddMintedSupply(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddMintedSupply", arg0)
+}
+
+// AddMintedSupply indicates an expected call of AddMintedSupply.
+func (mr *MockStateMockRecorder) AddMintedSupply(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMintedSupply", reflect.TypeOf((*MockState)(nil).AddMintedSupply), arg0)
+}
+
 // AddRewardUTXO mocks base method.
 func (m *MockState) AddRewardUTXO(arg0 ids.ID, arg1 *dione.UTXO) {
 	m.ctrl.T.Helper()
@@ -157,6 +182,20 @@ func (mr *MockStateMockRecorder) AddUTXO(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUTXO", reflect.TypeOf((*MockState)(nil).AddUTXO), arg0)
 }
 
+// ApplySyncedState mocks base method.
+func (m *MockState) ApplySyncedState(arg0 context.Context, arg1 database.Iteratee, arg2 ids.ID, arg3 blocks.Block) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplySyncedState", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplySyncedState indicates an expected call of ApplySyncedState.
+func (mr *MockStateMockRecorder) ApplySyncedState(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplySyncedState", reflect.TypeOf((*MockState)(nil).ApplySyncedState), arg0, arg1, arg2, arg3)
+}
+
 // ApplyValidatorPublicKeyDiffs mocks base method.
 func (m *MockState) ApplyValidatorPublicKeyDiffs(arg0 context.Context, arg1 map[ids.NodeID]*validators.GetValidatorOutput, arg2, arg3 uint64) error {
 	m.ctrl.T.Helper()
@@ -302,6 +341,36 @@ func (mr *MockStateMockRecorder) DeleteUTXO(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUTXO", reflect.TypeOf((*MockState)(nil).DeleteUTXO), arg0)
 }
 
+// GetAutoRestake mocks base method.
+func (m *MockState) GetAutoRestake(arg0 ids.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutoRestake", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutoRestake indicates an expected call of GetAutoRestake.
+func (mr *MockStateMockRecorder) GetAutoRestake(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoRestake", reflect.TypeOf((*MockState)(nil).GetAutoRestake), arg0)
+}
+
+// GetBLSKeyRotation mocks base method.
+func (m *MockState) GetBLSKeyRotation(arg0 ids.NodeID) (*BLSKeyRotation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBLSKeyRotation", arg0)
+	ret0, _ := ret[0].(*BLSKeyRotation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBLSKeyRotation indicates an expected call of GetBLSKeyRotation.
+func (mr *MockStateMockRecorder) GetBLSKeyRotation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBLSKeyRotation", reflect.TypeOf((*MockState)(nil).GetBLSKeyRotation), arg0)
+}
+
 // GetBlockIDAtHeight mocks base method.
 func (m *MockState) GetBlockIDAtHeight(arg0 uint64) (ids.ID, error) {
 	m.ctrl.T.Helper()
@@ -317,6 +386,21 @@ func (mr *MockStateMockRecorder) GetBlockIDAtHeight(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockIDAtHeight", reflect.TypeOf((*MockState)(nil).GetBlockIDAtHeight), arg0)
 }
 
+// GetBurnedFees mocks base method.
+func (m *MockState) GetBurnedFees() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBurnedFees")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBurnedFees indicates an expected call of GetBurnedFees.
+func (mr *MockStateMockRecorder) GetBurnedFees() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBurnedFees", reflect.TypeOf((*MockState)(nil).GetBurnedFees))
+}
+
 // GetChains mocks base method.
 func (m *MockState) GetChains(arg0 ids.ID) ([]*txs.Tx, error) {
 	m.ctrl.T.Helper()
@@ -362,6 +446,21 @@ func (mr *MockStateMockRecorder) GetCurrentDelegatorIterator(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentDelegatorIterator", reflect.TypeOf((*MockState)(nil).GetCurrentDelegatorIterator), arg0, arg1)
 }
 
+// GetCurrentStakedWeight mocks base method.
+func (m *MockState) GetCurrentStakedWeight(arg0 ids.ID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentStakedWeight", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentStakedWeight indicates an expected call of GetCurrentStakedWeight.
+func (mr *MockStateMockRecorder) GetCurrentStakedWeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentStakedWeight", reflect.TypeOf((*MockState)(nil).GetCurrentStakedWeight), arg0)
+}
+
 // GetCurrentStakerIterator mocks base method.
 func (m *MockState) GetCurrentStakerIterator() (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -452,6 +551,20 @@ func (mr *MockStateMockRecorder) GetFeePerWeightStored() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeePerWeightStored", reflect.TypeOf((*MockState)(nil).GetFeePerWeightStored))
 }
 
+// GetFeeRate mocks base method.
+func (m *MockState) GetFeeRate() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeRate")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetFeeRate indicates an expected call of GetFeeRate.
+func (mr *MockStateMockRecorder) GetFeeRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeRate", reflect.TypeOf((*MockState)(nil).GetFeeRate))
+}
+
 // GetLastAccepted mocks base method.
 func (m *MockState) GetLastAccepted() ids.ID {
 	m.ctrl.T.Helper()
@@ -481,6 +594,80 @@ func (mr *MockStateMockRecorder) GetLastAccumulatedFee() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastAccumulatedFee", reflect.TypeOf((*MockState)(nil).GetLastAccumulatedFee))
 }
 
+// GetLastFeeSync mocks base method.
+func (m *MockState) GetLastFeeSync() (*FeeSync, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastFeeSync")
+	ret0, _ := ret[0].(*FeeSync)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastFeeSync indicates an expected call of GetLastFeeSync.
+func (mr *MockStateMockRecorder) GetLastFeeSync() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastFeeSync", reflect.TypeOf((*MockState)(nil).GetLastFeeSync))
+}
+
+// GetLastOrionFeePayout mocks base method.
+func (m *MockState) GetLastOrionFeePayout(arg0 ids.NodeID) (*OrionFeePayout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastOrionFeePayout", arg0)
+	ret0, _ := ret[0].(*OrionFeePayout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastOrionFeePayout indicates an expected call of GetLastOrionFeePayout.
+func (mr *MockStateMockRecorder) GetLastOrionFeePayout(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastOrionFeePayout", reflect.TypeOf((*MockState)(nil).GetLastOrionFeePayout), arg0)
+}
+
+// GetLockedAmount mocks base method.
+func (m *MockState) GetLockedAmount(arg0 time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLockedAmount", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLockedAmount indicates an expected call of GetLockedAmount.
+func (mr *MockStateMockRecorder) GetLockedAmount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLockedAmount", reflect.TypeOf((*MockState)(nil).GetLockedAmount), arg0)
+}
+
+// GetMerkleDB mocks base method.
+func (m *MockState) GetMerkleDB() merkledb.MerkleDB {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMerkleDB")
+	ret0, _ := ret[0].(merkledb.MerkleDB)
+	return ret0
+}
+
+// GetMerkleDB indicates an expected call of GetMerkleDB.
+func (mr *MockStateMockRecorder) GetMerkleDB() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMerkleDB", reflect.TypeOf((*MockState)(nil).GetMerkleDB))
+}
+
+// GetMintedSupply mocks base method.
+func (m *MockState) GetMintedSupply() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMintedSupply")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMintedSupply indicates an expected call of GetMintedSupply.
+func (mr *MockStateMockRecorder) GetMintedSupply() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMintedSupply", reflect.TypeOf((*MockState)(nil).GetMintedSupply))
+}
+
 // GetPendingDelegatorIterator mocks base method.
 func (m *MockState) GetPendingDelegatorIterator(arg0 ids.ID, arg1 ids.NodeID) (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -616,6 +803,36 @@ func (mr *MockStateMockRecorder) GetStatelessBlock(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatelessBlock", reflect.TypeOf((*MockState)(nil).GetStatelessBlock), arg0)
 }
 
+// GetSubnetFeeConfig mocks base method.
+func (m *MockState) GetSubnetFeeConfig(arg0 ids.ID) (*SubnetFeeConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetFeeConfig", arg0)
+	ret0, _ := ret[0].(*SubnetFeeConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetFeeConfig indicates an expected call of GetSubnetFeeConfig.
+func (mr *MockStateMockRecorder) GetSubnetFeeConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetFeeConfig", reflect.TypeOf((*MockState)(nil).GetSubnetFeeConfig), arg0)
+}
+
+// GetSubnetFeePool mocks base method.
+func (m *MockState) GetSubnetFeePool(arg0 ids.ID) (*SubnetFeePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetFeePool", arg0)
+	ret0, _ := ret[0].(*SubnetFeePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetFeePool indicates an expected call of GetSubnetFeePool.
+func (mr *MockStateMockRecorder) GetSubnetFeePool(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetFeePool", reflect.TypeOf((*MockState)(nil).GetSubnetFeePool), arg0)
+}
+
 // GetSubnetOwner mocks base method.
 func (m *MockState) GetSubnetOwner(arg0 ids.ID) (fx.Owner, error) {
 	m.ctrl.T.Helper()
@@ -661,6 +878,21 @@ func (mr *MockStateMockRecorder) GetSubnets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnets", reflect.TypeOf((*MockState)(nil).GetSubnets))
 }
 
+// GetSupplyBreakdown mocks base method.
+func (m *MockState) GetSupplyBreakdown(arg0 uint64) (*SupplyBreakdown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupplyBreakdown", arg0)
+	ret0, _ := ret[0].(*SupplyBreakdown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupplyBreakdown indicates an expected call of GetSupplyBreakdown.
+func (mr *MockStateMockRecorder) GetSupplyBreakdown(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupplyBreakdown", reflect.TypeOf((*MockState)(nil).GetSupplyBreakdown), arg0)
+}
+
 // GetTimestamp mocks base method.
 func (m *MockState) GetTimestamp() time.Time {
 	m.ctrl.T.Helper()
@@ -722,6 +954,21 @@ func (mr *MockStateMockRecorder) GetUptime(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUptime", reflect.TypeOf((*MockState)(nil).GetUptime), arg0, arg1)
 }
 
+// GetUptimeScore mocks base method.
+func (m *MockState) GetUptimeScore(arg0 ids.NodeID) (*UptimeScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUptimeScore", arg0)
+	ret0, _ := ret[0].(*UptimeScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUptimeScore indicates an expected call of GetUptimeScore.
+func (mr *MockStateMockRecorder) GetUptimeScore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUptimeScore", reflect.TypeOf((*MockState)(nil).GetUptimeScore), arg0)
+}
+
 // PruneAndIndex mocks base method.
 func (m *MockState) PruneAndIndex(arg0 sync.Locker, arg1 logging.Logger) error {
 	m.ctrl.T.Helper()
@@ -736,6 +983,18 @@ func (mr *MockStateMockRecorder) PruneAndIndex(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneAndIndex", reflect.TypeOf((*MockState)(nil).PruneAndIndex), arg0, arg1)
 }
 
+// PutBLSKeyRotation mocks base method.
+func (m *MockState) PutBLSKeyRotation(arg0 *BLSKeyRotation) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PutBLSKeyRotation", arg0)
+}
+
+// PutBLSKeyRotation indicates an expected call of PutBLSKeyRotation.
+func (mr *MockStateMockRecorder) PutBLSKeyRotation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutBLSKeyRotation", reflect.TypeOf((*MockState)(nil).PutBLSKeyRotation), arg0)
+}
+
 // PutCurrentDelegator mocks base method.
 func (m *MockState) PutCurrentDelegator(arg0 *Staker) {
 	m.ctrl.T.Helper()
@@ -784,6 +1043,30 @@ func (mr *MockStateMockRecorder) PutPendingValidator(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPendingValidator", reflect.TypeOf((*MockState)(nil).PutPendingValidator), arg0)
 }
 
+// PutUptimeScore mocks base method.
+func (m *MockState) PutUptimeScore(arg0 *UptimeScore) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PutUptimeScore", arg0)
+}
+
+// PutUptimeScore indicates an expected call of PutUptimeScore.
+func (mr *MockStateMockRecorder) PutUptimeScore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUptimeScore", reflect.TypeOf((*MockState)(nil).PutUptimeScore), arg0)
+}
+
+// SetAutoRestake mocks base method.
+func (m *MockState) SetAutoRestake(arg0 ids.ID, arg1 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAutoRestake", arg0, arg1)
+}
+
+// SetAutoRestake indicates an expected call of SetAutoRestake.
+func (mr *MockStateMockRecorder) SetAutoRestake(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutoRestake", reflect.TypeOf((*MockState)(nil).SetAutoRestake), arg0, arg1)
+}
+
 // SetCurrentSupply mocks base method.
 func (m *MockState) SetCurrentSupply(arg0 ids.ID, arg1 uint64) {
 	m.ctrl.T.Helper()
@@ -822,6 +1105,18 @@ func (mr *MockStateMockRecorder) SetFeePerWeightStored(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeePerWeightStored", reflect.TypeOf((*MockState)(nil).SetFeePerWeightStored), arg0)
 }
 
+// SetFeeRate mocks base method.
+func (m *MockState) SetFeeRate(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFeeRate", arg0)
+}
+
+// SetFeeRate indicates an expected call of SetFeeRate.
+func (mr *MockStateMockRecorder) SetFeeRate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeeRate", reflect.TypeOf((*MockState)(nil).SetFeeRate), arg0)
+}
+
 // SetHeight mocks base method.
 func (m *MockState) SetHeight(arg0 uint64) {
 	m.ctrl.T.Helper()
@@ -858,6 +1153,30 @@ func (mr *MockStateMockRecorder) SetLastAccumulatedFee(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastAccumulatedFee", reflect.TypeOf((*MockState)(nil).SetLastAccumulatedFee), arg0)
 }
 
+// SetLastFeeSync mocks base method.
+func (m *MockState) SetLastFeeSync(arg0 *FeeSync) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLastFeeSync", arg0)
+}
+
+// SetLastFeeSync indicates an expected call of SetLastFeeSync.
+func (mr *MockStateMockRecorder) SetLastFeeSync(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastFeeSync", reflect.TypeOf((*MockState)(nil).SetLastFeeSync), arg0)
+}
+
+// SetLastOrionFeePayout mocks base method.
+func (m *MockState) SetLastOrionFeePayout(arg0 ids.NodeID, arg1 *OrionFeePayout) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLastOrionFeePayout", arg0, arg1)
+}
+
+// SetLastOrionFeePayout indicates an expected call of SetLastOrionFeePayout.
+func (mr *MockStateMockRecorder) SetLastOrionFeePayout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastOrionFeePayout", reflect.TypeOf((*MockState)(nil).SetLastOrionFeePayout), arg0, arg1)
+}
+
 // SetStakeSyncTimestamp mocks base method.
 func (m *MockState) SetStakeSyncTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
@@ -882,6 +1201,42 @@ func (mr *MockStateMockRecorder) SetStakerAccumulatedMintRate(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStakerAccumulatedMintRate", reflect.TypeOf((*MockState)(nil).SetStakerAccumulatedMintRate), arg0)
 }
 
+// SetSubnetFeeConfig mocks base method.
+func (m *MockState) SetSubnetFeeConfig(arg0 ids.ID, arg1 *SubnetFeeConfig) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetFeeConfig", arg0, arg1)
+}
+
+// SetSubnetFeeConfig indicates an expected call of SetSubnetFeeConfig.
+func (mr *MockStateMockRecorder) SetSubnetFeeConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetFeeConfig", reflect.TypeOf((*MockState)(nil).SetSubnetFeeConfig), arg0, arg1)
+}
+
+// SetSubnetFeePool mocks base method.
+func (m *MockState) SetSubnetFeePool(arg0 ids.ID, arg1 *SubnetFeePool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetFeePool", arg0, arg1)
+}
+
+// SetSubnetFeePool indicates an expected call of SetSubnetFeePool.
+func (mr *MockStateMockRecorder) SetSubnetFeePool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetFeePool", reflect.TypeOf((*MockState)(nil).SetSubnetFeePool), arg0, arg1)
+}
+
+// SetSubnetOwner mocks base method.
+func (m *MockState) SetSubnetOwner(arg0 ids.ID, arg1 fx.Owner) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetOwner", arg0, arg1)
+}
+
+// SetSubnetOwner indicates an expected call of SetSubnetOwner.
+func (mr *MockStateMockRecorder) SetSubnetOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetOwner", reflect.TypeOf((*MockState)(nil).SetSubnetOwner), arg0, arg1)
+}
+
 // SetTimestamp mocks base method.
 func (m *MockState) SetTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
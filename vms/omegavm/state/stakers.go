@@ -8,6 +8,7 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/math"
 )
 
 type Stakers interface {
@@ -64,6 +65,10 @@ type CurrentStakers interface {
 
 	// GetCurrentStakersLen returns current stakers amount
 	GetCurrentStakersLen() (uint64, error)
+
+	// GetCurrentStakedWeight returns the weight staked by the current
+	// validators and delegators of [subnetID].
+	GetCurrentStakedWeight(subnetID ids.ID) (uint64, error)
 }
 
 type PendingStakers interface {
@@ -107,6 +112,8 @@ type baseStakers struct {
 	stakers    *btree.BTreeG[*Staker]
 	// subnetID --> nodeID --> diff for that validator since the last db write
 	validatorDiffs map[ids.ID]map[ids.NodeID]*diffValidator
+	// subnetID --> weight staked on the subnet
+	weights map[ids.ID]uint64
 }
 
 type baseStaker struct {
@@ -119,6 +126,7 @@ func newBaseStakers() *baseStakers {
 		validators:     make(map[ids.ID]map[ids.NodeID]*baseStaker),
 		stakers:        btree.NewG(defaultTreeDegree, (*Staker).Less),
 		validatorDiffs: make(map[ids.ID]map[ids.NodeID]*diffValidator),
+		weights:        make(map[ids.ID]uint64),
 	}
 }
 
@@ -139,7 +147,11 @@ func (v *baseStakers) GetValidator(subnetID ids.ID, nodeID ids.NodeID) (*Staker,
 
 func (v *baseStakers) PutValidator(staker *Staker) {
 	validator := v.getOrCreateValidator(staker.SubnetID, staker.NodeID)
+	if validator.validator != nil {
+		v.removeWeight(staker.SubnetID, validator.validator.Weight)
+	}
 	validator.validator = staker
+	v.addWeight(staker.SubnetID, staker.Weight)
 
 	validatorDiff := v.getOrCreateValidatorDiff(staker.SubnetID, staker.NodeID)
 	validatorDiff.validatorStatus = added
@@ -150,6 +162,9 @@ func (v *baseStakers) PutValidator(staker *Staker) {
 
 func (v *baseStakers) DeleteValidator(staker *Staker) {
 	validator := v.getOrCreateValidator(staker.SubnetID, staker.NodeID)
+	if validator.validator != nil {
+		v.removeWeight(staker.SubnetID, validator.validator.Weight)
+	}
 	validator.validator = nil
 	v.pruneValidator(staker.SubnetID, staker.NodeID)
 
@@ -177,7 +192,10 @@ func (v *baseStakers) PutDelegator(staker *Staker) {
 	if validator.delegators == nil {
 		validator.delegators = btree.NewG(defaultTreeDegree, (*Staker).Less)
 	}
-	validator.delegators.ReplaceOrInsert(staker)
+	if replaced, ok := validator.delegators.ReplaceOrInsert(staker); ok {
+		v.removeWeight(staker.SubnetID, replaced.Weight)
+	}
+	v.addWeight(staker.SubnetID, staker.Weight)
 
 	validatorDiff := v.getOrCreateValidatorDiff(staker.SubnetID, staker.NodeID)
 	if validatorDiff.addedDelegators == nil {
@@ -191,7 +209,9 @@ func (v *baseStakers) PutDelegator(staker *Staker) {
 func (v *baseStakers) DeleteDelegator(staker *Staker) {
 	validator := v.getOrCreateValidator(staker.SubnetID, staker.NodeID)
 	if validator.delegators != nil {
-		validator.delegators.Delete(staker)
+		if deleted, ok := validator.delegators.Delete(staker); ok {
+			v.removeWeight(staker.SubnetID, deleted.Weight)
+		}
 	}
 	v.pruneValidator(staker.SubnetID, staker.NodeID)
 
@@ -208,6 +228,25 @@ func (v *baseStakers) GetStakerIterator() StakerIterator {
 	return NewTreeIterator(v.stakers)
 }
 
+func (v *baseStakers) GetWeight(subnetID ids.ID) uint64 {
+	return v.weights[subnetID]
+}
+
+// addWeight assumes that the weight staked on a subnet never overflows, as it
+// is bounded by the supply of the staking asset of the subnet.
+func (v *baseStakers) addWeight(subnetID ids.ID, weight uint64) {
+	v.weights[subnetID] += weight
+}
+
+func (v *baseStakers) removeWeight(subnetID ids.ID, weight uint64) {
+	newWeight := v.weights[subnetID] - weight
+	if newWeight == 0 {
+		delete(v.weights, subnetID)
+		return
+	}
+	v.weights[subnetID] = newWeight
+}
+
 func (v *baseStakers) getOrCreateValidator(subnetID ids.ID, nodeID ids.NodeID) *baseStaker {
 	subnetValidators, ok := v.validators[subnetID]
 	if !ok {
@@ -260,6 +299,8 @@ type diffStakers struct {
 	validatorDiffs map[ids.ID]map[ids.NodeID]*diffValidator
 	addedStakers   *btree.BTreeG[*Staker]
 	deletedStakers map[ids.ID]*Staker
+	// subnetID --> change of the weight staked on the subnet
+	weightDiffs map[ids.ID]*ValidatorWeightDiff
 }
 
 type diffValidator struct {
@@ -298,6 +339,7 @@ func (s *diffStakers) PutValidator(staker *Staker) {
 	validatorDiff := s.getOrCreateDiff(staker.SubnetID, staker.NodeID)
 	validatorDiff.validatorStatus = added
 	validatorDiff.validator = staker
+	s.updateWeight(staker.SubnetID, false, staker.Weight)
 
 	if s.addedStakers == nil {
 		s.addedStakers = btree.NewG(defaultTreeDegree, (*Staker).Less)
@@ -312,8 +354,10 @@ func (s *diffStakers) DeleteValidator(staker *Staker) {
 		// treat it as if it was never added.
 		validatorDiff.validatorStatus = unmodified
 		s.addedStakers.Delete(validatorDiff.validator)
+		s.updateWeight(staker.SubnetID, true, validatorDiff.validator.Weight)
 		validatorDiff.validator = nil
 	} else {
+		s.updateWeight(staker.SubnetID, true, staker.Weight)
 		validatorDiff.validatorStatus = deleted
 		validatorDiff.validator = staker
 		if s.deletedStakers == nil {
@@ -354,6 +398,7 @@ func (s *diffStakers) PutDelegator(staker *Staker) {
 		validatorDiff.addedDelegators = btree.NewG(defaultTreeDegree, (*Staker).Less)
 	}
 	validatorDiff.addedDelegators.ReplaceOrInsert(staker)
+	s.updateWeight(staker.SubnetID, false, staker.Weight)
 
 	if s.addedStakers == nil {
 		s.addedStakers = btree.NewG(defaultTreeDegree, (*Staker).Less)
@@ -367,6 +412,7 @@ func (s *diffStakers) DeleteDelegator(staker *Staker) {
 		validatorDiff.deletedDelegators = make(map[ids.ID]*Staker)
 	}
 	validatorDiff.deletedDelegators[staker.TxID] = staker
+	s.updateWeight(staker.SubnetID, true, staker.Weight)
 
 	if s.deletedStakers == nil {
 		s.deletedStakers = make(map[ids.ID]*Staker)
@@ -374,6 +420,33 @@ func (s *diffStakers) DeleteDelegator(staker *Staker) {
 	s.deletedStakers[staker.TxID] = staker
 }
 
+// GetWeight returns the weight staked on [subnetID], given the weight
+// [parentWeight] staked on it in the parent state.
+func (s *diffStakers) GetWeight(subnetID ids.ID, parentWeight uint64) (uint64, error) {
+	weightDiff, ok := s.weightDiffs[subnetID]
+	if !ok {
+		return parentWeight, nil
+	}
+	if weightDiff.Decrease {
+		return math.Sub(parentWeight, weightDiff.Amount)
+	}
+	return math.Add64(parentWeight, weightDiff.Amount)
+}
+
+func (s *diffStakers) updateWeight(subnetID ids.ID, decrease bool, weight uint64) {
+	if s.weightDiffs == nil {
+		s.weightDiffs = make(map[ids.ID]*ValidatorWeightDiff)
+	}
+	weightDiff, ok := s.weightDiffs[subnetID]
+	if !ok {
+		weightDiff = &ValidatorWeightDiff{}
+		s.weightDiffs[subnetID] = weightDiff
+	}
+	// The weight staked on a subnet never overflows, so neither does its
+	// change.
+	_ = weightDiff.Add(decrease, weight)
+}
+
 func (s *diffStakers) GetStakerIterator(parentIterator StakerIterator) StakerIterator {
 	return NewMaskedIterator(
 		NewMergedIterator(
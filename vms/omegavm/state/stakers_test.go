@@ -217,6 +217,49 @@ func TestDiffStakersDelegator(t *testing.T) {
 	assertIteratorsEqual(t, EmptyIterator, delegatorIterator)
 }
 
+func TestStakersWeight(t *testing.T) {
+	require := require.New(t)
+	validator := newTestStaker()
+	validator.Weight = 2
+	delegator := newTestStaker()
+	delegator.SubnetID = validator.SubnetID
+	delegator.NodeID = validator.NodeID
+	delegator.Weight = 3
+
+	v := newBaseStakers()
+	v.PutValidator(validator)
+	v.PutDelegator(delegator)
+	require.Equal(uint64(5), v.GetWeight(validator.SubnetID))
+	require.Zero(v.GetWeight(ids.GenerateTestID()))
+
+	// Replacing a staker only counts its new weight.
+	updatedValidator := *validator
+	updatedValidator.Weight = 4
+	v.PutValidator(&updatedValidator)
+	require.Equal(uint64(7), v.GetWeight(validator.SubnetID))
+
+	s := diffStakers{}
+	weight, err := s.GetWeight(validator.SubnetID, v.GetWeight(validator.SubnetID))
+	require.NoError(err)
+	require.Equal(uint64(7), weight)
+
+	addedDelegator := newTestStaker()
+	addedDelegator.SubnetID = validator.SubnetID
+	addedDelegator.NodeID = validator.NodeID
+	addedDelegator.Weight = 6
+	s.PutDelegator(addedDelegator)
+	s.DeleteDelegator(delegator)
+	s.DeleteValidator(&updatedValidator)
+	weight, err = s.GetWeight(validator.SubnetID, v.GetWeight(validator.SubnetID))
+	require.NoError(err)
+	require.Equal(uint64(6), weight)
+
+	v.DeleteDelegator(delegator)
+	v.DeleteValidator(&updatedValidator)
+	require.Zero(v.GetWeight(validator.SubnetID))
+	require.Empty(v.weights)
+}
+
 func newTestStaker() *Staker {
 	startTime := time.Now().Round(time.Second)
 	endTime := startTime.Add(28 * 24 * time.Hour)
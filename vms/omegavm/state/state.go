@@ -30,23 +30,28 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow/choices"
 	"github.com/DioneProtocol/odysseygo/snow/uptime"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/trace"
 	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
 	"github.com/DioneProtocol/odysseygo/utils/hashing"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/utils/timer"
 	"github.com/DioneProtocol/odysseygo/utils/wrappers"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/genesis"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/x/merkledb"
 )
 
 const (
@@ -83,9 +88,19 @@ var (
 	rewardUTXOsPrefix                   = []byte("rewardUTXOs")
 	utxoPrefix                          = []byte("utxo")
 	subnetPrefix                        = []byte("subnet")
+	subnetOwnerPrefix                   = []byte("subnetOwner")
+	blsKeyRotationPrefix                = []byte("blsKeyRotation")
+	autoRestakePrefix                   = []byte("autoRestake")
+	uptimeScorePrefix                   = []byte("uptimeScore")
+	subnetFeeConfigPrefix               = []byte("subnetFeeConfig")
+	subnetFeePoolPrefix                 = []byte("subnetFeePool")
 	transformedSubnetPrefix             = []byte("transformedSubnet")
 	supplyPrefix                        = []byte("supply")
 	chainPrefix                         = []byte("chain")
+	lockedAmountPrefix                  = []byte("lockedAmount")
+	orionFeePayoutPrefix                = []byte("orionFeePayout")
+	supplyBreakdownPrefix               = []byte("supplyBreakdown")
+	merklePrefix                        = []byte("merkle")
 	singletonPrefix                     = []byte("singleton")
 
 	timestampKey      = []byte("timestamp")
@@ -100,6 +115,12 @@ var (
 	feePerWeightStoredKey    = []byte("fee per staker stored")
 	lastAccumulatedFeeKey    = []byte("last accumulated fee")
 	currentAccumulatedFeeKey = []byte("current accumulated fee")
+	feeRateKey               = []byte("fee rate")
+	burnedFeesKey            = []byte("burned fees")
+	mintedSupplyKey          = []byte("minted supply")
+	lockedAmountsIndexedKey  = []byte("locked amounts indexed")
+	merkleStateIndexedKey    = []byte("merkle state indexed")
+	lastFeeSyncKey           = []byte("last fee sync")
 )
 
 // Chain collects all methods to manage the state of the chain for block
@@ -113,6 +134,11 @@ type Chain interface {
 	GetTimestamp() time.Time
 	SetTimestamp(tm time.Time)
 
+	// GetFeeRate returns the rate, in parts of [fees.RateDenominator], the
+	// static fees are charged at by the next block.
+	GetFeeRate() uint64
+	SetFeeRate(feeRate uint64)
+
 	SetStakeSyncTimestamp(tm time.Time)
 	GetStakeSyncTimestamp() (time.Time, error)
 
@@ -129,10 +155,40 @@ type Chain interface {
 	AddSubnet(createSubnetTx *txs.Tx)
 
 	GetSubnetOwner(subnetID ids.ID) (fx.Owner, error)
+	SetSubnetOwner(subnetID ids.ID, owner fx.Owner)
 
 	GetSubnetTransformation(subnetID ids.ID) (*txs.Tx, error)
 	AddSubnetTransformation(transformSubnetTx *txs.Tx)
 
+	// GetSubnetFeeConfig returns the fee schedule of the elastic subnet
+	// [subnetID]. Returns [database.ErrNotFound] if the subnet charges the
+	// fees of the primary network.
+	GetSubnetFeeConfig(subnetID ids.ID) (*SubnetFeeConfig, error)
+	SetSubnetFeeConfig(subnetID ids.ID, config *SubnetFeeConfig)
+
+	// GetSubnetFeePool returns the fees collected by the elastic subnet
+	// [subnetID]. Returns [database.ErrNotFound] if the subnet never
+	// collected fees.
+	GetSubnetFeePool(subnetID ids.ID) (*SubnetFeePool, error)
+	SetSubnetFeePool(subnetID ids.ID, pool *SubnetFeePool)
+
+	// GetBLSKeyRotation returns the latest rotation of the BLS key of the
+	// primary network validator [nodeID]. Returns [database.ErrNotFound] if
+	// the key of the validator was never rotated.
+	GetBLSKeyRotation(nodeID ids.NodeID) (*BLSKeyRotation, error)
+	PutBLSKeyRotation(rotation *BLSKeyRotation)
+
+	// GetAutoRestake returns whether the primary network validator added by
+	// [validatorTxID] is restaked when its staking period ends.
+	GetAutoRestake(validatorTxID ids.ID) (bool, error)
+	SetAutoRestake(validatorTxID ids.ID, autoRestake bool)
+
+	// GetUptimeScore returns the attested uptime of the primary network
+	// validator [nodeID]. Returns [database.ErrNotFound] if the uptime of the
+	// validator was never attested.
+	GetUptimeScore(nodeID ids.NodeID) (*UptimeScore, error)
+	PutUptimeScore(score *UptimeScore)
+
 	GetChains(subnetID ids.ID) ([]*txs.Tx, error)
 	AddChain(createChainTx *txs.Tx)
 
@@ -147,6 +203,16 @@ type Chain interface {
 
 	GetLastAccumulatedFee() (uint64, error)
 	SetLastAccumulatedFee(uint64)
+
+	// GetBurnedFees returns the fees that were burned from the primary
+	// network supply instead of being distributed to the stakers.
+	GetBurnedFees() (uint64, error)
+	AddBurnedFees(uint64)
+
+	// GetMintedSupply returns the rewards that were minted into the primary
+	// network supply.
+	GetMintedSupply() (uint64, error)
+	AddMintedSupply(uint64)
 }
 
 type State interface {
@@ -164,6 +230,27 @@ type State interface {
 
 	GetBlockIDAtHeight(height uint64) (ids.ID, error)
 
+	// GetLastFeeSync returns the last fee sync of an accepted block. Returns
+	// [database.ErrNotFound] if no fee sync was recorded.
+	GetLastFeeSync() (*FeeSync, error)
+	SetLastFeeSync(sync *FeeSync)
+
+	// GetLastOrionFeePayout returns the last orion fee paid out to [nodeID] by
+	// an accepted block. Returns [database.ErrNotFound] if no payout was
+	// recorded.
+	GetLastOrionFeePayout(nodeID ids.NodeID) (*OrionFeePayout, error)
+	SetLastOrionFeePayout(nodeID ids.NodeID, payout *OrionFeePayout)
+
+	// GetLockedAmount returns the amount of DIONE held by the
+	// [stakeable.LockOut] UTXOs that are still locked at [timestamp].
+	GetLockedAmount(timestamp time.Time) (uint64, error)
+
+	// GetSupplyBreakdown returns the supply breakdown of the primary network
+	// after the acceptance of the block at [height]. Returns
+	// [database.ErrNotFound] if [height] was accepted before the breakdowns
+	// were recorded.
+	GetSupplyBreakdown(height uint64) (*SupplyBreakdown, error)
+
 	// ValidatorSet adds all the validators and delegators of [subnetID] into
 	// [vdrs].
 	ValidatorSet(subnetID ids.ID, vdrs validators.Set) error
@@ -231,6 +318,16 @@ type State interface {
 
 	Checksum() ids.ID
 
+	// GetMerkleDB returns the merkle state committed to by the state root of
+	// the blocks.
+	GetMerkleDB() merkledb.MerkleDB
+
+	// ApplySyncedState replaces the state with the merkle state in [synced],
+	// whose root is [root], and marks [blk] as the last accepted block.
+	//
+	// Invariant: [synced] is the state after the acceptance of [blk].
+	ApplySyncedState(ctx context.Context, synced database.Iteratee, root ids.ID, blk blocks.Block) error
+
 	Close() error
 }
 
@@ -294,6 +391,12 @@ type stateBlk struct {
  * |     '-- utxoID -> utxo bytes
  * |- utxos
  * | '-- utxoDB
+ * |-. lockedAmount
+ * | '-- locktime -> amount
+ * |-. orionFeePayout
+ * | '-- nodeID -> height + fee of the last payout
+ * |-. supplyBreakdown
+ * | '-- inverse height -> supply breakdown recorded at that height
  * |-. subnets
  * | '-. list
  * |   '-- txID -> nil
@@ -301,12 +404,16 @@ type stateBlk struct {
  * | '-. subnetID
  * |   '-. list
  * |     '-- txID -> nil
+ * |-. merkle
+ * | '-- merkleDB
  * '-. singletons
  *   |-- initializedKey -> nil
  *   |-- prunedKey -> nil
  *   |-- timestampKey -> timestamp
+ *   |-- feeRateKey -> feeRate
  *   |-- currentSupplyKey -> currentSupply
  *   |-- lastAcceptedKey -> lastAccepted
+ *   |-- lastFeeSyncKey -> height + fees of the last fee sync
  *   '-- heightsIndexKey -> startIndexHeight + endIndexHeight
  */
 type state struct {
@@ -370,11 +477,48 @@ type state struct {
 	utxoDB        database.Database
 	utxoState     dione.UTXOState
 
+	// Locktime -> amount of DIONE held by the [stakeable.LockOut] UTXOs with
+	// that locktime. Only updated once [lockedAmountsIndexed] is set.
+	lockedAmountDB       database.Database
+	lockedAmountsIndexed bool
+
+	addedOrionFeePayouts map[ids.NodeID]*OrionFeePayout // map of nodeID -> last payout to write
+	orionFeePayoutDB     database.Database
+
+	// [lastSupplyBreakdown] is nil if no supply breakdown was recorded.
+	lastSupplyBreakdown *SupplyBreakdown
+	supplyBreakdownDB   database.Database
+
 	cachedSubnets []*txs.Tx // nil if the subnets haven't been loaded
 	addedSubnets  []*txs.Tx
 	subnetBaseDB  database.Database
 	subnetDB      linkeddb.LinkedDB
 
+	subnetOwners     map[ids.ID]fx.Owner            // map of subnetID -> owner
+	subnetOwnerCache cache.Cacher[ids.ID, fx.Owner] // cache of subnetID -> owner
+	subnetOwnerDB    database.Database
+
+	blsKeyRotations        map[ids.NodeID]*BLSKeyRotation // map of nodeID -> latest rotation
+	addedBLSKeyRotations   map[ids.NodeID]*BLSKeyRotation // map of nodeID -> rotation to write
+	pendingBLSKeyRotations set.Set[ids.NodeID]            // rotations whose key isn't used yet
+	blsKeyRotationDB       database.Database
+
+	autoRestakes         set.Set[ids.ID] // set of auto-restaked validator txIDs
+	modifiedAutoRestakes map[ids.ID]bool // map of validator txID -> auto-restake to write
+	autoRestakeDB        database.Database
+
+	uptimeScores      map[ids.NodeID]*UptimeScore // map of nodeID -> attested uptime
+	addedUptimeScores map[ids.NodeID]*UptimeScore // map of nodeID -> attested uptime to write
+	uptimeScoreDB     database.Database
+
+	subnetFeeConfigs         map[ids.ID]*SubnetFeeConfig // map of subnetID -> fee schedule
+	modifiedSubnetFeeConfigs map[ids.ID]*SubnetFeeConfig // map of subnetID -> fee schedule to write
+	subnetFeeConfigDB        database.Database
+
+	subnetFeePools         map[ids.ID]*SubnetFeePool // map of subnetID -> collected fees
+	modifiedSubnetFeePools map[ids.ID]*SubnetFeePool // map of subnetID -> collected fees to write
+	subnetFeePoolDB        database.Database
+
 	transformedSubnets     map[ids.ID]*txs.Tx            // map of subnetID -> transformSubnetTx
 	transformedSubnetCache cache.Cacher[ids.ID, *txs.Tx] // cache of subnetID -> transformSubnetTx if the entry is nil, it is not in the database
 	transformedSubnetDB    database.Database
@@ -391,16 +535,27 @@ type state struct {
 	// The persisted fields represent the current database value
 	timestamp, persistedTimestamp         time.Time
 	currentSupply, persistedCurrentSupply uint64
+	feeRate, persistedFeeRate             uint64
 	// [lastAccepted] is the most recently accepted block.
 	lastAccepted, persistedLastAccepted ids.ID
 	indexedHeights                      *heightRange
 	singletonDB                         database.Database
 
+	// [lastFeeSync] is nil if no fee sync was recorded.
+	lastFeeSync, persistedLastFeeSync *FeeSync
+
 	lastAccumulatedFee, persistedLastAccumulatedFee               uint64
 	currentAccumulatedFee, persistedCurrentAccumulatedFee         uint64
+	burnedFees, persistedBurnedFees                               uint64
+	mintedSupply, persistedMintedSupply                           uint64
 	feePerWeightStored, persistedFeePerWeightStored               *big.Int
 	stakerAccumulatedMintRate, persistedStakerAccumulatedMintRate *big.Int
 	stakeSyncTimestamp, persistedStakeSyncTimestamp               time.Time
+
+	// map of subnetID -> nodeID -> total accrued delegatee rewards modified
+	// since the last write
+	modifiedDelegateeRewards map[ids.ID]map[ids.NodeID]uint64
+	merkleDB                 merkledb.MerkleDB
 }
 
 // heightRange is used to track which heights are safe to use the native DB
@@ -602,6 +757,15 @@ func newState(
 
 	subnetBaseDB := prefixdb.New(subnetPrefix, baseDB)
 
+	subnetOwnerCache, err := metercacher.New[ids.ID, fx.Owner](
+		"subnet_owner_cache",
+		metricsReg,
+		&cache.LRU[ids.ID, fx.Owner]{Size: execCfg.ChainCacheSize},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	transformedSubnetCache, err := metercacher.New(
 		"transformed_subnet_cache",
 		metricsReg,
@@ -638,6 +802,18 @@ func newState(
 		return nil, err
 	}
 
+	merkleDB, err := merkledb.New(context.TODO(), prefixdb.New(merklePrefix, baseDB), merkledb.Config{
+		EvictionBatchSize:         merkleEvictionBatchSize,
+		HistoryLength:             MerkleHistoryLength,
+		ValueNodeCacheSize:        merkleValueNodeCacheSize,
+		IntermediateNodeCacheSize: merkleIntermediateNodeCacheSize,
+		Reg:                       metricsReg,
+		Tracer:                    trace.Noop,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &state{
 		validatorState: newValidatorState(),
 
@@ -695,9 +871,39 @@ func newState(
 		utxoDB:        utxoDB,
 		utxoState:     utxoState,
 
+		lockedAmountDB: prefixdb.New(lockedAmountPrefix, baseDB),
+
+		addedOrionFeePayouts: make(map[ids.NodeID]*OrionFeePayout),
+		orionFeePayoutDB:     prefixdb.New(orionFeePayoutPrefix, baseDB),
+
+		supplyBreakdownDB: prefixdb.New(supplyBreakdownPrefix, baseDB),
+
 		subnetBaseDB: subnetBaseDB,
 		subnetDB:     linkeddb.NewDefault(subnetBaseDB),
 
+		subnetOwners:     make(map[ids.ID]fx.Owner),
+		subnetOwnerCache: subnetOwnerCache,
+		subnetOwnerDB:    prefixdb.New(subnetOwnerPrefix, baseDB),
+
+		blsKeyRotations:      make(map[ids.NodeID]*BLSKeyRotation),
+		addedBLSKeyRotations: make(map[ids.NodeID]*BLSKeyRotation),
+		blsKeyRotationDB:     prefixdb.New(blsKeyRotationPrefix, baseDB),
+
+		modifiedAutoRestakes: make(map[ids.ID]bool),
+		autoRestakeDB:        prefixdb.New(autoRestakePrefix, baseDB),
+
+		uptimeScores:      make(map[ids.NodeID]*UptimeScore),
+		addedUptimeScores: make(map[ids.NodeID]*UptimeScore),
+		uptimeScoreDB:     prefixdb.New(uptimeScorePrefix, baseDB),
+
+		subnetFeeConfigs:         make(map[ids.ID]*SubnetFeeConfig),
+		modifiedSubnetFeeConfigs: make(map[ids.ID]*SubnetFeeConfig),
+		subnetFeeConfigDB:        prefixdb.New(subnetFeeConfigPrefix, baseDB),
+
+		subnetFeePools:         make(map[ids.ID]*SubnetFeePool),
+		modifiedSubnetFeePools: make(map[ids.ID]*SubnetFeePool),
+		subnetFeePoolDB:        prefixdb.New(subnetFeePoolPrefix, baseDB),
+
 		transformedSubnets:     make(map[ids.ID]*txs.Tx),
 		transformedSubnetCache: transformedSubnetCache,
 		transformedSubnetDB:    prefixdb.New(transformedSubnetPrefix, baseDB),
@@ -717,6 +923,9 @@ func newState(
 		persistedStakerAccumulatedMintRate: new(big.Int),
 		feePerWeightStored:                 new(big.Int),
 		persistedFeePerWeightStored:        new(big.Int),
+
+		modifiedDelegateeRewards: make(map[ids.ID]map[ids.NodeID]uint64),
+		merkleDB:                 merkleDB,
 	}, nil
 }
 
@@ -752,6 +961,10 @@ func (s *state) GetCurrentStakersLen() (uint64, error) {
 	return uint64(s.currentStakers.stakers.Len()), nil
 }
 
+func (s *state) GetCurrentStakedWeight(subnetID ids.ID) (uint64, error) {
+	return s.currentStakers.GetWeight(subnetID), nil
+}
+
 func (s *state) GetPendingValidator(subnetID ids.ID, nodeID ids.NodeID) (*Staker, error) {
 	return s.pendingStakers.GetValidator(subnetID, nodeID)
 }
@@ -861,6 +1074,29 @@ func (s *state) AddSubnet(createSubnetTx *txs.Tx) {
 }
 
 func (s *state) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
+	if owner, exists := s.subnetOwners[subnetID]; exists {
+		return owner, nil
+	}
+
+	if owner, cached := s.subnetOwnerCache.Get(subnetID); cached {
+		return owner, nil
+	}
+
+	ownerBytes, err := s.subnetOwnerDB.Get(subnetID[:])
+	if err == nil {
+		var owner fx.Owner
+		if _, err := blocks.GenesisCodec.Unmarshal(ownerBytes, &owner); err != nil {
+			return nil, err
+		}
+		s.subnetOwnerCache.Put(subnetID, owner)
+		return owner, nil
+	}
+	if err != database.ErrNotFound {
+		return nil, err
+	}
+
+	// The owner of the subnet was never transferred, so it is still the owner
+	// specified in the CreateSubnetTx.
 	subnetIntf, _, err := s.GetTx(subnetID)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -876,9 +1112,79 @@ func (s *state) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
 		return nil, fmt.Errorf("%q %w", subnetID, errIsNotSubnet)
 	}
 
+	s.subnetOwnerCache.Put(subnetID, subnet.Owner)
 	return subnet.Owner, nil
 }
 
+func (s *state) SetSubnetOwner(subnetID ids.ID, owner fx.Owner) {
+	s.subnetOwners[subnetID] = owner
+}
+
+func (s *state) GetBLSKeyRotation(nodeID ids.NodeID) (*BLSKeyRotation, error) {
+	rotation, exists := s.blsKeyRotations[nodeID]
+	if !exists {
+		return nil, database.ErrNotFound
+	}
+	return rotation, nil
+}
+
+func (s *state) PutBLSKeyRotation(rotation *BLSKeyRotation) {
+	s.blsKeyRotations[rotation.NodeID] = rotation
+	s.addedBLSKeyRotations[rotation.NodeID] = rotation
+}
+
+func (s *state) GetAutoRestake(validatorTxID ids.ID) (bool, error) {
+	return s.autoRestakes.Contains(validatorTxID), nil
+}
+
+func (s *state) SetAutoRestake(validatorTxID ids.ID, autoRestake bool) {
+	if autoRestake {
+		s.autoRestakes.Add(validatorTxID)
+	} else {
+		s.autoRestakes.Remove(validatorTxID)
+	}
+	s.modifiedAutoRestakes[validatorTxID] = autoRestake
+}
+
+func (s *state) GetUptimeScore(nodeID ids.NodeID) (*UptimeScore, error) {
+	score, exists := s.uptimeScores[nodeID]
+	if !exists {
+		return nil, database.ErrNotFound
+	}
+	return score, nil
+}
+
+func (s *state) PutUptimeScore(score *UptimeScore) {
+	s.uptimeScores[score.NodeID] = score
+	s.addedUptimeScores[score.NodeID] = score
+}
+
+func (s *state) GetSubnetFeeConfig(subnetID ids.ID) (*SubnetFeeConfig, error) {
+	config, exists := s.subnetFeeConfigs[subnetID]
+	if !exists {
+		return nil, database.ErrNotFound
+	}
+	return config, nil
+}
+
+func (s *state) SetSubnetFeeConfig(subnetID ids.ID, config *SubnetFeeConfig) {
+	s.subnetFeeConfigs[subnetID] = config
+	s.modifiedSubnetFeeConfigs[subnetID] = config
+}
+
+func (s *state) GetSubnetFeePool(subnetID ids.ID) (*SubnetFeePool, error) {
+	pool, exists := s.subnetFeePools[subnetID]
+	if !exists {
+		return nil, database.ErrNotFound
+	}
+	return pool, nil
+}
+
+func (s *state) SetSubnetFeePool(subnetID ids.ID, pool *SubnetFeePool) {
+	s.subnetFeePools[subnetID] = pool
+	s.modifiedSubnetFeePools[subnetID] = pool
+}
+
 func (s *state) GetSubnetTransformation(subnetID ids.ID) (*txs.Tx, error) {
 	if tx, exists := s.transformedSubnets[subnetID]; exists {
 		return tx, nil
@@ -1077,6 +1383,14 @@ func (s *state) SetTimestamp(tm time.Time) {
 	s.timestamp = tm
 }
 
+func (s *state) GetFeeRate() uint64 {
+	return s.feeRate
+}
+
+func (s *state) SetFeeRate(feeRate uint64) {
+	s.feeRate = feeRate
+}
+
 func (s *state) GetStakeSyncTimestamp() (time.Time, error) {
 	return s.stakeSyncTimestamp, nil
 }
@@ -1101,6 +1415,32 @@ func (s *state) SetLastAccepted(lastAccepted ids.ID) {
 	s.lastAccepted = lastAccepted
 }
 
+func (s *state) GetLastFeeSync() (*FeeSync, error) {
+	if s.lastFeeSync == nil {
+		return nil, database.ErrNotFound
+	}
+	return s.lastFeeSync, nil
+}
+
+func (s *state) SetLastFeeSync(sync *FeeSync) {
+	s.lastFeeSync = sync
+}
+
+func (s *state) GetLastOrionFeePayout(nodeID ids.NodeID) (*OrionFeePayout, error) {
+	if payout, exists := s.addedOrionFeePayouts[nodeID]; exists {
+		return payout, nil
+	}
+	payoutBytes, err := s.orionFeePayoutDB.Get(nodeID[:])
+	if err != nil {
+		return nil, err
+	}
+	return parseOrionFeePayout(payoutBytes)
+}
+
+func (s *state) SetLastOrionFeePayout(nodeID ids.NodeID, payout *OrionFeePayout) {
+	s.addedOrionFeePayouts[nodeID] = payout
+}
+
 func (s *state) GetCurrentSupply(subnetID ids.ID) (uint64, error) {
 	if subnetID == constants.PrimaryNetworkID {
 		return s.currentSupply, nil
@@ -1338,6 +1678,7 @@ func (s *state) syncGenesis(genesisBlk blocks.Block, genesis *genesis.State) err
 	genesisBlkID := genesisBlk.ID()
 	s.SetLastAccepted(genesisBlkID)
 	s.SetTimestamp(time.Unix(int64(genesis.Timestamp), 0))
+	s.SetFeeRate(fees.RateDenominator)
 	s.SetStakeSyncTimestamp(s.GetTimestamp())
 	s.SetCurrentSupply(constants.PrimaryNetworkID, genesis.InitialSupply)
 	s.AddStatelessBlock(genesisBlk)
@@ -1390,9 +1731,16 @@ func (s *state) load() error {
 	errs := wrappers.Errs{}
 	errs.Add(
 		s.loadMetadata(),
+		s.loadBLSKeyRotations(),
+		s.loadAutoRestakes(),
+		s.loadUptimeScores(),
+		s.loadSubnetFees(),
 		s.loadCurrentValidators(),
 		s.loadPendingValidators(),
 		s.initValidatorSets(),
+		s.loadLockedAmounts(),
+		s.loadSupplyBreakdown(),
+		s.loadMerkleState(),
 	)
 	return errs.Err
 }
@@ -1433,6 +1781,68 @@ func (s *state) loadMetadata() error {
 	s.persistedLastAccepted = lastAccepted
 	s.lastAccepted = lastAccepted
 
+	// The fee rate isn't stored until the state is written after dynamic fees
+	// are introduced, so the static fees are charged until then.
+	feeRate, err := database.GetUInt64(s.singletonDB, feeRateKey)
+	switch err {
+	case nil:
+		s.persistedFeeRate = feeRate
+		s.SetFeeRate(feeRate)
+	case database.ErrNotFound:
+		s.SetFeeRate(fees.RateDenominator)
+	default:
+		return err
+	}
+
+	burnedFees, err := database.GetUInt64(s.singletonDB, burnedFeesKey)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+	s.burnedFees = burnedFees
+	s.persistedBurnedFees = burnedFees
+
+	mintedSupply, err := database.GetUInt64(s.singletonDB, mintedSupplyKey)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+	s.mintedSupply = mintedSupply
+	s.persistedMintedSupply = mintedSupply
+
+	lastFeeSyncBytes, err := s.singletonDB.Get(lastFeeSyncKey)
+	switch err {
+	case nil:
+		lastFeeSync, err := parseFeeSync(lastFeeSyncBytes)
+		if err != nil {
+			return err
+		}
+		s.lastFeeSync = lastFeeSync
+		s.persistedLastFeeSync = lastFeeSync
+	case database.ErrNotFound:
+	default:
+		return err
+	}
+
+	feePerStakerBytes, err := s.singletonDB.Get(feePerWeightStoredKey)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+	s.feePerWeightStored = new(big.Int).SetBytes(feePerStakerBytes)
+	s.persistedFeePerWeightStored = new(big.Int).Set(s.feePerWeightStored)
+
+	lastAccumulatedFee, err := database.GetUInt64(s.singletonDB, lastAccumulatedFeeKey)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+	s.lastAccumulatedFee = lastAccumulatedFee
+	s.persistedLastAccumulatedFee = lastAccumulatedFee
+
+	currentAccumulatedFee, err := database.GetUInt64(s.singletonDB, currentAccumulatedFeeKey)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+	s.currentAccumulatedFee = currentAccumulatedFee
+	s.persistedCurrentAccumulatedFee = currentAccumulatedFee
+
 	// Lookup the most recently indexed range on disk. If we haven't started
 	// indexing the weights, then we keep the indexed heights as nil.
 	indexedHeightsBytes, err := s.singletonDB.Get(heightsIndexedKey)
@@ -1459,29 +1869,121 @@ func (s *state) loadMetadata() error {
 		return nil
 	}
 	s.indexedHeights = indexedHeights
+	return nil
+}
 
-	feePerStakerBytes, err := s.singletonDB.Get(feePerWeightStoredKey)
-	if err != nil && err != database.ErrNotFound {
+// loadLockedAmounts indexes the amounts held by the [stakeable.LockOut] UTXOs
+// if they weren't indexed yet. The index is written with the next commit.
+func (s *state) loadLockedAmounts() error {
+	indexed, err := s.singletonDB.Has(lockedAmountsIndexedKey)
+	if err != nil {
 		return err
 	}
-	s.feePerWeightStored = new(big.Int).SetBytes(feePerStakerBytes)
-	s.persistedFeePerWeightStored = new(big.Int).Set(s.feePerWeightStored)
+	if !indexed {
+		err := s.utxoState.IterateUTXOs(func(utxo *dione.UTXO) error {
+			return s.updateLockedAmount(utxo, false /*=removed*/)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to index locked amounts: %w", err)
+		}
+		if err := s.singletonDB.Put(lockedAmountsIndexedKey, nil); err != nil {
+			return err
+		}
+	}
+	s.lockedAmountsIndexed = true
+	return nil
+}
 
-	lastAccumulatedFee, err := database.GetUInt64(s.singletonDB, lastAccumulatedFeeKey)
-	if err != nil && err != database.ErrNotFound {
+// loadBLSKeyRotations loads all the BLS key rotations in memory. Rotations
+// above the last accepted height aren't used yet.
+func (s *state) loadBLSKeyRotations() error {
+	lastAcceptedBlock, err := s.GetStatelessBlock(s.lastAccepted)
+	if err != nil {
 		return err
 	}
-	s.lastAccumulatedFee = lastAccumulatedFee
-	s.persistedLastAccumulatedFee = lastAccumulatedFee
+	height := lastAcceptedBlock.Height()
 
-	currentAccumulatedFee, err := database.GetUInt64(s.singletonDB, currentAccumulatedFeeKey)
-	if err != nil && err != database.ErrNotFound {
+	it := s.blsKeyRotationDB.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		nodeID, err := ids.ToNodeID(it.Key())
+		if err != nil {
+			return err
+		}
+		rotation, err := parseBLSKeyRotation(nodeID, it.Value())
+		if err != nil {
+			return err
+		}
+		s.blsKeyRotations[nodeID] = rotation
+		if rotation.Height > height {
+			s.pendingBLSKeyRotations.Add(nodeID)
+		}
+	}
+	return it.Error()
+}
+
+func (s *state) loadAutoRestakes() error {
+	it := s.autoRestakeDB.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		validatorTxID, err := ids.ToID(it.Key())
+		if err != nil {
+			return err
+		}
+		s.autoRestakes.Add(validatorTxID)
+	}
+	return it.Error()
+}
+
+func (s *state) loadUptimeScores() error {
+	it := s.uptimeScoreDB.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		nodeID, err := ids.ToNodeID(it.Key())
+		if err != nil {
+			return err
+		}
+		score, err := parseUptimeScore(nodeID, it.Value())
+		if err != nil {
+			return err
+		}
+		s.uptimeScores[nodeID] = score
+	}
+	return it.Error()
+}
+
+func (s *state) loadSubnetFees() error {
+	configIt := s.subnetFeeConfigDB.NewIterator()
+	defer configIt.Release()
+	for configIt.Next() {
+		subnetID, err := ids.ToID(configIt.Key())
+		if err != nil {
+			return err
+		}
+		config, err := parseSubnetFeeConfig(configIt.Value())
+		if err != nil {
+			return err
+		}
+		s.subnetFeeConfigs[subnetID] = config
+	}
+	if err := configIt.Error(); err != nil {
 		return err
 	}
-	s.currentAccumulatedFee = currentAccumulatedFee
-	s.persistedCurrentAccumulatedFee = currentAccumulatedFee
 
-	return nil
+	poolIt := s.subnetFeePoolDB.NewIterator()
+	defer poolIt.Release()
+	for poolIt.Next() {
+		subnetID, err := ids.ToID(poolIt.Key())
+		if err != nil {
+			return err
+		}
+		pool, err := parseSubnetFeePool(poolIt.Value())
+		if err != nil {
+			return err
+		}
+		s.subnetFeePools[subnetID] = pool
+	}
+	return poolIt.Error()
 }
 
 func (s *state) loadCurrentValidators() error {
@@ -1521,9 +2023,11 @@ func (s *state) loadCurrentValidators() error {
 		if err != nil {
 			return err
 		}
+		staker.PublicKey = s.rotatedPublicKey(staker)
 
 		validator := s.currentStakers.getOrCreateValidator(staker.SubnetID, staker.NodeID)
 		validator.validator = staker
+		s.currentStakers.addWeight(staker.SubnetID, staker.Weight)
 
 		s.currentStakers.stakers.ReplaceOrInsert(staker)
 
@@ -1567,6 +2071,7 @@ func (s *state) loadCurrentValidators() error {
 		}
 		validator := s.currentStakers.getOrCreateValidator(staker.SubnetID, staker.NodeID)
 		validator.validator = staker
+		s.currentStakers.addWeight(staker.SubnetID, staker.Weight)
 
 		s.currentStakers.stakers.ReplaceOrInsert(staker)
 
@@ -1614,6 +2119,7 @@ func (s *state) loadCurrentValidators() error {
 				validator.delegators = btree.NewG(defaultTreeDegree, (*Staker).Less)
 			}
 			validator.delegators.ReplaceOrInsert(staker)
+			s.currentStakers.addWeight(staker.SubnetID, staker.Weight)
 
 			s.currentStakers.stakers.ReplaceOrInsert(staker)
 		}
@@ -1659,6 +2165,7 @@ func (s *state) loadPendingValidators() error {
 			if err != nil {
 				return err
 			}
+			staker.PublicKey = s.rotatedPublicKey(staker)
 
 			validator := s.pendingStakers.getOrCreateValidator(staker.SubnetID, staker.NodeID)
 			validator.validator = staker
@@ -1715,6 +2222,23 @@ func (s *state) loadPendingValidators() error {
 	return errs.Err
 }
 
+// rotatedPublicKey returns the BLS key currently used by the primary network
+// validator [staker], taking into account the rotations that were already
+// applied.
+func (s *state) rotatedPublicKey(staker *Staker) *bls.PublicKey {
+	rotation, exists := s.blsKeyRotations[staker.NodeID]
+	switch {
+	case !exists || rotation.ValidatorTxID != staker.TxID:
+		return staker.PublicKey
+	case s.pendingBLSKeyRotations.Contains(staker.NodeID):
+		// Only the latest rotation is kept, so the key used until it takes
+		// effect is the one it replaces.
+		return rotation.PreviousPublicKey
+	default:
+		return rotation.PublicKey
+	}
+}
+
 // Invariant: initValidatorSets requires loadCurrentValidators to have already
 // been called.
 func (s *state) initValidatorSets() error {
@@ -1757,18 +2281,26 @@ func (s *state) initValidatorSets() error {
 func (s *state) write(updateValidators bool, height uint64) error {
 	errs := wrappers.Errs{}
 	errs.Add(
+		s.writeMerkleState(), // Must be called before the changes are written
 		s.writeBlocks(),
 		s.writeCurrentStakers(updateValidators, height),
+		s.writeBLSKeyRotations(updateValidators, height), // Must be called after writeCurrentStakers
+		s.writeAutoRestakes(),
+		s.writeUptimeScores(), // Must be called after writeCurrentStakers
+		s.writeSubnetFees(),
 		s.writePendingStakers(),
 		s.WriteValidatorMetadata(s.currentValidatorList, s.currentSubnetValidatorList), // Must be called after writeCurrentStakers
 		s.writeTXs(),
 		s.writeRewardUTXOs(),
 		s.writeUTXOs(),
+		s.writeOrionFeePayouts(),
 		s.writeSubnets(),
+		s.writeSubnetOwners(),
 		s.writeTransformedSubnets(),
 		s.writeSubnetSupplies(),
 		s.writeChains(),
 		s.writeMetadata(),
+		s.writeSupplyBreakdown(height), // Must be called after writeUTXOs and writeMetadata
 	)
 	return errs.Err
 }
@@ -1776,6 +2308,8 @@ func (s *state) write(updateValidators bool, height uint64) error {
 func (s *state) Close() error {
 	errs := wrappers.Errs{}
 	errs.Add(
+		s.merkleDB.Close(),
+		s.baseDB.Commit(), // Flushes the nodes written by the merkle state
 		s.pendingSubnetValidatorBaseDB.Close(),
 		s.pendingSubnetDelegatorBaseDB.Close(),
 		s.pendingDelegatorBaseDB.Close(),
@@ -1790,7 +2324,16 @@ func (s *state) Close() error {
 		s.txDB.Close(),
 		s.rewardUTXODB.Close(),
 		s.utxoDB.Close(),
+		s.lockedAmountDB.Close(),
+		s.orionFeePayoutDB.Close(),
+		s.supplyBreakdownDB.Close(),
 		s.subnetBaseDB.Close(),
+		s.subnetOwnerDB.Close(),
+		s.blsKeyRotationDB.Close(),
+		s.autoRestakeDB.Close(),
+		s.uptimeScoreDB.Close(),
+		s.subnetFeeConfigDB.Close(),
+		s.subnetFeePoolDB.Close(),
 		s.transformedSubnetDB.Close(),
 		s.supplyDB.Close(),
 		s.chainDB.Close(),
@@ -1852,6 +2395,11 @@ func (s *state) init(genesisBytes []byte) error {
 		return err
 	}
 
+	// The genesis merkle state was written with the genesis state.
+	if err := s.singletonDB.Put(merkleStateIndexedKey, nil); err != nil {
+		return err
+	}
+
 	return s.Commit()
 }
 
@@ -1899,6 +2447,41 @@ func (s *state) SetLastAccumulatedFee(f uint64) {
 	s.lastAccumulatedFee = f
 }
 
+func (s *state) GetBurnedFees() (uint64, error) {
+	return s.burnedFees, nil
+}
+
+func (s *state) AddBurnedFees(f uint64) {
+	s.burnedFees += f
+}
+
+func (s *state) GetMintedSupply() (uint64, error) {
+	return s.mintedSupply, nil
+}
+
+func (s *state) AddMintedSupply(m uint64) {
+	s.mintedSupply += m
+}
+
+func (s *state) GetLockedAmount(timestamp time.Time) (uint64, error) {
+	// A UTXO is locked while its locktime is after the current time
+	it := s.lockedAmountDB.NewIteratorWithStart(database.PackUInt64(uint64(timestamp.Unix()) + 1))
+	defer it.Release()
+
+	lockedAmount := uint64(0)
+	for it.Next() {
+		amount, err := database.ParseUInt64(it.Value())
+		if err != nil {
+			return 0, err
+		}
+		lockedAmount, err = math.Add64(lockedAmount, amount)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return lockedAmount, it.Error()
+}
+
 func (s *state) Commit() error {
 	defer s.Abort()
 	batch, err := s.CommitBatch()
@@ -2139,6 +2722,26 @@ func (s *state) writeCurrentStakers(updateValidators bool, height uint64) error
 					return fmt.Errorf("failed to delete current staker: %w", err)
 				}
 
+				// The rotations of the key of a validator are removed with
+				// the validator.
+				if rotation, exists := s.blsKeyRotations[nodeID]; exists && rotation.ValidatorTxID == staker.TxID {
+					delete(s.blsKeyRotations, nodeID)
+					delete(s.addedBLSKeyRotations, nodeID)
+					s.pendingBLSKeyRotations.Remove(nodeID)
+					if err := s.blsKeyRotationDB.Delete(nodeID[:]); err != nil {
+						return fmt.Errorf("failed to delete BLS key rotation: %w", err)
+					}
+				}
+
+				// So is its attested uptime.
+				if score, exists := s.uptimeScores[nodeID]; exists && score.ValidatorTxID == staker.TxID {
+					delete(s.uptimeScores, nodeID)
+					delete(s.addedUptimeScores, nodeID)
+					if err := s.uptimeScoreDB.Delete(nodeID[:]); err != nil {
+						return fmt.Errorf("failed to delete uptime score: %w", err)
+					}
+				}
+
 				s.validatorState.DeleteValidatorMetadata(nodeID, subnetID)
 			}
 
@@ -2378,11 +2981,27 @@ func (s *state) writeUTXOs() error {
 		delete(s.modifiedUTXOs, utxoID)
 
 		if utxo == nil {
+			if s.lockedAmountsIndexed {
+				removedUTXO, err := s.utxoState.GetUTXO(utxoID)
+				switch {
+				case err == nil:
+					if err := s.updateLockedAmount(removedUTXO, true /*=removed*/); err != nil {
+						return fmt.Errorf("failed to update locked amount: %w", err)
+					}
+				case err != database.ErrNotFound:
+					return fmt.Errorf("failed to get removed UTXO: %w", err)
+				}
+			}
 			if err := s.utxoState.DeleteUTXO(utxoID); err != nil {
 				return fmt.Errorf("failed to delete UTXO: %w", err)
 			}
 			continue
 		}
+		if s.lockedAmountsIndexed {
+			if err := s.updateLockedAmount(utxo, false /*=removed*/); err != nil {
+				return fmt.Errorf("failed to update locked amount: %w", err)
+			}
+		}
 		if err := s.utxoState.PutUTXO(utxo); err != nil {
 			return fmt.Errorf("failed to add UTXO: %w", err)
 		}
@@ -2390,6 +3009,33 @@ func (s *state) writeUTXOs() error {
 	return nil
 }
 
+// updateLockedAmount adds the amount of [utxo] to, or removes it from, the
+// amount locked until its locktime if it is a DIONE [stakeable.LockOut].
+func (s *state) updateLockedAmount(utxo *dione.UTXO, removed bool) error {
+	out, ok := utxo.Out.(*stakeable.LockOut)
+	if !ok || utxo.AssetID() != s.ctx.DIONEAssetID {
+		return nil
+	}
+
+	key := database.PackUInt64(out.Locktime)
+	lockedAmount, err := database.GetUInt64(s.lockedAmountDB, key)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+	if removed {
+		lockedAmount, err = math.Sub(lockedAmount, out.Amount())
+	} else {
+		lockedAmount, err = math.Add64(lockedAmount, out.Amount())
+	}
+	if err != nil {
+		return err
+	}
+	if lockedAmount == 0 {
+		return s.lockedAmountDB.Delete(key)
+	}
+	return database.PutUInt64(s.lockedAmountDB, key, lockedAmount)
+}
+
 func (s *state) writeSubnets() error {
 	for _, subnet := range s.addedSubnets {
 		subnetID := subnet.ID()
@@ -2402,6 +3048,184 @@ func (s *state) writeSubnets() error {
 	return nil
 }
 
+func (s *state) writeSubnetOwners() error {
+	for subnetID, owner := range s.subnetOwners {
+		subnetID := subnetID
+		owner := owner
+		delete(s.subnetOwners, subnetID)
+
+		ownerBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, &owner)
+		if err != nil {
+			return fmt.Errorf("failed to marshal subnet owner: %w", err)
+		}
+
+		s.subnetOwnerCache.Put(subnetID, owner)
+		if err := s.subnetOwnerDB.Put(subnetID[:], ownerBytes); err != nil {
+			return fmt.Errorf("failed to write subnet owner: %w", err)
+		}
+	}
+	return nil
+}
+
+// writeBLSKeyRotations writes the added rotations and switches the keys of the
+// validators whose rotations reached [height].
+func (s *state) writeBLSKeyRotations(updateValidators bool, height uint64) error {
+	for nodeID, rotation := range s.addedBLSKeyRotations {
+		nodeID := nodeID
+		delete(s.addedBLSKeyRotations, nodeID)
+
+		rotationBytes, err := marshalBLSKeyRotation(rotation)
+		if err != nil {
+			return fmt.Errorf("failed to serialize BLS key rotation: %w", err)
+		}
+		if err := s.blsKeyRotationDB.Put(nodeID[:], rotationBytes); err != nil {
+			return fmt.Errorf("failed to write BLS key rotation: %w", err)
+		}
+		s.pendingBLSKeyRotations.Add(nodeID)
+	}
+
+	heightBytes := database.PackUInt64(height)
+	rawNestedPublicKeyDiffDB := prefixdb.New(heightBytes, s.nestedValidatorPublicKeyDiffsDB)
+	nestedPKDiffDB := linkeddb.NewDefault(rawNestedPublicKeyDiffDB)
+	for nodeID := range s.pendingBLSKeyRotations {
+		nodeID := nodeID
+		rotation := s.blsKeyRotations[nodeID]
+		if rotation.Height > height {
+			continue
+		}
+		s.pendingBLSKeyRotations.Remove(nodeID)
+
+		staker, err := s.currentStakers.GetValidator(constants.PrimaryNetworkID, nodeID)
+		if err == database.ErrNotFound {
+			// A restaked validator may not be current yet. It isn't part of
+			// the validator set, so its key is switched without a diff.
+			staker, err := s.pendingStakers.GetValidator(constants.PrimaryNetworkID, nodeID)
+			if err == nil && staker.TxID == rotation.ValidatorTxID {
+				staker.PublicKey = rotation.PublicKey
+			}
+			continue
+		}
+		if err == nil && staker.TxID != rotation.ValidatorTxID {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// Record the prior value of the public key, unless it was already
+		// recorded by a change to the validator at the same height.
+		diffKey := marshalDiffKey(constants.PrimaryNetworkID, height, nodeID)
+		hasDiff, err := s.flatValidatorPublicKeyDiffsDB.Has(diffKey)
+		if err != nil {
+			return err
+		}
+		if !hasDiff {
+			var pkBytes []byte
+			if staker.PublicKey != nil {
+				pkBytes = staker.PublicKey.Serialize()
+
+				// TODO: Remove this once we no longer support version
+				// rollbacks.
+				if err := nestedPKDiffDB.Put(nodeID[:], bls.PublicKeyToBytes(staker.PublicKey)); err != nil {
+					return err
+				}
+			}
+			if err := s.flatValidatorPublicKeyDiffsDB.Put(diffKey, pkBytes); err != nil {
+				return err
+			}
+		}
+
+		staker.PublicKey = rotation.PublicKey
+
+		// TODO: Move the validator set management out of the state package
+		if !updateValidators {
+			continue
+		}
+		if err := validators.SetPublicKey(s.cfg.Validators, constants.PrimaryNetworkID, nodeID, rotation.PublicKey); err != nil {
+			return fmt.Errorf("failed to update validator public key: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *state) writeAutoRestakes() error {
+	for validatorTxID, autoRestake := range s.modifiedAutoRestakes {
+		validatorTxID := validatorTxID
+		delete(s.modifiedAutoRestakes, validatorTxID)
+
+		var err error
+		if autoRestake {
+			err = s.autoRestakeDB.Put(validatorTxID[:], nil)
+		} else {
+			err = s.autoRestakeDB.Delete(validatorTxID[:])
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write auto-restake: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *state) writeOrionFeePayouts() error {
+	for nodeID, payout := range s.addedOrionFeePayouts {
+		nodeID := nodeID
+		delete(s.addedOrionFeePayouts, nodeID)
+
+		payoutBytes, err := marshalOrionFeePayout(payout)
+		if err != nil {
+			return fmt.Errorf("failed to serialize orion fee payout: %w", err)
+		}
+		if err := s.orionFeePayoutDB.Put(nodeID[:], payoutBytes); err != nil {
+			return fmt.Errorf("failed to write orion fee payout: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *state) writeUptimeScores() error {
+	for nodeID, score := range s.addedUptimeScores {
+		nodeID := nodeID
+		delete(s.addedUptimeScores, nodeID)
+
+		scoreBytes, err := marshalUptimeScore(score)
+		if err != nil {
+			return fmt.Errorf("failed to serialize uptime score: %w", err)
+		}
+		if err := s.uptimeScoreDB.Put(nodeID[:], scoreBytes); err != nil {
+			return fmt.Errorf("failed to write uptime score: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *state) writeSubnetFees() error {
+	for subnetID, config := range s.modifiedSubnetFeeConfigs {
+		subnetID := subnetID
+		delete(s.modifiedSubnetFeeConfigs, subnetID)
+
+		configBytes, err := marshalSubnetFeeConfig(config)
+		if err != nil {
+			return fmt.Errorf("failed to serialize subnet fee config: %w", err)
+		}
+		if err := s.subnetFeeConfigDB.Put(subnetID[:], configBytes); err != nil {
+			return fmt.Errorf("failed to write subnet fee config: %w", err)
+		}
+	}
+	for subnetID, pool := range s.modifiedSubnetFeePools {
+		subnetID := subnetID
+		delete(s.modifiedSubnetFeePools, subnetID)
+
+		poolBytes, err := marshalSubnetFeePool(pool)
+		if err != nil {
+			return fmt.Errorf("failed to serialize subnet fee pool: %w", err)
+		}
+		if err := s.subnetFeePoolDB.Put(subnetID[:], poolBytes); err != nil {
+			return fmt.Errorf("failed to write subnet fee pool: %w", err)
+		}
+	}
+	return nil
+}
+
 func (s *state) writeTransformedSubnets() error {
 	for subnetID, tx := range s.transformedSubnets {
 		txID := tx.ID()
@@ -2452,6 +3276,12 @@ func (s *state) writeMetadata() error {
 		}
 		s.persistedTimestamp = s.timestamp
 	}
+	if s.persistedFeeRate != s.feeRate {
+		if err := database.PutUInt64(s.singletonDB, feeRateKey, s.feeRate); err != nil {
+			return fmt.Errorf("failed to write fee rate: %w", err)
+		}
+		s.persistedFeeRate = s.feeRate
+	}
 	if !s.persistedStakeSyncTimestamp.Equal(s.stakeSyncTimestamp) {
 		if err := database.PutTimestamp(s.singletonDB, stakeSyncTimestampKey, s.stakeSyncTimestamp); err != nil {
 			return fmt.Errorf("failed to write timestamp: %w", err)
@@ -2508,6 +3338,31 @@ func (s *state) writeMetadata() error {
 		s.persistedCurrentAccumulatedFee = s.currentAccumulatedFee
 	}
 
+	if s.persistedBurnedFees != s.burnedFees {
+		if err := database.PutUInt64(s.singletonDB, burnedFeesKey, s.burnedFees); err != nil {
+			return fmt.Errorf("failed to write burned fees: %w", err)
+		}
+		s.persistedBurnedFees = s.burnedFees
+	}
+
+	if s.persistedMintedSupply != s.mintedSupply {
+		if err := database.PutUInt64(s.singletonDB, mintedSupplyKey, s.mintedSupply); err != nil {
+			return fmt.Errorf("failed to write minted supply: %w", err)
+		}
+		s.persistedMintedSupply = s.mintedSupply
+	}
+
+	if s.persistedLastFeeSync != s.lastFeeSync {
+		lastFeeSyncBytes, err := marshalFeeSync(s.lastFeeSync)
+		if err != nil {
+			return fmt.Errorf("failed to serialize last fee sync: %w", err)
+		}
+		if err := s.singletonDB.Put(lastFeeSyncKey, lastFeeSyncBytes); err != nil {
+			return fmt.Errorf("failed to write last fee sync: %w", err)
+		}
+		s.persistedLastFeeSync = s.lastFeeSync
+	}
+
 	return nil
 }
 
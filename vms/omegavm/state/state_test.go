@@ -33,6 +33,8 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/genesis"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 )
@@ -339,8 +341,15 @@ func TestStateAddRemoveValidator(t *testing.T) {
 		stakers   = make([]Staker, numNodes)
 	)
 	for i := 0; i < numNodes; i++ {
+		// The staker txs are part of the merkle state.
+		tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: dione.BaseTx{
+			BlockchainID: ids.GenerateTestID(),
+		}}}
+		require.NoError(tx.Initialize(txs.Codec))
+		state.AddTx(tx, status.Committed)
+
 		stakers[i] = Staker{
-			TxID:             ids.GenerateTestID(),
+			TxID:             tx.ID(),
 			NodeID:           ids.GenerateTestNodeID(),
 			Weight:           uint64(i + 1),
 			StartTime:        startTime.Add(time.Duration(i) * time.Second),
@@ -660,3 +669,450 @@ func TestParsedStateBlock(t *testing.T) {
 		require.Equal(blk.ID(), gotBlk.ID())
 	}
 }
+
+func TestStateLockedAmounts(t *testing.T) {
+	require := require.New(t)
+
+	s, _ := newInitializedState(require)
+	chainTime := s.GetTimestamp()
+	newLockedUTXO := func(locktime time.Time, amount uint64) *dione.UTXO {
+		return &dione.UTXO{
+			UTXOID: dione.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Out: &stakeable.LockOut{
+				Locktime: uint64(locktime.Unix()),
+				TransferableOut: &secp256k1fx.TransferOutput{
+					Amt: amount,
+				},
+			},
+		}
+	}
+
+	// UTXOs written before the index is built are indexed when it is built
+	utxo0 := newLockedUTXO(chainTime.Add(time.Hour), 1)
+	s.AddUTXO(utxo0)
+	require.NoError(s.Commit())
+	require.NoError(s.(*state).loadLockedAmounts())
+
+	utxo1 := newLockedUTXO(chainTime.Add(2*time.Hour), 2)
+	s.AddUTXO(utxo1)
+	s.AddUTXO(newLockedUTXO(chainTime.Add(2*time.Hour), 4))
+	require.NoError(s.Commit())
+
+	lockedAmount, err := s.GetLockedAmount(chainTime)
+	require.NoError(err)
+	require.Equal(uint64(7), lockedAmount)
+
+	lockedAmount, err = s.GetLockedAmount(chainTime.Add(time.Hour))
+	require.NoError(err)
+	require.Equal(uint64(6), lockedAmount)
+
+	s.DeleteUTXO(utxo0.InputID())
+	s.DeleteUTXO(utxo1.InputID())
+	require.NoError(s.Commit())
+
+	lockedAmount, err = s.GetLockedAmount(chainTime)
+	require.NoError(err)
+	require.Equal(uint64(4), lockedAmount)
+
+	lockedAmount, err = s.GetLockedAmount(chainTime.Add(2 * time.Hour))
+	require.NoError(err)
+	require.Zero(lockedAmount)
+}
+
+func TestStateBurnedFees(t *testing.T) {
+	require := require.New(t)
+
+	s, db := newInitializedState(require)
+	require.NoError(s.(*state).loadMetadata())
+
+	burnedFees, err := s.GetBurnedFees()
+	require.NoError(err)
+	require.Zero(burnedFees)
+
+	s.AddBurnedFees(1)
+	s.AddBurnedFees(2)
+	require.NoError(s.Commit())
+
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).loadMetadata())
+
+	burnedFees, err = s.GetBurnedFees()
+	require.NoError(err)
+	require.Equal(uint64(3), burnedFees)
+}
+
+func TestStateFeeSync(t *testing.T) {
+	require := require.New(t)
+
+	s, db := newInitializedState(require)
+	require.NoError(s.(*state).loadMetadata())
+
+	_, err := s.GetLastFeeSync()
+	require.ErrorIs(err, database.ErrNotFound)
+
+	nodeID := ids.GenerateTestNodeID()
+	_, err = s.GetLastOrionFeePayout(nodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	sync := &FeeSync{
+		Height:    3,
+		AChainFee: 10,
+		DChainFee: 20,
+	}
+	s.SetLastFeeSync(sync)
+	payout := &OrionFeePayout{
+		Height: 4,
+		Fee:    30,
+	}
+	s.SetLastOrionFeePayout(nodeID, payout)
+	require.NoError(s.Commit())
+
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).loadMetadata())
+
+	loadedSync, err := s.GetLastFeeSync()
+	require.NoError(err)
+	require.Equal(sync, loadedSync)
+
+	loadedPayout, err := s.GetLastOrionFeePayout(nodeID)
+	require.NoError(err)
+	require.Equal(payout, loadedPayout)
+}
+
+func TestStateSupplyBreakdown(t *testing.T) {
+	require := require.New(t)
+
+	s, db := newInitializedState(require)
+
+	// The breakdown is recorded with the genesis
+	genesisBreakdown, err := s.GetSupplyBreakdown(0)
+	require.NoError(err)
+	require.Equal(uint64(units.Dione), genesisBreakdown.Staked)
+	require.Zero(genesisBreakdown.Minted)
+	require.Zero(genesisBreakdown.Burned)
+
+	s.AddMintedSupply(10)
+	s.AddBurnedFees(3)
+	s.SetHeight(2)
+	require.NoError(s.Commit())
+
+	// Unchanged breakdowns aren't recorded again
+	s.SetHeight(3)
+	require.NoError(s.Commit())
+
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).loadSupplyBreakdown())
+
+	breakdown, err := s.GetSupplyBreakdown(1)
+	require.NoError(err)
+	require.Equal(genesisBreakdown, breakdown)
+
+	expectedBreakdown := *genesisBreakdown
+	expectedBreakdown.Minted = 10
+	expectedBreakdown.Burned = 3
+	for _, height := range []uint64{2, 3} {
+		breakdown, err := s.GetSupplyBreakdown(height)
+		require.NoError(err)
+		require.Equal(&expectedBreakdown, breakdown)
+	}
+	require.Equal(&expectedBreakdown, s.(*state).lastSupplyBreakdown)
+}
+
+func TestStateSubnetOwner(t *testing.T) {
+	require := require.New(t)
+
+	s, db := newInitializedState(require)
+
+	var (
+		owner1 = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+		owner2 = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+		createSubnetTx = &txs.Tx{
+			Unsigned: &txs.CreateSubnetTx{
+				BaseTx: txs.BaseTx{},
+				Owner:  owner1,
+			},
+		}
+	)
+	require.NoError(createSubnetTx.Initialize(txs.Codec))
+	subnetID := createSubnetTx.ID()
+
+	_, err := s.GetSubnetOwner(subnetID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	s.AddTx(createSubnetTx, status.Committed)
+	s.AddSubnet(createSubnetTx)
+
+	owner, err := s.GetSubnetOwner(subnetID)
+	require.NoError(err)
+	require.Equal(owner1, owner)
+
+	s.SetSubnetOwner(subnetID, owner2)
+	owner, err = s.GetSubnetOwner(subnetID)
+	require.NoError(err)
+	require.Equal(owner2, owner)
+	require.NoError(s.Commit())
+
+	s = newStateFromDB(require, db)
+	owner, err = s.GetSubnetOwner(subnetID)
+	require.NoError(err)
+	require.Equal(owner2, owner)
+}
+
+func TestStateBLSKeyRotation(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	s, db := newInitializedState(require)
+
+	validatorTx := &txs.Tx{Unsigned: &txs.AddValidatorTx{
+		Validator: txs.Validator{
+			NodeID: ids.GenerateTestNodeID(),
+			Start:  uint64(initialTime.Unix()),
+			End:    uint64(initialValidatorEndTime.Unix()),
+			Wght:   units.Dione,
+		},
+		StakeOuts: []*dione.TransferableOutput{
+			{
+				Asset: dione.Asset{ID: initialTxID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: units.Dione,
+				},
+			},
+		},
+		RewardsOwner:     &secp256k1fx.OutputOwners{},
+		DelegationShares: reward.PercentDenominator,
+	}}
+	require.NoError(validatorTx.Initialize(txs.Codec))
+	staker, err := NewCurrentStaker(validatorTx.ID(), validatorTx.Unsigned.(txs.Staker), 1)
+	require.NoError(err)
+	nodeID := staker.NodeID
+
+	// acceptBlock commits [s] as of a block at [height].
+	acceptBlock := func(s State, height uint64) {
+		blk, err := blocks.NewBanffStandardBlock(s.GetTimestamp(), s.GetLastAccepted(), height, nil)
+		require.NoError(err)
+		s.AddStatelessBlock(blk)
+		s.SetLastAccepted(blk.ID())
+		s.SetHeight(height)
+		require.NoError(s.Commit())
+	}
+	// requirePublicKey checks the key of the validator in the validator set
+	// and in the state.
+	requirePublicKey := func(s State, expected *bls.PublicKey) {
+		vdrs, ok := s.(*state).cfg.Validators.Get(constants.PrimaryNetworkID)
+		require.True(ok)
+		vdr, ok := vdrs.Get(nodeID)
+		require.True(ok)
+		require.Equal(expected, vdr.PublicKey)
+
+		staker, err := s.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+		require.NoError(err)
+		require.Equal(expected, staker.PublicKey)
+	}
+	// requirePublicKeyAt checks the key of the validator at [height], as
+	// reconstructed from the validator set at [currentHeight].
+	requirePublicKeyAt := func(s State, height, currentHeight uint64, expected *bls.PublicKey) {
+		primaryVdrs, ok := s.(*state).cfg.Validators.Get(constants.PrimaryNetworkID)
+		require.True(ok)
+		vdr, ok := primaryVdrs.Get(nodeID)
+		require.True(ok)
+		vdrs := map[ids.NodeID]*validators.GetValidatorOutput{
+			nodeID: {
+				NodeID:    nodeID,
+				PublicKey: vdr.PublicKey,
+				Weight:    vdr.Weight,
+			},
+		}
+		require.NoError(s.ApplyValidatorPublicKeyDiffs(ctx, vdrs, currentHeight, height+1))
+		require.Equal(expected, vdrs[nodeID].PublicKey)
+	}
+
+	s.AddTx(validatorTx, status.Committed)
+	s.PutCurrentValidator(staker)
+	acceptBlock(s, 1)
+	requirePublicKey(s, nil)
+
+	sk1, err := bls.NewSecretKey()
+	require.NoError(err)
+	pk1 := bls.PublicFromSecretKey(sk1)
+	rotation1 := &BLSKeyRotation{
+		TxID:          ids.GenerateTestID(),
+		NodeID:        nodeID,
+		ValidatorTxID: staker.TxID,
+		PublicKey:     pk1,
+		Height:        3,
+	}
+	s.PutBLSKeyRotation(rotation1)
+	rotation, err := s.GetBLSKeyRotation(nodeID)
+	require.NoError(err)
+	require.Equal(rotation1, rotation)
+
+	// The key isn't rotated before the rotation height.
+	acceptBlock(s, 2)
+	requirePublicKey(s, nil)
+
+	// The key is rotated at the rotation height.
+	acceptBlock(s, 3)
+	requirePublicKey(s, pk1)
+	requirePublicKeyAt(s, 2, 3, nil)
+	requirePublicKeyAt(s, 3, 3, pk1)
+
+	sk2, err := bls.NewSecretKey()
+	require.NoError(err)
+	pk2 := bls.PublicFromSecretKey(sk2)
+	rotation2 := &BLSKeyRotation{
+		TxID:              ids.GenerateTestID(),
+		NodeID:            nodeID,
+		ValidatorTxID:     staker.TxID,
+		PreviousPublicKey: pk1,
+		PublicKey:         pk2,
+		Height:            5,
+	}
+	s.PutBLSKeyRotation(rotation2)
+	acceptBlock(s, 4)
+	requirePublicKey(s, pk1)
+
+	// The pending rotation is applied once the reloaded state reaches the
+	// rotation height.
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).load())
+	requirePublicKey(s, pk1)
+	rotation, err = s.GetBLSKeyRotation(nodeID)
+	require.NoError(err)
+	require.Equal(rotation2, rotation)
+
+	acceptBlock(s, 5)
+	requirePublicKey(s, pk2)
+	requirePublicKeyAt(s, 2, 5, nil)
+	requirePublicKeyAt(s, 4, 5, pk1)
+	requirePublicKeyAt(s, 5, 5, pk2)
+
+	// The rotated key is kept by the reloaded state.
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).load())
+	requirePublicKey(s, pk2)
+
+	// The rotation is removed along with the validator.
+	s.DeleteCurrentValidator(staker)
+	acceptBlock(s, 6)
+	_, err = s.GetBLSKeyRotation(nodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).load())
+	_, err = s.GetBLSKeyRotation(nodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestStateAutoRestake(t *testing.T) {
+	require := require.New(t)
+
+	s, db := newInitializedState(require)
+
+	validatorTxID := ids.GenerateTestID()
+	autoRestake, err := s.GetAutoRestake(validatorTxID)
+	require.NoError(err)
+	require.False(autoRestake)
+
+	s.SetAutoRestake(validatorTxID, true)
+	autoRestake, err = s.GetAutoRestake(validatorTxID)
+	require.NoError(err)
+	require.True(autoRestake)
+	require.NoError(s.Commit())
+
+	// The flag is kept by the reloaded state.
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).load())
+	autoRestake, err = s.GetAutoRestake(validatorTxID)
+	require.NoError(err)
+	require.True(autoRestake)
+
+	s.SetAutoRestake(validatorTxID, false)
+	require.NoError(s.Commit())
+
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).load())
+	autoRestake, err = s.GetAutoRestake(validatorTxID)
+	require.NoError(err)
+	require.False(autoRestake)
+}
+
+func TestStateUptimeScore(t *testing.T) {
+	require := require.New(t)
+
+	s, db := newInitializedState(require)
+
+	validatorTx := &txs.Tx{Unsigned: &txs.AddValidatorTx{
+		Validator: txs.Validator{
+			NodeID: ids.GenerateTestNodeID(),
+			Start:  uint64(initialTime.Unix()),
+			End:    uint64(initialValidatorEndTime.Unix()),
+			Wght:   units.Dione,
+		},
+		StakeOuts: []*dione.TransferableOutput{
+			{
+				Asset: dione.Asset{ID: initialTxID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: units.Dione,
+				},
+			},
+		},
+		RewardsOwner:     &secp256k1fx.OutputOwners{},
+		DelegationShares: reward.PercentDenominator,
+	}}
+	require.NoError(validatorTx.Initialize(txs.Codec))
+	staker, err := NewCurrentStaker(validatorTx.ID(), validatorTx.Unsigned.(txs.Staker), 1)
+	require.NoError(err)
+	nodeID := staker.NodeID
+
+	s.AddTx(validatorTx, status.Committed)
+	s.PutCurrentValidator(staker)
+
+	_, err = s.GetUptimeScore(nodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	score := &UptimeScore{
+		NodeID:        nodeID,
+		ValidatorTxID: staker.TxID,
+		UpDuration:    time.Hour,
+		LastUpdated:   initialTime.Add(2 * time.Hour),
+	}
+	s.PutUptimeScore(score)
+	require.NoError(s.Commit())
+
+	// The score is kept by the reloaded state.
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).load())
+	loadedScore, err := s.GetUptimeScore(nodeID)
+	require.NoError(err)
+	require.Equal(score.ValidatorTxID, loadedScore.ValidatorTxID)
+	require.Equal(score.UpDuration, loadedScore.UpDuration)
+	require.Equal(score.LastUpdated.Unix(), loadedScore.LastUpdated.Unix())
+
+	// The validator was up half of its staking period so far.
+	require.Equal(0.5, loadedScore.Uptime(staker, initialTime.Add(2*time.Hour)))
+	// The uptime is capped by the end of the staking period.
+	require.Equal(
+		float64(time.Hour)/float64(staker.EndTime.Sub(staker.StartTime)),
+		loadedScore.Uptime(staker, staker.EndTime.Add(time.Hour)),
+	)
+
+	// The score is removed along with the validator.
+	s.DeleteCurrentValidator(staker)
+	require.NoError(s.Commit())
+	_, err = s.GetUptimeScore(nodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).load())
+	_, err = s.GetUptimeScore(nodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"math/big"
+
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+)
+
+// SubnetFeeConfig is the fee schedule of an elastic subnet. The fees are paid
+// in the staking asset of the subnet.
+type SubnetFeeConfig struct {
+	// Fee charged by an AddPermissionlessValidatorTx on the subnet
+	AddValidatorFee uint64 `serialize:"true"`
+	// Fee charged by an AddPermissionlessDelegatorTx on the subnet
+	AddDelegatorFee uint64 `serialize:"true"`
+}
+
+// SubnetFeePool holds the fees collected by an elastic subnet until they are
+// paid out to the stakers of the subnet, at the end of their staking period.
+type SubnetFeePool struct {
+	// Fees distributed per unit of weight staked on the subnet since the
+	// subnet started collecting fees, shifted left by [reward.BitShift]
+	FeePerWeightStored *big.Int
+	// Fees collected while no stake was validating the subnet, to be
+	// distributed with the next collected fee
+	Undistributed uint64
+	// Part of the distributed fees, shifted left by [reward.BitShift], that
+	// couldn't be split evenly over the staked weight, to be distributed with
+	// the next collected fee
+	Remainder uint64
+}
+
+// subnetFeePoolMetadata is the persisted form of a [SubnetFeePool], keyed by
+// subnet ID.
+type subnetFeePoolMetadata struct {
+	FeePerWeightStored []byte `serialize:"true"`
+	Undistributed      uint64 `serialize:"true"`
+	Remainder          uint64 `serialize:"true"`
+}
+
+func marshalSubnetFeeConfig(config *SubnetFeeConfig) ([]byte, error) {
+	return blocks.GenesisCodec.Marshal(blocks.Version, config)
+}
+
+func parseSubnetFeeConfig(bytes []byte) (*SubnetFeeConfig, error) {
+	config := &SubnetFeeConfig{}
+	if _, err := blocks.GenesisCodec.Unmarshal(bytes, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func marshalSubnetFeePool(pool *SubnetFeePool) ([]byte, error) {
+	metadata := &subnetFeePoolMetadata{
+		FeePerWeightStored: pool.FeePerWeightStored.Bytes(),
+		Undistributed:      pool.Undistributed,
+		Remainder:          pool.Remainder,
+	}
+	return blocks.GenesisCodec.Marshal(blocks.Version, metadata)
+}
+
+func parseSubnetFeePool(bytes []byte) (*SubnetFeePool, error) {
+	metadata := subnetFeePoolMetadata{}
+	if _, err := blocks.GenesisCodec.Unmarshal(bytes, &metadata); err != nil {
+		return nil, err
+	}
+	return &SubnetFeePool{
+		FeePerWeightStored: new(big.Int).SetBytes(metadata.FeePerWeightStored),
+		Undistributed:      metadata.Undistributed,
+		Remainder:          metadata.Remainder,
+	}, nil
+}
@@ -0,0 +1,175 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	stdmath "math"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+)
+
+// SupplyBreakdown is how the DIONE supply of the primary network is split at
+// an accepted height.
+type SupplyBreakdown struct {
+	Supply uint64 `serialize:"true"`
+	// Rewards minted into the supply
+	Minted uint64 `serialize:"true"`
+	// Fees burned from the supply instead of being distributed to the stakers
+	Burned uint64 `serialize:"true"`
+	// Amount held by the [stakeable.LockOut] UTXOs that are still locked
+	Locked uint64 `serialize:"true"`
+	// Amount staked by the current and pending stakers
+	Staked uint64 `serialize:"true"`
+	// Rewards accrued so far by the current stakers
+	PendingRewards uint64 `serialize:"true"`
+}
+
+func marshalSupplyBreakdown(breakdown *SupplyBreakdown) ([]byte, error) {
+	return blocks.GenesisCodec.Marshal(blocks.Version, breakdown)
+}
+
+func parseSupplyBreakdown(bytes []byte) (*SupplyBreakdown, error) {
+	breakdown := &SupplyBreakdown{}
+	_, err := blocks.GenesisCodec.Unmarshal(bytes, breakdown)
+	return breakdown, err
+}
+
+// A breakdown is only recorded at the heights it changes at. As the height is
+// bit flipped in the key, iterating from [height] returns the breakdown
+// recorded at the greatest height <= [height].
+func marshalSupplyBreakdownKey(height uint64) []byte {
+	key := make([]byte, database.Uint64Size)
+	packIterableHeight(key, height)
+	return key
+}
+
+func (s *state) GetSupplyBreakdown(height uint64) (*SupplyBreakdown, error) {
+	it := s.supplyBreakdownDB.NewIteratorWithStart(marshalSupplyBreakdownKey(height))
+	defer it.Release()
+
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return nil, err
+		}
+		return nil, database.ErrNotFound
+	}
+	return parseSupplyBreakdown(it.Value())
+}
+
+// loadSupplyBreakdown loads the last recorded supply breakdown, if any.
+func (s *state) loadSupplyBreakdown() error {
+	breakdown, err := s.GetSupplyBreakdown(stdmath.MaxUint64)
+	switch err {
+	case nil:
+		s.lastSupplyBreakdown = breakdown
+		return nil
+	case database.ErrNotFound:
+		return nil
+	default:
+		return err
+	}
+}
+
+// writeSupplyBreakdown records the supply breakdown at [height] if it changed
+// since the last recorded one.
+//
+// Invariant: must be called after the UTXOs and the metadata are written.
+func (s *state) writeSupplyBreakdown(height uint64) error {
+	breakdown, err := s.supplyBreakdown()
+	if err != nil {
+		return err
+	}
+	if s.lastSupplyBreakdown != nil && *s.lastSupplyBreakdown == *breakdown {
+		return nil
+	}
+
+	breakdownBytes, err := marshalSupplyBreakdown(breakdown)
+	if err != nil {
+		return err
+	}
+	if err := s.supplyBreakdownDB.Put(marshalSupplyBreakdownKey(height), breakdownBytes); err != nil {
+		return err
+	}
+	s.lastSupplyBreakdown = breakdown
+	return nil
+}
+
+func (s *state) supplyBreakdown() (*SupplyBreakdown, error) {
+	locked, err := s.GetLockedAmount(s.timestamp)
+	if err != nil {
+		return nil, err
+	}
+	staked, pendingRewards, err := s.stakedAndPendingRewards()
+	if err != nil {
+		return nil, err
+	}
+	return &SupplyBreakdown{
+		Supply:         s.currentSupply,
+		Minted:         s.mintedSupply,
+		Burned:         s.burnedFees,
+		Locked:         locked,
+		Staked:         staked,
+		PendingRewards: pendingRewards,
+	}, nil
+}
+
+// stakedAndPendingRewards returns the amount staked by the primary network
+// stakers and the rewards accrued so far by the current ones.
+func (s *state) stakedAndPendingRewards() (uint64, uint64, error) {
+	currentStakerIterator, err := s.GetCurrentStakerIterator()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer currentStakerIterator.Release()
+
+	var staked, pendingRewards uint64
+	for currentStakerIterator.Next() {
+		staker := currentStakerIterator.Value()
+		if staker.SubnetID != constants.PrimaryNetworkID {
+			continue
+		}
+		staked, err = math.Add64(staked, staker.Weight)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		// The reward of a staker is only fixed once it is due, until then it
+		// is the reward accrued up to the last stake sync.
+		stakerReward := staker.PotentialReward
+		if stakerReward == 0 {
+			if staker.MintRate != nil {
+				stakerReward += reward.CalculateMintReward(staker.Weight, staker.MintRate, s.stakerAccumulatedMintRate)
+			}
+			if staker.FeePerWeightPaid != nil {
+				stakerReward += reward.CalculateFeeReward(s.feePerWeightStored, staker.Weight, staker.FeePerWeightPaid)
+			}
+		}
+		pendingRewards, err = math.Add64(pendingRewards, stakerReward)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	pendingStakerIterator, err := s.GetPendingStakerIterator()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer pendingStakerIterator.Release()
+
+	for pendingStakerIterator.Next() {
+		staker := pendingStakerIterator.Value()
+		if staker.SubnetID != constants.PrimaryNetworkID {
+			continue
+		}
+		staked, err = math.Add64(staked, staker.Weight)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return staked, pendingRewards, nil
+}
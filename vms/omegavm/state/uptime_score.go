@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+)
+
+// UptimeScore is the uptime of a primary network validator during its current
+// staking period, as attested by a quorum of the stake of the primary network.
+// It is removed once the validator is removed.
+type UptimeScore struct {
+	NodeID ids.NodeID
+	// ID of the tx that added the validator
+	ValidatorTxID ids.ID
+	// Longest duration the validator was attested to be up since the start of
+	// its staking period
+	UpDuration time.Duration
+	// Chain time at which [UpDuration] was last increased
+	LastUpdated time.Time
+}
+
+// Uptime returns the fraction of the staking period of [validator] until
+// [now] it was attested to be up.
+func (s *UptimeScore) Uptime(validator *Staker, now time.Time) float64 {
+	if now.After(validator.EndTime) {
+		now = validator.EndTime
+	}
+	duration := now.Sub(validator.StartTime)
+	if duration <= 0 {
+		return 1
+	}
+	uptime := float64(s.UpDuration) / float64(duration)
+	if uptime > 1 {
+		return 1
+	}
+	return uptime
+}
+
+// uptimeScoreMetadata is the persisted form of an [UptimeScore], keyed by node
+// ID.
+type uptimeScoreMetadata struct {
+	ValidatorTxID ids.ID `serialize:"true"`
+	UpDuration    uint64 `serialize:"true"` // In seconds
+	LastUpdated   uint64 `serialize:"true"` // Unix time in seconds
+}
+
+func marshalUptimeScore(s *UptimeScore) ([]byte, error) {
+	metadata := &uptimeScoreMetadata{
+		ValidatorTxID: s.ValidatorTxID,
+		UpDuration:    uint64(s.UpDuration / time.Second),
+		LastUpdated:   uint64(s.LastUpdated.Unix()),
+	}
+	return blocks.GenesisCodec.Marshal(blocks.Version, metadata)
+}
+
+func parseUptimeScore(nodeID ids.NodeID, bytes []byte) (*UptimeScore, error) {
+	metadata := uptimeScoreMetadata{}
+	if _, err := blocks.GenesisCodec.Unmarshal(bytes, &metadata); err != nil {
+		return nil, err
+	}
+	return &UptimeScore{
+		NodeID:        nodeID,
+		ValidatorTxID: metadata.ValidatorTxID,
+		UpDuration:    time.Duration(metadata.UpDuration) * time.Second,
+		LastUpdated:   time.Unix(int64(metadata.LastUpdated), 0),
+	}, nil
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/engine/snowman/block"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+)
+
+var _ block.StateSyncableVM = (*VM)(nil)
+
+func (vm *VM) StateSyncEnabled(ctx context.Context) (bool, error) {
+	return vm.syncer.StateSyncEnabled(ctx)
+}
+
+func (vm *VM) GetOngoingSyncStateSummary(ctx context.Context) (block.StateSummary, error) {
+	return vm.syncer.GetOngoingSyncStateSummary(ctx)
+}
+
+func (vm *VM) GetLastStateSummary(ctx context.Context) (block.StateSummary, error) {
+	return vm.syncer.GetLastStateSummary(ctx)
+}
+
+func (vm *VM) ParseStateSummary(ctx context.Context, summaryBytes []byte) (block.StateSummary, error) {
+	return vm.syncer.ParseStateSummary(ctx, summaryBytes)
+}
+
+func (vm *VM) GetStateSummary(ctx context.Context, summaryHeight uint64) (block.StateSummary, error) {
+	return vm.syncer.GetStateSummary(ctx, summaryHeight)
+}
+
+// AppRequest serves the state sync requests of the peers.
+func (vm *VM) AppRequest(ctx context.Context, nodeID ids.NodeID, requestID uint32, deadline time.Time, request []byte) error {
+	return vm.syncer.AppRequest(ctx, nodeID, requestID, deadline, request)
+}
+
+func (vm *VM) AppResponse(ctx context.Context, nodeID ids.NodeID, requestID uint32, response []byte) error {
+	return vm.syncer.AppResponse(ctx, nodeID, requestID, response)
+}
+
+func (vm *VM) AppRequestFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
+	return vm.syncer.AppRequestFailed(ctx, nodeID, requestID)
+}
+
+// onStateSynced resumes the chain from [blk] once its state has been synced.
+func (vm *VM) onStateSynced(blk blocks.Block) error {
+	blkID := blk.ID()
+	vm.manager.SetLastAccepted(blkID)
+	if err := vm.initBlockchains(); err != nil {
+		return fmt.Errorf("failed to initialize blockchains: %w", err)
+	}
+	return vm.SetPreference(context.TODO(), blkID)
+}
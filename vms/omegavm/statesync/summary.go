@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package statesync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/codec"
+	"github.com/DioneProtocol/odysseygo/codec/linearcodec"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/engine/snowman/block"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+)
+
+// CodecVersion is the current default codec version
+const CodecVersion = 0
+
+// maxSummarySize bounds the size of the summaries received from peers. A
+// summary holds a block and a state root.
+const maxSummarySize = 2 * units.MiB
+
+var _ block.StateSummary = (*Summary)(nil)
+
+// Codec is used to serialize the state summaries
+var Codec codec.Manager
+
+func init() {
+	c := linearcodec.NewDefault()
+	Codec = codec.NewManager(maxSummarySize)
+
+	if err := Codec.RegisterCodec(CodecVersion, c); err != nil {
+		panic(err)
+	}
+}
+
+// Summary is the state of the O-chain after the acceptance of a block.
+type Summary struct {
+	// Bytes of the block the state was synced at
+	BlockBytes []byte `serialize:"true"`
+	// Root of the merkle state after the acceptance of the block
+	StateRoot ids.ID `serialize:"true"`
+
+	id     ids.ID
+	blk    blocks.Block
+	bytes  []byte
+	accept func(context.Context, *Summary) (block.StateSyncMode, error)
+}
+
+// NewSummary returns the summary of the state after the acceptance of [blk].
+func NewSummary(blk blocks.Block, stateRoot ids.ID) (*Summary, error) {
+	summary := &Summary{
+		BlockBytes: blk.Bytes(),
+		StateRoot:  stateRoot,
+		blk:        blk,
+	}
+	bytes, err := Codec.Marshal(CodecVersion, summary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal summary: %w", err)
+	}
+	summary.id = hashing.ComputeHash256Array(bytes)
+	summary.bytes = bytes
+	return summary, nil
+}
+
+// ParseSummary parses a summary that may have been received from a peer.
+func ParseSummary(bytes []byte) (*Summary, error) {
+	summary := &Summary{}
+	if _, err := Codec.Unmarshal(bytes, summary); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal summary: %w", err)
+	}
+	blk, err := blocks.Parse(blocks.Codec, summary.BlockBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse summary block: %w", err)
+	}
+	summary.id = hashing.ComputeHash256Array(bytes)
+	summary.blk = blk
+	summary.bytes = bytes
+	return summary, nil
+}
+
+func (s *Summary) ID() ids.ID {
+	return s.id
+}
+
+func (s *Summary) Height() uint64 {
+	return s.blk.Height()
+}
+
+func (s *Summary) Bytes() []byte {
+	return s.bytes
+}
+
+// Block returns the block the state was synced at.
+func (s *Summary) Block() blocks.Block {
+	return s.blk
+}
+
+func (s *Summary) Accept(ctx context.Context) (block.StateSyncMode, error) {
+	if s.accept == nil {
+		return block.StateSyncSkipped, errSummaryNotParsed
+	}
+	return s.accept(ctx, s)
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package statesync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+)
+
+func TestSummaryParse(t *testing.T) {
+	require := require.New(t)
+
+	blk, err := blocks.NewStateSyncStandardBlock(
+		time.Unix(1_700_000_000, 0),
+		ids.GenerateTestID(),
+		SummaryInterval,
+		ids.GenerateTestID(),
+		nil,
+		1,
+		2,
+	)
+	require.NoError(err)
+
+	stateRoot := ids.GenerateTestID()
+	summary, err := NewSummary(blk, stateRoot)
+	require.NoError(err)
+	require.Equal(uint64(SummaryInterval), summary.Height())
+
+	parsedSummary, err := ParseSummary(summary.Bytes())
+	require.NoError(err)
+	require.Equal(summary.ID(), parsedSummary.ID())
+	require.Equal(summary.Height(), parsedSummary.Height())
+	require.Equal(summary.Bytes(), parsedSummary.Bytes())
+	require.Equal(stateRoot, parsedSummary.StateRoot)
+	require.Equal(blk.ID(), parsedSummary.Block().ID())
+
+	// Summaries that weren't parsed by a syncer can't be accepted.
+	_, err = parsedSummary.Accept(context.Background())
+	require.ErrorIs(err, errSummaryNotParsed)
+}
@@ -0,0 +1,387 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package statesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/snow/engine/snowman/block"
+	"github.com/DioneProtocol/odysseygo/trace"
+	"github.com/DioneProtocol/odysseygo/utils/maybe"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/version"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/x/merkledb"
+
+	xsync "github.com/DioneProtocol/odysseygo/x/sync"
+)
+
+const (
+	// SummaryInterval is the number of blocks between two state summaries.
+	//
+	// It must be lower than [state.MerkleHistoryLength] so that the merkle
+	// state of the last summary can be served to peers.
+	SummaryInterval = 4096
+
+	metricsNamespace = "state_sync"
+
+	maxActiveRequests     = 16
+	simultaneousWorkLimit = 8
+	clearBatchSize        = units.MiB
+)
+
+var (
+	_ SummaryIndexer = (*Syncer)(nil)
+	_ SummaryIndexer = (*noSummaryIndexer)(nil)
+
+	errSummaryNotParsed = errors.New("summary wasn't parsed by the syncer")
+
+	summaryPrefix   = []byte("summary")
+	singletonPrefix = []byte("singleton")
+	syncPrefix      = []byte("sync")
+
+	lastSummaryKey    = []byte("last summary")
+	ongoingSummaryKey = []byte("ongoing summary")
+)
+
+// SummaryIndexer records the state summaries of the accepted blocks.
+type SummaryIndexer interface {
+	// IndexSummary records the summary of the state after the acceptance of
+	// [blk], if [blk] is at a summary height. Must be called after the state
+	// has been committed.
+	IndexSummary(blk blocks.Block) error
+}
+
+type noSummaryIndexer struct{}
+
+// NewNoSummaryIndexer returns a SummaryIndexer that doesn't record any
+// summary.
+func NewNoSummaryIndexer() SummaryIndexer {
+	return noSummaryIndexer{}
+}
+
+func (noSummaryIndexer) IndexSummary(blocks.Block) error {
+	return nil
+}
+
+type Config struct {
+	Ctx    *snow.Context
+	Config *config.Config
+	// Enabled is true if the node should sync its state from its peers rather
+	// than execute all the blocks of the chain.
+	Enabled bool
+	State   state.State
+	// DB is where the summaries and the state being synced are persisted.
+	DB         database.Database
+	AppSender  common.AppSender
+	ToEngine   chan<- common.Message
+	Registerer prometheus.Registerer
+	// OnSynced is called, with the context lock held, once the state of [blk]
+	// has been synced.
+	OnSynced func(blk blocks.Block) error
+}
+
+/*
+ * DB
+ * |-. summary
+ * | '-- height -> summary bytes
+ * |-. singleton
+ * | |-- last summary -> height of the last summary
+ * | '-- ongoing summary -> bytes of the summary being synced
+ * '-. sync
+ *   '-- merkle state being synced
+ */
+
+// Syncer serves the merkle state of the O-chain to its peers and syncs the
+// state of a summary from them.
+type Syncer struct {
+	config Config
+
+	summaryDB   database.Database
+	singletonDB database.Database
+	syncDB      database.Database
+
+	server        *xsync.NetworkServer
+	networkClient xsync.NetworkClient
+	client        xsync.Client
+
+	// Held while a sync is ongoing.
+	syncLock sync.Mutex
+}
+
+func New(config Config) (*Syncer, error) {
+	networkClient, err := xsync.NewNetworkClient(
+		config.AppSender,
+		config.Ctx.NodeID,
+		maxActiveRequests,
+		config.Ctx.Log,
+		metricsNamespace,
+		config.Registerer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network client: %w", err)
+	}
+	syncMetrics, err := xsync.NewMetrics(metricsNamespace, config.Registerer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics: %w", err)
+	}
+
+	return &Syncer{
+		config:        config,
+		summaryDB:     prefixdb.New(summaryPrefix, config.DB),
+		singletonDB:   prefixdb.New(singletonPrefix, config.DB),
+		syncDB:        prefixdb.New(syncPrefix, config.DB),
+		server:        xsync.NewNetworkServer(config.AppSender, config.State.GetMerkleDB(), config.Ctx.Log),
+		networkClient: networkClient,
+		client: xsync.NewClient(&xsync.ClientConfig{
+			NetworkClient: networkClient,
+			// Only peers running this version commit to the merkle state.
+			StateSyncMinVersion: version.CurrentApp,
+			Log:                 config.Ctx.Log,
+			Metrics:             syncMetrics,
+		}),
+	}, nil
+}
+
+func (s *Syncer) IndexSummary(blk blocks.Block) error {
+	height := blk.Height()
+	if height%SummaryInterval != 0 || !s.config.Config.IsStateSyncActivated(s.config.State.GetTimestamp()) {
+		return nil
+	}
+
+	root, err := s.config.State.GetMerkleDB().GetMerkleRoot(context.TODO())
+	if err != nil {
+		return err
+	}
+	summary, err := NewSummary(blk, root)
+	if err != nil {
+		return err
+	}
+
+	key := database.PackUInt64(height)
+	if err := s.summaryDB.Put(key, summary.Bytes()); err != nil {
+		return err
+	}
+	return s.singletonDB.Put(lastSummaryKey, key)
+}
+
+func (s *Syncer) StateSyncEnabled(context.Context) (bool, error) {
+	return s.config.Enabled, nil
+}
+
+func (s *Syncer) GetOngoingSyncStateSummary(context.Context) (block.StateSummary, error) {
+	bytes, err := s.singletonDB.Get(ongoingSummaryKey)
+	if err != nil {
+		return nil, err
+	}
+	return s.parseSummary(bytes)
+}
+
+func (s *Syncer) GetLastStateSummary(ctx context.Context) (block.StateSummary, error) {
+	key, err := s.singletonDB.Get(lastSummaryKey)
+	if err != nil {
+		return nil, err
+	}
+	height, err := database.ParseUInt64(key)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetStateSummary(ctx, height)
+}
+
+func (s *Syncer) ParseStateSummary(_ context.Context, summaryBytes []byte) (block.StateSummary, error) {
+	return s.parseSummary(summaryBytes)
+}
+
+// GetStateSummary returns the summary at [height] if its merkle state can
+// still be served to peers.
+func (s *Syncer) GetStateSummary(ctx context.Context, height uint64) (block.StateSummary, error) {
+	bytes, err := s.summaryDB.Get(database.PackUInt64(height))
+	if err != nil {
+		return nil, err
+	}
+	summary, err := s.parseSummary(bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// The merkle history is only kept in memory, so the state of older
+	// summaries may not be available anymore.
+	_, err = s.config.State.GetMerkleDB().GetRangeProofAtRoot(
+		ctx,
+		summary.StateRoot,
+		maybe.Nothing[[]byte](),
+		maybe.Nothing[[]byte](),
+		1,
+	)
+	if err != nil {
+		s.config.Ctx.Log.Debug("state summary isn't servable",
+			zap.Uint64("height", height),
+			zap.Stringer("root", summary.StateRoot),
+			zap.Error(err),
+		)
+		return nil, database.ErrNotFound
+	}
+	return summary, nil
+}
+
+func (s *Syncer) parseSummary(bytes []byte) (*Summary, error) {
+	summary, err := ParseSummary(bytes)
+	if err != nil {
+		return nil, err
+	}
+	summary.accept = s.acceptSummary
+	return summary, nil
+}
+
+// acceptSummary starts syncing the state of [summary] unless this node is
+// already past it. Must be called with the context lock held.
+func (s *Syncer) acceptSummary(_ context.Context, summary *Summary) (block.StateSyncMode, error) {
+	lastAccepted, err := s.config.State.GetStatelessBlock(s.config.State.GetLastAccepted())
+	if err != nil {
+		return block.StateSyncSkipped, err
+	}
+	if summary.Height() <= lastAccepted.Height() {
+		s.config.Ctx.Log.Info("skipping state sync",
+			zap.Uint64("summaryHeight", summary.Height()),
+			zap.Uint64("lastAcceptedHeight", lastAccepted.Height()),
+		)
+		return block.StateSyncSkipped, nil
+	}
+
+	if err := s.singletonDB.Put(ongoingSummaryKey, summary.Bytes()); err != nil {
+		return block.StateSyncSkipped, err
+	}
+
+	s.config.Ctx.Log.Info("starting state sync",
+		zap.Stringer("summaryID", summary.ID()),
+		zap.Uint64("height", summary.Height()),
+		zap.Stringer("root", summary.StateRoot),
+	)
+	go func() {
+		if err := s.sync(summary); err != nil {
+			s.config.Ctx.Log.Error("state sync failed",
+				zap.Stringer("summaryID", summary.ID()),
+				zap.Error(err),
+			)
+		}
+		s.config.ToEngine <- common.StateSyncDone
+	}()
+	return block.StateSyncStatic, nil
+}
+
+// sync fetches the merkle state of [summary] from the peers and replaces the
+// local state with it.
+func (s *Syncer) sync(summary *Summary) error {
+	s.syncLock.Lock()
+	defer s.syncLock.Unlock()
+
+	ctx := context.TODO()
+	syncDB, err := merkledb.New(ctx, s.syncDB, merkledb.Config{
+		EvictionBatchSize:         units.MiB,
+		HistoryLength:             1,
+		ValueNodeCacheSize:        16 * units.MiB,
+		IntermediateNodeCacheSize: 16 * units.MiB,
+		Tracer:                    trace.Noop,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to open sync database: %w", err)
+	}
+
+	if err := s.fetch(ctx, syncDB, summary.StateRoot); err != nil {
+		_ = syncDB.Close()
+		return err
+	}
+
+	s.config.Ctx.Lock.Lock()
+	err = s.apply(ctx, syncDB, summary)
+	s.config.Ctx.Lock.Unlock()
+	if closeErr := syncDB.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return database.Clear(s.syncDB, clearBatchSize)
+}
+
+func (s *Syncer) fetch(ctx context.Context, syncDB merkledb.MerkleDB, root ids.ID) error {
+	manager, err := xsync.NewManager(xsync.ManagerConfig{
+		DB:                    syncDB,
+		Client:                s.client,
+		SimultaneousWorkLimit: simultaneousWorkLimit,
+		Log:                   s.config.Ctx.Log,
+		TargetRoot:            root,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create sync manager: %w", err)
+	}
+	if err := manager.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start sync manager: %w", err)
+	}
+	return manager.Wait(ctx)
+}
+
+// apply replaces the local state with the synced state. Must be called with
+// the context lock held.
+func (s *Syncer) apply(ctx context.Context, syncDB merkledb.MerkleDB, summary *Summary) error {
+	blk := summary.Block()
+	if err := s.config.State.ApplySyncedState(ctx, syncDB, summary.StateRoot, blk); err != nil {
+		return fmt.Errorf("failed to apply synced state: %w", err)
+	}
+
+	// The fee pools hold values local to this node, so they aren't synced. The
+	// changes committed by this node are kept, as if committed by [blk], so
+	// that they reconcile with the synced last accepted block.
+	chainID := s.config.Ctx.ChainID
+	pools := s.config.Ctx.FeeCollector.GetChainPools(chainID)
+	if err := s.config.Ctx.FeeCollector.SetChainPools(chainID, blk.ID(), blk.Height(), pools); err != nil {
+		return fmt.Errorf("failed to rebase fee pools: %w", err)
+	}
+	if err := s.config.OnSynced(blk); err != nil {
+		return err
+	}
+
+	s.config.Ctx.Log.Info("finished state sync",
+		zap.Stringer("summaryID", summary.ID()),
+		zap.Uint64("height", blk.Height()),
+	)
+	return s.singletonDB.Delete(ongoingSummaryKey)
+}
+
+// AppRequest serves the merkle state to a peer.
+func (s *Syncer) AppRequest(ctx context.Context, nodeID ids.NodeID, requestID uint32, deadline time.Time, request []byte) error {
+	return s.server.AppRequest(ctx, nodeID, requestID, deadline, request)
+}
+
+func (s *Syncer) AppResponse(ctx context.Context, nodeID ids.NodeID, requestID uint32, response []byte) error {
+	return s.networkClient.AppResponse(ctx, nodeID, requestID, response)
+}
+
+func (s *Syncer) AppRequestFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
+	return s.networkClient.AppRequestFailed(ctx, nodeID, requestID)
+}
+
+func (s *Syncer) Connected(ctx context.Context, nodeID ids.NodeID, nodeVersion *version.Application) error {
+	return s.networkClient.Connected(ctx, nodeID, nodeVersion)
+}
+
+func (s *Syncer) Disconnected(ctx context.Context, nodeID ids.NodeID) error {
+	return s.networkClient.Disconnected(ctx, nodeID)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 )
 
 func TestBaseTxMarshalJSON(t *testing.T) {
@@ -51,3 +52,37 @@ func TestBaseTxMarshalJSON(t *testing.T) {
 	require.Contains(asString, `"inputs":[{"txID":"t64jLxDRmxo8y48WjbRALPAZuSDZ6qPVaaeDzxHA4oSojhLt","outputIndex":5,"assetID":"2KdbbWvpeAShCx5hGbtdF15FMMepq9kajsNTqVvvEbhiCRSxU","fxID":"2mB8TguRrYvbGw7G2UBqKfmL8osS7CfmzAAHSzuZK8bwpRKdY","input":{"Err":null,"Val":100}}]`)
 	require.Contains(asString, `"outputs":[{"assetID":"2KdbbWvpeAShCx5hGbtdF15FMMepq9kajsNTqVvvEbhiCRSxU","fxID":"2mB8TguRrYvbGw7G2UBqKfmL8osS7CfmzAAHSzuZK8bwpRKdY","output":{"Err":null,"Val":100}}]`)
 }
+
+func TestBaseTxSerialization(t *testing.T) {
+	require := require.New(t)
+
+	assetID := ids.ID{1}
+	tx := &Tx{Unsigned: &BaseTx{BaseTx: dione.BaseTx{
+		NetworkID:    4,
+		BlockchainID: ids.ID{2},
+		Ins: []*dione.TransferableInput{{
+			UTXOID: dione.UTXOID{TxID: ids.ID{3}},
+			Asset:  dione.Asset{ID: assetID},
+			In: &secp256k1fx.TransferInput{
+				Amt:   100,
+				Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+		}},
+		Outs: []*dione.TransferableOutput{{
+			Asset: dione.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 90,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{{4}},
+				},
+			},
+		}},
+	}}}
+	require.NoError(tx.Initialize(Codec))
+
+	parsedTx, err := Parse(Codec, tx.Bytes())
+	require.NoError(err)
+	require.IsType(&BaseTx{}, parsedTx.Unsigned)
+	require.Equal(tx.ID(), parsedTx.ID())
+}
@@ -11,15 +11,19 @@ import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/utils/math"
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/utxo"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 )
 
@@ -92,6 +96,114 @@ type DecisionTxBuilder interface {
 		keys []*secp256k1.PrivateKey,
 		changeAddr ids.ShortID,
 	) (*txs.Tx, error)
+
+	// amount: amount of DIONE to send
+	// to: address of recipient
+	// keys: keys to pay the fee and provide the tokens
+	// changeAddr: address to send change to, if there is any
+	NewBaseTx(
+		amount uint64,
+		to ids.ShortID,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	// subnetID: ID of the subnet whose ownership is transferred
+	// threshold: [threshold] of [ownerAddrs] needed to manage this subnet
+	// ownerAddrs: control addresses for the new subnet owner
+	// keys: keys to pay the fee and authorize the transfer
+	// changeAddr: address to send change to, if there is any
+	NewTransferSubnetOwnershipTx(
+		subnetID ids.ID,
+		threshold uint32,
+		ownerAddrs []ids.ShortID,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	// nodeID: ID of the primary network validator whose BLS key is rotated
+	// sk: new BLS secret key of the validator
+	// keys: keys to pay the fee and prove the ownership of the validation
+	//       rewards of the validator
+	// changeAddr: address to send change to, if there is any
+	NewRotateBLSKeyTx(
+		nodeID ids.NodeID,
+		sk *bls.SecretKey,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	// nodeID: ID of the configured primary network validator
+	// autoRestake: whether the validator is restaked when its staking period
+	//              ends
+	// keys: keys to pay the fee and prove the ownership of the validation
+	//       rewards of the validator
+	// changeAddr: address to send change to, if there is any
+	NewSetAutoRestakeTx(
+		nodeID ids.NodeID,
+		autoRestake bool,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	// txID: ID of the tx that added the primary network staker to remove
+	// keys: keys to pay the fee and prove the ownership of the rewards of the
+	//       staker
+	// changeAddr: address to send change to, if there is any
+	NewEarlyUnstakeTx(
+		txID ids.ID,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	// txID: ID of the tx that added the primary network delegator to move
+	// nodeID: ID of the validator to move the delegator to
+	// keys: keys to pay the fee and prove the ownership of the rewards of the
+	//       delegator
+	// changeAddr: address to send change to, if there is any
+	NewRedelegateTx(
+		txID ids.ID,
+		nodeID ids.NodeID,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	// attestation: uptimes of primary network validators
+	// signature: aggregate signature of the warp message of [attestation] by
+	//            the primary network validators
+	// keys: keys to pay the fee
+	// changeAddr: address to send change to, if there is any
+	NewUptimeAttestationTx(
+		attestation txs.UptimeAttestation,
+		signature warp.BitSetSignature,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	// subnetID: ID of the elastic subnet whose fees are set
+	// addValidatorFee: fee charged to add a permissionless validator to the
+	//                  subnet, in the staking asset of the subnet
+	// addDelegatorFee: fee charged to add a permissionless delegator to the
+	//                  subnet, in the staking asset of the subnet
+	// keys: keys to pay the fee and prove the ownership of the subnet
+	// changeAddr: address to send change to, if there is any
+	NewSetSubnetFeeConfigTx(
+		subnetID ids.ID,
+		addValidatorFee uint64,
+		addDelegatorFee uint64,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	// txID: ID of the tx that added the pending staker to remove
+	// keys: keys to pay the fee and prove the ownership of the stake of the
+	//       staker
+	// changeAddr: address to send change to, if there is any
+	NewCancelPendingStakerTx(
+		txID ids.ID,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
 }
 
 type ProposalTxBuilder interface {
@@ -249,22 +361,23 @@ func (b *builder) NewImportTx(
 	}
 
 	importedDIONE := importedAmounts[b.ctx.DIONEAssetID]
+	txFee := b.dynamicFee(b.cfg.TxFee)
 
 	ins := []*dione.TransferableInput{}
 	outs := []*dione.TransferableOutput{}
 	switch {
-	case importedDIONE < b.cfg.TxFee: // imported amount goes toward paying tx fee
+	case importedDIONE < txFee: // imported amount goes toward paying tx fee
 		var baseSigners [][]*secp256k1.PrivateKey
-		ins, outs, _, baseSigners, err = b.Spend(b.state, keys, 0, b.cfg.TxFee-importedDIONE, changeAddr)
+		ins, outs, _, baseSigners, err = b.Spend(b.state, keys, 0, txFee-importedDIONE, changeAddr)
 		if err != nil {
 			return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 		}
 		signers = append(baseSigners, signers...)
 		delete(importedAmounts, b.ctx.DIONEAssetID)
-	case importedDIONE == b.cfg.TxFee:
+	case importedDIONE == txFee:
 		delete(importedAmounts, b.ctx.DIONEAssetID)
 	default:
-		importedAmounts[b.ctx.DIONEAssetID] -= b.cfg.TxFee
+		importedAmounts[b.ctx.DIONEAssetID] -= txFee
 	}
 
 	for assetID, amount := range importedAmounts {
@@ -309,9 +422,10 @@ func (b *builder) NewExportTx(
 	keys []*secp256k1.PrivateKey,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	toBurn, err := math.Add64(amount, b.cfg.TxFee)
+	txFee := b.dynamicFee(b.cfg.TxFee)
+	toBurn, err := math.Add64(amount, txFee)
 	if err != nil {
-		return nil, fmt.Errorf("amount (%d) + tx fee(%d) overflows", amount, b.cfg.TxFee)
+		return nil, fmt.Errorf("amount (%d) + tx fee(%d) overflows", amount, txFee)
 	}
 	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, toBurn, changeAddr)
 	if err != nil {
@@ -356,7 +470,7 @@ func (b *builder) NewCreateChainTx(
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
 	timestamp := b.state.GetTimestamp()
-	createBlockchainTxFee := b.cfg.GetCreateBlockchainTxFee(timestamp)
+	createBlockchainTxFee := b.dynamicFee(b.cfg.GetCreateBlockchainTxFee(timestamp))
 	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, createBlockchainTxFee, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
@@ -400,7 +514,7 @@ func (b *builder) NewCreateSubnetTx(
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
 	timestamp := b.state.GetTimestamp()
-	createSubnetTxFee := b.cfg.GetCreateSubnetTxFee(timestamp)
+	createSubnetTxFee := b.dynamicFee(b.cfg.GetCreateSubnetTxFee(timestamp))
 	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, createSubnetTxFee, changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
@@ -429,6 +543,49 @@ func (b *builder) NewCreateSubnetTx(
 	return tx, tx.SyntacticVerify(b.ctx)
 }
 
+func (b *builder) NewBaseTx(
+	amount uint64,
+	to ids.ShortID,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	txFee := b.dynamicFee(b.cfg.TxFee)
+	toBurn, err := math.Add64(amount, txFee)
+	if err != nil {
+		return nil, fmt.Errorf("amount (%d) + tx fee(%d) overflows", amount, txFee)
+	}
+	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, toBurn, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	outs = append(outs, &dione.TransferableOutput{
+		Asset: dione.Asset{ID: b.ctx.DIONEAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: amount,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Locktime:  0,
+				Threshold: 1,
+				Addrs:     []ids.ShortID{to},
+			},
+		},
+	})
+	dione.SortTransferableOutputs(outs, txs.Codec)
+
+	// Create the tx
+	utx := &txs.BaseTx{BaseTx: dione.BaseTx{
+		NetworkID:    b.ctx.NetworkID,
+		BlockchainID: b.ctx.ChainID,
+		Ins:          ins,
+		Outs:         outs,
+	}}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
 func (b *builder) NewAddValidatorTx(
 	stakeAmount,
 	startTime,
@@ -439,7 +596,7 @@ func (b *builder) NewAddValidatorTx(
 	keys []*secp256k1.PrivateKey,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	ins, unstakedOuts, stakedOuts, signers, err := b.Spend(b.state, keys, stakeAmount, b.cfg.AddPrimaryNetworkValidatorFee, changeAddr)
+	ins, unstakedOuts, stakedOuts, signers, err := b.Spend(b.state, keys, stakeAmount, b.dynamicFee(b.cfg.AddPrimaryNetworkValidatorFee), changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -481,7 +638,7 @@ func (b *builder) NewAddDelegatorTx(
 	keys []*secp256k1.PrivateKey,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	ins, unlockedOuts, lockedOuts, signers, err := b.Spend(b.state, keys, stakeAmount, b.cfg.AddPrimaryNetworkDelegatorFee, changeAddr)
+	ins, unlockedOuts, lockedOuts, signers, err := b.Spend(b.state, keys, stakeAmount, b.dynamicFee(b.cfg.AddPrimaryNetworkDelegatorFee), changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -522,7 +679,7 @@ func (b *builder) NewAddSubnetValidatorTx(
 	keys []*secp256k1.PrivateKey,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.cfg.TxFee, changeAddr)
+	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.dynamicFee(b.cfg.TxFee), changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -565,7 +722,7 @@ func (b *builder) NewRemoveSubnetValidatorTx(
 	keys []*secp256k1.PrivateKey,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.cfg.TxFee, changeAddr)
+	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.dynamicFee(b.cfg.TxFee), changeAddr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -595,6 +752,292 @@ func (b *builder) NewRemoveSubnetValidatorTx(
 	return tx, tx.SyntacticVerify(b.ctx)
 }
 
+func (b *builder) NewTransferSubnetOwnershipTx(
+	subnetID ids.ID,
+	threshold uint32,
+	ownerAddrs []ids.ShortID,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.dynamicFee(b.cfg.TxFee), changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	subnetAuth, subnetSigners, err := b.Authorize(b.state, subnetID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's subnet restrictions: %w", err)
+	}
+	signers = append(signers, subnetSigners)
+
+	// Sort control addresses
+	utils.Sort(ownerAddrs)
+
+	// Create the tx
+	utx := &txs.TransferSubnetOwnershipTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Subnet:     subnetID,
+		SubnetAuth: subnetAuth,
+		Owner: &secp256k1fx.OutputOwners{
+			Threshold: threshold,
+			Addrs:     ownerAddrs,
+		},
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *builder) NewRotateBLSKeyTx(
+	nodeID ids.NodeID,
+	sk *bls.SecretKey,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.dynamicFee(b.cfg.TxFee), changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	validatorAuth, validatorSigners, err := b.AuthorizeValidator(b.state, nodeID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's validator restrictions: %w", err)
+	}
+	signers = append(signers, validatorSigners)
+
+	// Create the tx
+	utx := &txs.RotateBLSKeyTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		NodeID:        nodeID,
+		Signer:        signer.NewProofOfPossession(sk),
+		ValidatorAuth: validatorAuth,
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *builder) NewSetAutoRestakeTx(
+	nodeID ids.NodeID,
+	autoRestake bool,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.dynamicFee(b.cfg.TxFee), changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	validatorAuth, validatorSigners, err := b.AuthorizeValidator(b.state, nodeID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's validator restrictions: %w", err)
+	}
+	signers = append(signers, validatorSigners)
+
+	// Create the tx
+	utx := &txs.SetAutoRestakeTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		NodeID:        nodeID,
+		AutoRestake:   autoRestake,
+		ValidatorAuth: validatorAuth,
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *builder) NewEarlyUnstakeTx(
+	txID ids.ID,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.dynamicFee(b.cfg.TxFee), changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	stakerAuth, stakerSigners, err := b.AuthorizeStaker(b.state, txID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's staker restrictions: %w", err)
+	}
+	signers = append(signers, stakerSigners)
+
+	// Create the tx
+	utx := &txs.EarlyUnstakeTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		TxID:       txID,
+		StakerAuth: stakerAuth,
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *builder) NewRedelegateTx(
+	txID ids.ID,
+	nodeID ids.NodeID,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.dynamicFee(b.cfg.TxFee), changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	delegatorAuth, delegatorSigners, err := b.AuthorizeStaker(b.state, txID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's delegator restrictions: %w", err)
+	}
+	signers = append(signers, delegatorSigners)
+
+	// Create the tx
+	utx := &txs.RedelegateTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		TxID:          txID,
+		NodeID:        nodeID,
+		DelegatorAuth: delegatorAuth,
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *builder) NewUptimeAttestationTx(
+	attestation txs.UptimeAttestation,
+	signature warp.BitSetSignature,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.dynamicFee(b.cfg.TxFee), changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	// Create the tx
+	utx := &txs.UptimeAttestationTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Attestation: attestation,
+		Signature:   signature,
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *builder) NewSetSubnetFeeConfigTx(
+	subnetID ids.ID,
+	addValidatorFee uint64,
+	addDelegatorFee uint64,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.dynamicFee(b.cfg.TxFee), changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	subnetAuth, subnetSigners, err := b.Authorize(b.state, subnetID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's subnet restrictions: %w", err)
+	}
+	signers = append(signers, subnetSigners)
+
+	// Create the tx
+	utx := &txs.SetSubnetFeeConfigTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Subnet:          subnetID,
+		AddValidatorFee: addValidatorFee,
+		AddDelegatorFee: addDelegatorFee,
+		SubnetAuth:      subnetAuth,
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *builder) NewCancelPendingStakerTx(
+	txID ids.ID,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.dynamicFee(b.cfg.TxFee), changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	stakerAuth, stakerSigners, err := b.AuthorizeStake(b.state, txID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's staker restrictions: %w", err)
+	}
+	signers = append(signers, stakerSigners)
+
+	// Create the tx
+	utx := &txs.CancelPendingStakerTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		TxID:       txID,
+		StakerAuth: stakerAuth,
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
 func (b *builder) NewAdvanceTimeTx(timestamp time.Time) (*txs.Tx, error) {
 	utx := &txs.AdvanceTimeTx{Time: uint64(timestamp.Unix())}
 	tx, err := txs.NewSigned(utx, txs.Codec, nil)
@@ -623,3 +1066,9 @@ func (b *builder) NewRewardValidatorTxWithFee(txID ids.ID, orionFee uint64) (*tx
 
 	return tx, tx.SyntacticVerify(b.ctx)
 }
+
+// dynamicFee returns the static [fee] charged at the current fee rate.
+func (b *builder) dynamicFee(fee uint64) uint64 {
+	feeRate := b.cfg.GetFeeRate(b.state.GetFeeRate(), b.state.GetTimestamp())
+	return fees.CalculateFee(fee, feeRate)
+}
@@ -12,8 +12,10 @@ import (
 	time "time"
 
 	ids "github.com/DioneProtocol/odysseygo/ids"
+	bls "github.com/DioneProtocol/odysseygo/utils/crypto/bls"
 	secp256k1 "github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	txs "github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	warp "github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -100,6 +102,36 @@ func (mr *MockBuilderMockRecorder) NewAdvanceTimeTx(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAdvanceTimeTx", reflect.TypeOf((*MockBuilder)(nil).NewAdvanceTimeTx), arg0)
 }
 
+// NewBaseTx mocks base method.
+func (m *MockBuilder) NewBaseTx(arg0 uint64, arg1 ids.ShortID, arg2 []*secp256k1.PrivateKey, arg3 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewBaseTx", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewBaseTx indicates an expected call of NewBaseTx.
+func (mr *MockBuilderMockRecorder) NewBaseTx(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewBaseTx", reflect.TypeOf((*MockBuilder)(nil).NewBaseTx), arg0, arg1, arg2, arg3)
+}
+
+// NewCancelPendingStakerTx mocks base method.
+func (m *MockBuilder) NewCancelPendingStakerTx(arg0 ids.ID, arg1 []*secp256k1.PrivateKey, arg2 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelPendingStakerTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewCancelPendingStakerTx indicates an expected call of NewCancelPendingStakerTx.
+func (mr *MockBuilderMockRecorder) NewCancelPendingStakerTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelPendingStakerTx", reflect.TypeOf((*MockBuilder)(nil).NewCancelPendingStakerTx), arg0, arg1, arg2)
+}
+
 // NewCreateChainTx mocks base method.
 func (m *MockBuilder) NewCreateChainTx(arg0 ids.ID, arg1 []byte, arg2 ids.ID, arg3 []ids.ID, arg4 string, arg5 []*secp256k1.PrivateKey, arg6 ids.ShortID) (*txs.Tx, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +162,21 @@ func (mr *MockBuilderMockRecorder) NewCreateSubnetTx(arg0, arg1, arg2, arg3 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateSubnetTx", reflect.TypeOf((*MockBuilder)(nil).NewCreateSubnetTx), arg0, arg1, arg2, arg3)
 }
 
+// NewEarlyUnstakeTx mocks base method.
+func (m *MockBuilder) NewEarlyUnstakeTx(arg0 ids.ID, arg1 []*secp256k1.PrivateKey, arg2 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewEarlyUnstakeTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewEarlyUnstakeTx indicates an expected call of NewEarlyUnstakeTx.
+func (mr *MockBuilderMockRecorder) NewEarlyUnstakeTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewEarlyUnstakeTx", reflect.TypeOf((*MockBuilder)(nil).NewEarlyUnstakeTx), arg0, arg1, arg2)
+}
+
 // NewExportTx mocks base method.
 func (m *MockBuilder) NewExportTx(arg0 uint64, arg1 ids.ID, arg2 ids.ShortID, arg3 []*secp256k1.PrivateKey, arg4 ids.ShortID) (*txs.Tx, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +207,21 @@ func (mr *MockBuilderMockRecorder) NewImportTx(arg0, arg1, arg2, arg3 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewImportTx", reflect.TypeOf((*MockBuilder)(nil).NewImportTx), arg0, arg1, arg2, arg3)
 }
 
+// NewRedelegateTx mocks base method.
+func (m *MockBuilder) NewRedelegateTx(arg0 ids.ID, arg1 ids.NodeID, arg2 []*secp256k1.PrivateKey, arg3 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRedelegateTx", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewRedelegateTx indicates an expected call of NewRedelegateTx.
+func (mr *MockBuilderMockRecorder) NewRedelegateTx(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRedelegateTx", reflect.TypeOf((*MockBuilder)(nil).NewRedelegateTx), arg0, arg1, arg2, arg3)
+}
+
 // NewRemoveSubnetValidatorTx mocks base method.
 func (m *MockBuilder) NewRemoveSubnetValidatorTx(arg0 ids.NodeID, arg1 ids.ID, arg2 []*secp256k1.PrivateKey, arg3 ids.ShortID) (*txs.Tx, error) {
 	m.ctrl.T.Helper()
@@ -204,3 +266,78 @@ func (mr *MockBuilderMockRecorder) NewRewardValidatorTxWithFee(arg0, arg1 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRewardValidatorTxWithFee", reflect.TypeOf((*MockBuilder)(nil).NewRewardValidatorTxWithFee), arg0, arg1)
 }
+
+// NewRotateBLSKeyTx mocks base method.
+func (m *MockBuilder) NewRotateBLSKeyTx(arg0 ids.NodeID, arg1 *bls.SecretKey, arg2 []*secp256k1.PrivateKey, arg3 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRotateBLSKeyTx", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewRotateBLSKeyTx indicates an expected call of NewRotateBLSKeyTx.
+func (mr *MockBuilderMockRecorder) NewRotateBLSKeyTx(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRotateBLSKeyTx", reflect.TypeOf((*MockBuilder)(nil).NewRotateBLSKeyTx), arg0, arg1, arg2, arg3)
+}
+
+// NewSetAutoRestakeTx mocks base method.
+func (m *MockBuilder) NewSetAutoRestakeTx(arg0 ids.NodeID, arg1 bool, arg2 []*secp256k1.PrivateKey, arg3 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSetAutoRestakeTx", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewSetAutoRestakeTx indicates an expected call of NewSetAutoRestakeTx.
+func (mr *MockBuilderMockRecorder) NewSetAutoRestakeTx(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSetAutoRestakeTx", reflect.TypeOf((*MockBuilder)(nil).NewSetAutoRestakeTx), arg0, arg1, arg2, arg3)
+}
+
+// NewSetSubnetFeeConfigTx mocks base method.
+func (m *MockBuilder) NewSetSubnetFeeConfigTx(arg0 ids.ID, arg1, arg2 uint64, arg3 []*secp256k1.PrivateKey, arg4 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSetSubnetFeeConfigTx", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewSetSubnetFeeConfigTx indicates an expected call of NewSetSubnetFeeConfigTx.
+func (mr *MockBuilderMockRecorder) NewSetSubnetFeeConfigTx(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSetSubnetFeeConfigTx", reflect.TypeOf((*MockBuilder)(nil).NewSetSubnetFeeConfigTx), arg0, arg1, arg2, arg3, arg4)
+}
+
+// NewTransferSubnetOwnershipTx mocks base method.
+func (m *MockBuilder) NewTransferSubnetOwnershipTx(arg0 ids.ID, arg1 uint32, arg2 []ids.ShortID, arg3 []*secp256k1.PrivateKey, arg4 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTransferSubnetOwnershipTx", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewTransferSubnetOwnershipTx indicates an expected call of NewTransferSubnetOwnershipTx.
+func (mr *MockBuilderMockRecorder) NewTransferSubnetOwnershipTx(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTransferSubnetOwnershipTx", reflect.TypeOf((*MockBuilder)(nil).NewTransferSubnetOwnershipTx), arg0, arg1, arg2, arg3, arg4)
+}
+
+// NewUptimeAttestationTx mocks base method.
+func (m *MockBuilder) NewUptimeAttestationTx(arg0 txs.UptimeAttestation, arg1 warp.BitSetSignature, arg2 []*secp256k1.PrivateKey, arg3 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUptimeAttestationTx", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewUptimeAttestationTx indicates an expected call of NewUptimeAttestationTx.
+func (mr *MockBuilderMockRecorder) NewUptimeAttestationTx(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUptimeAttestationTx", reflect.TypeOf((*MockBuilder)(nil).NewUptimeAttestationTx), arg0, arg1, arg2, arg3)
+}
@@ -127,3 +127,39 @@ func (*BurnedAssetCalculator) RewardValidatorTx(*RewardValidatorTx) error {
 func (b *BurnedAssetCalculator) TransformSubnetTx(tx *TransformSubnetTx) error {
 	return b.setDifference(&tx.BaseTx.BaseTx)
 }
+
+func (b *BurnedAssetCalculator) BaseTx(tx *BaseTx) error {
+	return b.setDifference(&tx.BaseTx)
+}
+
+func (b *BurnedAssetCalculator) TransferSubnetOwnershipTx(tx *TransferSubnetOwnershipTx) error {
+	return b.setDifference(&tx.BaseTx.BaseTx)
+}
+
+func (b *BurnedAssetCalculator) RotateBLSKeyTx(tx *RotateBLSKeyTx) error {
+	return b.setDifference(&tx.BaseTx.BaseTx)
+}
+
+func (b *BurnedAssetCalculator) SetAutoRestakeTx(tx *SetAutoRestakeTx) error {
+	return b.setDifference(&tx.BaseTx.BaseTx)
+}
+
+func (b *BurnedAssetCalculator) EarlyUnstakeTx(tx *EarlyUnstakeTx) error {
+	return b.setDifference(&tx.BaseTx.BaseTx)
+}
+
+func (b *BurnedAssetCalculator) RedelegateTx(tx *RedelegateTx) error {
+	return b.setDifference(&tx.BaseTx.BaseTx)
+}
+
+func (b *BurnedAssetCalculator) UptimeAttestationTx(tx *UptimeAttestationTx) error {
+	return b.setDifference(&tx.BaseTx.BaseTx)
+}
+
+func (b *BurnedAssetCalculator) SetSubnetFeeConfigTx(tx *SetSubnetFeeConfigTx) error {
+	return b.setDifference(&tx.BaseTx.BaseTx)
+}
+
+func (b *BurnedAssetCalculator) CancelPendingStakerTx(tx *CancelPendingStakerTx) error {
+	return b.setDifference(&tx.BaseTx.BaseTx)
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+)
+
+func TestCancelPendingStakerTxSyntacticVerify(t *testing.T) {
+	type test struct {
+		name        string
+		txFunc      func(*gomock.Controller) *CancelPendingStakerTx
+		expectedErr error
+	}
+
+	var (
+		networkID  = uint32(1337)
+		chainID    = ids.GenerateTestID()
+		stakerTxID = ids.GenerateTestID()
+	)
+
+	ctx := &snow.Context{
+		ChainID:   chainID,
+		NetworkID: networkID,
+	}
+
+	// A BaseTx that already passed syntactic verification.
+	verifiedBaseTx := BaseTx{
+		SyntacticallyVerified: true,
+	}
+
+	// A BaseTx that passes syntactic verification.
+	validBaseTx := BaseTx{
+		BaseTx: dione.BaseTx{
+			NetworkID:    networkID,
+			BlockchainID: chainID,
+		},
+	}
+
+	// A BaseTx that fails syntactic verification.
+	invalidBaseTx := BaseTx{}
+
+	tests := []test{
+		{
+			name: "nil tx",
+			txFunc: func(*gomock.Controller) *CancelPendingStakerTx {
+				return nil
+			},
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			txFunc: func(*gomock.Controller) *CancelPendingStakerTx {
+				return &CancelPendingStakerTx{BaseTx: verifiedBaseTx}
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "empty staker tx ID",
+			txFunc: func(*gomock.Controller) *CancelPendingStakerTx {
+				return &CancelPendingStakerTx{
+					BaseTx: validBaseTx,
+					TxID:   ids.Empty,
+				}
+			},
+			expectedErr: errEmptyStakerTxID,
+		},
+		{
+			name: "invalid BaseTx",
+			txFunc: func(*gomock.Controller) *CancelPendingStakerTx {
+				return &CancelPendingStakerTx{
+					BaseTx: invalidBaseTx,
+					TxID:   stakerTxID,
+				}
+			},
+			expectedErr: dione.ErrWrongNetworkID,
+		},
+		{
+			name: "invalid stakerAuth",
+			txFunc: func(ctrl *gomock.Controller) *CancelPendingStakerTx {
+				// This StakerAuth fails verification.
+				invalidStakerAuth := verify.NewMockVerifiable(ctrl)
+				invalidStakerAuth.EXPECT().Verify().Return(errInvalidStakerAuth)
+				return &CancelPendingStakerTx{
+					BaseTx:     validBaseTx,
+					TxID:       stakerTxID,
+					StakerAuth: invalidStakerAuth,
+				}
+			},
+			expectedErr: errInvalidStakerAuth,
+		},
+		{
+			name: "passes verification",
+			txFunc: func(ctrl *gomock.Controller) *CancelPendingStakerTx {
+				// This StakerAuth passes verification.
+				validStakerAuth := verify.NewMockVerifiable(ctrl)
+				validStakerAuth.EXPECT().Verify().Return(nil)
+				return &CancelPendingStakerTx{
+					BaseTx:     validBaseTx,
+					TxID:       stakerTxID,
+					StakerAuth: validStakerAuth,
+				}
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			tx := tt.txFunc(ctrl)
+			err := tx.SyntacticVerify(ctx)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			require.True(tx.SyntacticallyVerified)
+		})
+	}
+}
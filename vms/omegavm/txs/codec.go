@@ -41,6 +41,30 @@ func init() {
 		c.SkipRegistrations(5)
 
 		errs.Add(RegisterUnsignedTxsTypes(c))
+
+		// To maintain codec type ordering, we skip positions for the Banff
+		// blocks.
+		c.SkipRegistrations(4)
+
+		errs.Add(
+			RegisterBaseTxTypes(c),
+			RegisterTransferSubnetOwnershipTypes(c),
+		)
+
+		// To maintain codec type ordering, we skip positions for the
+		// StateSync blocks.
+		c.SkipRegistrations(1)
+
+		errs.Add(
+			RegisterBLSKeyRotationTypes(c),
+			RegisterAutoRestakeTypes(c),
+			RegisterEarlyUnstakeTypes(c),
+			RegisterRedelegateTypes(c),
+			RegisterUptimeAttestationTypes(c),
+			RegisterSubnetFeesTypes(c),
+			RegisterCancelPendingStakerTypes(c),
+			RegisterWeightedOwnersTypes(c),
+		)
 	}
 	errs.Add(
 		Codec.RegisterCodec(Version, c),
@@ -97,3 +121,65 @@ func RegisterUnsignedTxsTypes(targetCodec linearcodec.Codec) error {
 	)
 	return errs.Err
 }
+
+// RegisterBaseTxTypes registers the types introduced by the BaseTx network
+// upgrade. They must be registered after the Banff block types.
+func RegisterBaseTxTypes(targetCodec linearcodec.Codec) error {
+	return targetCodec.RegisterType(&BaseTx{})
+}
+
+// RegisterTransferSubnetOwnershipTypes registers the types introduced by the
+// TransferSubnetOwnership network upgrade. They must be registered after the
+// BaseTx types.
+func RegisterTransferSubnetOwnershipTypes(targetCodec linearcodec.Codec) error {
+	return targetCodec.RegisterType(&TransferSubnetOwnershipTx{})
+}
+
+// RegisterBLSKeyRotationTypes registers the types introduced by the
+// BLSKeyRotation network upgrade. They must be registered after the StateSync
+// block types.
+func RegisterBLSKeyRotationTypes(targetCodec linearcodec.Codec) error {
+	return targetCodec.RegisterType(&RotateBLSKeyTx{})
+}
+
+// RegisterAutoRestakeTypes registers the types introduced by the AutoRestake
+// network upgrade.
+func RegisterAutoRestakeTypes(targetCodec linearcodec.Codec) error {
+	return targetCodec.RegisterType(&SetAutoRestakeTx{})
+}
+
+// RegisterEarlyUnstakeTypes registers the types introduced by the EarlyUnstake
+// network upgrade.
+func RegisterEarlyUnstakeTypes(targetCodec linearcodec.Codec) error {
+	return targetCodec.RegisterType(&EarlyUnstakeTx{})
+}
+
+// RegisterRedelegateTypes registers the types introduced by the Redelegate
+// network upgrade.
+func RegisterRedelegateTypes(targetCodec linearcodec.Codec) error {
+	return targetCodec.RegisterType(&RedelegateTx{})
+}
+
+// RegisterUptimeAttestationTypes registers the types introduced by the
+// UptimeAttestation network upgrade.
+func RegisterUptimeAttestationTypes(targetCodec linearcodec.Codec) error {
+	return targetCodec.RegisterType(&UptimeAttestationTx{})
+}
+
+// RegisterSubnetFeesTypes registers the types introduced by the SubnetFees
+// network upgrade.
+func RegisterSubnetFeesTypes(targetCodec linearcodec.Codec) error {
+	return targetCodec.RegisterType(&SetSubnetFeeConfigTx{})
+}
+
+// RegisterCancelPendingStakerTypes registers the types introduced by the
+// CancelPendingStaker network upgrade.
+func RegisterCancelPendingStakerTypes(targetCodec linearcodec.Codec) error {
+	return targetCodec.RegisterType(&CancelPendingStakerTx{})
+}
+
+// RegisterWeightedOwnersTypes registers the weighted owners of the
+// secp256k1fx.
+func RegisterWeightedOwnersTypes(targetCodec linearcodec.Codec) error {
+	return secp256k1fx.RegisterWeightedTypes(targetCodec)
+}
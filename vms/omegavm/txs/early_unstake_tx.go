@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+)
+
+var (
+	_ UnsignedTx = (*EarlyUnstakeTx)(nil)
+
+	errEmptyStakerTxID = errors.New("staker tx ID cannot be empty")
+)
+
+// EarlyUnstakeTx removes a current primary network validator or delegator
+// before its end time. A share of its stake and of the reward it accrued so
+// far is forfeited to the undistributed reward pool. The current delegators of
+// a removed validator are removed along with it, without penalty.
+type EarlyUnstakeTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the tx that added the staker
+	TxID ids.ID `serialize:"true" json:"txID"`
+	// Proves that the issuer is the owner of the rewards of the staker: the
+	// validation rewards owner of a validator or the rewards owner of a
+	// delegator.
+	StakerAuth verify.Verifiable `serialize:"true" json:"stakerAuthorization"`
+}
+
+func (tx *EarlyUnstakeTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	case tx.TxID == ids.Empty:
+		return errEmptyStakerTxID
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	if err := tx.StakerAuth.Verify(); err != nil {
+		return err
+	}
+
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *EarlyUnstakeTx) Visit(visitor Visitor) error {
+	return visitor.EarlyUnstakeTx(tx)
+}
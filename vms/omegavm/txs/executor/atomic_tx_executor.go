@@ -72,6 +72,42 @@ func (*AtomicTxExecutor) AddPermissionlessDelegatorTx(*txs.AddPermissionlessDele
 	return ErrWrongTxType
 }
 
+func (*AtomicTxExecutor) BaseTx(*txs.BaseTx) error {
+	return ErrWrongTxType
+}
+
+func (*AtomicTxExecutor) TransferSubnetOwnershipTx(*txs.TransferSubnetOwnershipTx) error {
+	return ErrWrongTxType
+}
+
+func (*AtomicTxExecutor) RotateBLSKeyTx(*txs.RotateBLSKeyTx) error {
+	return ErrWrongTxType
+}
+
+func (*AtomicTxExecutor) SetAutoRestakeTx(*txs.SetAutoRestakeTx) error {
+	return ErrWrongTxType
+}
+
+func (*AtomicTxExecutor) EarlyUnstakeTx(*txs.EarlyUnstakeTx) error {
+	return ErrWrongTxType
+}
+
+func (*AtomicTxExecutor) RedelegateTx(*txs.RedelegateTx) error {
+	return ErrWrongTxType
+}
+
+func (*AtomicTxExecutor) UptimeAttestationTx(*txs.UptimeAttestationTx) error {
+	return ErrWrongTxType
+}
+
+func (*AtomicTxExecutor) SetSubnetFeeConfigTx(*txs.SetSubnetFeeConfigTx) error {
+	return ErrWrongTxType
+}
+
+func (*AtomicTxExecutor) CancelPendingStakerTx(*txs.CancelPendingStakerTx) error {
+	return ErrWrongTxType
+}
+
 func (e *AtomicTxExecutor) ImportTx(tx *txs.ImportTx) error {
 	return e.atomicTx(tx)
 }
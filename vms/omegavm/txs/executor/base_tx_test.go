@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestStandardTxExecutorBaseTx(t *testing.T) {
+	tests := []struct {
+		description string
+		baseTxTime  time.Time
+		expectedErr error
+	}{
+		{
+			description: "before activation",
+			baseTxTime:  mockable.MaxTime,
+			expectedErr: errBaseTxNotActivated,
+		},
+		{
+			description: "after activation",
+			baseTxTime:  time.Time{},
+			expectedErr: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, true /*=postBanff*/, false /*=postCortina*/)
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.BaseTxTime = test.baseTxTime
+
+			to := ids.GenerateTestShortID()
+			amount := defaultBalance / 2
+			tx, err := env.txBuilder.NewBaseTx(
+				amount,
+				to,
+				[]*secp256k1.PrivateKey{preFundedKeys[0]},
+				ids.ShortEmpty, // change addr
+			)
+			require.NoError(err)
+
+			onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor := StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+			}
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			for _, in := range tx.Unsigned.InputIDs().List() {
+				_, err := onAcceptState.GetUTXO(in)
+				require.ErrorIs(err, database.ErrNotFound)
+			}
+
+			txID := tx.ID()
+			var received uint64
+			for i, out := range tx.Unsigned.Outputs() {
+				utxoID := &dione.UTXOID{
+					TxID:        txID,
+					OutputIndex: uint32(i),
+				}
+				utxo, err := onAcceptState.GetUTXO(utxoID.InputID())
+				require.NoError(err)
+				require.Equal(out.Out, utxo.Out)
+
+				owners := out.Out.(*secp256k1fx.TransferOutput).OutputOwners
+				if owners.Addrs[0] == to {
+					received += out.Out.Amount()
+				}
+			}
+			require.Equal(amount, received)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+)
+
+// cancelPendingStaker removes the pending staker [staker], added by
+// [stakerTx], and refunds its stake. The pending delegators of a removed
+// validator are removed and refunded along with it.
+func (e *StandardTxExecutor) cancelPendingStaker(staker *state.Staker, stakerTx txs.PermissionlessStaker) error {
+	switch stakerTx.(type) {
+	case txs.ValidatorTx:
+		delegators, err := e.removePendingDelegators(staker.SubnetID, staker.NodeID)
+		if err != nil {
+			return err
+		}
+		for _, delegator := range delegators {
+			delegatorTx, err := e.getDelegatorTx(delegator.TxID)
+			if err != nil {
+				return err
+			}
+			if _, err := e.refundStake(delegator.TxID, delegatorTx, 0); err != nil {
+				return err
+			}
+		}
+
+		e.State.DeletePendingValidator(staker)
+		autoRestake, err := e.State.GetAutoRestake(staker.TxID)
+		if err != nil {
+			return fmt.Errorf("failed to get auto-restake flag of %s: %w", staker.TxID, err)
+		}
+		if autoRestake {
+			e.State.SetAutoRestake(staker.TxID, false)
+		}
+	case txs.DelegatorTx:
+		e.State.DeletePendingDelegator(staker)
+	default:
+		return ErrWrongTxType
+	}
+
+	_, err := e.refundStake(staker.TxID, stakerTx, 0)
+	return err
+}
@@ -0,0 +1,345 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+// cancelPendingStakerTestStakers are the stakers added by
+// [addCancelPendingStakerTestStakers].
+type cancelPendingStakerTestStakers struct {
+	vdrTx         *txs.Tx
+	vdrStaker     *state.Staker
+	delTx         *txs.Tx
+	delStaker     *state.Staker
+	stakeKey      *secp256k1.PrivateKey
+	vdrRewardsKey *secp256k1.PrivateKey
+	delRewardsKey *secp256k1.PrivateKey
+}
+
+// addCancelPendingStakerTestStakers adds a pending validator to the state of
+// [env], with a pending delegator. The stake of both is owned by another key
+// than their rewards.
+func addCancelPendingStakerTestStakers(t *testing.T, env *environment) *cancelPendingStakerTestStakers {
+	require := require.New(t)
+
+	stakers := &cancelPendingStakerTestStakers{
+		stakeKey:      preFundedKeys[0],
+		vdrRewardsKey: preFundedKeys[1],
+		delRewardsKey: preFundedKeys[2],
+	}
+
+	vdrStartTime := env.state.GetTimestamp().Add(time.Hour)
+	vdrEndTime := vdrStartTime.Add(2 * defaultMinValidatorStakingDuration)
+	vdrNodeID := ids.GenerateTestNodeID()
+
+	var err error
+	stakers.vdrTx, err = env.txBuilder.NewAddValidatorTx(
+		env.config.MinValidatorStake,
+		uint64(vdrStartTime.Unix()),
+		uint64(vdrEndTime.Unix()),
+		vdrNodeID,
+		stakers.vdrRewardsKey.PublicKey().Address(),
+		reward.PercentDenominator,
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		stakers.stakeKey.PublicKey().Address(), // stake and change addr
+	)
+	require.NoError(err)
+	stakers.vdrStaker, err = state.NewPendingStaker(stakers.vdrTx.ID(), stakers.vdrTx.Unsigned.(*txs.AddValidatorTx))
+	require.NoError(err)
+
+	stakers.delTx, err = env.txBuilder.NewAddDelegatorTx(
+		env.config.MinDelegatorStake,
+		uint64(vdrStartTime.Unix()),
+		uint64(vdrStartTime.Add(defaultMinDelegatorStakingDuration).Unix()),
+		vdrNodeID,
+		stakers.delRewardsKey.PublicKey().Address(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		stakers.stakeKey.PublicKey().Address(), // stake and change addr
+	)
+	require.NoError(err)
+	stakers.delStaker, err = state.NewPendingStaker(stakers.delTx.ID(), stakers.delTx.Unsigned.(*txs.AddDelegatorTx))
+	require.NoError(err)
+
+	env.state.PutPendingValidator(stakers.vdrStaker)
+	env.state.AddTx(stakers.vdrTx, status.Committed)
+	env.state.PutPendingDelegator(stakers.delStaker)
+	env.state.AddTx(stakers.delTx, status.Committed)
+	require.NoError(env.state.Commit())
+	return stakers
+}
+
+func TestStandardTxExecutorCancelPendingStakerTxActivation(t *testing.T) {
+	tests := []struct {
+		description             string
+		cancelPendingStakerTime time.Time
+		expectedErr             error
+	}{
+		{
+			description:             "before activation",
+			cancelPendingStakerTime: mockable.MaxTime,
+			expectedErr:             errCancelPendingStakerNotActivated,
+		},
+		{
+			description:             "after activation",
+			cancelPendingStakerTime: time.Time{},
+			expectedErr:             nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.CancelPendingStakerTime = test.cancelPendingStakerTime
+
+			stakers := addCancelPendingStakerTestStakers(t, env)
+
+			tx, err := env.txBuilder.NewCancelPendingStakerTx(
+				stakers.delTx.ID(),
+				[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.delRewardsKey},
+				ids.ShortEmpty,
+			)
+			require.NoError(err)
+
+			onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor := StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+			}
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestStandardTxExecutorCancelPendingStakerTxValidator(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	stakers := addCancelPendingStakerTestStakers(t, env)
+	env.state.SetAutoRestake(stakers.vdrTx.ID(), true)
+	require.NoError(env.state.Commit())
+
+	tx, err := env.txBuilder.NewCancelPendingStakerTx(
+		stakers.vdrTx.ID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.vdrRewardsKey},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	require.NoError(tx.Unsigned.Visit(&executor))
+
+	// The validator and its delegator are removed.
+	_, err = onAcceptState.GetPendingValidator(constants.PrimaryNetworkID, stakers.vdrStaker.NodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+	delegatorIterator, err := onAcceptState.GetPendingDelegatorIterator(constants.PrimaryNetworkID, stakers.vdrStaker.NodeID)
+	require.NoError(err)
+	require.False(delegatorIterator.Next())
+	delegatorIterator.Release()
+
+	autoRestake, err := onAcceptState.GetAutoRestake(stakers.vdrTx.ID())
+	require.NoError(err)
+	require.False(autoRestake)
+
+	// The stakes are refunded in full.
+	uVdrTx := stakers.vdrTx.Unsigned.(*txs.AddValidatorTx)
+	requireUTXOAmount(require, onAcceptState, stakers.vdrTx.ID(), len(uVdrTx.Outs), stakers.vdrStaker.Weight)
+	requireUTXOAmount(require, onAcceptState, stakers.vdrTx.ID(), len(uVdrTx.Outs)+len(uVdrTx.StakeOuts), 0)
+	uDelTx := stakers.delTx.Unsigned.(*txs.AddDelegatorTx)
+	requireUTXOAmount(require, onAcceptState, stakers.delTx.ID(), len(uDelTx.Outs), stakers.delStaker.Weight)
+	requireUTXOAmount(require, onAcceptState, stakers.delTx.ID(), len(uDelTx.Outs)+len(uDelTx.StakeOuts), 0)
+}
+
+func TestStandardTxExecutorCancelPendingStakerTxDelegator(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	stakers := addCancelPendingStakerTestStakers(t, env)
+
+	// The owner of the stake authorizes the cancellation, without the owner of
+	// the rewards.
+	tx, err := env.txBuilder.NewCancelPendingStakerTx(
+		stakers.delTx.ID(),
+		[]*secp256k1.PrivateKey{stakers.stakeKey},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	require.NoError(tx.Unsigned.Visit(&executor))
+
+	// Only the delegator is removed.
+	_, err = onAcceptState.GetPendingValidator(constants.PrimaryNetworkID, stakers.vdrStaker.NodeID)
+	require.NoError(err)
+	delegatorIterator, err := onAcceptState.GetPendingDelegatorIterator(constants.PrimaryNetworkID, stakers.vdrStaker.NodeID)
+	require.NoError(err)
+	require.False(delegatorIterator.Next())
+	delegatorIterator.Release()
+
+	uDelTx := stakers.delTx.Unsigned.(*txs.AddDelegatorTx)
+	requireUTXOAmount(require, onAcceptState, stakers.delTx.ID(), len(uDelTx.Outs), stakers.delStaker.Weight)
+	uVdrTx := stakers.vdrTx.Unsigned.(*txs.AddValidatorTx)
+	requireUTXOAmount(require, onAcceptState, stakers.vdrTx.ID(), len(uVdrTx.Outs), 0)
+}
+
+func TestStandardTxExecutorCancelPendingStakerTxCurrentStaker(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	stakers := addCancelPendingStakerTestStakers(t, env)
+
+	// The staking period of the validator started.
+	env.state.DeletePendingDelegator(stakers.delStaker)
+	env.state.DeletePendingValidator(stakers.vdrStaker)
+	vdrStaker, err := state.NewCurrentStaker(stakers.vdrTx.ID(), stakers.vdrTx.Unsigned.(*txs.AddValidatorTx), 0)
+	require.NoError(err)
+	env.state.PutCurrentValidator(vdrStaker)
+	env.state.SetTimestamp(vdrStaker.StartTime)
+	require.NoError(env.state.Commit())
+
+	tx, err := env.txBuilder.NewCancelPendingStakerTx(
+		stakers.vdrTx.ID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.vdrRewardsKey},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	err = tx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, errNotPendingStaker)
+}
+
+func TestStandardTxExecutorCancelPendingStakerTxSubnetValidator(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	stakers := addCancelPendingStakerTestStakers(t, env)
+
+	subnetVdrTx, err := env.txBuilder.NewAddSubnetValidatorTx(
+		1, // weight
+		uint64(stakers.vdrStaker.StartTime.Unix()),
+		uint64(stakers.vdrStaker.EndTime.Unix()),
+		stakers.vdrStaker.NodeID,
+		testSubnet1.ID(),
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+	subnetVdrStaker, err := state.NewPendingStaker(subnetVdrTx.ID(), subnetVdrTx.Unsigned.(*txs.AddSubnetValidatorTx))
+	require.NoError(err)
+	env.state.PutPendingValidator(subnetVdrStaker)
+	env.state.AddTx(subnetVdrTx, status.Committed)
+	require.NoError(env.state.Commit())
+
+	tx, err := env.txBuilder.NewCancelPendingStakerTx(
+		stakers.vdrTx.ID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.vdrRewardsKey},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	err = tx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, errValidatingSubnets)
+}
+
+func TestStandardTxExecutorCancelPendingStakerTxUnauthorized(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	stakers := addCancelPendingStakerTestStakers(t, env)
+
+	tx, err := env.txBuilder.NewCancelPendingStakerTx(
+		stakers.delTx.ID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.delRewardsKey},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	// Replace the staker authorization with a signature of the key that owns
+	// the rewards of the delegator, but not its stake.
+	utx := tx.Unsigned.(*txs.CancelPendingStakerTx)
+	utx.StakerAuth = &secp256k1fx.Input{SigIndices: []uint32{0}}
+	stx, err := txs.NewSigned(utx, txs.Codec, [][]*secp256k1.PrivateKey{
+		{preFundedKeys[0]},
+		{stakers.delRewardsKey},
+	})
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      stx,
+	}
+	err = stx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, errUnauthorizedStakerModification)
+}
@@ -0,0 +1,19 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"github.com/DioneProtocol/odysseygo/vms/components/fees"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+)
+
+// dynamicFee returns the static [fee] charged at the fee rate of [chainState].
+func dynamicFee(backend *Backend, chainState state.Chain, fee uint64) uint64 {
+	// The state isn't read when dynamic fees are disabled.
+	if !backend.Config.DynamicFeeConfig.IsEnabled() {
+		return fee
+	}
+	feeRate := backend.Config.GetFeeRate(chainState.GetFeeRate(), chainState.GetTimestamp())
+	return fees.CalculateFee(fee, feeRate)
+}
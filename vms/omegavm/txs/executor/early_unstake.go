@@ -0,0 +1,428 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+// unstakeEarly removes the current primary network staker [staker], added by
+// [stakerTx], before its end time.
+//
+// The stake is refunded and the reward accrued so far is paid out, less the
+// share [Config.EarlyUnstakePenalty] of both, which is added to
+// [UndistributedReward]. The current and pending delegators of a removed
+// validator are removed along with it, without penalty.
+//
+// Once the orion fee upgrade is activated, a removed validator also forfeits
+// the orion fee accrued by its node, which is added to [ForfeitedOrionFees].
+func (e *StandardTxExecutor) unstakeEarly(staker *state.Staker, stakerTx txs.PermissionlessStaker) error {
+	// Stop the accrual of the reward of the staker at the current chain time.
+	accumulatedMintRate, feePerWeightStored, err := e.syncRewardAccumulators()
+	if err != nil {
+		return err
+	}
+
+	penaltyRate := e.Config.EarlyUnstakePenalty
+	totalReward, totalMinted := uint64(0), uint64(0)
+	switch stakerTx := stakerTx.(type) {
+	case txs.ValidatorTx:
+		delegateeReward, err := e.State.GetDelegateeReward(constants.PrimaryNetworkID, staker.NodeID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch accrued delegatee rewards: %w", err)
+		}
+
+		delegators, err := e.removeDelegators(staker.NodeID)
+		if err != nil {
+			return err
+		}
+		for _, delegator := range delegators {
+			delegatorTx, err := e.getDelegatorTx(delegator.TxID)
+			if err != nil {
+				return err
+			}
+
+			accrued := accruedReward(delegator, accumulatedMintRate, feePerWeightStored)
+			totalReward += accrued
+			totalMinted += accruedMintReward(delegator, accumulatedMintRate)
+
+			delegatorReward, delegateeShare := splitDelegationReward(accrued, stakerTx.Shares())
+			delegateeReward += delegateeShare
+
+			if _, err := e.refundStake(delegator.TxID, delegatorTx, 0); err != nil {
+				return err
+			}
+			if _, err := e.payReward(delegator.TxID, delegatorTx, 0, delegatorReward, delegatorTx.RewardsOwner()); err != nil {
+				return err
+			}
+		}
+
+		pendingDelegators, err := e.removePendingDelegators(constants.PrimaryNetworkID, staker.NodeID)
+		if err != nil {
+			return err
+		}
+		for _, delegator := range pendingDelegators {
+			delegatorTx, err := e.getDelegatorTx(delegator.TxID)
+			if err != nil {
+				return err
+			}
+			if _, err := e.refundStake(delegator.TxID, delegatorTx, 0); err != nil {
+				return err
+			}
+		}
+
+		e.State.DeleteCurrentValidator(staker)
+		autoRestake, err := e.State.GetAutoRestake(staker.TxID)
+		if err != nil {
+			return fmt.Errorf("failed to get auto-restake flag of %s: %w", staker.TxID, err)
+		}
+		if autoRestake {
+			e.State.SetAutoRestake(staker.TxID, false)
+		}
+
+		accrued := accruedReward(staker, accumulatedMintRate, feePerWeightStored)
+		totalReward += accrued
+		totalMinted += accruedMintReward(staker, accumulatedMintRate)
+
+		forfeitedStake, err := e.refundStake(staker.TxID, stakerTx, penaltyRate)
+		if err != nil {
+			return err
+		}
+		forfeitedReward := forfeitedShare(accrued, penaltyRate)
+		e.UndistributedReward += forfeitedStake + forfeitedReward
+
+		// The orion fee accrued by the node is only known to the fee collector,
+		// so it can't be paid out with the reward. It is forfeited instead.
+		if e.Config.IsOrionFeeActivated(e.State.GetTimestamp()) {
+			e.ForfeitedOrionFees = append(e.ForfeitedOrionFees, staker.NodeID)
+		}
+
+		offset, err := e.payReward(staker.TxID, stakerTx, 0, accrued-forfeitedReward, stakerTx.ValidationRewardsOwner())
+		if err != nil {
+			return err
+		}
+		// The delegatee rewards aren't penalized, as they are earned by the
+		// delegators of the validator.
+		if _, err := e.payReward(staker.TxID, stakerTx, offset, delegateeReward, stakerTx.DelegationRewardsOwner()); err != nil {
+			return err
+		}
+	case txs.DelegatorTx:
+		vdrTx, err := e.getCurrentValidatorTx(staker.NodeID)
+		if err != nil {
+			return err
+		}
+
+		e.State.DeleteCurrentDelegator(staker)
+
+		accrued := accruedReward(staker, accumulatedMintRate, feePerWeightStored)
+		totalReward += accrued
+		totalMinted += accruedMintReward(staker, accumulatedMintRate)
+
+		delegatorReward, delegateeReward := splitDelegationReward(accrued, vdrTx.Shares())
+
+		forfeitedStake, err := e.refundStake(staker.TxID, stakerTx, penaltyRate)
+		if err != nil {
+			return err
+		}
+		forfeitedReward := forfeitedShare(delegatorReward, penaltyRate)
+		e.UndistributedReward += forfeitedStake + forfeitedReward
+
+		if _, err := e.payReward(staker.TxID, stakerTx, 0, delegatorReward-forfeitedReward, stakerTx.RewardsOwner()); err != nil {
+			return err
+		}
+
+		// The delegatee reward isn't penalized.
+		if err := e.deferDelegateeReward(staker.NodeID, delegateeReward); err != nil {
+			return err
+		}
+	default:
+		return ErrWrongTxType
+	}
+
+	// The accrued rewards are minted now, as for the stakers rewarded at the
+	// end of their staking period.
+	return e.mintReward(totalReward, totalMinted)
+}
+
+// syncRewardAccumulators brings the reward accumulators of the primary
+// network up to the current chain time and returns them.
+func (e *StandardTxExecutor) syncRewardAccumulators() (*big.Int, *big.Int, error) {
+	changes := &stateChanges{}
+	if err := changes.updateAccumulatedMintRate(e.Backend, e.State, e.State.GetTimestamp()); err != nil {
+		return nil, nil, err
+	}
+	if err := changes.updateFeePerWeight(e.Backend, e.State); err != nil {
+		return nil, nil, err
+	}
+	changes.Apply(e.State)
+
+	accumulatedMintRate, err := e.State.GetStakerAccumulatedMintRate()
+	if err != nil {
+		return nil, nil, err
+	}
+	feePerWeightStored, err := e.State.GetFeePerWeightStored()
+	if err != nil {
+		return nil, nil, err
+	}
+	return accumulatedMintRate, feePerWeightStored, nil
+}
+
+// deferDelegateeReward adds [amount] to the delegatee reward of the primary
+// network validator [nodeID]. It is paid out at the end of the staking period
+// of the validator, with all its accrued delegatee rewards.
+func (e *StandardTxExecutor) deferDelegateeReward(nodeID ids.NodeID, amount uint64) error {
+	if amount == 0 {
+		return nil
+	}
+
+	previousDelegateeReward, err := e.State.GetDelegateeReward(constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		return fmt.Errorf("failed to get delegatee reward: %w", err)
+	}
+	err = e.State.SetDelegateeReward(
+		constants.PrimaryNetworkID,
+		nodeID,
+		previousDelegateeReward+amount,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update delegatee reward: %w", err)
+	}
+	return nil
+}
+
+// mintReward adds the reward [amount] paid out before the end of a staking
+// period to the supply of the primary network, [minted] of which is the minted
+// part of the reward.
+func (e *StandardTxExecutor) mintReward(amount, minted uint64) error {
+	currentSupply, err := e.State.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return err
+	}
+	newSupply, err := math.Add64(currentSupply, amount)
+	if err != nil {
+		return err
+	}
+	e.State.SetCurrentSupply(constants.PrimaryNetworkID, newSupply)
+	e.State.AddMintedSupply(minted)
+	return nil
+}
+
+// removeDelegators removes the current primary network delegators of [nodeID]
+// and returns them.
+func (e *StandardTxExecutor) removeDelegators(nodeID ids.NodeID) ([]*state.Staker, error) {
+	delegatorIterator, err := e.State.GetCurrentDelegatorIterator(constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delegators of %s: %w", nodeID, err)
+	}
+	delegators := []*state.Staker{}
+	for delegatorIterator.Next() {
+		delegators = append(delegators, delegatorIterator.Value())
+	}
+	delegatorIterator.Release()
+
+	for _, delegator := range delegators {
+		e.State.DeleteCurrentDelegator(delegator)
+	}
+	return delegators, nil
+}
+
+// removePendingDelegators removes the pending delegators of [nodeID] on
+// [subnetID] and returns them.
+func (e *StandardTxExecutor) removePendingDelegators(subnetID ids.ID, nodeID ids.NodeID) ([]*state.Staker, error) {
+	delegatorIterator, err := e.State.GetPendingDelegatorIterator(subnetID, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending delegators of %s: %w", nodeID, err)
+	}
+	delegators := []*state.Staker{}
+	for delegatorIterator.Next() {
+		delegators = append(delegators, delegatorIterator.Value())
+	}
+	delegatorIterator.Release()
+
+	for _, delegator := range delegators {
+		e.State.DeletePendingDelegator(delegator)
+	}
+	return delegators, nil
+}
+
+func (e *StandardTxExecutor) getDelegatorTx(txID ids.ID) (txs.DelegatorTx, error) {
+	delegatorTx, _, err := e.State.GetTx(txID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delegator tx %s: %w", txID, err)
+	}
+	uDelegatorTx, ok := delegatorTx.Unsigned.(txs.DelegatorTx)
+	if !ok {
+		return nil, ErrWrongTxType
+	}
+	return uDelegatorTx, nil
+}
+
+// getCurrentValidatorTx returns the tx that added the current primary network
+// validator [nodeID].
+func (e *StandardTxExecutor) getCurrentValidatorTx(nodeID ids.NodeID) (txs.ValidatorTx, error) {
+	vdrStaker, err := e.State.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator %s: %w", nodeID, err)
+	}
+	vdrTx, _, err := e.State.GetTx(vdrStaker.TxID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator tx %s: %w", vdrStaker.TxID, err)
+	}
+	uVdrTx, ok := vdrTx.Unsigned.(txs.ValidatorTx)
+	if !ok {
+		return nil, ErrWrongTxType
+	}
+	return uVdrTx, nil
+}
+
+// refundStake refunds the stake of the staker added by [stakerTx], with the
+// same UTXO IDs as if it was removed by a RewardValidatorTx. The share
+// [penaltyRate] of every stake output is forfeited. Returns the forfeited
+// amount.
+func (e *StandardTxExecutor) refundStake(
+	stakerTxID ids.ID,
+	stakerTx txs.PermissionlessStaker,
+	penaltyRate uint64,
+) (uint64, error) {
+	outputs := stakerTx.Outputs()
+	forfeited := uint64(0)
+	for i, stake := range stakerTx.Stake() {
+		out := stake.Output()
+		if penaltyRate > 0 {
+			amount := out.Amount()
+			penalty := forfeitedShare(amount, penaltyRate)
+			forfeited += penalty
+			if penalty == amount {
+				continue
+			}
+
+			var err error
+			out, err = withAmount(out, amount-penalty)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		e.State.AddUTXO(&dione.UTXO{
+			UTXOID: dione.UTXOID{
+				TxID:        stakerTxID,
+				OutputIndex: uint32(len(outputs) + i),
+			},
+			Asset: stake.Asset,
+			Out:   out,
+		})
+	}
+	return forfeited, nil
+}
+
+// payReward pays [amount] to [owner] as a reward of the staker added by
+// [stakerTx]. The reward UTXOs of a staker follow its refunded stake, and
+// [offset] is the number of reward UTXOs already paid to it. Returns the
+// number of UTXOs created.
+func (e *StandardTxExecutor) payReward(
+	stakerTxID ids.ID,
+	stakerTx txs.PermissionlessStaker,
+	offset int,
+	amount uint64,
+	owner fx.Owner,
+) (int, error) {
+	if amount == 0 {
+		return 0, nil
+	}
+
+	outIntf, err := e.Fx.CreateOutput(amount, owner)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create output: %w", err)
+	}
+	out, ok := outIntf.(verify.State)
+	if !ok {
+		return 0, ErrInvalidState
+	}
+
+	stake := stakerTx.Stake()
+	utxo := &dione.UTXO{
+		UTXOID: dione.UTXOID{
+			TxID:        stakerTxID,
+			OutputIndex: uint32(len(stakerTx.Outputs()) + len(stake) + offset),
+		},
+		// Invariant: The staked asset must be equal to the reward asset.
+		Asset: stake[0].Asset,
+		Out:   out,
+	}
+	e.State.AddUTXO(utxo)
+	e.State.AddRewardUTXO(stakerTxID, utxo)
+	return 1, nil
+}
+
+// accruedReward returns the reward accrued by the primary network staker
+// [staker] up to the accumulators [accumulatedMintRate] and
+// [feePerWeightStored].
+func accruedReward(staker *state.Staker, accumulatedMintRate, feePerWeightStored *big.Int) uint64 {
+	accrued := accruedMintReward(staker, accumulatedMintRate)
+	if staker.FeePerWeightPaid != nil {
+		accrued += reward.CalculateFeeReward(feePerWeightStored, staker.Weight, staker.FeePerWeightPaid)
+	}
+	return accrued
+}
+
+// accruedMintReward returns the minted part of the reward accrued by the
+// primary network staker [staker] up to [accumulatedMintRate].
+func accruedMintReward(staker *state.Staker, accumulatedMintRate *big.Int) uint64 {
+	if staker.MintRate == nil {
+		return 0
+	}
+	return reward.CalculateMintReward(staker.Weight, staker.MintRate, accumulatedMintRate)
+}
+
+// splitDelegationReward splits the reward [amount] of a delegator between the
+// delegator and its validator, which takes [shares] of it.
+func splitDelegationReward(amount uint64, shares uint32) (uint64, uint64) {
+	delegatorShares := reward.PercentDenominator - uint64(shares)             // shares <= reward.PercentDenominator so no underflow
+	delegatorReward := delegatorShares * (amount / reward.PercentDenominator) // delegatorShares <= reward.PercentDenominator so no overflow
+	// Delay rounding as long as possible for small numbers
+	if optimisticReward, err := math.Mul64(delegatorShares, amount); err == nil {
+		delegatorReward = optimisticReward / reward.PercentDenominator
+	}
+	return delegatorReward, amount - delegatorReward // delegatorReward <= amount so no underflow
+}
+
+// forfeitedShare returns the share [rate] of [amount], rounded down.
+func forfeitedShare(amount, rate uint64) uint64 {
+	// rate <= reward.PercentDenominator so none of the products overflow
+	return amount/reward.PercentDenominator*rate + amount%reward.PercentDenominator*rate/reward.PercentDenominator
+}
+
+// withAmount returns a copy of the stake output [out] holding [amount].
+func withAmount(out dione.TransferableOut, amount uint64) (dione.TransferableOut, error) {
+	switch out := out.(type) {
+	case *secp256k1fx.TransferOutput:
+		newOut := *out
+		newOut.Amt = amount
+		return &newOut, nil
+	case *stakeable.LockOut:
+		innerOut, err := withAmount(out.TransferableOut, amount)
+		if err != nil {
+			return nil, err
+		}
+		return &stakeable.LockOut{
+			Locktime:        out.Locktime,
+			TransferableOut: innerOut,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", errUnexpectedStakeOutput, out)
+	}
+}
@@ -0,0 +1,511 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+const earlyUnstakeTestPenalty = reward.PercentDenominator / 10
+
+// earlyUnstakeTestStakers are the stakers added by [addEarlyUnstakeTestStakers].
+type earlyUnstakeTestStakers struct {
+	vdrTx             *txs.Tx
+	vdrStaker         *state.Staker
+	delTx             *txs.Tx
+	delStaker         *state.Staker
+	pendingDelTx      *txs.Tx
+	pendingDelStaker  *state.Staker
+	vdrRewardsKey     *secp256k1.PrivateKey
+	delRewardsKey     *secp256k1.PrivateKey
+	unstakeChainTime  time.Time
+	initialSupply     uint64
+	delegationsShares uint32
+}
+
+// addEarlyUnstakeTestStakers adds a current validator to the state of [env],
+// with a current and a pending delegator. The fee reward accumulator is set
+// so that every current staker accrued a reward equal to its weight.
+func addEarlyUnstakeTestStakers(t *testing.T, env *environment) *earlyUnstakeTestStakers {
+	require := require.New(t)
+
+	stakers := &earlyUnstakeTestStakers{
+		vdrRewardsKey:     preFundedKeys[1],
+		delRewardsKey:     preFundedKeys[2],
+		delegationsShares: reward.PercentDenominator / 4,
+	}
+
+	vdrStartTime := defaultValidateStartTime.Add(time.Second)
+	vdrEndTime := vdrStartTime.Add(2 * defaultMinValidatorStakingDuration)
+	vdrNodeID := ids.GenerateTestNodeID()
+
+	var err error
+	stakers.vdrTx, err = env.txBuilder.NewAddValidatorTx(
+		env.config.MinValidatorStake,
+		uint64(vdrStartTime.Unix()),
+		uint64(vdrEndTime.Unix()),
+		vdrNodeID,
+		stakers.vdrRewardsKey.PublicKey().Address(),
+		stakers.delegationsShares,
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+	stakers.vdrStaker, err = state.NewCurrentStaker(stakers.vdrTx.ID(), stakers.vdrTx.Unsigned.(*txs.AddValidatorTx), 0)
+	require.NoError(err)
+
+	stakers.delTx, err = env.txBuilder.NewAddDelegatorTx(
+		env.config.MinDelegatorStake,
+		uint64(vdrStartTime.Unix()),
+		uint64(vdrStartTime.Add(defaultMinDelegatorStakingDuration).Unix()),
+		vdrNodeID,
+		stakers.delRewardsKey.PublicKey().Address(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+	stakers.delStaker, err = state.NewCurrentStaker(stakers.delTx.ID(), stakers.delTx.Unsigned.(*txs.AddDelegatorTx), 0)
+	require.NoError(err)
+
+	stakers.pendingDelTx, err = env.txBuilder.NewAddDelegatorTx(
+		env.config.MinDelegatorStake,
+		uint64(vdrStartTime.Add(defaultMinDelegatorStakingDuration).Unix()),
+		uint64(vdrEndTime.Unix()),
+		vdrNodeID,
+		stakers.delRewardsKey.PublicKey().Address(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+	stakers.pendingDelStaker, err = state.NewPendingStaker(stakers.pendingDelTx.ID(), stakers.pendingDelTx.Unsigned.(*txs.AddDelegatorTx))
+	require.NoError(err)
+
+	env.state.PutCurrentValidator(stakers.vdrStaker)
+	env.state.AddTx(stakers.vdrTx, status.Committed)
+	env.state.PutCurrentDelegator(stakers.delStaker)
+	env.state.AddTx(stakers.delTx, status.Committed)
+	env.state.PutPendingDelegator(stakers.pendingDelStaker)
+	env.state.AddTx(stakers.pendingDelTx, status.Committed)
+
+	// Every current staker accrued a reward equal to its weight.
+	env.state.SetFeePerWeightStored(new(big.Int).Lsh(big.NewInt(1), reward.BitShift))
+
+	stakers.unstakeChainTime = vdrStartTime.Add(time.Hour)
+	env.state.SetTimestamp(stakers.unstakeChainTime)
+	env.state.SetHeight(1)
+	require.NoError(env.state.Commit())
+
+	stakers.initialSupply, err = env.state.GetCurrentSupply(constants.PrimaryNetworkID)
+	require.NoError(err)
+	return stakers
+}
+
+// requireUTXOAmount requires [chainState] to hold [amount] in the UTXO
+// [outputIndex] of [txID], which doesn't exist if [amount] is 0.
+func requireUTXOAmount(require *require.Assertions, chainState state.Chain, txID ids.ID, outputIndex int, amount uint64) {
+	utxoID := dione.UTXOID{
+		TxID:        txID,
+		OutputIndex: uint32(outputIndex),
+	}
+	utxo, err := chainState.GetUTXO(utxoID.InputID())
+	if amount == 0 {
+		require.ErrorIs(err, database.ErrNotFound)
+		return
+	}
+	require.NoError(err)
+	require.Equal(amount, utxo.Out.(*secp256k1fx.TransferOutput).Amount())
+}
+
+func TestStandardTxExecutorEarlyUnstakeTxActivation(t *testing.T) {
+	tests := []struct {
+		description      string
+		earlyUnstakeTime time.Time
+		expectedErr      error
+	}{
+		{
+			description:      "before activation",
+			earlyUnstakeTime: mockable.MaxTime,
+			expectedErr:      errEarlyUnstakeNotActivated,
+		},
+		{
+			description:      "after activation",
+			earlyUnstakeTime: time.Time{},
+			expectedErr:      nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.EarlyUnstakeTime = test.earlyUnstakeTime
+
+			stakers := addEarlyUnstakeTestStakers(t, env)
+
+			tx, err := env.txBuilder.NewEarlyUnstakeTx(
+				stakers.delTx.ID(),
+				[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.delRewardsKey},
+				ids.ShortEmpty,
+			)
+			require.NoError(err)
+
+			onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor := StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+			}
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestStandardTxExecutorEarlyUnstakeTxValidator(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+	env.config.EarlyUnstakePenalty = earlyUnstakeTestPenalty
+
+	stakers := addEarlyUnstakeTestStakers(t, env)
+	env.state.SetAutoRestake(stakers.vdrTx.ID(), true)
+	require.NoError(env.state.Commit())
+
+	tx, err := env.txBuilder.NewEarlyUnstakeTx(
+		stakers.vdrTx.ID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.vdrRewardsKey},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	require.NoError(tx.Unsigned.Visit(&executor))
+
+	// The validator and its delegators are removed.
+	_, err = onAcceptState.GetCurrentValidator(constants.PrimaryNetworkID, stakers.vdrStaker.NodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+	delegatorIterator, err := onAcceptState.GetCurrentDelegatorIterator(constants.PrimaryNetworkID, stakers.vdrStaker.NodeID)
+	require.NoError(err)
+	require.False(delegatorIterator.Next())
+	delegatorIterator.Release()
+	delegatorIterator, err = onAcceptState.GetPendingDelegatorIterator(constants.PrimaryNetworkID, stakers.vdrStaker.NodeID)
+	require.NoError(err)
+	require.False(delegatorIterator.Next())
+	delegatorIterator.Release()
+
+	autoRestake, err := onAcceptState.GetAutoRestake(stakers.vdrTx.ID())
+	require.NoError(err)
+	require.False(autoRestake)
+
+	vdrWeight := stakers.vdrStaker.Weight
+	delWeight := stakers.delStaker.Weight
+	delegateeReward := delWeight / 4
+
+	// The validator forfeits 10% of its stake and of its reward. Its
+	// delegatee reward isn't penalized.
+	uVdrTx := stakers.vdrTx.Unsigned.(*txs.AddValidatorTx)
+	vdrRewardIndex := len(uVdrTx.Outs) + len(uVdrTx.StakeOuts)
+	requireUTXOAmount(require, onAcceptState, stakers.vdrTx.ID(), len(uVdrTx.Outs), vdrWeight-vdrWeight/10)
+	requireUTXOAmount(require, onAcceptState, stakers.vdrTx.ID(), vdrRewardIndex, vdrWeight-vdrWeight/10)
+	requireUTXOAmount(require, onAcceptState, stakers.vdrTx.ID(), vdrRewardIndex+1, delegateeReward)
+
+	// The delegators are refunded without penalty.
+	uDelTx := stakers.delTx.Unsigned.(*txs.AddDelegatorTx)
+	requireUTXOAmount(require, onAcceptState, stakers.delTx.ID(), len(uDelTx.Outs), delWeight)
+	requireUTXOAmount(require, onAcceptState, stakers.delTx.ID(), len(uDelTx.Outs)+len(uDelTx.StakeOuts), delWeight-delegateeReward)
+	uPendingDelTx := stakers.pendingDelTx.Unsigned.(*txs.AddDelegatorTx)
+	requireUTXOAmount(require, onAcceptState, stakers.pendingDelTx.ID(), len(uPendingDelTx.Outs), stakers.pendingDelStaker.Weight)
+	requireUTXOAmount(require, onAcceptState, stakers.pendingDelTx.ID(), len(uPendingDelTx.Outs)+len(uPendingDelTx.StakeOuts), 0)
+
+	require.Equal(2*(vdrWeight/10), executor.UndistributedReward)
+
+	// The orion fee accrued by the node is forfeited.
+	require.Equal([]ids.NodeID{stakers.vdrStaker.NodeID}, executor.ForfeitedOrionFees)
+
+	supply, err := onAcceptState.GetCurrentSupply(constants.PrimaryNetworkID)
+	require.NoError(err)
+	require.Equal(stakers.initialSupply+vdrWeight+delWeight, supply)
+
+	// The orion fee isn't forfeited before the orion fee upgrade.
+	env.config.OrionFeeTime = mockable.MaxTime
+	onAcceptState, err = state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+	executor = StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	require.NoError(tx.Unsigned.Visit(&executor))
+	require.Empty(executor.ForfeitedOrionFees)
+	env.config.OrionFeeTime = time.Time{}
+
+	// The accrued rewards are fee rewards, none of which is minted.
+	minted, err := onAcceptState.GetMintedSupply()
+	require.NoError(err)
+	require.Zero(minted)
+}
+
+func TestStandardTxExecutorEarlyUnstakeTxDelegator(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+	env.config.EarlyUnstakePenalty = earlyUnstakeTestPenalty
+
+	stakers := addEarlyUnstakeTestStakers(t, env)
+
+	tx, err := env.txBuilder.NewEarlyUnstakeTx(
+		stakers.delTx.ID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.delRewardsKey},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	require.NoError(tx.Unsigned.Visit(&executor))
+
+	// Only the delegator is removed.
+	_, err = onAcceptState.GetCurrentValidator(constants.PrimaryNetworkID, stakers.vdrStaker.NodeID)
+	require.NoError(err)
+	delegatorIterator, err := onAcceptState.GetCurrentDelegatorIterator(constants.PrimaryNetworkID, stakers.vdrStaker.NodeID)
+	require.NoError(err)
+	require.False(delegatorIterator.Next())
+	delegatorIterator.Release()
+
+	// The delegator forfeits 10% of its stake and of its share of the reward.
+	delWeight := stakers.delStaker.Weight
+	delegateeReward := delWeight / 4
+	delegatorReward := delWeight - delegateeReward
+	uDelTx := stakers.delTx.Unsigned.(*txs.AddDelegatorTx)
+	requireUTXOAmount(require, onAcceptState, stakers.delTx.ID(), len(uDelTx.Outs), delWeight-delWeight/10)
+	requireUTXOAmount(require, onAcceptState, stakers.delTx.ID(), len(uDelTx.Outs)+len(uDelTx.StakeOuts), delegatorReward-delegatorReward/10)
+	require.Equal(delWeight/10+delegatorReward/10, executor.UndistributedReward)
+
+	// The share of the validator is deferred to its end time.
+	gotDelegateeReward, err := onAcceptState.GetDelegateeReward(constants.PrimaryNetworkID, stakers.vdrStaker.NodeID)
+	require.NoError(err)
+	require.Equal(delegateeReward, gotDelegateeReward)
+
+	require.NoError(onAcceptState.Apply(env.state))
+	env.state.SetTimestamp(stakers.vdrStaker.EndTime)
+	require.NoError(env.state.Commit())
+
+	// The RewardValidatorTx of the validator pays out the deferred delegatee
+	// reward.
+	rewardTx, err := env.txBuilder.NewRewardValidatorTx(stakers.vdrTx.ID())
+	require.NoError(err)
+
+	onCommitState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+	onAbortState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	proposalExecutor := ProposalTxExecutor{
+		OnCommitState: onCommitState,
+		OnAbortState:  onAbortState,
+		Backend:       &env.backend,
+		Tx:            rewardTx,
+	}
+	require.NoError(rewardTx.Unsigned.Visit(&proposalExecutor))
+
+	uVdrTx := stakers.vdrTx.Unsigned.(*txs.AddValidatorTx)
+	requireUTXOAmount(require, onCommitState, stakers.vdrTx.ID(), len(uVdrTx.Outs)+len(uVdrTx.StakeOuts), delegateeReward)
+}
+
+func TestStandardTxExecutorEarlyUnstakeTxRewardValidatorTx(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	stakers := addEarlyUnstakeTestStakers(t, env)
+	keys := []*secp256k1.PrivateKey{preFundedKeys[0], stakers.vdrRewardsKey}
+
+	// The validator can't leave early once its RewardValidatorTx is due.
+	env.state.SetTimestamp(stakers.vdrStaker.EndTime)
+	require.NoError(env.state.Commit())
+
+	tx, err := env.txBuilder.NewEarlyUnstakeTx(stakers.vdrTx.ID(), keys, ids.ShortEmpty)
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	err = tx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, errStakingPeriodOver)
+
+	// A validator that left early is no longer rewarded.
+	env.state.SetTimestamp(stakers.unstakeChainTime)
+	require.NoError(env.state.Commit())
+
+	tx, err = env.txBuilder.NewEarlyUnstakeTx(stakers.vdrTx.ID(), keys, ids.ShortEmpty)
+	require.NoError(err)
+
+	onAcceptState, err = state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor = StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	require.NoError(tx.Unsigned.Visit(&executor))
+	require.NoError(onAcceptState.Apply(env.state))
+	env.state.SetTimestamp(stakers.vdrStaker.EndTime)
+	require.NoError(env.state.Commit())
+
+	rewardTx, err := env.txBuilder.NewRewardValidatorTx(stakers.vdrTx.ID())
+	require.NoError(err)
+
+	onCommitState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+	onAbortState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	proposalExecutor := ProposalTxExecutor{
+		OnCommitState: onCommitState,
+		OnAbortState:  onAbortState,
+		Backend:       &env.backend,
+		Tx:            rewardTx,
+	}
+	err = rewardTx.Unsigned.Visit(&proposalExecutor)
+	require.ErrorIs(err, ErrRemoveWrongStaker)
+
+	// The validator can't leave twice.
+	onAcceptState, err = state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor = StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	err = tx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, errNotCurrentStaker)
+}
+
+func TestStandardTxExecutorEarlyUnstakeTxSubnetValidator(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	stakers := addEarlyUnstakeTestStakers(t, env)
+
+	subnetVdrTx, err := env.txBuilder.NewAddSubnetValidatorTx(
+		1, // weight
+		uint64(stakers.vdrStaker.StartTime.Add(time.Hour).Unix()),
+		uint64(stakers.vdrStaker.EndTime.Unix()),
+		stakers.vdrStaker.NodeID,
+		testSubnet1.ID(),
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+	subnetVdrStaker, err := state.NewCurrentStaker(subnetVdrTx.ID(), subnetVdrTx.Unsigned.(*txs.AddSubnetValidatorTx), 0)
+	require.NoError(err)
+	env.state.PutCurrentValidator(subnetVdrStaker)
+	env.state.AddTx(subnetVdrTx, status.Committed)
+	require.NoError(env.state.Commit())
+
+	tx, err := env.txBuilder.NewEarlyUnstakeTx(
+		stakers.vdrTx.ID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.vdrRewardsKey},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	err = tx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, errValidatingSubnets)
+}
+
+func TestStandardTxExecutorEarlyUnstakeTxUnauthorized(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	stakers := addEarlyUnstakeTestStakers(t, env)
+
+	tx, err := env.txBuilder.NewEarlyUnstakeTx(
+		stakers.delTx.ID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.delRewardsKey},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	// Replace the staker authorization with a signature of a key that doesn't
+	// own the rewards of the delegator.
+	utx := tx.Unsigned.(*txs.EarlyUnstakeTx)
+	utx.StakerAuth = &secp256k1fx.Input{SigIndices: []uint32{0}}
+	stx, err := txs.NewSigned(utx, txs.Codec, [][]*secp256k1.PrivateKey{
+		{preFundedKeys[0]},
+		{preFundedKeys[0]},
+	})
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      stx,
+	}
+	err = stx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, errUnauthorizedStakerModification)
+}
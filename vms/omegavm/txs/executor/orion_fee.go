@@ -0,0 +1,25 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+)
+
+// OrionFeeNodeID returns the node whose accrued orion fee is paid out with the
+// reward of the staker added by [stakerTx], if any.
+//
+// Orion fees are accrued per node, so they are paid out to the primary network
+// validator of the node, regardless of the tx that added it. Delegators and
+// subnet validators are never paid orion fees. The fee accrued by a node while
+// they stake is paid out to its primary network validator instead.
+func OrionFeeNodeID(stakerTx txs.UnsignedTx) (ids.NodeID, bool) {
+	validatorTx, ok := stakerTx.(txs.ValidatorTx)
+	if !ok || validatorTx.SubnetID() != constants.PrimaryNetworkID {
+		return ids.EmptyNodeID, false
+	}
+	return validatorTx.NodeID(), true
+}
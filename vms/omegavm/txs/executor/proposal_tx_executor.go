@@ -15,7 +15,9 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 )
 
@@ -41,6 +43,8 @@ var (
 	ErrInvalidID                     = errors.New("invalid ID")
 	ErrProposedAddStakerTxAfterBanff = errors.New("staker transaction proposed after Banff")
 	ErrAdvanceTimeTxIssuedAfterBanff = errors.New("AdvanceTimeTx issued after Banff")
+	ErrUnexpectedOrionFee            = errors.New("orion fee paid to a staker that can't receive it")
+	ErrOrionFeeNotActivated          = errors.New("orion fee paid before the orion fee upgrade")
 )
 
 type ProposalTxExecutor struct {
@@ -66,6 +70,20 @@ type ProposalTxExecutor struct {
 
 	// [UndistributedReward] is reward that the delegator/validator should have received
 	UndistributedReward uint64
+
+	// [MintReward], [FeeReward] and [OrionFee] are the components of the
+	// reward of the staker removed by a RewardValidatorTx.
+	MintReward uint64
+	FeeReward  uint64
+	OrionFee   uint64
+
+	// [DelegationShares] and [DelegationFee] are the shares of the validator
+	// and the part of the reward paid to it when a delegator is removed.
+	// [DelegateeReward] is the reward accrued from delegations that is paid
+	// out when a validator is removed.
+	DelegationShares uint32
+	DelegationFee    uint64
+	DelegateeReward  uint64
 }
 
 func (*ProposalTxExecutor) CreateChainTx(*txs.CreateChainTx) error {
@@ -100,6 +118,42 @@ func (*ProposalTxExecutor) AddPermissionlessDelegatorTx(*txs.AddPermissionlessDe
 	return ErrWrongTxType
 }
 
+func (*ProposalTxExecutor) BaseTx(*txs.BaseTx) error {
+	return ErrWrongTxType
+}
+
+func (*ProposalTxExecutor) TransferSubnetOwnershipTx(*txs.TransferSubnetOwnershipTx) error {
+	return ErrWrongTxType
+}
+
+func (*ProposalTxExecutor) RotateBLSKeyTx(*txs.RotateBLSKeyTx) error {
+	return ErrWrongTxType
+}
+
+func (*ProposalTxExecutor) SetAutoRestakeTx(*txs.SetAutoRestakeTx) error {
+	return ErrWrongTxType
+}
+
+func (*ProposalTxExecutor) EarlyUnstakeTx(*txs.EarlyUnstakeTx) error {
+	return ErrWrongTxType
+}
+
+func (*ProposalTxExecutor) RedelegateTx(*txs.RedelegateTx) error {
+	return ErrWrongTxType
+}
+
+func (*ProposalTxExecutor) UptimeAttestationTx(*txs.UptimeAttestationTx) error {
+	return ErrWrongTxType
+}
+
+func (*ProposalTxExecutor) SetSubnetFeeConfigTx(*txs.SetSubnetFeeConfigTx) error {
+	return ErrWrongTxType
+}
+
+func (*ProposalTxExecutor) CancelPendingStakerTx(*txs.CancelPendingStakerTx) error {
+	return ErrWrongTxType
+}
+
 func (e *ProposalTxExecutor) AddValidatorTx(tx *txs.AddValidatorTx) error {
 	// AddValidatorTx is a proposal transaction until the Banff fork
 	// activation. Following the activation, AddValidatorTxs must be issued into
@@ -326,6 +380,16 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 	stakerToRemove := currentStakerIterator.Value()
 	currentStakerIterator.Release()
 
+	if err := e.splitReward(stakerToRemove); err != nil {
+		return err
+	}
+
+	// Orion fees are only paid out once the orion fee upgrade is activated.
+	// Before that, they remain in the orion pool of the node.
+	if tx.OrionFee != 0 && !e.Config.IsOrionFeeActivated(e.OnCommitState.GetTimestamp()) {
+		return fmt.Errorf("%w: %s", ErrOrionFeeNotActivated, tx.TxID)
+	}
+	e.OrionFee = tx.OrionFee
 	stakerToRemove.PotentialReward += tx.OrionFee
 
 	if stakerToRemove.TxID != tx.TxID {
@@ -364,6 +428,20 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 		return fmt.Errorf("failed to get next removed staker tx: %w", err)
 	}
 
+	if _, ok := OrionFeeNodeID(stakerTx.Unsigned); !ok && tx.OrionFee != 0 {
+		return fmt.Errorf("%w: %s", ErrUnexpectedOrionFee, tx.TxID)
+	}
+
+	// Stakers of elastic subnets are also rewarded their share of the fees
+	// collected by the subnet, unless the reward is aborted.
+	feeReward, err := subnetFeeReward(e.OnCommitState, stakerToRemove)
+	if err != nil {
+		return err
+	}
+	if err := forfeitSubnetFeeReward(e.OnAbortState, stakerToRemove.SubnetID, feeReward); err != nil {
+		return err
+	}
+
 	switch uStakerTx := stakerTx.Unsigned.(type) {
 	case txs.ValidatorTx:
 		e.OnCommitState.DeleteCurrentValidator(stakerToRemove)
@@ -374,7 +452,13 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 		// Invariant: The staked asset must be equal to the reward asset.
 		stakeAsset := stake[0].Asset
 
-		// Refund the stake here
+		restake, err := e.shouldRestake(stakerToRemove)
+		if err != nil {
+			return err
+		}
+
+		// Refund the stake here. If the validator is restaked, its stake
+		// remains locked for its next staking period.
 		for i, out := range stake {
 			utxo := &dione.UTXO{
 				UTXOID: dione.UTXOID{
@@ -384,16 +468,30 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 				Asset: out.Asset,
 				Out:   out.Output(),
 			}
-			e.OnCommitState.AddUTXO(utxo)
+			if !restake {
+				e.OnCommitState.AddUTXO(utxo)
+			}
 			e.OnAbortState.AddUTXO(utxo)
 		}
 
+		validationReward, err := math.Add64(stakerToRemove.PotentialReward, feeReward)
+		if err != nil {
+			return err
+		}
+		if restake {
+			restakedReward, err := e.restakeValidator(stakerToRemove, uStakerTx, stakeAsset)
+			if err != nil {
+				return err
+			}
+			validationReward -= restakedReward
+		}
+
 		offset := 0
 
 		// Provide the reward here
-		if stakerToRemove.PotentialReward > 0 {
+		if validationReward > 0 {
 			validationRewardsOwner := uStakerTx.ValidationRewardsOwner()
-			outIntf, err := e.Fx.CreateOutput(stakerToRemove.PotentialReward, validationRewardsOwner)
+			outIntf, err := e.Fx.CreateOutput(validationReward, validationRewardsOwner)
 			if err != nil {
 				return fmt.Errorf("failed to create output: %w", err)
 			}
@@ -425,6 +523,7 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 		if err != nil {
 			return fmt.Errorf("failed to fetch accrued delegatee rewards: %w", err)
 		}
+		e.DelegateeReward = delegateeReward
 
 		if delegateeReward > 0 {
 			delegationRewardsOwner := uStakerTx.DelegationRewardsOwner()
@@ -519,14 +618,13 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 
 		// Calculate split of reward between delegator/delegatee
 		// The delegator gives stake to the validatee
-		validatorShares := vdrTx.Shares()
-		delegatorShares := reward.PercentDenominator - uint64(validatorShares)                            // parentTx.Shares <= reward.PercentDenominator so no underflow
-		delegatorReward := delegatorShares * (stakerToRemove.PotentialReward / reward.PercentDenominator) // delegatorShares <= reward.PercentDenominator so no overflow
-		// Delay rounding as long as possible for small numbers
-		if optimisticReward, err := math.Mul64(delegatorShares, stakerToRemove.PotentialReward); err == nil {
-			delegatorReward = optimisticReward / reward.PercentDenominator
+		delegationReward, err := math.Add64(stakerToRemove.PotentialReward, feeReward)
+		if err != nil {
+			return err
 		}
-		delegateeReward := stakerToRemove.PotentialReward - delegatorReward // delegatorReward <= reward so no underflow
+		delegatorReward, delegateeReward := splitDelegationReward(delegationReward, vdrTx.Shares())
+		e.DelegationShares = vdrTx.Shares()
+		e.DelegationFee = delegateeReward
 
 		offset := 0
 
@@ -643,13 +741,9 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 	}
 
 	// TODO: calculate subnet uptimes
-	uptime, err := e.Uptimes.CalculateUptimePercentFrom(
-		primaryNetworkValidator.NodeID,
-		constants.PrimaryNetworkID,
-		primaryNetworkValidator.StartTime,
-	)
+	uptime, err := e.primaryNetworkUptime(primaryNetworkValidator)
 	if err != nil {
-		return fmt.Errorf("failed to calculate uptime: %w", err)
+		return err
 	}
 
 	e.PrefersCommit = uptime >= expectedUptimePercentage
@@ -661,6 +755,159 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 	return nil
 }
 
+// splitReward populates the mint and fee components of the reward of
+// [stakerToRemove].
+func (e *ProposalTxExecutor) splitReward(stakerToRemove *state.Staker) error {
+	if stakerToRemove.SubnetID != constants.PrimaryNetworkID || stakerToRemove.MintRate == nil {
+		e.MintReward = stakerToRemove.PotentialReward
+		return nil
+	}
+
+	// The chain time is the staker's end time, so the accumulated mint rate
+	// is the one its reward was calculated with.
+	accumulatedMintRate, err := e.OnCommitState.GetStakerAccumulatedMintRate()
+	if err != nil {
+		return err
+	}
+	mint := reward.CalculateMintReward(
+		stakerToRemove.Weight,
+		stakerToRemove.MintRate,
+		accumulatedMintRate,
+	)
+	e.MintReward = math.Min(mint, stakerToRemove.PotentialReward)
+	e.FeeReward = stakerToRemove.PotentialReward - e.MintReward
+	return nil
+}
+
+// shouldRestake returns true if the validator [stakerToRemove] must be
+// restaked if its RewardValidatorTx is committed. The auto-restake flag of the
+// validator is cleared, as its validator tx is being removed.
+//
+// A validator whose stake is out of the bounds allowed for new validators is
+// not restaked.
+func (e *ProposalTxExecutor) shouldRestake(stakerToRemove *state.Staker) (bool, error) {
+	if stakerToRemove.SubnetID != constants.PrimaryNetworkID {
+		return false, nil
+	}
+
+	autoRestake, err := e.OnCommitState.GetAutoRestake(stakerToRemove.TxID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get auto-restake flag of %s: %w", stakerToRemove.TxID, err)
+	}
+	if !autoRestake {
+		return false, nil
+	}
+	e.OnCommitState.SetAutoRestake(stakerToRemove.TxID, false)
+	e.OnAbortState.SetAutoRestake(stakerToRemove.TxID, false)
+
+	return stakerToRemove.Weight >= e.Config.MinValidatorStake &&
+		stakerToRemove.Weight <= e.Config.MaxValidatorStake, nil
+}
+
+// restakeValidator adds the primary network validator [validator] back to the
+// pending validators of [e.OnCommitState] for another staking period of the
+// same duration, starting at the end of its current one. As much of its
+// validation reward as [e.Config.MaxValidatorStake] allows is added to its
+// stake. Returns the amount of the validation reward that was restaked.
+//
+// The restaked validator is added by a new AddPermissionlessValidatorTx that
+// reuses the stake outputs, reward owners and delegation shares of the
+// validator. The tx is created by the chain, so it has no inputs and no
+// credentials.
+//
+// Invariant: [validator.Weight] <= [e.Config.MaxValidatorStake]
+func (e *ProposalTxExecutor) restakeValidator(
+	validator *state.Staker,
+	validatorTx txs.ValidatorTx,
+	stakeAsset dione.Asset,
+) (uint64, error) {
+	restakedReward := math.Min(validator.PotentialReward, e.Config.MaxValidatorStake-validator.Weight)
+
+	stake := validatorTx.Stake()
+	stakeOuts := make([]*dione.TransferableOutput, len(stake), len(stake)+1)
+	copy(stakeOuts, stake)
+	if restakedReward > 0 {
+		outIntf, err := e.Fx.CreateOutput(restakedReward, validatorTx.ValidationRewardsOwner())
+		if err != nil {
+			return 0, fmt.Errorf("failed to create output: %w", err)
+		}
+		out, ok := outIntf.(dione.TransferableOut)
+		if !ok {
+			return 0, ErrInvalidState
+		}
+		stakeOuts = append(stakeOuts, &dione.TransferableOutput{
+			Asset: stakeAsset,
+			Out:   out,
+		})
+		dione.SortTransferableOutputs(stakeOuts, txs.Codec)
+	}
+
+	duration := validator.EndTime.Sub(validator.StartTime)
+	if duration < e.Config.MinValidatorStakeDuration {
+		duration = e.Config.MinValidatorStakeDuration
+	}
+	if duration > e.Config.MaxValidatorStakeDuration {
+		duration = e.Config.MaxValidatorStakeDuration
+	}
+	startTime := validator.EndTime
+	endTime := startTime.Add(duration)
+
+	var validatorSigner signer.Signer = &signer.Empty{}
+	if permissionlessTx, ok := validatorTx.(*txs.AddPermissionlessValidatorTx); ok {
+		validatorSigner = permissionlessTx.Signer
+	}
+
+	utx := &txs.AddPermissionlessValidatorTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    e.Ctx.NetworkID,
+			BlockchainID: e.Ctx.ChainID,
+		}},
+		Validator: txs.Validator{
+			NodeID: validator.NodeID,
+			Start:  uint64(startTime.Unix()),
+			End:    uint64(endTime.Unix()),
+			Wght:   validator.Weight + restakedReward,
+		},
+		Subnet:                constants.PrimaryNetworkID,
+		Signer:                validatorSigner,
+		StakeOuts:             stakeOuts,
+		ValidatorRewardsOwner: validatorTx.ValidationRewardsOwner(),
+		DelegatorRewardsOwner: validatorTx.DelegationRewardsOwner(),
+		DelegationShares:      validatorTx.Shares(),
+	}
+	restakeTx := &txs.Tx{Unsigned: utx}
+	if err := restakeTx.Initialize(txs.Codec); err != nil {
+		return 0, err
+	}
+	restakeTxID := restakeTx.ID()
+
+	staker, err := state.NewPendingStaker(restakeTxID, utx)
+	if err != nil {
+		return 0, err
+	}
+	// The restaked validator keeps the BLS key it currently uses, which may
+	// have been rotated.
+	staker.PublicKey = validator.PublicKey
+
+	e.OnCommitState.PutPendingValidator(staker)
+	e.OnCommitState.AddTx(restakeTx, status.Committed)
+	e.OnCommitState.SetAutoRestake(restakeTxID, true)
+
+	// Carry the latest BLS key rotation of the validator over to its restaked
+	// validator tx, so that a rotation that didn't take effect yet still does.
+	rotation, err := e.OnCommitState.GetBLSKeyRotation(validator.NodeID)
+	switch {
+	case err == database.ErrNotFound:
+	case err != nil:
+		return 0, fmt.Errorf("failed to get BLS key rotation of %s: %w", validator.NodeID, err)
+	case rotation.ValidatorTxID == validator.TxID:
+		restakedRotation := *rotation
+		restakedRotation.ValidatorTxID = restakeTxID
+		e.OnCommitState.PutBLSKeyRotation(&restakedRotation)
+	}
+	return restakedReward, nil
+}
+
 // GetNextStakerChangeTime returns the next time a staker will be either added
 // or removed to/from the current validator set.
 func GetNextStakerChangeTime(state state.Chain) (time.Time, error) {
@@ -815,3 +1062,28 @@ func GetMaxWeight(
 	// updated accordingly.
 	return math.Max(currentMax, currentWeight), nil
 }
+
+// primaryNetworkUptime returns the uptime of [validator] since the start of its
+// staking period. If uptime scores are enabled, the uptime attested on chain is
+// preferred over the local view of this node.
+func (e *ProposalTxExecutor) primaryNetworkUptime(validator *state.Staker) (float64, error) {
+	if e.Config.UptimeScoresEnabled {
+		score, err := e.OnCommitState.GetUptimeScore(validator.NodeID)
+		switch {
+		case err == nil && score.ValidatorTxID == validator.TxID:
+			return score.Uptime(validator, e.OnCommitState.GetTimestamp()), nil
+		case err != nil && err != database.ErrNotFound:
+			return 0, fmt.Errorf("failed to get uptime score: %w", err)
+		}
+	}
+
+	uptime, err := e.Uptimes.CalculateUptimePercentFrom(
+		validator.NodeID,
+		constants.PrimaryNetworkID,
+		validator.StartTime,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to calculate uptime: %w", err)
+	}
+	return uptime, nil
+}
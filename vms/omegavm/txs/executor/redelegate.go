@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+)
+
+// redelegate moves the current primary network delegator [delegator], added
+// by [delegatorTx], to the validator [nodeID] for the rest of its staking
+// period.
+//
+// The reward accrued by the delegator so far is settled as if its staking
+// period ended now: the delegator share is paid out and the delegatee share
+// is deferred to its current validator. The moved delegator is added by a new
+// AddPermissionlessDelegatorTx that reuses the stake outputs and the rewards
+// owner of the delegator. The tx is created by the chain, so it has no inputs
+// and no credentials. [redelegateTxID] makes it unique.
+func (e *StandardTxExecutor) redelegate(
+	redelegateTxID ids.ID,
+	delegator *state.Staker,
+	delegatorTx txs.DelegatorTx,
+	nodeID ids.NodeID,
+) error {
+	accumulatedMintRate, feePerWeightStored, err := e.syncRewardAccumulators()
+	if err != nil {
+		return err
+	}
+
+	vdrTx, err := e.getCurrentValidatorTx(delegator.NodeID)
+	if err != nil {
+		return err
+	}
+
+	e.State.DeleteCurrentDelegator(delegator)
+
+	accrued := accruedReward(delegator, accumulatedMintRate, feePerWeightStored)
+	delegatorReward, delegateeReward := splitDelegationReward(accrued, vdrTx.Shares())
+	if _, err := e.payReward(delegator.TxID, delegatorTx, 0, delegatorReward, delegatorTx.RewardsOwner()); err != nil {
+		return err
+	}
+	if err := e.deferDelegateeReward(delegator.NodeID, delegateeReward); err != nil {
+		return err
+	}
+	if err := e.mintReward(accrued, accruedMintReward(delegator, accumulatedMintRate)); err != nil {
+		return err
+	}
+
+	utx := &txs.AddPermissionlessDelegatorTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    e.Ctx.NetworkID,
+			BlockchainID: e.Ctx.ChainID,
+			Memo:         redelegateTxID[:],
+		}},
+		Validator: txs.Validator{
+			NodeID: nodeID,
+			Start:  uint64(e.State.GetTimestamp().Unix()),
+			End:    uint64(delegator.EndTime.Unix()),
+			Wght:   delegator.Weight,
+		},
+		Subnet:                 constants.PrimaryNetworkID,
+		StakeOuts:              delegatorTx.Stake(),
+		DelegationRewardsOwner: delegatorTx.RewardsOwner(),
+	}
+	newDelegatorTx := &txs.Tx{Unsigned: utx}
+	if err := newDelegatorTx.Initialize(txs.Codec); err != nil {
+		return err
+	}
+
+	// The moved delegator starts accruing its reward now.
+	newDelegator, err := state.NewCurrentStakerWithRewardRate(
+		newDelegatorTx.ID(),
+		utx,
+		0,
+		accumulatedMintRate,
+		feePerWeightStored,
+	)
+	if err != nil {
+		return err
+	}
+
+	e.State.PutCurrentDelegator(newDelegator)
+	e.State.AddTx(newDelegatorTx, status.Committed)
+	return nil
+}
@@ -0,0 +1,329 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+// genesisNodeID is the ID of a genesis validator, which validates until
+// [defaultValidateEndTime].
+var genesisNodeID = ids.NodeID(preFundedKeys[0].PublicKey().Address())
+
+func TestStandardTxExecutorRedelegateTxActivation(t *testing.T) {
+	tests := []struct {
+		description    string
+		redelegateTime time.Time
+		expectedErr    error
+	}{
+		{
+			description:    "before activation",
+			redelegateTime: mockable.MaxTime,
+			expectedErr:    errRedelegateNotActivated,
+		},
+		{
+			description:    "after activation",
+			redelegateTime: time.Time{},
+			expectedErr:    nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.RedelegateTime = test.redelegateTime
+
+			stakers := addEarlyUnstakeTestStakers(t, env)
+
+			tx, err := env.txBuilder.NewRedelegateTx(
+				stakers.delTx.ID(),
+				genesisNodeID,
+				[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.delRewardsKey},
+				ids.ShortEmpty,
+			)
+			require.NoError(err)
+
+			onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor := StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+			}
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestStandardTxExecutorRedelegateTx(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	stakers := addEarlyUnstakeTestStakers(t, env)
+
+	tx, err := env.txBuilder.NewRedelegateTx(
+		stakers.delTx.ID(),
+		genesisNodeID,
+		[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.delRewardsKey},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	require.NoError(tx.Unsigned.Visit(&executor))
+
+	// The delegator is removed from its validator.
+	delegatorIterator, err := onAcceptState.GetCurrentDelegatorIterator(constants.PrimaryNetworkID, stakers.vdrStaker.NodeID)
+	require.NoError(err)
+	require.False(delegatorIterator.Next())
+	delegatorIterator.Release()
+
+	// The delegator is added to the new validator for the rest of its
+	// staking period.
+	delegatorIterator, err = onAcceptState.GetCurrentDelegatorIterator(constants.PrimaryNetworkID, genesisNodeID)
+	require.NoError(err)
+	require.True(delegatorIterator.Next())
+	newDelStaker := delegatorIterator.Value()
+	require.False(delegatorIterator.Next())
+	delegatorIterator.Release()
+
+	require.Equal(stakers.delStaker.Weight, newDelStaker.Weight)
+	require.Equal(stakers.unstakeChainTime.Unix(), newDelStaker.StartTime.Unix())
+	require.Equal(stakers.delStaker.EndTime.Unix(), newDelStaker.EndTime.Unix())
+
+	newDelTx, txStatus, err := onAcceptState.GetTx(newDelStaker.TxID)
+	require.NoError(err)
+	require.Equal(status.Committed, txStatus)
+	uNewDelTx := newDelTx.Unsigned.(*txs.AddPermissionlessDelegatorTx)
+	uDelTx := stakers.delTx.Unsigned.(*txs.AddDelegatorTx)
+	require.Empty(uNewDelTx.Ins)
+	require.Equal(uDelTx.StakeOuts, uNewDelTx.StakeOuts)
+	require.Equal(uDelTx.DelegationRewardsOwner, uNewDelTx.DelegationRewardsOwner)
+
+	// The reward accrued so far is settled: the delegator share is paid out
+	// and the share of the previous validator is deferred to its end time.
+	delWeight := stakers.delStaker.Weight
+	delegateeReward := delWeight / 4
+	requireUTXOAmount(require, onAcceptState, stakers.delTx.ID(), len(uDelTx.Outs), 0)
+	requireUTXOAmount(require, onAcceptState, stakers.delTx.ID(), len(uDelTx.Outs)+len(uDelTx.StakeOuts), delWeight-delegateeReward)
+
+	gotDelegateeReward, err := onAcceptState.GetDelegateeReward(constants.PrimaryNetworkID, stakers.vdrStaker.NodeID)
+	require.NoError(err)
+	require.Equal(delegateeReward, gotDelegateeReward)
+
+	supply, err := onAcceptState.GetCurrentSupply(constants.PrimaryNetworkID)
+	require.NoError(err)
+	require.Equal(stakers.initialSupply+delWeight, supply)
+
+	require.NoError(onAcceptState.Apply(env.state))
+	env.state.SetTimestamp(newDelStaker.EndTime)
+	require.NoError(env.state.Commit())
+
+	// The moved delegator is rewarded at the end of its staking period, which
+	// refunds its stake.
+	rewardTx, err := env.txBuilder.NewRewardValidatorTx(newDelStaker.TxID)
+	require.NoError(err)
+
+	onCommitState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+	onAbortState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	proposalExecutor := ProposalTxExecutor{
+		OnCommitState: onCommitState,
+		OnAbortState:  onAbortState,
+		Backend:       &env.backend,
+		Tx:            rewardTx,
+	}
+	require.NoError(rewardTx.Unsigned.Visit(&proposalExecutor))
+	requireUTXOAmount(require, onCommitState, newDelStaker.TxID, 0, delWeight)
+}
+
+func TestStandardTxExecutorRedelegateTxInvalidTarget(t *testing.T) {
+	tests := []struct {
+		description string
+		// returns the node to move the delegator to
+		setup       func(*testing.T, *environment, *earlyUnstakeTestStakers) ids.NodeID
+		expectedErr error
+	}{
+		{
+			description: "same validator",
+			setup: func(_ *testing.T, _ *environment, stakers *earlyUnstakeTestStakers) ids.NodeID {
+				return stakers.vdrStaker.NodeID
+			},
+			expectedErr: errRedelegateToSameValidator,
+		},
+		{
+			description: "not a validator",
+			setup: func(*testing.T, *environment, *earlyUnstakeTestStakers) ids.NodeID {
+				return ids.GenerateTestNodeID()
+			},
+			expectedErr: database.ErrNotFound,
+		},
+		{
+			description: "validator ends before the delegator",
+			setup: func(t *testing.T, env *environment, stakers *earlyUnstakeTestStakers) ids.NodeID {
+				require := require.New(t)
+
+				nodeID := ids.GenerateTestNodeID()
+				vdrTx, err := env.txBuilder.NewAddValidatorTx(
+					env.config.MinValidatorStake,
+					uint64(stakers.vdrStaker.StartTime.Unix()),
+					uint64(stakers.delStaker.EndTime.Add(-time.Second).Unix()),
+					nodeID,
+					stakers.vdrRewardsKey.PublicKey().Address(),
+					stakers.delegationsShares,
+					[]*secp256k1.PrivateKey{preFundedKeys[0]},
+					ids.ShortEmpty,
+				)
+				require.NoError(err)
+				vdrStaker, err := state.NewCurrentStaker(vdrTx.ID(), vdrTx.Unsigned.(*txs.AddValidatorTx), 0)
+				require.NoError(err)
+				env.state.PutCurrentValidator(vdrStaker)
+				env.state.AddTx(vdrTx, status.Committed)
+				require.NoError(env.state.Commit())
+				return nodeID
+			},
+			expectedErr: ErrPeriodMismatch,
+		},
+		{
+			description: "over delegated",
+			setup: func(_ *testing.T, env *environment, _ *earlyUnstakeTestStakers) ids.NodeID {
+				env.config.MaxValidatorStake = defaultWeight
+				return genesisNodeID
+			},
+			expectedErr: ErrOverDelegated,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+
+			stakers := addEarlyUnstakeTestStakers(t, env)
+			nodeID := test.setup(t, env, stakers)
+
+			tx, err := env.txBuilder.NewRedelegateTx(
+				stakers.delTx.ID(),
+				nodeID,
+				[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.delRewardsKey},
+				ids.ShortEmpty,
+			)
+			require.NoError(err)
+
+			onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor := StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+			}
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestStandardTxExecutorRedelegateTxNotDelegator(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	stakers := addEarlyUnstakeTestStakers(t, env)
+
+	// Only current delegators can move.
+	for _, txID := range []ids.ID{stakers.vdrTx.ID(), stakers.pendingDelTx.ID()} {
+		tx, err := env.txBuilder.NewRedelegateTx(
+			txID,
+			genesisNodeID,
+			[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.vdrRewardsKey, stakers.delRewardsKey},
+			ids.ShortEmpty,
+		)
+		require.NoError(err)
+
+		onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+		require.NoError(err)
+
+		executor := StandardTxExecutor{
+			Backend: &env.backend,
+			State:   onAcceptState,
+			Tx:      tx,
+		}
+		err = tx.Unsigned.Visit(&executor)
+		require.ErrorIs(err, errNotCurrentStaker)
+	}
+}
+
+func TestStandardTxExecutorRedelegateTxUnauthorized(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	stakers := addEarlyUnstakeTestStakers(t, env)
+
+	tx, err := env.txBuilder.NewRedelegateTx(
+		stakers.delTx.ID(),
+		genesisNodeID,
+		[]*secp256k1.PrivateKey{preFundedKeys[0], stakers.delRewardsKey},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	// Replace the delegator authorization with a signature of a key that
+	// doesn't own the rewards of the delegator.
+	utx := tx.Unsigned.(*txs.RedelegateTx)
+	utx.DelegatorAuth = &secp256k1fx.Input{SigIndices: []uint32{0}}
+	stx, err := txs.NewSigned(utx, txs.Codec, [][]*secp256k1.PrivateKey{
+		{preFundedKeys[0]},
+		{preFundedKeys[0]},
+	})
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      stx,
+	}
+	err = stx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, errUnauthorizedStakerModification)
+}
@@ -100,6 +100,10 @@ func TestRewardValidatorTxExecuteOnCommit(t *testing.T) {
 		Tx:            tx,
 	}
 	require.NoError(tx.Unsigned.Visit(&txExecutor))
+	require.Equal(
+		stakerToRemove.PotentialReward,
+		txExecutor.MintReward+txExecutor.FeeReward+txExecutor.OrionFee,
+	)
 
 	onCommitStakerIterator, err := txExecutor.OnCommitState.GetCurrentStakerIterator()
 	require.NoError(err)
@@ -659,6 +663,8 @@ func TestRewardDelegatorTxAndValidatorTxExecuteOnCommitPostDelegateeDeferral(t *
 		Tx:            tx,
 	}
 	require.NoError(tx.Unsigned.Visit(&txExecutor))
+	require.Equal(uint32(reward.PercentDenominator/4), txExecutor.DelegationShares)
+	require.Equal(delRewardAmt/4, txExecutor.DelegationFee)
 
 	// Create Validator Diffs
 	testID := ids.GenerateTestID()
@@ -680,6 +686,7 @@ func TestRewardDelegatorTxAndValidatorTxExecuteOnCommitPostDelegateeDeferral(t *
 		Tx:            tx,
 	}
 	require.NoError(tx.Unsigned.Visit(&txExecutor))
+	require.Equal(delRewardAmt/4, txExecutor.DelegateeReward)
 
 	// aborted validator tx should still distribute accrued delegator rewards
 	numVdrStakeUTXOs := uint32(len(delTx.Unsigned.InputIDs()))
@@ -841,3 +848,281 @@ func TestRewardDelegatorTxExecuteOnAbort(t *testing.T) {
 	require.NoError(err)
 	require.Equal(initialSupply, newSupply, "should have removed un-rewarded tokens from the potential supply")
 }
+
+func TestRewardValidatorTxOrionFee(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+	dummyHeight := uint64(1)
+
+	vdrStartTime := uint64(defaultValidateStartTime.Unix()) + 1
+	vdrEndTime := uint64(defaultValidateStartTime.Add(2 * defaultMinValidatorStakingDuration).Unix())
+	vdrNodeID := ids.GenerateTestNodeID()
+
+	vdrTx, err := env.txBuilder.NewAddValidatorTx(
+		env.config.MinValidatorStake, // stakeAmt
+		vdrStartTime,
+		vdrEndTime,
+		vdrNodeID,                 // node ID
+		ids.GenerateTestShortID(), // reward address
+		reward.PercentDenominator/4,
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	delTx, err := env.txBuilder.NewAddDelegatorTx(
+		env.config.MinDelegatorStake,
+		vdrStartTime,
+		vdrEndTime-1,
+		vdrNodeID,
+		ids.GenerateTestShortID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	vdrStaker, err := state.NewCurrentStaker(
+		vdrTx.ID(),
+		vdrTx.Unsigned.(*txs.AddValidatorTx),
+		1000,
+	)
+	require.NoError(err)
+
+	delStaker, err := state.NewCurrentStaker(
+		delTx.ID(),
+		delTx.Unsigned.(*txs.AddDelegatorTx),
+		1000,
+	)
+	require.NoError(err)
+
+	env.state.PutCurrentValidator(vdrStaker)
+	env.state.AddTx(vdrTx, status.Committed)
+	env.state.PutCurrentDelegator(delStaker)
+	env.state.AddTx(delTx, status.Committed)
+	env.state.SetTimestamp(delStaker.EndTime)
+	env.state.SetHeight(dummyHeight)
+	require.NoError(env.state.Commit())
+
+	// Delegators can't be paid an orion fee
+	tx, err := env.txBuilder.NewRewardValidatorTxWithFee(delTx.ID(), 10)
+	require.NoError(err)
+
+	onCommitState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	onAbortState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	txExecutor := ProposalTxExecutor{
+		OnCommitState: onCommitState,
+		OnAbortState:  onAbortState,
+		Backend:       &env.backend,
+		Tx:            tx,
+	}
+	err = tx.Unsigned.Visit(&txExecutor)
+	require.ErrorIs(err, ErrUnexpectedOrionFee)
+
+	tx, err = env.txBuilder.NewRewardValidatorTx(delTx.ID())
+	require.NoError(err)
+
+	txExecutor.Tx = tx
+	require.NoError(tx.Unsigned.Visit(&txExecutor))
+	require.NoError(txExecutor.OnCommitState.Apply(env.state))
+	env.state.SetTimestamp(vdrStaker.EndTime)
+	require.NoError(env.state.Commit())
+
+	// Orion fees can't be paid out before the orion fee upgrade
+	tx, err = env.txBuilder.NewRewardValidatorTxWithFee(vdrTx.ID(), 10)
+	require.NoError(err)
+
+	env.config.OrionFeeTime = vdrStaker.EndTime.Add(time.Second)
+
+	onCommitState, err = state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	onAbortState, err = state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	txExecutor = ProposalTxExecutor{
+		OnCommitState: onCommitState,
+		OnAbortState:  onAbortState,
+		Backend:       &env.backend,
+		Tx:            tx,
+	}
+	err = tx.Unsigned.Visit(&txExecutor)
+	require.ErrorIs(err, ErrOrionFeeNotActivated)
+
+	env.config.OrionFeeTime = vdrStaker.EndTime
+
+	// The orion fee of a primary network validator is part of its reward and
+	// is undistributed if the validator isn't rewarded
+	onCommitState, err = state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	onAbortState, err = state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	txExecutor = ProposalTxExecutor{
+		OnCommitState: onCommitState,
+		OnAbortState:  onAbortState,
+		Backend:       &env.backend,
+		Tx:            tx,
+	}
+	require.NoError(tx.Unsigned.Visit(&txExecutor))
+	require.Equal(uint64(10), txExecutor.OrionFee)
+	require.Equal(uint64(1010), txExecutor.UndistributedReward)
+}
+
+func TestRewardValidatorTxAutoRestake(t *testing.T) {
+	tests := []struct {
+		description            string
+		autoRestake            bool
+		maxValidatorStakeDelta uint64
+		expectedRestakedReward uint64
+	}{
+		{
+			description:            "not auto-restaked",
+			autoRestake:            false,
+			maxValidatorStakeDelta: 1000,
+		},
+		{
+			description:            "reward restaked",
+			autoRestake:            true,
+			maxValidatorStakeDelta: 1000,
+			expectedRestakedReward: 1000,
+		},
+		{
+			description:            "reward restaked up to the max stake",
+			autoRestake:            true,
+			maxValidatorStakeDelta: 400,
+			expectedRestakedReward: 400,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.MaxValidatorStake = env.config.MinValidatorStake + test.maxValidatorStakeDelta
+
+			vdrStartTime := uint64(defaultValidateStartTime.Unix()) + 1
+			vdrEndTime := uint64(defaultValidateStartTime.Add(2 * defaultMinValidatorStakingDuration).Unix())
+			vdrNodeID := ids.GenerateTestNodeID()
+			vdrRewardAddress := ids.GenerateTestShortID()
+
+			vdrTx, err := env.txBuilder.NewAddValidatorTx(
+				env.config.MinValidatorStake, // stakeAmt
+				vdrStartTime,
+				vdrEndTime,
+				vdrNodeID,        // node ID
+				vdrRewardAddress, // reward address
+				reward.PercentDenominator/4,
+				[]*secp256k1.PrivateKey{preFundedKeys[0]},
+				ids.ShortEmpty,
+			)
+			require.NoError(err)
+			uVdrTx := vdrTx.Unsigned.(*txs.AddValidatorTx)
+
+			vdrStaker, err := state.NewCurrentStaker(vdrTx.ID(), uVdrTx, 1000)
+			require.NoError(err)
+
+			env.state.PutCurrentValidator(vdrStaker)
+			env.state.AddTx(vdrTx, status.Committed)
+			env.state.SetAutoRestake(vdrTx.ID(), test.autoRestake)
+			env.state.SetTimestamp(vdrStaker.EndTime)
+			env.state.SetHeight(1)
+			require.NoError(env.state.Commit())
+
+			tx, err := env.txBuilder.NewRewardValidatorTx(vdrTx.ID())
+			require.NoError(err)
+
+			onCommitState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			onAbortState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			txExecutor := ProposalTxExecutor{
+				OnCommitState: onCommitState,
+				OnAbortState:  onAbortState,
+				Backend:       &env.backend,
+				Tx:            tx,
+			}
+			require.NoError(tx.Unsigned.Visit(&txExecutor))
+
+			stakeUTXOID := dione.UTXOID{
+				TxID:        vdrTx.ID(),
+				OutputIndex: uint32(len(uVdrTx.Outs)),
+			}
+			rewardUTXOID := dione.UTXOID{
+				TxID:        vdrTx.ID(),
+				OutputIndex: uint32(len(uVdrTx.Outs) + len(uVdrTx.StakeOuts)),
+			}
+
+			// The validator is never restaked if the tx is aborted.
+			_, err = onAbortState.GetPendingValidator(constants.PrimaryNetworkID, vdrNodeID)
+			require.ErrorIs(err, database.ErrNotFound)
+			_, err = onAbortState.GetUTXO(stakeUTXOID.InputID())
+			require.NoError(err)
+			autoRestake, err := onAbortState.GetAutoRestake(vdrTx.ID())
+			require.NoError(err)
+			require.False(autoRestake)
+
+			autoRestake, err = onCommitState.GetAutoRestake(vdrTx.ID())
+			require.NoError(err)
+			require.False(autoRestake)
+
+			if !test.autoRestake {
+				_, err = onCommitState.GetPendingValidator(constants.PrimaryNetworkID, vdrNodeID)
+				require.ErrorIs(err, database.ErrNotFound)
+				_, err = onCommitState.GetUTXO(stakeUTXOID.InputID())
+				require.NoError(err)
+				return
+			}
+
+			// The stake remains locked by the restaked validator.
+			_, err = onCommitState.GetUTXO(stakeUTXOID.InputID())
+			require.ErrorIs(err, database.ErrNotFound)
+
+			// The part of the reward that isn't restaked is paid out.
+			rewardUTXO, err := onCommitState.GetUTXO(rewardUTXOID.InputID())
+			if test.expectedRestakedReward == vdrStaker.PotentialReward {
+				require.ErrorIs(err, database.ErrNotFound)
+			} else {
+				require.NoError(err)
+				require.Equal(
+					vdrStaker.PotentialReward-test.expectedRestakedReward,
+					rewardUTXO.Out.(*secp256k1fx.TransferOutput).Amount(),
+				)
+			}
+
+			restakedStaker, err := onCommitState.GetPendingValidator(constants.PrimaryNetworkID, vdrNodeID)
+			require.NoError(err)
+			require.Equal(vdrStaker.EndTime, restakedStaker.StartTime)
+			require.Equal(vdrStaker.EndTime.Add(vdrStaker.EndTime.Sub(vdrStaker.StartTime)), restakedStaker.EndTime)
+			require.Equal(vdrStaker.Weight+test.expectedRestakedReward, restakedStaker.Weight)
+
+			autoRestake, err = onCommitState.GetAutoRestake(restakedStaker.TxID)
+			require.NoError(err)
+			require.True(autoRestake)
+
+			restakeTx, txStatus, err := onCommitState.GetTx(restakedStaker.TxID)
+			require.NoError(err)
+			require.Equal(status.Committed, txStatus)
+			uRestakeTx := restakeTx.Unsigned.(*txs.AddPermissionlessValidatorTx)
+			require.Equal(uVdrTx.RewardsOwner, uRestakeTx.ValidatorRewardsOwner)
+			require.Equal(uVdrTx.DelegationShares, uRestakeTx.DelegationShares)
+
+			stakeAmount := uint64(0)
+			for _, out := range uRestakeTx.StakeOuts {
+				stakeAmount += out.Output().Amount()
+			}
+			require.Equal(restakedStaker.Weight, stakeAmount)
+		})
+	}
+}
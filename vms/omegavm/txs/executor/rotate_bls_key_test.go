@@ -0,0 +1,211 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestStandardTxExecutorRotateBLSKeyTx(t *testing.T) {
+	tests := []struct {
+		description        string
+		blsKeyRotationTime time.Time
+		expectedErr        error
+	}{
+		{
+			description:        "before activation",
+			blsKeyRotationTime: mockable.MaxTime,
+			expectedErr:        errBLSKeyRotationNotActivated,
+		},
+		{
+			description:        "after activation",
+			blsKeyRotationTime: time.Time{},
+			expectedErr:        nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, true /*=postBanff*/, false /*=postCortina*/)
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.BLSKeyRotationTime = test.blsKeyRotationTime
+
+			// The validators of the genesis are owned by the key they are
+			// derived from.
+			nodeID := ids.NodeID(preFundedKeys[0].PublicKey().Address())
+			keys := []*secp256k1.PrivateKey{preFundedKeys[0]}
+			changeAddr := preFundedKeys[0].PublicKey().Address()
+
+			sk, err := bls.NewSecretKey()
+			require.NoError(err)
+			tx, err := env.txBuilder.NewRotateBLSKeyTx(
+				nodeID,
+				sk,
+				keys,
+				changeAddr,
+			)
+			require.NoError(err)
+
+			onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor := StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+			}
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			validator, err := onAcceptState.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+			require.NoError(err)
+
+			rotation, err := onAcceptState.GetBLSKeyRotation(nodeID)
+			require.NoError(err)
+			require.Equal(&state.BLSKeyRotation{
+				TxID:          tx.ID(),
+				NodeID:        nodeID,
+				ValidatorTxID: validator.TxID,
+				PublicKey:     bls.PublicFromSecretKey(sk),
+				Height:        BLSKeyRotationDelay,
+			}, rotation)
+
+			require.NoError(onAcceptState.Apply(env.state))
+			require.NoError(env.state.Commit())
+
+			// The key can't be rotated again before the previous rotation
+			// took effect.
+			newSK, err := bls.NewSecretKey()
+			require.NoError(err)
+			tx, err = env.txBuilder.NewRotateBLSKeyTx(
+				nodeID,
+				newSK,
+				keys,
+				changeAddr,
+			)
+			require.NoError(err)
+
+			onAcceptState, err = state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor = StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+				Height:  BLSKeyRotationDelay - 1,
+			}
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(err, errBLSKeyRotationPending)
+
+			// Once it took effect, the rotated key is the one replaced.
+			executor = StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+				Height:  BLSKeyRotationDelay,
+			}
+			require.NoError(tx.Unsigned.Visit(&executor))
+
+			rotation, err = onAcceptState.GetBLSKeyRotation(nodeID)
+			require.NoError(err)
+			require.Equal(bls.PublicFromSecretKey(sk), rotation.PreviousPublicKey)
+			require.Equal(bls.PublicFromSecretKey(newSK), rotation.PublicKey)
+			require.Equal(uint64(2*BLSKeyRotationDelay), rotation.Height)
+		})
+	}
+}
+
+func TestStandardTxExecutorRotateBLSKeyTxNotValidator(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, true /*=postBanff*/, false /*=postCortina*/)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+	tx, err := env.txBuilder.NewRotateBLSKeyTx(
+		ids.NodeID(preFundedKeys[0].PublicKey().Address()),
+		sk,
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		ids.ShortEmpty, // change addr
+	)
+	require.NoError(err)
+
+	// The builder refuses to build the tx for a node that isn't a validator.
+	utx := tx.Unsigned.(*txs.RotateBLSKeyTx)
+	utx.NodeID = ids.GenerateTestNodeID()
+	require.NoError(tx.Initialize(txs.Codec))
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	err = tx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, errNotCurrentValidator)
+}
+
+func TestStandardTxExecutorRotateBLSKeyTxUnauthorized(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, true /*=postBanff*/, false /*=postCortina*/)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	nodeID := ids.NodeID(preFundedKeys[0].PublicKey().Address())
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+	tx, err := env.txBuilder.NewRotateBLSKeyTx(
+		nodeID,
+		sk,
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		ids.ShortEmpty, // change addr
+	)
+	require.NoError(err)
+
+	// Replace the validator authorization with a signature of a key that
+	// doesn't own the validator.
+	utx := tx.Unsigned.(*txs.RotateBLSKeyTx)
+	utx.ValidatorAuth = &secp256k1fx.Input{SigIndices: []uint32{0}}
+	stx, err := txs.NewSigned(utx, txs.Codec, [][]*secp256k1.PrivateKey{
+		{preFundedKeys[1]},
+		{preFundedKeys[1]},
+	})
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      stx,
+	}
+	err = stx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, errUnauthorizedValidatorModification)
+}
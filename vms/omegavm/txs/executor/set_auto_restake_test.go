@@ -0,0 +1,167 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestStandardTxExecutorSetAutoRestakeTx(t *testing.T) {
+	tests := []struct {
+		description     string
+		autoRestakeTime time.Time
+		expectedErr     error
+	}{
+		{
+			description:     "before activation",
+			autoRestakeTime: mockable.MaxTime,
+			expectedErr:     errAutoRestakeNotActivated,
+		},
+		{
+			description:     "after activation",
+			autoRestakeTime: time.Time{},
+			expectedErr:     nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, true /*=postBanff*/, false /*=postCortina*/)
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.AutoRestakeTime = test.autoRestakeTime
+
+			// The validators of the genesis are owned by the key they are
+			// derived from.
+			nodeID := ids.NodeID(preFundedKeys[0].PublicKey().Address())
+			keys := []*secp256k1.PrivateKey{preFundedKeys[0]}
+			changeAddr := preFundedKeys[0].PublicKey().Address()
+
+			onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			validator, err := onAcceptState.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+			require.NoError(err)
+
+			for _, autoRestake := range []bool{true, false} {
+				tx, err := env.txBuilder.NewSetAutoRestakeTx(
+					nodeID,
+					autoRestake,
+					keys,
+					changeAddr,
+				)
+				require.NoError(err)
+
+				executor := StandardTxExecutor{
+					Backend: &env.backend,
+					State:   onAcceptState,
+					Tx:      tx,
+				}
+				err = tx.Unsigned.Visit(&executor)
+				require.ErrorIs(err, test.expectedErr)
+				if test.expectedErr != nil {
+					return
+				}
+
+				gotAutoRestake, err := onAcceptState.GetAutoRestake(validator.TxID)
+				require.NoError(err)
+				require.Equal(autoRestake, gotAutoRestake)
+
+				require.NoError(onAcceptState.Apply(env.state))
+				require.NoError(env.state.Commit())
+
+				gotAutoRestake, err = env.state.GetAutoRestake(validator.TxID)
+				require.NoError(err)
+				require.Equal(autoRestake, gotAutoRestake)
+
+				onAcceptState, err = state.NewDiff(lastAcceptedID, env)
+				require.NoError(err)
+			}
+		})
+	}
+}
+
+func TestStandardTxExecutorSetAutoRestakeTxNotValidator(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, true /*=postBanff*/, false /*=postCortina*/)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	tx, err := env.txBuilder.NewSetAutoRestakeTx(
+		ids.NodeID(preFundedKeys[0].PublicKey().Address()),
+		true, // autoRestake
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		ids.ShortEmpty, // change addr
+	)
+	require.NoError(err)
+
+	// The builder refuses to build the tx for a node that isn't a validator.
+	utx := tx.Unsigned.(*txs.SetAutoRestakeTx)
+	utx.NodeID = ids.GenerateTestNodeID()
+	require.NoError(tx.Initialize(txs.Codec))
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	err = tx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, ErrNotValidator)
+}
+
+func TestStandardTxExecutorSetAutoRestakeTxUnauthorized(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, true /*=postBanff*/, false /*=postCortina*/)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	tx, err := env.txBuilder.NewSetAutoRestakeTx(
+		ids.NodeID(preFundedKeys[0].PublicKey().Address()),
+		true, // autoRestake
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		ids.ShortEmpty, // change addr
+	)
+	require.NoError(err)
+
+	// Replace the validator authorization with a signature of a key that
+	// doesn't own the validator.
+	utx := tx.Unsigned.(*txs.SetAutoRestakeTx)
+	utx.ValidatorAuth = &secp256k1fx.Input{SigIndices: []uint32{0}}
+	stx, err := txs.NewSigned(utx, txs.Codec, [][]*secp256k1.PrivateKey{
+		{preFundedKeys[1]},
+		{preFundedKeys[1]},
+	})
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      stx,
+	}
+	err = stx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, errUnauthorizedValidatorModification)
+}
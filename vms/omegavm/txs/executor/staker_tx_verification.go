@@ -4,6 +4,7 @@
 package executor
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -15,6 +16,8 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/math"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 )
@@ -87,7 +90,7 @@ func verifyAddValidatorTx(
 	error,
 ) {
 	// Verify the tx is well-formed
-	if err := sTx.SyntacticVerify(backend.Ctx); err != nil {
+	if err := syntacticVerify(backend, chainState, sTx); err != nil {
 		return nil, err
 	}
 
@@ -159,7 +162,7 @@ func verifyAddValidatorTx(
 		outs,
 		sTx.Creds,
 		map[ids.ID]uint64{
-			backend.Ctx.DIONEAssetID: backend.Config.AddPrimaryNetworkValidatorFee,
+			backend.Ctx.DIONEAssetID: dynamicFee(backend, chainState, backend.Config.AddPrimaryNetworkValidatorFee),
 		},
 	); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
@@ -184,7 +187,7 @@ func verifyAddSubnetValidatorTx(
 	tx *txs.AddSubnetValidatorTx,
 ) error {
 	// Verify the tx is well-formed
-	if err := sTx.SyntacticVerify(backend.Ctx); err != nil {
+	if err := syntacticVerify(backend, chainState, sTx); err != nil {
 		return err
 	}
 
@@ -249,7 +252,7 @@ func verifyAddSubnetValidatorTx(
 		tx.Outs,
 		baseTxCreds,
 		map[ids.ID]uint64{
-			backend.Ctx.DIONEAssetID: backend.Config.AddSubnetValidatorFee,
+			backend.Ctx.DIONEAssetID: dynamicFee(backend, chainState, backend.Config.AddSubnetValidatorFee),
 		},
 	); err != nil {
 		return fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
@@ -280,7 +283,7 @@ func removeSubnetValidatorValidation(
 	tx *txs.RemoveSubnetValidatorTx,
 ) (*state.Staker, bool, error) {
 	// Verify the tx is well-formed
-	if err := sTx.SyntacticVerify(backend.Ctx); err != nil {
+	if err := syntacticVerify(backend, chainState, sTx); err != nil {
 		return nil, false, err
 	}
 
@@ -323,7 +326,7 @@ func removeSubnetValidatorValidation(
 		tx.Outs,
 		baseTxCreds,
 		map[ids.ID]uint64{
-			backend.Ctx.DIONEAssetID: backend.Config.TxFee,
+			backend.Ctx.DIONEAssetID: dynamicFee(backend, chainState, backend.Config.TxFee),
 		},
 	); err != nil {
 		return nil, false, fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
@@ -345,7 +348,7 @@ func verifyAddDelegatorTx(
 	error,
 ) {
 	// Verify the tx is well-formed
-	if err := sTx.SyntacticVerify(backend.Ctx); err != nil {
+	if err := syntacticVerify(backend, chainState, sTx); err != nil {
 		return nil, err
 	}
 
@@ -432,7 +435,7 @@ func verifyAddDelegatorTx(
 		outs,
 		sTx.Creds,
 		map[ids.ID]uint64{
-			backend.Ctx.DIONEAssetID: backend.Config.AddPrimaryNetworkDelegatorFee,
+			backend.Ctx.DIONEAssetID: dynamicFee(backend, chainState, backend.Config.AddPrimaryNetworkDelegatorFee),
 		},
 	); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
@@ -457,7 +460,7 @@ func verifyAddPermissionlessValidatorTx(
 	tx *txs.AddPermissionlessValidatorTx,
 ) error {
 	// Verify the tx is well-formed
-	if err := sTx.SyntacticVerify(backend.Ctx); err != nil {
+	if err := syntacticVerify(backend, chainState, sTx); err != nil {
 		return err
 	}
 
@@ -533,15 +536,31 @@ func verifyAddPermissionlessValidatorTx(
 		)
 	}
 
-	var txFee uint64
+	var fees map[ids.ID]uint64
 	if tx.Subnet != constants.PrimaryNetworkID {
 		if err := verifySubnetValidatorPrimaryNetworkRequirements(chainState, tx.Validator); err != nil {
 			return err
 		}
 
-		txFee = backend.Config.AddSubnetValidatorFee
+		feeConfig, err := getSubnetFeeConfig(chainState, tx.Subnet)
+		if err != nil {
+			return err
+		}
+		fees = map[ids.ID]uint64{
+			backend.Ctx.DIONEAssetID: dynamicFee(backend, chainState, backend.Config.AddSubnetValidatorFee),
+		}
+		if feeConfig != nil {
+			// The fee schedule of the subnet is charged in its staking asset,
+			// on top of the DIONE fee.
+			fees[validatorRules.assetID], err = math.Add64(fees[validatorRules.assetID], feeConfig.AddValidatorFee)
+			if err != nil {
+				return err
+			}
+		}
 	} else {
-		txFee = backend.Config.AddPrimaryNetworkValidatorFee
+		fees = map[ids.ID]uint64{
+			backend.Ctx.DIONEAssetID: dynamicFee(backend, chainState, backend.Config.AddPrimaryNetworkValidatorFee),
+		}
 	}
 
 	outs := make([]*dione.TransferableOutput, len(tx.Outs)+len(tx.StakeOuts))
@@ -555,9 +574,7 @@ func verifyAddPermissionlessValidatorTx(
 		tx.Ins,
 		outs,
 		sTx.Creds,
-		map[ids.ID]uint64{
-			backend.Ctx.DIONEAssetID: txFee,
-		},
+		fees,
 	); err != nil {
 		return fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
 	}
@@ -625,7 +642,7 @@ func verifyAddPermissionlessDelegatorTx(
 	tx *txs.AddPermissionlessDelegatorTx,
 ) error {
 	// Verify the tx is well-formed
-	if err := sTx.SyntacticVerify(backend.Ctx); err != nil {
+	if err := syntacticVerify(backend, chainState, sTx); err != nil {
 		return err
 	}
 
@@ -719,7 +736,7 @@ func verifyAddPermissionlessDelegatorTx(
 	copy(outs, tx.Outs)
 	copy(outs[len(tx.Outs):], tx.StakeOuts)
 
-	var txFee uint64
+	var fees map[ids.ID]uint64
 	if tx.Subnet != constants.PrimaryNetworkID {
 		// Invariant: Delegators must only be able to reference validator
 		//            transactions that implement [txs.ValidatorTx]. All
@@ -731,9 +748,25 @@ func verifyAddPermissionlessDelegatorTx(
 			return ErrDelegateToPermissionedValidator
 		}
 
-		txFee = backend.Config.AddSubnetDelegatorFee
+		feeConfig, err := getSubnetFeeConfig(chainState, tx.Subnet)
+		if err != nil {
+			return err
+		}
+		fees = map[ids.ID]uint64{
+			backend.Ctx.DIONEAssetID: dynamicFee(backend, chainState, backend.Config.AddSubnetDelegatorFee),
+		}
+		if feeConfig != nil {
+			// The fee schedule of the subnet is charged in its staking asset,
+			// on top of the DIONE fee.
+			fees[delegatorRules.assetID], err = math.Add64(fees[delegatorRules.assetID], feeConfig.AddDelegatorFee)
+			if err != nil {
+				return err
+			}
+		}
 	} else {
-		txFee = backend.Config.AddPrimaryNetworkDelegatorFee
+		fees = map[ids.ID]uint64{
+			backend.Ctx.DIONEAssetID: dynamicFee(backend, chainState, backend.Config.AddPrimaryNetworkDelegatorFee),
+		}
 	}
 
 	// Verify the flowcheck
@@ -743,9 +776,7 @@ func verifyAddPermissionlessDelegatorTx(
 		tx.Ins,
 		outs,
 		sTx.Creds,
-		map[ids.ID]uint64{
-			backend.Ctx.DIONEAssetID: txFee,
-		},
+		fees,
 	); err != nil {
 		return fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
 	}
@@ -803,3 +834,500 @@ func getDelegatorRules(
 		maxValidatorWeightFactor: transformSubnet.MaxValidatorWeightFactor,
 	}, nil
 }
+
+// verifyEarlyUnstakeTx carries out the validation for an EarlyUnstakeTx.
+// It returns the staker to remove and the tx that added it.
+func verifyEarlyUnstakeTx(
+	backend *Backend,
+	chainState state.Chain,
+	sTx *txs.Tx,
+	tx *txs.EarlyUnstakeTx,
+) (*state.Staker, txs.PermissionlessStaker, error) {
+	currentTimestamp := chainState.GetTimestamp()
+	if !backend.Config.IsEarlyUnstakeActivated(currentTimestamp) {
+		return nil, nil, errEarlyUnstakeNotActivated
+	}
+
+	// Verify the tx is well-formed
+	if err := syntacticVerify(backend, chainState, sTx); err != nil {
+		return nil, nil, err
+	}
+
+	stakerTx, _, err := chainState.GetTx(tx.TxID)
+	if err == database.ErrNotFound {
+		return nil, nil, fmt.Errorf("%s %w", tx.TxID, errNotCurrentStaker)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get staker tx %s: %w", tx.TxID, err)
+	}
+
+	var (
+		staker    *state.Staker
+		uStakerTx txs.PermissionlessStaker
+		owner     fx.Owner
+	)
+	switch stakerTx := stakerTx.Unsigned.(type) {
+	case txs.ValidatorTx:
+		if stakerTx.SubnetID() != constants.PrimaryNetworkID {
+			return nil, nil, fmt.Errorf("%s %w", tx.TxID, errNotCurrentStaker)
+		}
+		staker, err = chainState.GetCurrentValidator(constants.PrimaryNetworkID, stakerTx.NodeID())
+		if err != nil && err != database.ErrNotFound {
+			return nil, nil, fmt.Errorf("failed to get validator %s: %w", stakerTx.NodeID(), err)
+		}
+		if staker == nil || staker.TxID != tx.TxID {
+			return nil, nil, fmt.Errorf("%s %w", tx.TxID, errNotCurrentStaker)
+		}
+
+		// The staking period of a subnet validator must be contained in the
+		// one of its primary network validator.
+		validatingSubnets, err := isSubnetValidator(chainState, staker.NodeID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if validatingSubnets {
+			return nil, nil, fmt.Errorf("%s: %w", staker.NodeID, errValidatingSubnets)
+		}
+		uStakerTx = stakerTx
+		owner = stakerTx.ValidationRewardsOwner()
+	case txs.DelegatorTx:
+		if stakerTx.SubnetID() != constants.PrimaryNetworkID {
+			return nil, nil, fmt.Errorf("%s %w", tx.TxID, errNotCurrentStaker)
+		}
+		staker, err = getCurrentDelegator(chainState, stakerTx.NodeID(), tx.TxID)
+		if err != nil {
+			return nil, nil, err
+		}
+		uStakerTx = stakerTx
+		owner = stakerTx.RewardsOwner()
+	default:
+		return nil, nil, fmt.Errorf("%s %w", tx.TxID, errNotCurrentStaker)
+	}
+
+	// A staker whose staking period is over is removed by a
+	// RewardValidatorTx.
+	if !staker.EndTime.After(currentTimestamp) {
+		return nil, nil, fmt.Errorf(
+			"%w: TxID = %s with %s <= %s",
+			errStakingPeriodOver,
+			tx.TxID,
+			staker.EndTime,
+			currentTimestamp,
+		)
+	}
+
+	if !backend.Bootstrapped.Get() {
+		// Not bootstrapped yet -- don't need to do full verification.
+		return staker, uStakerTx, nil
+	}
+
+	if err := verifyStakerModification(backend, chainState, sTx, &tx.BaseTx, tx.StakerAuth, owner); err != nil {
+		return nil, nil, err
+	}
+	return staker, uStakerTx, nil
+}
+
+// verifyRedelegateTx carries out the validation for a RedelegateTx.
+// It returns the delegator to move and the tx that added it.
+func verifyRedelegateTx(
+	backend *Backend,
+	chainState state.Chain,
+	sTx *txs.Tx,
+	tx *txs.RedelegateTx,
+) (*state.Staker, txs.DelegatorTx, error) {
+	currentTimestamp := chainState.GetTimestamp()
+	if !backend.Config.IsRedelegateActivated(currentTimestamp) {
+		return nil, nil, errRedelegateNotActivated
+	}
+
+	// Verify the tx is well-formed
+	if err := syntacticVerify(backend, chainState, sTx); err != nil {
+		return nil, nil, err
+	}
+
+	delegatorTx, _, err := chainState.GetTx(tx.TxID)
+	if err == database.ErrNotFound {
+		return nil, nil, fmt.Errorf("%s %w", tx.TxID, errNotCurrentStaker)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get delegator tx %s: %w", tx.TxID, err)
+	}
+	uDelegatorTx, ok := delegatorTx.Unsigned.(txs.DelegatorTx)
+	if !ok || uDelegatorTx.SubnetID() != constants.PrimaryNetworkID {
+		return nil, nil, fmt.Errorf("%s %w", tx.TxID, errNotCurrentStaker)
+	}
+	delegator, err := getCurrentDelegator(chainState, uDelegatorTx.NodeID(), tx.TxID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// A delegator whose staking period is over is removed by a
+	// RewardValidatorTx.
+	if !delegator.EndTime.After(currentTimestamp) {
+		return nil, nil, fmt.Errorf(
+			"%w: TxID = %s with %s <= %s",
+			errStakingPeriodOver,
+			tx.TxID,
+			delegator.EndTime,
+			currentTimestamp,
+		)
+	}
+	if delegator.NodeID == tx.NodeID {
+		return nil, nil, fmt.Errorf("%w: %s", errRedelegateToSameValidator, tx.NodeID)
+	}
+
+	delegatorRules, err := getDelegatorRules(backend, chainState, constants.PrimaryNetworkID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validator, err := GetValidator(chainState, constants.PrimaryNetworkID, tx.NodeID)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to fetch the validator for %s on %s: %w",
+			tx.NodeID,
+			constants.PrimaryNetworkID,
+			err,
+		)
+	}
+
+	maximumWeight, err := math.Mul64(
+		uint64(delegatorRules.maxValidatorWeightFactor),
+		validator.Weight,
+	)
+	if err != nil {
+		maximumWeight = stdmath.MaxUint64
+	}
+	maximumWeight = math.Min(maximumWeight, delegatorRules.maxValidatorStake)
+
+	// The delegator is added to the validator for the rest of its staking
+	// period.
+	newStaker := *delegator
+	newStaker.NodeID = tx.NodeID
+	newStaker.StartTime = currentTimestamp
+
+	if !txs.BoundedBy(
+		newStaker.StartTime,
+		newStaker.EndTime,
+		validator.StartTime,
+		validator.EndTime,
+	) {
+		return nil, nil, ErrPeriodMismatch
+	}
+	overDelegated, err := overDelegated(chainState, validator, maximumWeight, &newStaker)
+	if err != nil {
+		return nil, nil, err
+	}
+	if overDelegated {
+		return nil, nil, ErrOverDelegated
+	}
+
+	if !backend.Bootstrapped.Get() {
+		// Not bootstrapped yet -- don't need to do full verification.
+		return delegator, uDelegatorTx, nil
+	}
+
+	if err := verifyStakerModification(backend, chainState, sTx, &tx.BaseTx, tx.DelegatorAuth, uDelegatorTx.RewardsOwner()); err != nil {
+		return nil, nil, err
+	}
+	return delegator, uDelegatorTx, nil
+}
+
+// verifyUptimeAttestationTx carries out the validation for an
+// UptimeAttestationTx.
+func verifyUptimeAttestationTx(
+	backend *Backend,
+	chainState state.Chain,
+	sTx *txs.Tx,
+	tx *txs.UptimeAttestationTx,
+) error {
+	currentTimestamp := chainState.GetTimestamp()
+	if !backend.Config.IsUptimeAttestationActivated(currentTimestamp) {
+		return errUptimeAttestationNotActivated
+	}
+
+	// Verify the tx is well-formed
+	if err := syntacticVerify(backend, chainState, sTx); err != nil {
+		return err
+	}
+
+	if !backend.Bootstrapped.Get() {
+		return nil
+	}
+
+	for _, uptime := range tx.Attestation.Uptimes {
+		validator, err := chainState.GetCurrentValidator(constants.PrimaryNetworkID, uptime.NodeID)
+		if err != nil && err != database.ErrNotFound {
+			return fmt.Errorf("failed to get validator %s: %w", uptime.NodeID, err)
+		}
+		if validator == nil || validator.TxID != uptime.ValidatorTxID {
+			return fmt.Errorf("%s %w", uptime.NodeID, errNotCurrentValidator)
+		}
+
+		stakedDuration := currentTimestamp.Sub(validator.StartTime)
+		if time.Duration(uptime.UpDuration)*time.Second > stakedDuration {
+			return fmt.Errorf(
+				"%w: %s attested up for %ds but staked for %s",
+				errUpDurationTooLong,
+				uptime.NodeID,
+				uptime.UpDuration,
+				stakedDuration,
+			)
+		}
+	}
+
+	msg, err := tx.Attestation.UnsignedMessage(backend.Ctx.NetworkID, backend.Ctx.ChainID)
+	if err != nil {
+		return err
+	}
+	err = tx.Signature.Verify(
+		context.TODO(),
+		msg,
+		backend.Ctx.NetworkID,
+		&chainValidatorState{chainState: chainState},
+		0, // The current validators are used regardless of the height
+		UptimeAttestationQuorumNumerator,
+		UptimeAttestationQuorumDenominator,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidUptimeAttestation, err)
+	}
+
+	// Verify the flowcheck
+	if err := backend.FlowChecker.VerifySpend(
+		tx,
+		chainState,
+		tx.Ins,
+		tx.Outs,
+		sTx.Creds,
+		map[ids.ID]uint64{
+			backend.Ctx.DIONEAssetID: dynamicFee(backend, chainState, backend.Config.TxFee),
+		},
+	); err != nil {
+		return fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
+	}
+	return nil
+}
+
+// verifyStakerModification verifies that the last credential of [sTx]
+// satisfies [auth], which must prove that the issuer is [owner], and that the
+// other credentials spend the inputs of [baseTx].
+func verifyStakerModification(
+	backend *Backend,
+	chainState state.Chain,
+	sTx *txs.Tx,
+	baseTx *txs.BaseTx,
+	auth verify.Verifiable,
+	owner fx.Owner,
+) error {
+	if len(sTx.Creds) == 0 {
+		// Ensure there is at least one credential for the staker
+		// authorization
+		return errWrongNumberOfCredentials
+	}
+
+	baseTxCredsLen := len(sTx.Creds) - 1
+	stakerCred := sTx.Creds[baseTxCredsLen]
+	if err := backend.Fx.VerifyPermission(sTx.Unsigned, auth, stakerCred, owner); err != nil {
+		return fmt.Errorf("%w: %w", errUnauthorizedStakerModification, err)
+	}
+
+	// Verify the flowcheck
+	if err := backend.FlowChecker.VerifySpend(
+		sTx.Unsigned,
+		chainState,
+		baseTx.Ins,
+		baseTx.Outs,
+		sTx.Creds[:baseTxCredsLen],
+		map[ids.ID]uint64{
+			backend.Ctx.DIONEAssetID: dynamicFee(backend, chainState, backend.Config.TxFee),
+		},
+	); err != nil {
+		return fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
+	}
+	return nil
+}
+
+// getCurrentDelegator returns the current primary network delegator of
+// [nodeID] added by [txID].
+func getCurrentDelegator(chainState state.Chain, nodeID ids.NodeID, txID ids.ID) (*state.Staker, error) {
+	delegatorIterator, err := chainState.GetCurrentDelegatorIterator(constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delegators of %s: %w", nodeID, err)
+	}
+	defer delegatorIterator.Release()
+
+	for delegatorIterator.Next() {
+		delegator := delegatorIterator.Value()
+		if delegator.TxID == txID {
+			return delegator, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %w", txID, errNotCurrentStaker)
+}
+
+// isSubnetValidator returns true if [nodeID] is a current or pending
+// validator of a subnet.
+func isSubnetValidator(chainState state.Chain, nodeID ids.NodeID) (bool, error) {
+	currentStakerIterator, err := chainState.GetCurrentStakerIterator()
+	if err != nil {
+		return false, err
+	}
+	defer currentStakerIterator.Release()
+
+	for currentStakerIterator.Next() {
+		staker := currentStakerIterator.Value()
+		if staker.SubnetID != constants.PrimaryNetworkID && staker.NodeID == nodeID {
+			return true, nil
+		}
+	}
+
+	pendingStakerIterator, err := chainState.GetPendingStakerIterator()
+	if err != nil {
+		return false, err
+	}
+	defer pendingStakerIterator.Release()
+
+	for pendingStakerIterator.Next() {
+		staker := pendingStakerIterator.Value()
+		if staker.SubnetID != constants.PrimaryNetworkID && staker.NodeID == nodeID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// verifyCancelPendingStakerTx carries out the validation for a
+// CancelPendingStakerTx. It returns the staker to remove and the tx that added
+// it.
+func verifyCancelPendingStakerTx(
+	backend *Backend,
+	chainState state.Chain,
+	sTx *txs.Tx,
+	tx *txs.CancelPendingStakerTx,
+) (*state.Staker, txs.PermissionlessStaker, error) {
+	currentTimestamp := chainState.GetTimestamp()
+	if !backend.Config.IsCancelPendingStakerActivated(currentTimestamp) {
+		return nil, nil, errCancelPendingStakerNotActivated
+	}
+
+	// Verify the tx is well-formed
+	if err := syntacticVerify(backend, chainState, sTx); err != nil {
+		return nil, nil, err
+	}
+
+	stakerTx, _, err := chainState.GetTx(tx.TxID)
+	if err == database.ErrNotFound {
+		return nil, nil, fmt.Errorf("%s %w", tx.TxID, errNotPendingStaker)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get staker tx %s: %w", tx.TxID, err)
+	}
+
+	var (
+		staker    *state.Staker
+		uStakerTx txs.PermissionlessStaker
+	)
+	switch stakerTx := stakerTx.Unsigned.(type) {
+	case txs.ValidatorTx:
+		subnetID := stakerTx.SubnetID()
+		staker, err = chainState.GetPendingValidator(subnetID, stakerTx.NodeID())
+		if err != nil && err != database.ErrNotFound {
+			return nil, nil, fmt.Errorf("failed to get pending validator %s: %w", stakerTx.NodeID(), err)
+		}
+		if staker == nil || staker.TxID != tx.TxID {
+			return nil, nil, fmt.Errorf("%s %w", tx.TxID, errNotPendingStaker)
+		}
+
+		if subnetID == constants.PrimaryNetworkID {
+			// The staking period of a subnet validator must be contained in
+			// the one of its primary network validator.
+			validatingSubnets, err := isSubnetValidator(chainState, staker.NodeID)
+			if err != nil {
+				return nil, nil, err
+			}
+			if validatingSubnets {
+				return nil, nil, fmt.Errorf("%s: %w", staker.NodeID, errValidatingSubnets)
+			}
+		}
+		uStakerTx = stakerTx
+	case txs.DelegatorTx:
+		staker, err = getPendingDelegator(chainState, stakerTx.SubnetID(), stakerTx.NodeID(), tx.TxID)
+		if err != nil {
+			return nil, nil, err
+		}
+		uStakerTx = stakerTx
+	default:
+		return nil, nil, fmt.Errorf("%s %w", tx.TxID, errNotPendingStaker)
+	}
+
+	// A staker whose staking period started is promoted to the current
+	// stakers.
+	if !staker.StartTime.After(currentTimestamp) {
+		return nil, nil, fmt.Errorf(
+			"%w: TxID = %s with %s <= %s",
+			errStakingPeriodStarted,
+			tx.TxID,
+			staker.StartTime,
+			currentTimestamp,
+		)
+	}
+
+	if !backend.Bootstrapped.Get() {
+		// Not bootstrapped yet -- don't need to do full verification.
+		return staker, uStakerTx, nil
+	}
+
+	if len(sTx.Creds) == 0 {
+		// Ensure there is at least one credential for the staker
+		// authorization
+		return nil, nil, errWrongNumberOfCredentials
+	}
+
+	// The stake is refunded, so the cancellation must be authorized by the
+	// owner of the stake rather than by the owner of the rewards.
+	owner, err := txs.StakeOwner(uStakerTx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", errUnauthorizedStakerModification, err)
+	}
+
+	baseTxCredsLen := len(sTx.Creds) - 1
+	stakerCred := sTx.Creds[baseTxCredsLen]
+	if err := backend.Fx.VerifyPermission(sTx.Unsigned, tx.StakerAuth, stakerCred, owner); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", errUnauthorizedStakerModification, err)
+	}
+
+	// Verify the flowcheck
+	if err := backend.FlowChecker.VerifySpend(
+		tx,
+		chainState,
+		tx.Ins,
+		tx.Outs,
+		sTx.Creds[:baseTxCredsLen],
+		map[ids.ID]uint64{
+			backend.Ctx.DIONEAssetID: dynamicFee(backend, chainState, backend.Config.TxFee),
+		},
+	); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
+	}
+
+	return staker, uStakerTx, nil
+}
+
+// getPendingDelegator returns the pending delegator of [nodeID] on [subnetID]
+// added by [txID].
+func getPendingDelegator(chainState state.Chain, subnetID ids.ID, nodeID ids.NodeID, txID ids.ID) (*state.Staker, error) {
+	delegatorIterator, err := chainState.GetPendingDelegatorIterator(subnetID, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending delegators of %s: %w", nodeID, err)
+	}
+	defer delegatorIterator.Release()
+
+	for delegatorIterator.Next() {
+		delegator := delegatorIterator.Value()
+		if delegator.TxID == txID {
+			return delegator, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %w", txID, errNotPendingStaker)
+}
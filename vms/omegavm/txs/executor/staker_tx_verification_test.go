@@ -421,6 +421,7 @@ func TestVerifyAddPermissionlessValidatorTx(t *testing.T) {
 					EndTime:   verifiedTx.EndTime(),
 				}
 				mockState.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, verifiedTx.NodeID()).Return(primaryNetworkVdr, nil)
+				mockState.EXPECT().GetSubnetFeeConfig(subnetID).Return(nil, database.ErrNotFound)
 				return mockState
 			},
 			sTxF: func() *txs.Tx {
@@ -467,6 +468,7 @@ func TestVerifyAddPermissionlessValidatorTx(t *testing.T) {
 					EndTime:   mockable.MaxTime,
 				}
 				mockState.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, verifiedTx.NodeID()).Return(primaryNetworkVdr, nil)
+				mockState.EXPECT().GetSubnetFeeConfig(subnetID).Return(nil, database.ErrNotFound)
 				return mockState
 			},
 			sTxF: func() *txs.Tx {
@@ -517,6 +519,60 @@ func TestVerifyAddPermissionlessValidatorTx(t *testing.T) {
 					EndTime:   mockable.MaxTime,
 				}
 				mockState.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, verifiedTx.NodeID()).Return(primaryNetworkVdr, nil)
+				mockState.EXPECT().GetSubnetFeeConfig(subnetID).Return(nil, database.ErrNotFound)
+				return mockState
+			},
+			sTxF: func() *txs.Tx {
+				return &verifiedSignedTx
+			},
+			txF: func() *txs.AddPermissionlessValidatorTx {
+				return &verifiedTx
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "success with subnet fee config",
+			backendF: func(ctrl *gomock.Controller) *Backend {
+				bootstrapped := &utils.Atomic[bool]{}
+				bootstrapped.Set(true)
+
+				// The subnet fee is charged in the staking asset of the
+				// subnet, on top of the DIONE fee.
+				ctx := snow.DefaultContextTest()
+				flowChecker := utxo.NewMockVerifier(ctrl)
+				flowChecker.EXPECT().VerifySpend(
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					map[ids.ID]uint64{
+						ctx.DIONEAssetID: 1,
+						customAssetID:    7,
+					},
+				).Return(nil)
+
+				return &Backend{
+					FlowChecker: flowChecker,
+					Config: &config.Config{
+						AddSubnetValidatorFee: 1,
+					},
+					Ctx:          ctx,
+					Bootstrapped: bootstrapped,
+				}
+			},
+			stateF: func(ctrl *gomock.Controller) state.Chain {
+				mockState := state.NewMockChain(ctrl)
+				mockState.EXPECT().GetTimestamp().Return(time.Unix(0, 0))
+				mockState.EXPECT().GetSubnetTransformation(subnetID).Return(&transformTx, nil)
+				mockState.EXPECT().GetCurrentValidator(subnetID, verifiedTx.NodeID()).Return(nil, database.ErrNotFound)
+				mockState.EXPECT().GetPendingValidator(subnetID, verifiedTx.NodeID()).Return(nil, database.ErrNotFound)
+				primaryNetworkVdr := &state.Staker{
+					StartTime: time.Unix(0, 0),
+					EndTime:   mockable.MaxTime,
+				}
+				mockState.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, verifiedTx.NodeID()).Return(primaryNetworkVdr, nil)
+				mockState.EXPECT().GetSubnetFeeConfig(subnetID).Return(&state.SubnetFeeConfig{AddValidatorFee: 7}, nil)
 				return mockState
 			},
 			sTxF: func() *txs.Tx {
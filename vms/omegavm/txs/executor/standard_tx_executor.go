@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/DioneProtocol/odysseygo/chains/atomic"
+	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/set"
@@ -26,18 +27,53 @@ var (
 
 	errEmptyNodeID              = errors.New("validator nodeID cannot be empty")
 	errMaxStakeDurationTooLarge = errors.New("max stake duration must be less than or equal to the global max stake duration")
+	errBaseTxNotActivated       = errors.New("attempting to use a BaseTx before its activation")
+
+	errTransferSubnetOwnershipNotActivated = errors.New("attempting to use a TransferSubnetOwnershipTx before its activation")
+	errBLSKeyRotationNotActivated          = errors.New("attempting to use a RotateBLSKeyTx before its activation")
+	errNotCurrentValidator                 = errors.New("isn't a current primary network validator")
+	errBLSKeyRotationPending               = errors.New("the previous BLS key rotation of the validator isn't effective yet")
+	errAutoRestakeNotActivated             = errors.New("attempting to use a SetAutoRestakeTx before its activation")
+	errEarlyUnstakeNotActivated            = errors.New("attempting to use an EarlyUnstakeTx before its activation")
+	errNotCurrentStaker                    = errors.New("isn't a current primary network staker")
+	errStakingPeriodOver                   = errors.New("staking period is over")
+	errValidatingSubnets                   = errors.New("primary network validator is still validating subnets")
+	errUnauthorizedStakerModification      = errors.New("unauthorized staker modification")
+	errUnexpectedStakeOutput               = errors.New("unexpected stake output type")
+	errRedelegateNotActivated              = errors.New("attempting to use a RedelegateTx before its activation")
+	errRedelegateToSameValidator           = errors.New("delegator is already delegating to the validator")
+	errUptimeAttestationNotActivated       = errors.New("attempting to use an UptimeAttestationTx before its activation")
+	errUpDurationTooLong                   = errors.New("up duration is longer than the staking period so far")
+	errInvalidUptimeAttestation            = errors.New("invalid uptime attestation signature")
+	errSubnetFeesNotActivated              = errors.New("attempting to use a SetSubnetFeeConfigTx before its activation")
+	errNotElasticSubnet                    = errors.New("isn't an elastic subnet")
+	errCancelPendingStakerNotActivated     = errors.New("attempting to use a CancelPendingStakerTx before its activation")
+	errNotPendingStaker                    = errors.New("isn't a pending staker")
+	errStakingPeriodStarted                = errors.New("staking period has started")
 )
 
+// BLSKeyRotationDelay is the number of blocks after the block including a
+// [txs.RotateBLSKeyTx] at which the new key takes effect.
+const BLSKeyRotationDelay = 64
+
 type StandardTxExecutor struct {
 	// inputs, to be filled before visitor methods are called
 	*Backend
 	State state.Diff // state is expected to be modified
 	Tx    *txs.Tx
+	// Height of the block including [Tx]
+	Height uint64
 
 	// outputs of visitor execution
 	OnAccept       func() // may be nil
 	Inputs         set.Set[ids.ID]
 	AtomicRequests map[ids.ID]*atomic.Requests // may be nil
+	// [UndistributedReward] is the stake and reward forfeited by a staker
+	// removed before its end time
+	UndistributedReward uint64
+	// [ForfeitedOrionFees] are the nodes whose validator was removed before
+	// its end time, which forfeits the orion fee accrued by the node
+	ForfeitedOrionFees []ids.NodeID
 }
 
 func (*StandardTxExecutor) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
@@ -49,7 +85,7 @@ func (*StandardTxExecutor) RewardValidatorTx(*txs.RewardValidatorTx) error {
 }
 
 func (e *StandardTxExecutor) CreateChainTx(tx *txs.CreateChainTx) error {
-	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+	if err := syntacticVerify(e.Backend, e.State, e.Tx); err != nil {
 		return err
 	}
 
@@ -60,7 +96,7 @@ func (e *StandardTxExecutor) CreateChainTx(tx *txs.CreateChainTx) error {
 
 	// Verify the flowcheck
 	timestamp := e.State.GetTimestamp()
-	createBlockchainTxFee := e.Config.GetCreateBlockchainTxFee(timestamp)
+	createBlockchainTxFee := dynamicFee(e.Backend, e.State, e.Config.GetCreateBlockchainTxFee(timestamp))
 	if err := e.FlowChecker.VerifySpend(
 		tx,
 		e.State,
@@ -93,13 +129,13 @@ func (e *StandardTxExecutor) CreateChainTx(tx *txs.CreateChainTx) error {
 
 func (e *StandardTxExecutor) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
 	// Make sure this transaction is well formed.
-	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+	if err := syntacticVerify(e.Backend, e.State, e.Tx); err != nil {
 		return err
 	}
 
 	// Verify the flowcheck
 	timestamp := e.State.GetTimestamp()
-	createSubnetTxFee := e.Config.GetCreateSubnetTxFee(timestamp)
+	createSubnetTxFee := dynamicFee(e.Backend, e.State, e.Config.GetCreateSubnetTxFee(timestamp))
 	if err := e.FlowChecker.VerifySpend(
 		tx,
 		e.State,
@@ -125,7 +161,7 @@ func (e *StandardTxExecutor) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
 }
 
 func (e *StandardTxExecutor) ImportTx(tx *txs.ImportTx) error {
-	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+	if err := syntacticVerify(e.Backend, e.State, e.Tx); err != nil {
 		return err
 	}
 
@@ -177,7 +213,7 @@ func (e *StandardTxExecutor) ImportTx(tx *txs.ImportTx) error {
 			tx.Outs,
 			e.Tx.Creds,
 			map[ids.ID]uint64{
-				e.Ctx.DIONEAssetID: e.Config.TxFee,
+				e.Ctx.DIONEAssetID: dynamicFee(e.Backend, e.State, e.Config.TxFee),
 			},
 		); err != nil {
 			return err
@@ -203,7 +239,7 @@ func (e *StandardTxExecutor) ImportTx(tx *txs.ImportTx) error {
 }
 
 func (e *StandardTxExecutor) ExportTx(tx *txs.ExportTx) error {
-	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+	if err := syntacticVerify(e.Backend, e.State, e.Tx); err != nil {
 		return err
 	}
 
@@ -225,7 +261,7 @@ func (e *StandardTxExecutor) ExportTx(tx *txs.ExportTx) error {
 		outs,
 		e.Tx.Creds,
 		map[ids.ID]uint64{
-			e.Ctx.DIONEAssetID: e.Config.TxFee,
+			e.Ctx.DIONEAssetID: dynamicFee(e.Backend, e.State, e.Config.TxFee),
 		},
 	); err != nil {
 		return fmt.Errorf("failed verifySpend: %w", err)
@@ -388,7 +424,7 @@ func (e *StandardTxExecutor) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidat
 }
 
 func (e *StandardTxExecutor) TransformSubnetTx(tx *txs.TransformSubnetTx) error {
-	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+	if err := syntacticVerify(e.Backend, e.State, e.Tx); err != nil {
 		return err
 	}
 
@@ -418,7 +454,7 @@ func (e *StandardTxExecutor) TransformSubnetTx(tx *txs.TransformSubnetTx) error
 		//            entry in this map literal from being overwritten by the
 		//            second entry.
 		map[ids.ID]uint64{
-			e.Ctx.DIONEAssetID: e.Config.TransformSubnetTxFee,
+			e.Ctx.DIONEAssetID: dynamicFee(e.Backend, e.State, e.Config.TransformSubnetTxFee),
 			tx.AssetID:         totalRewardAmount,
 		},
 	); err != nil {
@@ -457,6 +493,16 @@ func (e *StandardTxExecutor) AddPermissionlessValidatorTx(tx *txs.AddPermissionl
 	dione.Consume(e.State, tx.Ins)
 	dione.Produce(e.State, txID, tx.Outs)
 
+	feeConfig, err := getSubnetFeeConfig(e.State, tx.Subnet)
+	if err != nil {
+		return err
+	}
+	if feeConfig != nil {
+		if err := distributeSubnetFee(e.State, tx.Subnet, feeConfig.AddValidatorFee); err != nil {
+			return err
+		}
+	}
+
 	if e.Config.PartialSyncPrimaryNetwork &&
 		tx.Subnet == constants.PrimaryNetworkID &&
 		tx.Validator.NodeID == e.Ctx.NodeID {
@@ -491,5 +537,336 @@ func (e *StandardTxExecutor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionl
 	dione.Consume(e.State, tx.Ins)
 	dione.Produce(e.State, txID, tx.Outs)
 
+	feeConfig, err := getSubnetFeeConfig(e.State, tx.Subnet)
+	if err != nil {
+		return err
+	}
+	if feeConfig != nil {
+		return distributeSubnetFee(e.State, tx.Subnet, feeConfig.AddDelegatorFee)
+	}
+	return nil
+}
+
+func (e *StandardTxExecutor) BaseTx(tx *txs.BaseTx) error {
+	if !e.Config.IsBaseTxActivated(e.State.GetTimestamp()) {
+		return errBaseTxNotActivated
+	}
+	if err := syntacticVerify(e.Backend, e.State, e.Tx); err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds,
+		map[ids.ID]uint64{
+			e.Ctx.DIONEAssetID: dynamicFee(e.Backend, e.State, e.Config.TxFee),
+		},
+	); err != nil {
+		return fmt.Errorf("failed verifySpend: %w", err)
+	}
+
+	txID := e.Tx.ID()
+
+	// Consume the UTXOS
+	dione.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	dione.Produce(e.State, txID, tx.Outs)
+	return nil
+}
+
+func (e *StandardTxExecutor) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
+	if !e.Config.IsTransferSubnetOwnershipActivated(e.State.GetTimestamp()) {
+		return errTransferSubnetOwnershipNotActivated
+	}
+	if err := syntacticVerify(e.Backend, e.State, e.Tx); err != nil {
+		return err
+	}
+
+	baseTxCreds, err := verifyPoASubnetAuthorization(e.Backend, e.State, e.Tx, tx.Subnet, tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.Ctx.DIONEAssetID: dynamicFee(e.Backend, e.State, e.Config.TxFee),
+		},
+	); err != nil {
+		return fmt.Errorf("failed verifySpend: %w", err)
+	}
+
+	e.State.SetSubnetOwner(tx.Subnet, tx.Owner)
+
+	txID := e.Tx.ID()
+
+	// Consume the UTXOS
+	dione.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	dione.Produce(e.State, txID, tx.Outs)
+	return nil
+}
+
+func (e *StandardTxExecutor) RotateBLSKeyTx(tx *txs.RotateBLSKeyTx) error {
+	if !e.Config.IsBLSKeyRotationActivated(e.State.GetTimestamp()) {
+		return errBLSKeyRotationNotActivated
+	}
+	if err := syntacticVerify(e.Backend, e.State, e.Tx); err != nil {
+		return err
+	}
+
+	validator, err := e.State.GetCurrentValidator(constants.PrimaryNetworkID, tx.NodeID)
+	if err == database.ErrNotFound {
+		return fmt.Errorf("%s %w", tx.NodeID, errNotCurrentValidator)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get validator %s: %w", tx.NodeID, err)
+	}
+
+	// The key of the validator is replaced starting at [rotationHeight], so
+	// the key it is replacing is the key used at [rotationHeight]-1.
+	rotationHeight := e.Height + BLSKeyRotationDelay
+	previousPublicKey := validator.PublicKey
+	previousRotation, err := e.State.GetBLSKeyRotation(tx.NodeID)
+	switch {
+	case err == database.ErrNotFound:
+	case err != nil:
+		return fmt.Errorf("failed to get BLS key rotation of %s: %w", tx.NodeID, err)
+	case previousRotation.ValidatorTxID != validator.TxID:
+		// The rotation belongs to a previous validation period of the node.
+	case previousRotation.Height > e.Height:
+		return errBLSKeyRotationPending
+	default:
+		previousPublicKey = previousRotation.PublicKey
+	}
+
+	baseTxCreds, err := verifyValidatorAuthorization(e.Backend, e.State, e.Tx, validator, tx.ValidatorAuth)
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.Ctx.DIONEAssetID: dynamicFee(e.Backend, e.State, e.Config.TxFee),
+		},
+	); err != nil {
+		return fmt.Errorf("failed verifySpend: %w", err)
+	}
+
+	txID := e.Tx.ID()
+	e.State.PutBLSKeyRotation(&state.BLSKeyRotation{
+		TxID:              txID,
+		NodeID:            tx.NodeID,
+		ValidatorTxID:     validator.TxID,
+		PreviousPublicKey: previousPublicKey,
+		PublicKey:         tx.PublicKey(),
+		Height:            rotationHeight,
+	})
+
+	// Consume the UTXOS
+	dione.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	dione.Produce(e.State, txID, tx.Outs)
+	return nil
+}
+
+func (e *StandardTxExecutor) SetAutoRestakeTx(tx *txs.SetAutoRestakeTx) error {
+	if !e.Config.IsAutoRestakeActivated(e.State.GetTimestamp()) {
+		return errAutoRestakeNotActivated
+	}
+	if err := syntacticVerify(e.Backend, e.State, e.Tx); err != nil {
+		return err
+	}
+
+	validator, err := GetValidator(e.State, constants.PrimaryNetworkID, tx.NodeID)
+	if err == database.ErrNotFound {
+		return fmt.Errorf("%s %w of the primary network", tx.NodeID, ErrNotValidator)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get validator %s: %w", tx.NodeID, err)
+	}
+
+	baseTxCreds, err := verifyValidatorAuthorization(e.Backend, e.State, e.Tx, validator, tx.ValidatorAuth)
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.Ctx.DIONEAssetID: dynamicFee(e.Backend, e.State, e.Config.TxFee),
+		},
+	); err != nil {
+		return fmt.Errorf("failed verifySpend: %w", err)
+	}
+
+	e.State.SetAutoRestake(validator.TxID, tx.AutoRestake)
+
+	txID := e.Tx.ID()
+	// Consume the UTXOS
+	dione.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	dione.Produce(e.State, txID, tx.Outs)
+	return nil
+}
+
+func (e *StandardTxExecutor) EarlyUnstakeTx(tx *txs.EarlyUnstakeTx) error {
+	staker, stakerTx, err := verifyEarlyUnstakeTx(
+		e.Backend,
+		e.State,
+		e.Tx,
+		tx,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := e.unstakeEarly(staker, stakerTx); err != nil {
+		return err
+	}
+
+	txID := e.Tx.ID()
+	// Consume the UTXOS
+	dione.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	dione.Produce(e.State, txID, tx.Outs)
+	return nil
+}
+
+func (e *StandardTxExecutor) RedelegateTx(tx *txs.RedelegateTx) error {
+	delegator, delegatorTx, err := verifyRedelegateTx(
+		e.Backend,
+		e.State,
+		e.Tx,
+		tx,
+	)
+	if err != nil {
+		return err
+	}
+
+	txID := e.Tx.ID()
+	if err := e.redelegate(txID, delegator, delegatorTx, tx.NodeID); err != nil {
+		return err
+	}
+
+	// Consume the UTXOS
+	dione.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	dione.Produce(e.State, txID, tx.Outs)
+	return nil
+}
+
+func (e *StandardTxExecutor) UptimeAttestationTx(tx *txs.UptimeAttestationTx) error {
+	if err := verifyUptimeAttestationTx(
+		e.Backend,
+		e.State,
+		e.Tx,
+		tx,
+	); err != nil {
+		return err
+	}
+
+	if err := e.attestUptimes(tx.Attestation.Uptimes); err != nil {
+		return err
+	}
+
+	txID := e.Tx.ID()
+	// Consume the UTXOS
+	dione.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	dione.Produce(e.State, txID, tx.Outs)
+	return nil
+}
+
+func (e *StandardTxExecutor) SetSubnetFeeConfigTx(tx *txs.SetSubnetFeeConfigTx) error {
+	if !e.Config.IsSubnetFeesActivated(e.State.GetTimestamp()) {
+		return errSubnetFeesNotActivated
+	}
+	if err := syntacticVerify(e.Backend, e.State, e.Tx); err != nil {
+		return err
+	}
+
+	baseTxCreds, err := verifySubnetAuthorization(e.Backend, e.State, e.Tx, tx.Subnet, tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+
+	// Only elastic subnets have a staking asset to charge their fees in.
+	_, err = e.State.GetSubnetTransformation(tx.Subnet)
+	if err == database.ErrNotFound {
+		return fmt.Errorf("%q %w", tx.Subnet, errNotElasticSubnet)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.Ctx.DIONEAssetID: dynamicFee(e.Backend, e.State, e.Config.TxFee),
+		},
+	); err != nil {
+		return fmt.Errorf("failed verifySpend: %w", err)
+	}
+
+	e.State.SetSubnetFeeConfig(tx.Subnet, &state.SubnetFeeConfig{
+		AddValidatorFee: tx.AddValidatorFee,
+		AddDelegatorFee: tx.AddDelegatorFee,
+	})
+
+	txID := e.Tx.ID()
+
+	// Consume the UTXOS
+	dione.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	dione.Produce(e.State, txID, tx.Outs)
+	return nil
+}
+
+func (e *StandardTxExecutor) CancelPendingStakerTx(tx *txs.CancelPendingStakerTx) error {
+	staker, stakerTx, err := verifyCancelPendingStakerTx(
+		e.Backend,
+		e.State,
+		e.Tx,
+		tx,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := e.cancelPendingStaker(staker, stakerTx); err != nil {
+		return err
+	}
+
+	txID := e.Tx.ID()
+	// Consume the UTXOS
+	dione.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	dione.Produce(e.State, txID, tx.Outs)
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
@@ -74,6 +75,7 @@ type stateChanges struct {
 	accumulatedMintRate       *big.Int
 	feePerWeightStored        *big.Int
 	lastAccumulatedFee        uint64
+	mintedSupply              uint64
 }
 
 func (s *stateChanges) Apply(stateDiff state.Diff) {
@@ -107,6 +109,9 @@ func (s *stateChanges) Apply(stateDiff state.Diff) {
 	if s.feePerWeightStored != nil {
 		stateDiff.SetFeePerWeightStored(s.feePerWeightStored)
 	}
+	if s.mintedSupply != 0 {
+		stateDiff.AddMintedSupply(s.mintedSupply)
+	}
 }
 
 func (s *stateChanges) Len() int {
@@ -136,10 +141,8 @@ func (s *stateChanges) updateAccumulatedMintRate(backend *Backend, parentState s
 		return err
 	}
 
-	mintConfig := backend.Config.MintConfig
-
 	// Config is not set
-	if mintConfig.MintingPeriod == 0 {
+	if !backend.Config.MintSchedule.IsSet() {
 		s.accumulatedMintRate = new(big.Int).SetUint64(0)
 		return nil
 	}
@@ -286,6 +289,16 @@ func AdvanceTimeTo(
 			)
 			stakerToAdd.PotentialReward = potentialReward
 
+			// The staker is rewarded the fees the subnet collects from now on.
+			feePool, err := parentState.GetSubnetFeePool(stakerToRemove.SubnetID)
+			switch err {
+			case nil:
+				stakerToAdd.FeePerWeightPaid = new(big.Int).Set(feePool.FeePerWeightStored)
+			case database.ErrNotFound:
+			default:
+				return nil, err
+			}
+
 			// Invariant: [rewards.Calculate] can never return a [potentialReward]
 			//            such that [supply + potentialReward > maximumSupply].
 			changes.updatedSupplies[stakerToRemove.SubnetID] = supply + potentialReward
@@ -346,6 +359,7 @@ func AdvanceTimeTo(
 
 			stakerToRemove.PotentialReward = mint + fee
 			changes.updatedSupplies[stakerToRemove.SubnetID] = supply + stakerToRemove.PotentialReward
+			changes.mintedSupply += mint
 		}
 
 		// Invariant: Permissioned stakers are encountered first for a given
@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"math/big"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+)
+
+// getSubnetFeeConfig returns the fee schedule of the elastic subnet
+// [subnetID], or nil if the subnet charges the fees of the primary network.
+func getSubnetFeeConfig(chainState state.Chain, subnetID ids.ID) (*state.SubnetFeeConfig, error) {
+	if subnetID == constants.PrimaryNetworkID {
+		return nil, nil
+	}
+	config, err := chainState.GetSubnetFeeConfig(subnetID)
+	if err == database.ErrNotFound {
+		return nil, nil
+	}
+	return config, err
+}
+
+// getSubnetFeePool returns the fees collected by the elastic subnet
+// [subnetID]. An empty pool is returned if the subnet never collected fees.
+func getSubnetFeePool(chainState state.Chain, subnetID ids.ID) (*state.SubnetFeePool, error) {
+	pool, err := chainState.GetSubnetFeePool(subnetID)
+	switch err {
+	case nil:
+		return pool, nil
+	case database.ErrNotFound:
+		return &state.SubnetFeePool{
+			FeePerWeightStored: new(big.Int),
+		}, nil
+	default:
+		return nil, err
+	}
+}
+
+// distributeSubnetFee distributes the [fee] collected by the elastic subnet
+// [subnetID] to its current stakers, pro rata of their weight. If no weight is
+// staked on the subnet, the fee is kept until the next distribution. The part
+// of the fee that can't be split evenly over the weight is carried forward to
+// the next distribution as well.
+func distributeSubnetFee(chainState state.Chain, subnetID ids.ID, fee uint64) error {
+	if fee == 0 {
+		return nil
+	}
+
+	pool, err := getSubnetFeePool(chainState, subnetID)
+	if err != nil {
+		return err
+	}
+	undistributed, err := math.Add64(pool.Undistributed, fee)
+	if err != nil {
+		return err
+	}
+	weight, err := chainState.GetCurrentStakedWeight(subnetID)
+	if err != nil {
+		return err
+	}
+
+	// The pool may be shared with the parent state, so it is never modified
+	// in place.
+	newPool := &state.SubnetFeePool{
+		FeePerWeightStored: new(big.Int).Set(pool.FeePerWeightStored),
+		Undistributed:      undistributed,
+		Remainder:          pool.Remainder,
+	}
+	if weight != 0 {
+		feePerWeightIncrement := new(big.Int).SetUint64(undistributed)
+		feePerWeightIncrement.Lsh(feePerWeightIncrement, reward.BitShift)
+		feePerWeightIncrement.Add(feePerWeightIncrement, new(big.Int).SetUint64(pool.Remainder))
+
+		// Invariant: [remainder] < [weight] so it fits in a uint64.
+		remainder := new(big.Int)
+		feePerWeightIncrement.DivMod(feePerWeightIncrement, new(big.Int).SetUint64(weight), remainder)
+
+		newPool.FeePerWeightStored.Add(newPool.FeePerWeightStored, feePerWeightIncrement)
+		newPool.Undistributed = 0
+		newPool.Remainder = remainder.Uint64()
+	}
+	chainState.SetSubnetFeePool(subnetID, newPool)
+	return nil
+}
+
+// subnetFeeReward returns the share of the fees collected by the subnet of
+// [staker] that was distributed to [staker] during its staking period.
+func subnetFeeReward(chainState state.Chain, staker *state.Staker) (uint64, error) {
+	if staker.SubnetID == constants.PrimaryNetworkID {
+		return 0, nil
+	}
+	pool, err := chainState.GetSubnetFeePool(staker.SubnetID)
+	switch err {
+	case nil:
+		return reward.CalculateFeeReward(pool.FeePerWeightStored, staker.Weight, staker.FeePerWeightPaid), nil
+	case database.ErrNotFound:
+		return 0, nil
+	default:
+		return 0, err
+	}
+}
+
+// forfeitSubnetFeeReward returns the fee reward [amount] forfeited by a staker
+// of [subnetID] to the fees the subnet has left to distribute.
+func forfeitSubnetFeeReward(chainState state.Chain, subnetID ids.ID, amount uint64) error {
+	if amount == 0 {
+		return nil
+	}
+
+	pool, err := getSubnetFeePool(chainState, subnetID)
+	if err != nil {
+		return err
+	}
+	undistributed, err := math.Add64(pool.Undistributed, amount)
+	if err != nil {
+		return err
+	}
+	chainState.SetSubnetFeePool(subnetID, &state.SubnetFeePool{
+		FeePerWeightStored: pool.FeePerWeightStored,
+		Undistributed:      undistributed,
+		Remainder:          pool.Remainder,
+	})
+	return nil
+}
@@ -0,0 +1,277 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+)
+
+func TestStandardTxExecutorSetSubnetFeeConfigTx(t *testing.T) {
+	tests := []struct {
+		description    string
+		subnetFeesTime time.Time
+		elastic        bool
+		expectedErr    error
+	}{
+		{
+			description:    "before activation",
+			subnetFeesTime: mockable.MaxTime,
+			elastic:        true,
+			expectedErr:    errSubnetFeesNotActivated,
+		},
+		{
+			description:    "not an elastic subnet",
+			subnetFeesTime: time.Time{},
+			elastic:        false,
+			expectedErr:    errNotElasticSubnet,
+		},
+		{
+			description:    "elastic subnet",
+			subnetFeesTime: time.Time{},
+			elastic:        true,
+			expectedErr:    nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.SubnetFeesTime = test.subnetFeesTime
+
+			subnetID := testSubnet1.ID()
+			if test.elastic {
+				_, transformSubnetTx := newTransformSubnetTx(t)
+				transformSubnetTx.Unsigned.(*txs.TransformSubnetTx).Subnet = subnetID
+				env.state.AddSubnetTransformation(transformSubnetTx)
+				env.state.AddTx(transformSubnetTx, status.Committed)
+				require.NoError(env.state.Commit())
+			}
+
+			tx, err := env.txBuilder.NewSetSubnetFeeConfigTx(
+				subnetID,
+				10, // addValidatorFee
+				5,  // addDelegatorFee
+				[]*secp256k1.PrivateKey{preFundedKeys[0], preFundedKeys[1]},
+				preFundedKeys[0].PublicKey().Address(), // change addr
+			)
+			require.NoError(err)
+
+			onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor := StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+			}
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			feeConfig, err := onAcceptState.GetSubnetFeeConfig(subnetID)
+			require.NoError(err)
+			require.Equal(&state.SubnetFeeConfig{
+				AddValidatorFee: 10,
+				AddDelegatorFee: 5,
+			}, feeConfig)
+		})
+	}
+}
+
+func TestSubnetFeeDistribution(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	subnetID := ids.GenerateTestID()
+	chainState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	// Without stake on the subnet, the fee is kept for later.
+	require.NoError(distributeSubnetFee(chainState, subnetID, 4))
+	pool, err := chainState.GetSubnetFeePool(subnetID)
+	require.NoError(err)
+	require.Equal(&state.SubnetFeePool{
+		FeePerWeightStored: new(big.Int),
+		Undistributed:      4,
+	}, pool)
+
+	endTime := chainState.GetTimestamp().Add(defaultMinValidatorStakingDuration)
+	validator := &state.Staker{
+		TxID:             ids.GenerateTestID(),
+		NodeID:           ids.GenerateTestNodeID(),
+		SubnetID:         subnetID,
+		Weight:           1,
+		EndTime:          endTime,
+		NextTime:         endTime,
+		Priority:         txs.SubnetPermissionlessValidatorCurrentPriority,
+		MintRate:         new(big.Int),
+		FeePerWeightPaid: new(big.Int),
+	}
+	delegator := &state.Staker{
+		TxID:             ids.GenerateTestID(),
+		NodeID:           validator.NodeID,
+		SubnetID:         subnetID,
+		Weight:           3,
+		EndTime:          endTime,
+		NextTime:         endTime,
+		Priority:         txs.SubnetPermissionlessDelegatorCurrentPriority,
+		MintRate:         new(big.Int),
+		FeePerWeightPaid: new(big.Int),
+	}
+	chainState.PutCurrentValidator(validator)
+	chainState.PutCurrentDelegator(delegator)
+
+	// The kept fee is distributed with the next one, pro rata of the weight
+	// of the stakers.
+	require.NoError(distributeSubnetFee(chainState, subnetID, 8))
+	pool, err = chainState.GetSubnetFeePool(subnetID)
+	require.NoError(err)
+	require.Zero(pool.Undistributed)
+
+	validatorFeeReward, err := subnetFeeReward(chainState, validator)
+	require.NoError(err)
+	require.Equal(uint64(3), validatorFeeReward)
+
+	delegatorFeeReward, err := subnetFeeReward(chainState, delegator)
+	require.NoError(err)
+	require.Equal(uint64(9), delegatorFeeReward)
+
+	// A staker that joins afterwards isn't rewarded the previous fees.
+	lateDelegator := *delegator
+	lateDelegator.TxID = ids.GenerateTestID()
+	lateDelegator.FeePerWeightPaid = new(big.Int).Set(pool.FeePerWeightStored)
+	lateDelegatorFeeReward, err := subnetFeeReward(chainState, &lateDelegator)
+	require.NoError(err)
+	require.Zero(lateDelegatorFeeReward)
+
+	// A forfeited fee reward is distributed again later.
+	require.NoError(forfeitSubnetFeeReward(chainState, subnetID, validatorFeeReward))
+	forfeitedPool, err := chainState.GetSubnetFeePool(subnetID)
+	require.NoError(err)
+	require.Equal(pool.FeePerWeightStored, forfeitedPool.FeePerWeightStored)
+	require.Equal(validatorFeeReward, forfeitedPool.Undistributed)
+
+	// Primary network stakers aren't rewarded subnet fees.
+	primaryNetworkStaker := *validator
+	primaryNetworkStaker.SubnetID = constants.PrimaryNetworkID
+	primaryNetworkFeeReward, err := subnetFeeReward(chainState, &primaryNetworkStaker)
+	require.NoError(err)
+	require.Zero(primaryNetworkFeeReward)
+}
+
+func TestSubnetFeeDistributionRemainder(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	subnetID := ids.GenerateTestID()
+	chainState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	endTime := chainState.GetTimestamp().Add(defaultMinValidatorStakingDuration)
+	validator := &state.Staker{
+		TxID:             ids.GenerateTestID(),
+		NodeID:           ids.GenerateTestNodeID(),
+		SubnetID:         subnetID,
+		Weight:           3,
+		EndTime:          endTime,
+		NextTime:         endTime,
+		Priority:         txs.SubnetPermissionlessValidatorCurrentPriority,
+		MintRate:         new(big.Int),
+		FeePerWeightPaid: new(big.Int),
+	}
+	chainState.PutCurrentValidator(validator)
+
+	// A fee that can't be split evenly over the weight leaves a remainder.
+	require.NoError(distributeSubnetFee(chainState, subnetID, 1))
+	pool, err := chainState.GetSubnetFeePool(subnetID)
+	require.NoError(err)
+	require.Zero(pool.Undistributed)
+	require.Equal(uint64(1), pool.Remainder)
+
+	// The remainders are carried forward until they add up to a split fee.
+	require.NoError(distributeSubnetFee(chainState, subnetID, 1))
+	require.NoError(distributeSubnetFee(chainState, subnetID, 1))
+	pool, err = chainState.GetSubnetFeePool(subnetID)
+	require.NoError(err)
+	require.Zero(pool.Remainder)
+
+	feeReward, err := subnetFeeReward(chainState, validator)
+	require.NoError(err)
+	require.Equal(uint64(3), feeReward)
+}
+
+func TestAdvanceTimeToSubnetFeePerWeightPaid(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	transformSubnet, transformSubnetTx := newTransformSubnetTx(t)
+	subnetID := transformSubnet.Subnet
+	env.state.AddSubnetTransformation(transformSubnetTx)
+	env.state.AddTx(transformSubnetTx, status.Committed)
+	env.state.SetCurrentSupply(subnetID, transformSubnet.InitialSupply)
+	require.NoError(env.state.Commit())
+
+	parentState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	feePerWeightStored := new(big.Int).Lsh(big.NewInt(5), reward.BitShift)
+	parentState.SetSubnetFeePool(subnetID, &state.SubnetFeePool{
+		FeePerWeightStored: feePerWeightStored,
+	})
+
+	startTime := parentState.GetTimestamp().Add(time.Second)
+	pendingValidator := &state.Staker{
+		TxID:             ids.GenerateTestID(),
+		NodeID:           ids.GenerateTestNodeID(),
+		SubnetID:         subnetID,
+		Weight:           transformSubnet.MinValidatorStake,
+		StartTime:        startTime,
+		EndTime:          startTime.Add(time.Second),
+		NextTime:         startTime,
+		Priority:         txs.SubnetPermissionlessValidatorPendingPriority,
+		MintRate:         new(big.Int),
+		FeePerWeightPaid: new(big.Int),
+	}
+	parentState.PutPendingValidator(pendingValidator)
+
+	changes, err := AdvanceTimeTo(&env.backend, parentState, startTime)
+	require.NoError(err)
+	changes.Apply(parentState)
+
+	// The promoted staker is only rewarded the fees collected from now on.
+	validator, err := parentState.GetCurrentValidator(subnetID, pendingValidator.NodeID)
+	require.NoError(err)
+	require.Equal(feePerWeightStored, validator.FeePerWeightPaid)
+
+	feeReward, err := subnetFeeReward(parentState, validator)
+	require.NoError(err)
+	require.Zero(feeReward)
+}
@@ -0,0 +1,26 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+)
+
+var errWeightedOwnersNotActivated = errors.New("attempting to use weighted owners before their activation")
+
+// syntacticVerify verifies that [tx] is well-formed and that it only uses
+// weighted owners once they are activated at the time of [chainState].
+func syntacticVerify(backend *Backend, chainState state.Chain, tx *txs.Tx) error {
+	if err := tx.SyntacticVerify(backend.Ctx); err != nil {
+		return err
+	}
+	// The state isn't read unless weighted owners are used.
+	if txs.UsesWeightedOwners(tx.Unsigned) && !backend.Config.IsWeightedOwnersActivated(chainState.GetTimestamp()) {
+		return errWeightedOwnersNotActivated
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestStandardTxExecutorWeightedOwners(t *testing.T) {
+	tests := []struct {
+		description        string
+		weightedOwnersTime time.Time
+		expectedErr        error
+	}{
+		{
+			description:        "before activation",
+			weightedOwnersTime: mockable.MaxTime,
+			expectedErr:        errWeightedOwnersNotActivated,
+		},
+		{
+			description:        "after activation",
+			weightedOwnersTime: time.Time{},
+			expectedErr:        nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, true /*=postBanff*/, false /*=postCortina*/)
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.WeightedOwnersTime = test.weightedOwnersTime
+
+			key := preFundedKeys[0]
+			tx, err := env.txBuilder.NewCreateSubnetTx(
+				1,
+				[]ids.ShortID{key.PublicKey().Address()},
+				[]*secp256k1.PrivateKey{key},
+				key.PublicKey().Address(),
+			)
+			require.NoError(err)
+
+			// Give the subnet weighted owners and sign the tx again
+			utx := tx.Unsigned.(*txs.CreateSubnetTx)
+			utx.Owner = &secp256k1fx.WeightedOutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{key.PublicKey().Address()},
+				Weights:   []uint32{1},
+			}
+			signers := make([][]*secp256k1.PrivateKey, len(utx.Ins))
+			for i := range signers {
+				signers[i] = []*secp256k1.PrivateKey{key}
+			}
+			tx, err = txs.NewSigned(utx, txs.Codec, signers)
+			require.NoError(err)
+
+			onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			err = tx.Unsigned.Visit(&StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+			})
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestStandardTxExecutorTransferSubnetOwnershipTx(t *testing.T) {
+	tests := []struct {
+		description                 string
+		transferSubnetOwnershipTime time.Time
+		expectedErr                 error
+	}{
+		{
+			description:                 "before activation",
+			transferSubnetOwnershipTime: mockable.MaxTime,
+			expectedErr:                 errTransferSubnetOwnershipNotActivated,
+		},
+		{
+			description:                 "after activation",
+			transferSubnetOwnershipTime: time.Time{},
+			expectedErr:                 nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, true /*=postBanff*/, false /*=postCortina*/)
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.TransferSubnetOwnershipTime = test.transferSubnetOwnershipTime
+
+			subnetID := testSubnet1.ID()
+			newOwnerKey := preFundedKeys[4]
+			newOwnerAddr := newOwnerKey.PublicKey().Address()
+			tx, err := env.txBuilder.NewTransferSubnetOwnershipTx(
+				subnetID,
+				1,
+				[]ids.ShortID{newOwnerAddr},
+				[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+				ids.ShortEmpty, // change addr
+			)
+			require.NoError(err)
+
+			onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor := StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+			}
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			owner, err := onAcceptState.GetSubnetOwner(subnetID)
+			require.NoError(err)
+			require.Equal(&secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{newOwnerAddr},
+			}, owner)
+
+			require.NoError(onAcceptState.Apply(env.state))
+			require.NoError(env.state.Commit())
+
+			// The previous owner can no longer manage the subnet.
+			_, err = env.txBuilder.NewTransferSubnetOwnershipTx(
+				subnetID,
+				1,
+				[]ids.ShortID{testSubnet1ControlKeys[0].PublicKey().Address()},
+				[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+				ids.ShortEmpty, // change addr
+			)
+			require.Error(err) //nolint:forbidigo // the error isn't exported
+
+			// The new owner can.
+			tx, err = env.txBuilder.NewTransferSubnetOwnershipTx(
+				subnetID,
+				1,
+				[]ids.ShortID{testSubnet1ControlKeys[0].PublicKey().Address()},
+				[]*secp256k1.PrivateKey{newOwnerKey},
+				ids.ShortEmpty, // change addr
+			)
+			require.NoError(err)
+
+			onAcceptState, err = state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor = StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+			}
+			require.NoError(tx.Unsigned.Visit(&executor))
+		})
+	}
+}
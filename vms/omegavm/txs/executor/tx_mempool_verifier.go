@@ -20,6 +20,8 @@ type MempoolTxVerifier struct {
 	ParentID      ids.ID
 	StateVersions state.Versions
 	Tx            *txs.Tx
+	// Height of the next block built on top of [ParentID]
+	Height uint64
 }
 
 func (*MempoolTxVerifier) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
@@ -74,6 +76,42 @@ func (v *MempoolTxVerifier) AddPermissionlessDelegatorTx(tx *txs.AddPermissionle
 	return v.standardTx(tx)
 }
 
+func (v *MempoolTxVerifier) BaseTx(tx *txs.BaseTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) RotateBLSKeyTx(tx *txs.RotateBLSKeyTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) SetAutoRestakeTx(tx *txs.SetAutoRestakeTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) EarlyUnstakeTx(tx *txs.EarlyUnstakeTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) RedelegateTx(tx *txs.RedelegateTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) UptimeAttestationTx(tx *txs.UptimeAttestationTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) SetSubnetFeeConfigTx(tx *txs.SetSubnetFeeConfigTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) CancelPendingStakerTx(tx *txs.CancelPendingStakerTx) error {
+	return v.standardTx(tx)
+}
+
 func (v *MempoolTxVerifier) standardTx(tx txs.UnsignedTx) error {
 	baseState, err := v.standardBaseState()
 	if err != nil {
@@ -84,6 +122,7 @@ func (v *MempoolTxVerifier) standardTx(tx txs.UnsignedTx) error {
 		Backend: v.Backend,
 		State:   baseState,
 		Tx:      v.Tx,
+		Height:  v.Height,
 	}
 	err = tx.Visit(&executor)
 	// We ignore [errFutureStakeTime] here because the time will be advanced
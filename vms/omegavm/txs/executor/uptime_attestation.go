@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"context"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+)
+
+// An uptime attestation must be signed by at least
+// [UptimeAttestationQuorumNumerator]/[UptimeAttestationQuorumDenominator] of
+// the stake of the primary network.
+const (
+	UptimeAttestationQuorumNumerator   = 67
+	UptimeAttestationQuorumDenominator = 100
+)
+
+var _ validators.State = (*chainValidatorState)(nil)
+
+// chainValidatorState exposes the current validators of [chainState] to the
+// verification of warp signatures. Heights are ignored: the validator set is
+// always the one of [chainState].
+type chainValidatorState struct {
+	chainState state.Chain
+}
+
+func (*chainValidatorState) GetMinimumHeight(context.Context) (uint64, error) {
+	return 0, nil
+}
+
+func (*chainValidatorState) GetCurrentHeight(context.Context) (uint64, error) {
+	return 0, nil
+}
+
+// GetSubnetID returns the primary network, as the validators of the primary
+// network sign the messages of the O-chain.
+func (*chainValidatorState) GetSubnetID(context.Context, ids.ID) (ids.ID, error) {
+	return constants.PrimaryNetworkID, nil
+}
+
+// GetValidatorSet returns the current validators of [subnetID], weighted by
+// their stake and the stake delegated to them.
+func (s *chainValidatorState) GetValidatorSet(
+	_ context.Context,
+	_ uint64,
+	subnetID ids.ID,
+) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	stakerIterator, err := s.chainState.GetCurrentStakerIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer stakerIterator.Release()
+
+	vdrs := make(map[ids.NodeID]*validators.GetValidatorOutput)
+	for stakerIterator.Next() {
+		staker := stakerIterator.Value()
+		if staker.SubnetID != subnetID {
+			continue
+		}
+
+		vdr, ok := vdrs[staker.NodeID]
+		if !ok {
+			vdr = &validators.GetValidatorOutput{
+				NodeID: staker.NodeID,
+			}
+			vdrs[staker.NodeID] = vdr
+		}
+		if staker.Priority.IsCurrentValidator() {
+			vdr.PublicKey = staker.PublicKey
+		}
+		vdr.Weight, err = math.Add64(vdr.Weight, staker.Weight)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return vdrs, nil
+}
+
+// attestUptimes records the attested [uptimes] in [e.State]. The up duration
+// of a validator only grows during its staking period, so the longest attested
+// duration is kept.
+func (e *StandardTxExecutor) attestUptimes(uptimes []*txs.ValidatorUptime) error {
+	chainTime := e.State.GetTimestamp()
+	for _, uptime := range uptimes {
+		upDuration := time.Duration(uptime.UpDuration) * time.Second
+		score, err := e.State.GetUptimeScore(uptime.NodeID)
+		switch {
+		case err == nil && score.ValidatorTxID == uptime.ValidatorTxID:
+			if upDuration <= score.UpDuration {
+				continue
+			}
+		case err == nil || err == database.ErrNotFound:
+			// The previous score, if any, belongs to a previous staking
+			// period of the node.
+		default:
+			return err
+		}
+
+		e.State.PutUptimeScore(&state.UptimeScore{
+			NodeID:        uptime.NodeID,
+			ValidatorTxID: uptime.ValidatorTxID,
+			UpDuration:    upDuration,
+			LastUpdated:   chainTime,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,423 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+)
+
+// uptimeAttestationTestValidator is a primary network validator with a BLS
+// key, added by [addUptimeAttestationTestValidators].
+type uptimeAttestationTestValidator struct {
+	sk     *bls.SecretKey
+	staker *state.Staker
+}
+
+// addUptimeAttestationTestValidators adds two primary network validators with
+// BLS keys to [env]. The first one holds more than the quorum of the stake of
+// the primary network, the second one less. The chain time is moved
+// [stakedDuration] after their start time.
+func addUptimeAttestationTestValidators(
+	t *testing.T,
+	env *environment,
+	stakedDuration time.Duration,
+) []*uptimeAttestationTestValidator {
+	require := require.New(t)
+
+	startTime := defaultValidateStartTime.Add(time.Second)
+	endTime := startTime.Add(2 * defaultMinValidatorStakingDuration)
+
+	weights := []uint64{400 * units.MilliDione, 100 * units.MilliDione}
+	vdrs := make([]*uptimeAttestationTestValidator, len(weights))
+	for i, weight := range weights {
+		sk, err := bls.NewSecretKey()
+		require.NoError(err)
+
+		key := preFundedKeys[i+1]
+		vdrTx, err := env.txBuilder.NewAddValidatorTx(
+			weight,
+			uint64(startTime.Unix()),
+			uint64(endTime.Unix()),
+			ids.GenerateTestNodeID(),
+			key.PublicKey().Address(),
+			0,
+			[]*secp256k1.PrivateKey{key},
+			ids.ShortEmpty,
+		)
+		require.NoError(err)
+		staker, err := state.NewCurrentStaker(vdrTx.ID(), vdrTx.Unsigned.(*txs.AddValidatorTx), 0)
+		require.NoError(err)
+		staker.PublicKey = bls.PublicFromSecretKey(sk)
+
+		env.state.PutCurrentValidator(staker)
+		env.state.AddTx(vdrTx, status.Committed)
+		vdrs[i] = &uptimeAttestationTestValidator{
+			sk:     sk,
+			staker: staker,
+		}
+	}
+
+	env.state.SetTimestamp(startTime.Add(stakedDuration))
+	env.state.SetHeight(1)
+	require.NoError(env.state.Commit())
+	return vdrs
+}
+
+// signUptimeAttestation returns the aggregate signature of [attestation] by
+// [signers].
+func signUptimeAttestation(
+	t *testing.T,
+	env *environment,
+	attestation txs.UptimeAttestation,
+	signers []*uptimeAttestationTestValidator,
+) warp.BitSetSignature {
+	require := require.New(t)
+
+	msg, err := attestation.UnsignedMessage(env.ctx.NetworkID, env.ctx.ChainID)
+	require.NoError(err)
+
+	canonicalVdrs, _, err := warp.GetCanonicalValidatorSet(
+		context.Background(),
+		&chainValidatorState{chainState: env.state},
+		0,
+		constants.PrimaryNetworkID,
+	)
+	require.NoError(err)
+
+	signerIndices := set.NewBits()
+	sigs := make([]*bls.Signature, len(signers))
+	for i, signer := range signers {
+		// The canonical validator set is keyed by the serialized, not
+		// compressed, public keys
+		pkBytes := bls.PublicFromSecretKey(signer.sk).Serialize()
+		found := false
+		for j, vdr := range canonicalVdrs {
+			if bytes.Equal(vdr.PublicKeyBytes, pkBytes) {
+				signerIndices.Add(j)
+				found = true
+			}
+		}
+		require.True(found, "signer isn't in the canonical validator set")
+		sigs[i] = bls.Sign(signer.sk, msg.Bytes())
+	}
+	aggSig, err := bls.AggregateSignatures(sigs)
+	require.NoError(err)
+
+	sig := warp.BitSetSignature{
+		Signers: signerIndices.Bytes(),
+	}
+	copy(sig.Signature[:], bls.SignatureToBytes(aggSig))
+	return sig
+}
+
+// newUptimeAttestation returns the attestation of [upDuration] for [vdrs].
+func newUptimeAttestation(vdrs []*uptimeAttestationTestValidator, upDuration time.Duration) txs.UptimeAttestation {
+	attestation := txs.UptimeAttestation{
+		Uptimes: make([]*txs.ValidatorUptime, len(vdrs)),
+	}
+	for i, vdr := range vdrs {
+		attestation.Uptimes[i] = &txs.ValidatorUptime{
+			NodeID:        vdr.staker.NodeID,
+			ValidatorTxID: vdr.staker.TxID,
+			UpDuration:    uint64(upDuration / time.Second),
+		}
+	}
+	utils.Sort(attestation.Uptimes)
+	return attestation
+}
+
+// executeUptimeAttestationTx executes an UptimeAttestationTx of [attestation]
+// signed by [signers] on top of the last accepted state.
+func executeUptimeAttestationTx(
+	t *testing.T,
+	env *environment,
+	attestation txs.UptimeAttestation,
+	signers []*uptimeAttestationTestValidator,
+) (state.Diff, error) {
+	require := require.New(t)
+
+	tx, err := env.txBuilder.NewUptimeAttestationTx(
+		attestation,
+		signUptimeAttestation(t, env, attestation, signers),
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		preFundedKeys[0].PublicKey().Address(), // change addr
+	)
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	return onAcceptState, tx.Unsigned.Visit(&executor)
+}
+
+func TestStandardTxExecutorUptimeAttestationTxActivation(t *testing.T) {
+	tests := []struct {
+		description           string
+		uptimeAttestationTime time.Time
+		expectedErr           error
+	}{
+		{
+			description:           "before activation",
+			uptimeAttestationTime: mockable.MaxTime,
+			expectedErr:           errUptimeAttestationNotActivated,
+		},
+		{
+			description:           "after activation",
+			uptimeAttestationTime: time.Time{},
+			expectedErr:           nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.UptimeAttestationTime = test.uptimeAttestationTime
+
+			vdrs := addUptimeAttestationTestValidators(t, env, time.Hour)
+
+			attestation := newUptimeAttestation(vdrs, time.Hour)
+			_, err := executeUptimeAttestationTx(t, env, attestation, vdrs[:1])
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestStandardTxExecutorUptimeAttestationTx(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	vdrs := addUptimeAttestationTestValidators(t, env, 2*time.Hour)
+	chainTime := env.state.GetTimestamp()
+
+	attestation := newUptimeAttestation(vdrs, time.Hour)
+	onAcceptState, err := executeUptimeAttestationTx(t, env, attestation, vdrs[:1])
+	require.NoError(err)
+	for _, vdr := range vdrs {
+		score, err := onAcceptState.GetUptimeScore(vdr.staker.NodeID)
+		require.NoError(err)
+		require.Equal(vdr.staker.TxID, score.ValidatorTxID)
+		require.Equal(time.Hour, score.UpDuration)
+		require.Equal(chainTime, score.LastUpdated)
+		require.Equal(0.5, score.Uptime(vdr.staker, chainTime))
+	}
+	require.NoError(onAcceptState.Apply(env.state))
+	require.NoError(env.state.Commit())
+
+	// A shorter up duration doesn't lower the score.
+	attestation = newUptimeAttestation(vdrs, time.Minute)
+	onAcceptState, err = executeUptimeAttestationTx(t, env, attestation, vdrs[:1])
+	require.NoError(err)
+	for _, vdr := range vdrs {
+		score, err := onAcceptState.GetUptimeScore(vdr.staker.NodeID)
+		require.NoError(err)
+		require.Equal(time.Hour, score.UpDuration)
+	}
+
+	// A longer up duration raises the score.
+	attestation = newUptimeAttestation(vdrs, 2*time.Hour)
+	onAcceptState, err = executeUptimeAttestationTx(t, env, attestation, vdrs[:1])
+	require.NoError(err)
+	for _, vdr := range vdrs {
+		score, err := onAcceptState.GetUptimeScore(vdr.staker.NodeID)
+		require.NoError(err)
+		require.Equal(2*time.Hour, score.UpDuration)
+		require.Equal(float64(1), score.Uptime(vdr.staker, chainTime))
+	}
+}
+
+func TestStandardTxExecutorUptimeAttestationTxInvalid(t *testing.T) {
+	tests := []struct {
+		description string
+		// updateAttestation modifies the attestation of an hour of uptime of
+		// the test validators
+		updateAttestation func(*txs.UptimeAttestation)
+		// signers returns the validators signing the attestation
+		signers     func([]*uptimeAttestationTestValidator) []*uptimeAttestationTestValidator
+		expectedErr error
+	}{
+		{
+			description: "not a validator",
+			updateAttestation: func(attestation *txs.UptimeAttestation) {
+				attestation.Uptimes[0].NodeID = ids.GenerateTestNodeID()
+			},
+			expectedErr: errNotCurrentValidator,
+		},
+		{
+			description: "previous staking period",
+			updateAttestation: func(attestation *txs.UptimeAttestation) {
+				attestation.Uptimes[0].ValidatorTxID = ids.GenerateTestID()
+			},
+			expectedErr: errNotCurrentValidator,
+		},
+		{
+			description: "up duration too long",
+			updateAttestation: func(attestation *txs.UptimeAttestation) {
+				attestation.Uptimes[0].UpDuration = uint64(3 * time.Hour / time.Second)
+			},
+			expectedErr: errUpDurationTooLong,
+		},
+		{
+			description: "insufficient weight",
+			signers: func(vdrs []*uptimeAttestationTestValidator) []*uptimeAttestationTestValidator {
+				return vdrs[1:]
+			},
+			expectedErr: warp.ErrInsufficientWeight,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+
+			vdrs := addUptimeAttestationTestValidators(t, env, 2*time.Hour)
+
+			attestation := newUptimeAttestation(vdrs, time.Hour)
+			if test.updateAttestation != nil {
+				test.updateAttestation(&attestation)
+				utils.Sort(attestation.Uptimes)
+			}
+			signers := vdrs[:1]
+			if test.signers != nil {
+				signers = test.signers(vdrs)
+			}
+			_, err := executeUptimeAttestationTx(t, env, attestation, signers)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestStandardTxExecutorUptimeAttestationTxInvalidSignature(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	vdrs := addUptimeAttestationTestValidators(t, env, 2*time.Hour)
+
+	// The validators signed a shorter up duration than the attested one.
+	attestation := newUptimeAttestation(vdrs, time.Hour)
+	signedAttestation := newUptimeAttestation(vdrs, time.Minute)
+	tx, err := env.txBuilder.NewUptimeAttestationTx(
+		attestation,
+		signUptimeAttestation(t, env, signedAttestation, vdrs[:1]),
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   onAcceptState,
+		Tx:      tx,
+	}
+	err = tx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, warp.ErrInvalidSignature)
+	require.ErrorIs(err, errInvalidUptimeAttestation)
+}
+
+func TestRewardValidatorTxUptimeScore(t *testing.T) {
+	tests := []struct {
+		description           string
+		upDuration            time.Duration
+		expectedPrefersCommit bool
+	}{
+		{
+			description:           "sufficient uptime",
+			upDuration:            defaultValidateEndTime.Sub(defaultValidateStartTime),
+			expectedPrefersCommit: true,
+		},
+		{
+			description:           "insufficient uptime",
+			upDuration:            time.Hour,
+			expectedPrefersCommit: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, false /*=postBanff*/, false /*=postCortina*/)
+			defer func() {
+				require.NoError(shutdownEnvironment(env))
+			}()
+			env.config.UptimePercentage = .8
+			env.config.UptimeScoresEnabled = true
+
+			currentStakerIterator, err := env.state.GetCurrentStakerIterator()
+			require.NoError(err)
+			require.True(currentStakerIterator.Next())
+			stakerToRemove := currentStakerIterator.Value()
+			currentStakerIterator.Release()
+
+			env.state.PutUptimeScore(&state.UptimeScore{
+				NodeID:        stakerToRemove.NodeID,
+				ValidatorTxID: stakerToRemove.TxID,
+				UpDuration:    test.upDuration,
+				LastUpdated:   stakerToRemove.EndTime,
+			})
+			env.state.SetTimestamp(stakerToRemove.EndTime)
+			require.NoError(env.state.Commit())
+
+			tx, err := env.txBuilder.NewRewardValidatorTx(stakerToRemove.TxID)
+			require.NoError(err)
+
+			onCommitState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			onAbortState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			txExecutor := ProposalTxExecutor{
+				OnCommitState: onCommitState,
+				OnAbortState:  onAbortState,
+				Backend:       &env.backend,
+				Tx:            tx,
+			}
+			require.NoError(tx.Unsigned.Visit(&txExecutor))
+			require.Equal(test.expectedPrefersCommit, txExecutor.PrefersCommit)
+
+			// The score is removed along with the validator.
+			require.NoError(onCommitState.Apply(env.state))
+			require.NoError(env.state.Commit())
+			_, err = env.state.GetUptimeScore(stakerToRemove.NodeID)
+			require.ErrorIs(err, database.ErrNotFound)
+		})
+	}
+}
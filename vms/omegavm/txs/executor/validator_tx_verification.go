@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+)
+
+var errUnauthorizedValidatorModification = errors.New("unauthorized validator modification")
+
+// verifyValidatorAuthorization carries out the validation for modifying the
+// validator [validator]. The last credential in [sTx.Creds] must prove the
+// ownership of the validation rewards of the validator.
+//
+// This is an extension of [verifySubnetAuthorization] for the validators of
+// the primary network.
+func verifyValidatorAuthorization(
+	backend *Backend,
+	chainState state.Chain,
+	sTx *txs.Tx,
+	validator *state.Staker,
+	validatorAuth verify.Verifiable,
+) ([]verify.Verifiable, error) {
+	if len(sTx.Creds) == 0 {
+		// Ensure there is at least one credential for the validator
+		// authorization
+		return nil, errWrongNumberOfCredentials
+	}
+
+	baseTxCredsLen := len(sTx.Creds) - 1
+	validatorCred := sTx.Creds[baseTxCredsLen]
+
+	validatorTx, _, err := chainState.GetTx(validator.TxID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator tx %s: %w", validator.TxID, err)
+	}
+	uValidatorTx, ok := validatorTx.Unsigned.(txs.ValidatorTx)
+	if !ok {
+		return nil, fmt.Errorf("expected tx type txs.ValidatorTx but got %T", validatorTx.Unsigned)
+	}
+
+	owner := uValidatorTx.ValidationRewardsOwner()
+	if err := backend.Fx.VerifyPermission(sTx.Unsigned, validatorAuth, validatorCred, owner); err != nil {
+		return nil, fmt.Errorf("%w: %w", errUnauthorizedValidatorModification, err)
+	}
+
+	return sTx.Creds[:baseTxCredsLen], nil
+}
@@ -17,8 +17,9 @@ import (
 var (
 	_ UnsignedTx = (*ExportTx)(nil)
 
-	ErrWrongLocktime   = errors.New("wrong locktime reported")
-	errNoExportOutputs = errors.New("no export outputs")
+	ErrWrongLocktime        = errors.New("wrong locktime reported")
+	ErrWeightedOwnersExport = errors.New("outputs with weighted owners can't be exported")
+	errNoExportOutputs      = errors.New("no export outputs")
 )
 
 // ExportTx is an unsigned exportTx
@@ -62,8 +63,13 @@ func (tx *ExportTx) SyntacticVerify(ctx *snow.Context) error {
 		if err := out.Verify(); err != nil {
 			return fmt.Errorf("output failed verification: %w", err)
 		}
-		if _, ok := out.Output().(*stakeable.LockOut); ok {
+		switch out.Output().(type) {
+		case *stakeable.LockOut:
 			return ErrWrongLocktime
+		case *secp256k1fx.WeightedTransferOutput:
+			// Chains register the weighted types with different type IDs, so
+			// they can't be sent through shared memory.
+			return ErrWeightedOwnersExport
 		}
 	}
 	if !dione.IsSortedTransferableOutputs(tx.ExportedOutputs, Codec) {
@@ -83,3 +83,48 @@ func (i *issuer) AddPermissionlessDelegatorTx(*txs.AddPermissionlessDelegatorTx)
 	i.m.addStakerTx(i.tx)
 	return nil
 }
+
+func (i *issuer) BaseTx(*txs.BaseTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) TransferSubnetOwnershipTx(*txs.TransferSubnetOwnershipTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) RotateBLSKeyTx(*txs.RotateBLSKeyTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) SetAutoRestakeTx(*txs.SetAutoRestakeTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) EarlyUnstakeTx(*txs.EarlyUnstakeTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) RedelegateTx(*txs.RedelegateTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) UptimeAttestationTx(*txs.UptimeAttestationTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) SetSubnetFeeConfigTx(*txs.SetSubnetFeeConfigTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) CancelPendingStakerTx(*txs.CancelPendingStakerTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
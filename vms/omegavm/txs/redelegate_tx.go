@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+)
+
+var _ UnsignedTx = (*RedelegateTx)(nil)
+
+// RedelegateTx moves a current primary network delegator to another validator
+// for the rest of its staking period. The reward the delegator accrued so far
+// is paid out when it moves.
+type RedelegateTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the tx that added the delegator
+	TxID ids.ID `serialize:"true" json:"txID"`
+	// ID of the node the delegator moves to
+	NodeID ids.NodeID `serialize:"true" json:"nodeID"`
+	// Proves that the issuer is the owner of the rewards of the delegator
+	DelegatorAuth verify.Verifiable `serialize:"true" json:"delegatorAuthorization"`
+}
+
+func (tx *RedelegateTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	case tx.TxID == ids.Empty:
+		return errEmptyStakerTxID
+	case tx.NodeID == ids.EmptyNodeID:
+		return errEmptyNodeID
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	if err := tx.DelegatorAuth.Verify(); err != nil {
+		return err
+	}
+
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *RedelegateTx) Visit(visitor Visitor) error {
+	return visitor.RedelegateTx(tx)
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+)
+
+var errInvalidDelegatorAuth = errors.New("invalid delegator auth")
+
+func TestRedelegateTxSyntacticVerify(t *testing.T) {
+	type test struct {
+		name        string
+		txFunc      func(*gomock.Controller) *RedelegateTx
+		expectedErr error
+	}
+
+	var (
+		networkID     = uint32(1337)
+		chainID       = ids.GenerateTestID()
+		delegatorTxID = ids.GenerateTestID()
+		nodeID        = ids.GenerateTestNodeID()
+	)
+
+	ctx := &snow.Context{
+		ChainID:   chainID,
+		NetworkID: networkID,
+	}
+
+	// A BaseTx that already passed syntactic verification.
+	verifiedBaseTx := BaseTx{
+		SyntacticallyVerified: true,
+	}
+
+	// A BaseTx that passes syntactic verification.
+	validBaseTx := BaseTx{
+		BaseTx: dione.BaseTx{
+			NetworkID:    networkID,
+			BlockchainID: chainID,
+		},
+	}
+
+	// A BaseTx that fails syntactic verification.
+	invalidBaseTx := BaseTx{}
+
+	tests := []test{
+		{
+			name: "nil tx",
+			txFunc: func(*gomock.Controller) *RedelegateTx {
+				return nil
+			},
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			txFunc: func(*gomock.Controller) *RedelegateTx {
+				return &RedelegateTx{BaseTx: verifiedBaseTx}
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "empty delegator tx ID",
+			txFunc: func(*gomock.Controller) *RedelegateTx {
+				return &RedelegateTx{
+					BaseTx: validBaseTx,
+					TxID:   ids.Empty,
+					NodeID: nodeID,
+				}
+			},
+			expectedErr: errEmptyStakerTxID,
+		},
+		{
+			name: "empty nodeID",
+			txFunc: func(*gomock.Controller) *RedelegateTx {
+				return &RedelegateTx{
+					BaseTx: validBaseTx,
+					TxID:   delegatorTxID,
+					NodeID: ids.EmptyNodeID,
+				}
+			},
+			expectedErr: errEmptyNodeID,
+		},
+		{
+			name: "invalid BaseTx",
+			txFunc: func(*gomock.Controller) *RedelegateTx {
+				return &RedelegateTx{
+					BaseTx: invalidBaseTx,
+					TxID:   delegatorTxID,
+					NodeID: nodeID,
+				}
+			},
+			expectedErr: dione.ErrWrongNetworkID,
+		},
+		{
+			name: "invalid delegatorAuth",
+			txFunc: func(ctrl *gomock.Controller) *RedelegateTx {
+				// This DelegatorAuth fails verification.
+				invalidDelegatorAuth := verify.NewMockVerifiable(ctrl)
+				invalidDelegatorAuth.EXPECT().Verify().Return(errInvalidDelegatorAuth)
+				return &RedelegateTx{
+					BaseTx:        validBaseTx,
+					TxID:          delegatorTxID,
+					NodeID:        nodeID,
+					DelegatorAuth: invalidDelegatorAuth,
+				}
+			},
+			expectedErr: errInvalidDelegatorAuth,
+		},
+		{
+			name: "passes verification",
+			txFunc: func(ctrl *gomock.Controller) *RedelegateTx {
+				// This DelegatorAuth passes verification.
+				validDelegatorAuth := verify.NewMockVerifiable(ctrl)
+				validDelegatorAuth.EXPECT().Verify().Return(nil)
+				return &RedelegateTx{
+					BaseTx:        validBaseTx,
+					TxID:          delegatorTxID,
+					NodeID:        nodeID,
+					DelegatorAuth: validDelegatorAuth,
+				}
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			tx := tt.txFunc(ctrl)
+			err := tx.SyntacticVerify(ctx)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			require.True(tx.SyntacticallyVerified)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
+)
+
+var (
+	_ UnsignedTx = (*RotateBLSKeyTx)(nil)
+
+	ErrMissingPublicKey = errors.New("missing public key")
+)
+
+// RotateBLSKeyTx replaces the BLS public key of a current primary network
+// validator. The new key takes effect a fixed number of blocks after the block
+// including this tx.
+type RotateBLSKeyTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the node whose BLS key is rotated
+	NodeID ids.NodeID `serialize:"true" json:"nodeID"`
+	// The new BLS key of the validator, along with its proof of possession
+	Signer signer.Signer `serialize:"true" json:"signer"`
+	// Proves that the issuer is the owner of the validation rewards of the
+	// validator.
+	ValidatorAuth verify.Verifiable `serialize:"true" json:"validatorAuthorization"`
+}
+
+func (tx *RotateBLSKeyTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	case tx.NodeID == ids.EmptyNodeID:
+		return errEmptyNodeID
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	if err := verify.All(tx.Signer, tx.ValidatorAuth); err != nil {
+		return err
+	}
+	if tx.Signer.Key() == nil {
+		return ErrMissingPublicKey
+	}
+
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+// PublicKey returns the new BLS key of the validator. It is only populated
+// once the tx passed syntactic verification.
+func (tx *RotateBLSKeyTx) PublicKey() *bls.PublicKey {
+	return tx.Signer.Key()
+}
+
+func (tx *RotateBLSKeyTx) Visit(visitor Visitor) error {
+	return visitor.RotateBLSKeyTx(tx)
+}
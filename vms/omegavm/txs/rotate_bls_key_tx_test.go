@@ -0,0 +1,158 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
+)
+
+var errInvalidValidatorAuth = errors.New("invalid validator auth")
+
+func TestRotateBLSKeyTxSyntacticVerify(t *testing.T) {
+	type test struct {
+		name        string
+		txFunc      func(*gomock.Controller) *RotateBLSKeyTx
+		expectedErr error
+	}
+
+	var (
+		networkID = uint32(1337)
+		chainID   = ids.GenerateTestID()
+		nodeID    = ids.GenerateTestNodeID()
+	)
+
+	ctx := &snow.Context{
+		ChainID:   chainID,
+		NetworkID: networkID,
+	}
+
+	// A BaseTx that already passed syntactic verification.
+	verifiedBaseTx := BaseTx{
+		SyntacticallyVerified: true,
+	}
+
+	// A BaseTx that passes syntactic verification.
+	validBaseTx := BaseTx{
+		BaseTx: dione.BaseTx{
+			NetworkID:    networkID,
+			BlockchainID: chainID,
+		},
+	}
+
+	// A BaseTx that fails syntactic verification.
+	invalidBaseTx := BaseTx{}
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(t, err)
+	validSigner := signer.NewProofOfPossession(sk)
+
+	tests := []test{
+		{
+			name: "nil tx",
+			txFunc: func(*gomock.Controller) *RotateBLSKeyTx {
+				return nil
+			},
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			txFunc: func(*gomock.Controller) *RotateBLSKeyTx {
+				return &RotateBLSKeyTx{BaseTx: verifiedBaseTx}
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "empty nodeID",
+			txFunc: func(*gomock.Controller) *RotateBLSKeyTx {
+				return &RotateBLSKeyTx{
+					BaseTx: validBaseTx,
+					NodeID: ids.EmptyNodeID,
+				}
+			},
+			expectedErr: errEmptyNodeID,
+		},
+		{
+			name: "invalid BaseTx",
+			txFunc: func(*gomock.Controller) *RotateBLSKeyTx {
+				return &RotateBLSKeyTx{
+					BaseTx: invalidBaseTx,
+					NodeID: nodeID,
+				}
+			},
+			expectedErr: dione.ErrWrongNetworkID,
+		},
+		{
+			name: "invalid validatorAuth",
+			txFunc: func(ctrl *gomock.Controller) *RotateBLSKeyTx {
+				// This ValidatorAuth fails verification.
+				invalidValidatorAuth := verify.NewMockVerifiable(ctrl)
+				invalidValidatorAuth.EXPECT().Verify().Return(errInvalidValidatorAuth)
+				return &RotateBLSKeyTx{
+					BaseTx:        validBaseTx,
+					NodeID:        nodeID,
+					Signer:        validSigner,
+					ValidatorAuth: invalidValidatorAuth,
+				}
+			},
+			expectedErr: errInvalidValidatorAuth,
+		},
+		{
+			name: "missing public key",
+			txFunc: func(ctrl *gomock.Controller) *RotateBLSKeyTx {
+				// This ValidatorAuth passes verification.
+				validValidatorAuth := verify.NewMockVerifiable(ctrl)
+				validValidatorAuth.EXPECT().Verify().Return(nil)
+				return &RotateBLSKeyTx{
+					BaseTx:        validBaseTx,
+					NodeID:        nodeID,
+					Signer:        &signer.Empty{},
+					ValidatorAuth: validValidatorAuth,
+				}
+			},
+			expectedErr: ErrMissingPublicKey,
+		},
+		{
+			name: "passes verification",
+			txFunc: func(ctrl *gomock.Controller) *RotateBLSKeyTx {
+				// This ValidatorAuth passes verification.
+				validValidatorAuth := verify.NewMockVerifiable(ctrl)
+				validValidatorAuth.EXPECT().Verify().Return(nil)
+				return &RotateBLSKeyTx{
+					BaseTx:        validBaseTx,
+					NodeID:        nodeID,
+					Signer:        validSigner,
+					ValidatorAuth: validValidatorAuth,
+				}
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			tx := tt.txFunc(ctrl)
+			err := tx.SyntacticVerify(ctx)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			require.True(tx.SyntacticallyVerified)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+)
+
+var _ UnsignedTx = (*SetAutoRestakeTx)(nil)
+
+// SetAutoRestakeTx opts a current or pending primary network validator in or
+// out of auto-restaking. An auto-restaked validator is added back to the
+// validator set for another staking period when its staking period ends, with
+// its validation reward added to its stake.
+type SetAutoRestakeTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the node whose validator is configured
+	NodeID ids.NodeID `serialize:"true" json:"nodeID"`
+	// Whether the validator is restaked when its staking period ends
+	AutoRestake bool `serialize:"true" json:"autoRestake"`
+	// Proves that the issuer is the owner of the validation rewards of the
+	// validator.
+	ValidatorAuth verify.Verifiable `serialize:"true" json:"validatorAuthorization"`
+}
+
+func (tx *SetAutoRestakeTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	case tx.NodeID == ids.EmptyNodeID:
+		return errEmptyNodeID
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	if err := tx.ValidatorAuth.Verify(); err != nil {
+		return err
+	}
+
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *SetAutoRestakeTx) Visit(visitor Visitor) error {
+	return visitor.SetAutoRestakeTx(tx)
+}
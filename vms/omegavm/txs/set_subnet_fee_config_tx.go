@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+)
+
+var (
+	_ UnsignedTx = (*SetSubnetFeeConfigTx)(nil)
+
+	ErrSetPrimaryNetworkFeeConfig = errors.New("can't set the fee config of the primary network")
+)
+
+// SetSubnetFeeConfigTx sets the fees charged, in the staking asset of an
+// elastic subnet, to add permissionless stakers to the subnet. The collected
+// fees are distributed to the stakers of the subnet.
+type SetSubnetFeeConfigTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the subnet this tx is modifying
+	Subnet ids.ID `serialize:"true" json:"subnetID"`
+	// Fee charged by an AddPermissionlessValidatorTx on the subnet
+	AddValidatorFee uint64 `serialize:"true" json:"addValidatorFee"`
+	// Fee charged by an AddPermissionlessDelegatorTx on the subnet
+	AddDelegatorFee uint64 `serialize:"true" json:"addDelegatorFee"`
+	// Proves that the issuer has the right to set the fees of the subnet.
+	SubnetAuth verify.Verifiable `serialize:"true" json:"subnetAuthorization"`
+}
+
+func (tx *SetSubnetFeeConfigTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	case tx.Subnet == constants.PrimaryNetworkID:
+		return ErrSetPrimaryNetworkFeeConfig
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	if err := tx.SubnetAuth.Verify(); err != nil {
+		return err
+	}
+
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *SetSubnetFeeConfigTx) Visit(visitor Visitor) error {
+	return visitor.SetSubnetFeeConfigTx(tx)
+}
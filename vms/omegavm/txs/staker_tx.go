@@ -4,12 +4,20 @@
 package txs
 
 import (
+	"errors"
 	"time"
 
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+var (
+	ErrUnknownStakeOwner = errors.New("unknown stake owner")
+	ErrStakeOwnersDiffer = errors.New("stake outputs have different owners")
 )
 
 // ValidatorTx defines the interface for a validator transaction that supports
@@ -42,6 +50,50 @@ type PermissionlessStaker interface {
 	Stake() []*dione.TransferableOutput
 }
 
+// StakeOwner returns the owner of the outputs staked by [stakerTx]. An error is
+// returned if the staked outputs don't share a single owner.
+func StakeOwner(stakerTx PermissionlessStaker) (fx.Owner, error) {
+	var owner fx.Owner
+	for _, out := range stakerTx.Stake() {
+		outIntf := out.Out
+		if lockedOut, ok := outIntf.(*stakeable.LockOut); ok {
+			outIntf = lockedOut.TransferableOut
+		}
+
+		var outOwner fx.Owner
+		switch out := outIntf.(type) {
+		case *secp256k1fx.TransferOutput:
+			outOwner = &out.OutputOwners
+		case *secp256k1fx.WeightedTransferOutput:
+			outOwner = &out.WeightedOutputOwners
+		default:
+			return nil, ErrUnknownStakeOwner
+		}
+
+		if owner != nil && !ownersEqual(owner, outOwner) {
+			return nil, ErrStakeOwnersDiffer
+		}
+		owner = outOwner
+	}
+	if owner == nil {
+		return nil, ErrUnknownStakeOwner
+	}
+	return owner, nil
+}
+
+func ownersEqual(a, b fx.Owner) bool {
+	switch a := a.(type) {
+	case *secp256k1fx.OutputOwners:
+		b, ok := b.(*secp256k1fx.OutputOwners)
+		return ok && a.Equals(b)
+	case *secp256k1fx.WeightedOutputOwners:
+		b, ok := b.(*secp256k1fx.WeightedOutputOwners)
+		return ok && a.Equals(b)
+	default:
+		return false
+	}
+}
+
 type Staker interface {
 	SubnetID() ids.ID
 	NodeID() ids.NodeID
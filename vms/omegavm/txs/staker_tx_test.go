@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestStakeOwner(t *testing.T) {
+	owner := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	otherOwner := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	newStakeOut := func(owner secp256k1fx.OutputOwners) *dione.TransferableOutput {
+		return &dione.TransferableOutput{
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          1,
+				OutputOwners: owner,
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		stake         []*dione.TransferableOutput
+		expectedOwner fx.Owner
+		expectedErr   error
+	}{
+		{
+			name:        "no stake",
+			expectedErr: ErrUnknownStakeOwner,
+		},
+		{
+			name: "single owner",
+			stake: []*dione.TransferableOutput{
+				newStakeOut(owner),
+				{
+					Out: &stakeable.LockOut{
+						Locktime:        1,
+						TransferableOut: newStakeOut(owner).Out,
+					},
+				},
+			},
+			expectedOwner: &owner,
+		},
+		{
+			name: "different owners",
+			stake: []*dione.TransferableOutput{
+				newStakeOut(owner),
+				newStakeOut(otherOwner),
+			},
+			expectedErr: ErrStakeOwnersDiffer,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			stakerTx := &AddDelegatorTx{
+				StakeOuts: test.stake,
+			}
+			stakeOwner, err := StakeOwner(stakerTx)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedOwner, stakeOwner)
+		})
+	}
+}
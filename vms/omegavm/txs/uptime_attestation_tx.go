@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+)
+
+var (
+	_ UnsignedTx                       = (*UptimeAttestationTx)(nil)
+	_ utils.Sortable[*ValidatorUptime] = (*ValidatorUptime)(nil)
+
+	errNoUptimes                 = errors.New("no uptimes attested")
+	errUptimesNotSortedAndUnique = errors.New("uptimes not sorted and unique")
+	errEmptyUpDuration           = errors.New("up duration cannot be empty")
+)
+
+// UptimeAttestationTx records the uptimes of primary network validators, as
+// attested by a quorum of the stake of the primary network.
+type UptimeAttestationTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// Uptimes attested by the signers
+	Attestation UptimeAttestation `serialize:"true" json:"attestation"`
+	// Aggregate BLS signature of the validators that signed the warp message
+	// of [Attestation]
+	Signature warp.BitSetSignature `serialize:"true" json:"signature"`
+}
+
+// UptimeAttestation is the payload of the warp message signed by the
+// validators that attest uptimes. A validator signs it if it observed every
+// attested validator being up for at least the attested duration.
+type UptimeAttestation struct {
+	// Uptimes of the validators, sorted by node ID
+	Uptimes []*ValidatorUptime `serialize:"true" json:"uptimes"`
+}
+
+// ValidatorUptime is the uptime of a primary network validator during its
+// current staking period.
+type ValidatorUptime struct {
+	NodeID ids.NodeID `serialize:"true" json:"nodeID"`
+	// ID of the tx that added the validator
+	ValidatorTxID ids.ID `serialize:"true" json:"validatorTxID"`
+	// Number of seconds the validator was up since the start of its staking
+	// period
+	UpDuration uint64 `serialize:"true" json:"upDuration"`
+}
+
+func (u *ValidatorUptime) Less(than *ValidatorUptime) bool {
+	return bytes.Compare(u.NodeID[:], than.NodeID[:]) == -1
+}
+
+// UnsignedMessage returns the warp message of the attestation, sent by the
+// O-chain [chainID] of the network [networkID].
+func (a *UptimeAttestation) UnsignedMessage(networkID uint32, chainID ids.ID) (*warp.UnsignedMessage, error) {
+	payload, err := Codec.Marshal(Version, a)
+	if err != nil {
+		return nil, err
+	}
+	return warp.NewUnsignedMessage(networkID, chainID, payload)
+}
+
+func (a *UptimeAttestation) verify() error {
+	if len(a.Uptimes) == 0 {
+		return errNoUptimes
+	}
+	for _, uptime := range a.Uptimes {
+		switch {
+		case uptime == nil:
+			return ErrNilTx
+		case uptime.NodeID == ids.EmptyNodeID:
+			return errEmptyNodeID
+		case uptime.ValidatorTxID == ids.Empty:
+			return errEmptyStakerTxID
+		case uptime.UpDuration == 0:
+			return errEmptyUpDuration
+		}
+	}
+	if !utils.IsSortedAndUnique(a.Uptimes) {
+		return errUptimesNotSortedAndUnique
+	}
+	return nil
+}
+
+func (tx *UptimeAttestationTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	if err := tx.Attestation.verify(); err != nil {
+		return err
+	}
+	if _, err := tx.Signature.NumSigners(); err != nil {
+		return err
+	}
+
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *UptimeAttestationTx) Visit(visitor Visitor) error {
+	return visitor.UptimeAttestationTx(tx)
+}
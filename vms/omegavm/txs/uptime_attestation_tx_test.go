@@ -0,0 +1,202 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+)
+
+func TestUptimeAttestationTxSyntacticVerify(t *testing.T) {
+	type test struct {
+		name        string
+		tx          *UptimeAttestationTx
+		expectedErr error
+	}
+
+	var (
+		networkID = uint32(1337)
+		chainID   = ids.GenerateTestID()
+	)
+
+	ctx := &snow.Context{
+		ChainID:   chainID,
+		NetworkID: networkID,
+	}
+
+	// A BaseTx that already passed syntactic verification.
+	verifiedBaseTx := BaseTx{
+		SyntacticallyVerified: true,
+	}
+
+	// A BaseTx that passes syntactic verification.
+	validBaseTx := BaseTx{
+		BaseTx: dione.BaseTx{
+			NetworkID:    networkID,
+			BlockchainID: chainID,
+		},
+	}
+
+	// A BaseTx that fails syntactic verification.
+	invalidBaseTx := BaseTx{}
+
+	uptimes := []*ValidatorUptime{
+		{
+			NodeID:        ids.GenerateTestNodeID(),
+			ValidatorTxID: ids.GenerateTestID(),
+			UpDuration:    1,
+		},
+		{
+			NodeID:        ids.GenerateTestNodeID(),
+			ValidatorTxID: ids.GenerateTestID(),
+			UpDuration:    2,
+		},
+	}
+	utils.Sort(uptimes)
+
+	tests := []test{
+		{
+			name:        "nil tx",
+			tx:          nil,
+			expectedErr: ErrNilTx,
+		},
+		{
+			name:        "already verified",
+			tx:          &UptimeAttestationTx{BaseTx: verifiedBaseTx},
+			expectedErr: nil,
+		},
+		{
+			name: "invalid BaseTx",
+			tx: &UptimeAttestationTx{
+				BaseTx: invalidBaseTx,
+				Attestation: UptimeAttestation{
+					Uptimes: uptimes,
+				},
+			},
+			expectedErr: dione.ErrWrongNetworkID,
+		},
+		{
+			name: "no uptimes",
+			tx: &UptimeAttestationTx{
+				BaseTx: validBaseTx,
+			},
+			expectedErr: errNoUptimes,
+		},
+		{
+			name: "nil uptime",
+			tx: &UptimeAttestationTx{
+				BaseTx: validBaseTx,
+				Attestation: UptimeAttestation{
+					Uptimes: []*ValidatorUptime{nil},
+				},
+			},
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "empty nodeID",
+			tx: &UptimeAttestationTx{
+				BaseTx: validBaseTx,
+				Attestation: UptimeAttestation{
+					Uptimes: []*ValidatorUptime{{
+						ValidatorTxID: ids.GenerateTestID(),
+						UpDuration:    1,
+					}},
+				},
+			},
+			expectedErr: errEmptyNodeID,
+		},
+		{
+			name: "empty validator tx ID",
+			tx: &UptimeAttestationTx{
+				BaseTx: validBaseTx,
+				Attestation: UptimeAttestation{
+					Uptimes: []*ValidatorUptime{{
+						NodeID:     ids.GenerateTestNodeID(),
+						UpDuration: 1,
+					}},
+				},
+			},
+			expectedErr: errEmptyStakerTxID,
+		},
+		{
+			name: "empty up duration",
+			tx: &UptimeAttestationTx{
+				BaseTx: validBaseTx,
+				Attestation: UptimeAttestation{
+					Uptimes: []*ValidatorUptime{{
+						NodeID:        ids.GenerateTestNodeID(),
+						ValidatorTxID: ids.GenerateTestID(),
+					}},
+				},
+			},
+			expectedErr: errEmptyUpDuration,
+		},
+		{
+			name: "unsorted uptimes",
+			tx: &UptimeAttestationTx{
+				BaseTx: validBaseTx,
+				Attestation: UptimeAttestation{
+					Uptimes: []*ValidatorUptime{uptimes[1], uptimes[0]},
+				},
+			},
+			expectedErr: errUptimesNotSortedAndUnique,
+		},
+		{
+			name: "duplicate uptimes",
+			tx: &UptimeAttestationTx{
+				BaseTx: validBaseTx,
+				Attestation: UptimeAttestation{
+					Uptimes: []*ValidatorUptime{uptimes[0], uptimes[0]},
+				},
+			},
+			expectedErr: errUptimesNotSortedAndUnique,
+		},
+		{
+			name: "zero-padded signers",
+			tx: &UptimeAttestationTx{
+				BaseTx: validBaseTx,
+				Attestation: UptimeAttestation{
+					Uptimes: uptimes,
+				},
+				Signature: warp.BitSetSignature{
+					Signers: []byte{0},
+				},
+			},
+			expectedErr: warp.ErrInvalidBitSet,
+		},
+		{
+			name: "passes verification",
+			tx: &UptimeAttestationTx{
+				BaseTx: validBaseTx,
+				Attestation: UptimeAttestation{
+					Uptimes: uptimes,
+				},
+				Signature: warp.BitSetSignature{
+					Signers: []byte{1},
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			err := tt.tx.SyntacticVerify(ctx)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			require.True(tt.tx.SyntacticallyVerified)
+		})
+	}
+}
@@ -18,4 +18,13 @@ type Visitor interface {
 	TransformSubnetTx(*TransformSubnetTx) error
 	AddPermissionlessValidatorTx(*AddPermissionlessValidatorTx) error
 	AddPermissionlessDelegatorTx(*AddPermissionlessDelegatorTx) error
+	BaseTx(*BaseTx) error
+	TransferSubnetOwnershipTx(*TransferSubnetOwnershipTx) error
+	RotateBLSKeyTx(*RotateBLSKeyTx) error
+	SetAutoRestakeTx(*SetAutoRestakeTx) error
+	EarlyUnstakeTx(*EarlyUnstakeTx) error
+	RedelegateTx(*RedelegateTx) error
+	UptimeAttestationTx(*UptimeAttestationTx) error
+	SetSubnetFeeConfigTx(*SetSubnetFeeConfigTx) error
+	CancelPendingStakerTx(*CancelPendingStakerTx) error
 }
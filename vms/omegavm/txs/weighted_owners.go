@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+// UsesWeightedOwners returns true if any output or owner of [tx] has weighted
+// owners.
+func UsesWeightedOwners(tx UnsignedTx) bool {
+	owners := []fx.Owner{}
+	outs := tx.Outputs()
+	switch tx := tx.(type) {
+	case *CreateSubnetTx:
+		owners = append(owners, tx.Owner)
+	case *TransferSubnetOwnershipTx:
+		owners = append(owners, tx.Owner)
+	case *ExportTx:
+		outs = append(outs, tx.ExportedOutputs...)
+	case ValidatorTx:
+		owners = append(owners, tx.ValidationRewardsOwner(), tx.DelegationRewardsOwner())
+		outs = append(outs, tx.Stake()...)
+	case DelegatorTx:
+		owners = append(owners, tx.RewardsOwner())
+		outs = append(outs, tx.Stake()...)
+	}
+
+	for _, owner := range owners {
+		if _, ok := owner.(*secp256k1fx.WeightedOutputOwners); ok {
+			return true
+		}
+	}
+	for _, out := range outs {
+		if isWeightedOutput(out) {
+			return true
+		}
+	}
+	return false
+}
+
+func isWeightedOutput(out *dione.TransferableOutput) bool {
+	outIntf := out.Out
+	if lockedOut, ok := outIntf.(*stakeable.LockOut); ok {
+		outIntf = lockedOut.TransferableOut
+	}
+	_, ok := outIntf.(*secp256k1fx.WeightedTransferOutput)
+	return ok
+}
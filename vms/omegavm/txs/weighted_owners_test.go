@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestUsesWeightedOwners(t *testing.T) {
+	owner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	weightedOwner := &secp256k1fx.WeightedOutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		Weights:   []uint32{1},
+	}
+	out := &dione.TransferableOutput{
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          1,
+			OutputOwners: *owner,
+		},
+	}
+	weightedOut := &dione.TransferableOutput{
+		Out: &secp256k1fx.WeightedTransferOutput{
+			Amt:                  1,
+			WeightedOutputOwners: *weightedOwner,
+		},
+	}
+	lockedWeightedOut := &dione.TransferableOutput{
+		Out: &stakeable.LockOut{
+			Locktime:        1,
+			TransferableOut: weightedOut.Out.(dione.TransferableOut),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		tx       UnsignedTx
+		expected bool
+	}{
+		{
+			name: "no weighted owners",
+			tx: &AddDelegatorTx{
+				BaseTx: BaseTx{BaseTx: dione.BaseTx{
+					Outs: []*dione.TransferableOutput{out},
+				}},
+				StakeOuts:              []*dione.TransferableOutput{out},
+				DelegationRewardsOwner: owner,
+			},
+			expected: false,
+		},
+		{
+			name: "weighted output",
+			tx: &BaseTx{BaseTx: dione.BaseTx{
+				Outs: []*dione.TransferableOutput{weightedOut},
+			}},
+			expected: true,
+		},
+		{
+			name: "locked weighted stake",
+			tx: &AddDelegatorTx{
+				StakeOuts:              []*dione.TransferableOutput{lockedWeightedOut},
+				DelegationRewardsOwner: owner,
+			},
+			expected: true,
+		},
+		{
+			name: "weighted rewards owner",
+			tx: &AddValidatorTx{
+				RewardsOwner: weightedOwner,
+			},
+			expected: true,
+		},
+		{
+			name: "weighted subnet owner",
+			tx: &TransferSubnetOwnershipTx{
+				Owner: weightedOwner,
+			},
+			expected: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, UsesWeightedOwners(test.tx))
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/utils/hashing"
 	"github.com/DioneProtocol/odysseygo/utils/math"
@@ -80,6 +81,45 @@ type Spender interface {
 		[]*secp256k1.PrivateKey, // Keys that prove ownership
 		error,
 	)
+
+	// AuthorizeValidator authorizes an operation on behalf of the current
+	// primary network validator [nodeID] with the provided keys. The keys must
+	// own the validation rewards of the validator.
+	AuthorizeValidator(
+		state state.Chain,
+		nodeID ids.NodeID,
+		keys []*secp256k1.PrivateKey,
+	) (
+		verify.Verifiable, // Input that names owners
+		[]*secp256k1.PrivateKey, // Keys that prove ownership
+		error,
+	)
+
+	// AuthorizeStaker authorizes an operation on behalf of the staker added by
+	// [stakerTxID] with the provided keys. The keys must own the validation
+	// rewards of a validator or the rewards of a delegator.
+	AuthorizeStaker(
+		state state.Chain,
+		stakerTxID ids.ID,
+		keys []*secp256k1.PrivateKey,
+	) (
+		verify.Verifiable, // Input that names owners
+		[]*secp256k1.PrivateKey, // Keys that prove ownership
+		error,
+	)
+
+	// AuthorizeStake authorizes an operation on behalf of the staker added by
+	// [stakerTxID] with the provided keys. The keys must own the outputs
+	// staked by the staker.
+	AuthorizeStake(
+		state state.Chain,
+		stakerTxID ids.ID,
+		keys []*secp256k1.PrivateKey,
+	) (
+		verify.Verifiable, // Input that names owners
+		[]*secp256k1.PrivateKey, // Keys that prove ownership
+		error,
+	)
 }
 
 type Verifier interface {
@@ -409,19 +449,130 @@ func (h *handler) Authorize(
 	}
 
 	// Make sure the owners of the subnet match the provided keys
-	owner, ok := subnetOwner.(*secp256k1fx.OutputOwners)
+	return h.authorizeOwner(subnetOwner, keys)
+}
+
+func (h *handler) AuthorizeValidator(
+	state state.Chain,
+	nodeID ids.NodeID,
+	keys []*secp256k1.PrivateKey,
+) (
+	verify.Verifiable, // Input that names owners
+	[]*secp256k1.PrivateKey, // Keys that prove ownership
+	error,
+) {
+	validator, err := state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to fetch validator %s: %w",
+			nodeID,
+			err,
+		)
+	}
+	validatorTx, _, err := state.GetTx(validator.TxID)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to fetch validator tx %s: %w",
+			validator.TxID,
+			err,
+		)
+	}
+	uValidatorTx, ok := validatorTx.Unsigned.(txs.ValidatorTx)
+	if !ok {
+		return nil, nil, fmt.Errorf("expected txs.ValidatorTx but got %T", validatorTx.Unsigned)
+	}
+
+	// Make sure the owners of the validation rewards match the provided keys
+	return h.authorizeOwner(uValidatorTx.ValidationRewardsOwner(), keys)
+}
+
+func (h *handler) AuthorizeStaker(
+	state state.Chain,
+	stakerTxID ids.ID,
+	keys []*secp256k1.PrivateKey,
+) (
+	verify.Verifiable, // Input that names owners
+	[]*secp256k1.PrivateKey, // Keys that prove ownership
+	error,
+) {
+	stakerTx, _, err := state.GetTx(stakerTxID)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to fetch staker tx %s: %w",
+			stakerTxID,
+			err,
+		)
+	}
+
+	// Make sure the owners of the rewards of the staker match the provided
+	// keys
+	switch uStakerTx := stakerTx.Unsigned.(type) {
+	case txs.ValidatorTx:
+		return h.authorizeOwner(uStakerTx.ValidationRewardsOwner(), keys)
+	case txs.DelegatorTx:
+		return h.authorizeOwner(uStakerTx.RewardsOwner(), keys)
+	default:
+		return nil, nil, fmt.Errorf("expected a staker tx but got %T", stakerTx.Unsigned)
+	}
+}
+
+func (h *handler) AuthorizeStake(
+	state state.Chain,
+	stakerTxID ids.ID,
+	keys []*secp256k1.PrivateKey,
+) (
+	verify.Verifiable, // Input that names owners
+	[]*secp256k1.PrivateKey, // Keys that prove ownership
+	error,
+) {
+	stakerTx, _, err := state.GetTx(stakerTxID)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to fetch staker tx %s: %w",
+			stakerTxID,
+			err,
+		)
+	}
+
+	uStakerTx, ok := stakerTx.Unsigned.(txs.PermissionlessStaker)
 	if !ok {
-		return nil, nil, fmt.Errorf("expected *secp256k1fx.OutputOwners but got %T", subnetOwner)
+		return nil, nil, fmt.Errorf("expected a staker tx but got %T", stakerTx.Unsigned)
 	}
+	owner, err := txs.StakeOwner(uStakerTx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return h.authorizeOwner(owner, keys)
+}
 
+func (h *handler) authorizeOwner(
+	ownerIntf fx.Owner,
+	keys []*secp256k1.PrivateKey,
+) (
+	verify.Verifiable, // Input that names owners
+	[]*secp256k1.PrivateKey, // Keys that prove ownership
+	error,
+) {
 	// Add the keys to a keychain
 	kc := secp256k1fx.NewKeychain(keys...)
 
 	// Make sure that the operation is valid after a minimum time
 	now := uint64(h.clk.Time().Unix())
 
-	// Attempt to prove ownership of the subnet
-	indices, signers, matches := kc.Match(owner, now)
+	// Attempt to prove ownership
+	var (
+		indices []uint32
+		signers []*secp256k1.PrivateKey
+		matches bool
+	)
+	switch owner := ownerIntf.(type) {
+	case *secp256k1fx.OutputOwners:
+		indices, signers, matches = kc.Match(owner, now)
+	case *secp256k1fx.WeightedOutputOwners:
+		indices, signers, matches = kc.MatchWeighted(owner, now)
+	default:
+		return nil, nil, fmt.Errorf("expected *secp256k1fx.OutputOwners but got %T", ownerIntf)
+	}
 	if !matches {
 		return nil, nil, errCantSign
 	}
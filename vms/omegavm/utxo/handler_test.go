@@ -627,6 +627,88 @@ func TestVerifySpendUTXOs(t *testing.T) {
 			producedAmounts: map[ids.ID]uint64{},
 			expectedErr:     ErrInsufficientLockedFunds,
 		},
+		{
+			description: "one locked weighted input, one locked weighted output, zero fee",
+			utxos: []*dione.UTXO{
+				{
+					Asset: dione.Asset{ID: h.ctx.DIONEAssetID},
+					Out: &stakeable.LockOut{
+						Locktime: uint64(now.Unix()) + 1,
+						TransferableOut: &secp256k1fx.WeightedTransferOutput{
+							Amt: 1,
+						},
+					},
+				},
+			},
+			ins: []*dione.TransferableInput{
+				{
+					Asset: dione.Asset{ID: h.ctx.DIONEAssetID},
+					In: &stakeable.LockIn{
+						Locktime: uint64(now.Unix()) + 1,
+						TransferableIn: &secp256k1fx.TransferInput{
+							Amt: 1,
+						},
+					},
+				},
+			},
+			outs: []*dione.TransferableOutput{
+				{
+					Asset: dione.Asset{ID: h.ctx.DIONEAssetID},
+					Out: &stakeable.LockOut{
+						Locktime: uint64(now.Unix()) + 1,
+						TransferableOut: &secp256k1fx.WeightedTransferOutput{
+							Amt: 1,
+						},
+					},
+				},
+			},
+			creds: []verify.Verifiable{
+				&secp256k1fx.Credential{},
+			},
+			producedAmounts: map[ids.ID]uint64{},
+			expectedErr:     nil,
+		},
+		{
+			description: "one locked weighted input, one locked output with other owners, zero fee",
+			utxos: []*dione.UTXO{
+				{
+					Asset: dione.Asset{ID: h.ctx.DIONEAssetID},
+					Out: &stakeable.LockOut{
+						Locktime: uint64(now.Unix()) + 1,
+						TransferableOut: &secp256k1fx.WeightedTransferOutput{
+							Amt: 1,
+						},
+					},
+				},
+			},
+			ins: []*dione.TransferableInput{
+				{
+					Asset: dione.Asset{ID: h.ctx.DIONEAssetID},
+					In: &stakeable.LockIn{
+						Locktime: uint64(now.Unix()) + 1,
+						TransferableIn: &secp256k1fx.TransferInput{
+							Amt: 1,
+						},
+					},
+				},
+			},
+			outs: []*dione.TransferableOutput{
+				{
+					Asset: dione.Asset{ID: h.ctx.DIONEAssetID},
+					Out: &stakeable.LockOut{
+						Locktime: uint64(now.Unix()) + 1,
+						TransferableOut: &secp256k1fx.TransferOutput{
+							Amt: 1,
+						},
+					},
+				},
+			},
+			creds: []verify.Verifiable{
+				&secp256k1fx.Credential{},
+			},
+			producedAmounts: map[ids.ID]uint64{},
+			expectedErr:     ErrInsufficientLockedFunds,
+		},
 		{
 			description: "attempted mint through locking",
 			utxos: []*dione.UTXO{
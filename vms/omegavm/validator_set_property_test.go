@@ -733,6 +733,7 @@ func buildVM(t *testing.T) (*VM, ids.ID, error) {
 		ApricotPhase3Time:         forkTime,
 		ApricotPhase5Time:         forkTime,
 		BanffTime:                 forkTime,
+		StateSyncTime:             mockable.MaxTime,
 		CortinaTime:               forkTime,
 	}}
 	vm.clock.Set(forkTime.Add(time.Second))
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/codec"
 	"github.com/DioneProtocol/odysseygo/codec/linearcodec"
 	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
@@ -38,9 +40,11 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/genesis"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/index"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/statesync"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/mempool"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/utxo"
@@ -60,6 +64,9 @@ var (
 	_ validators.SubnetConnector = (*VM)(nil)
 
 	errMissingValidatorSet = errors.New("missing validator set")
+
+	rewardIndexPrefix = []byte("rewardIndex")
+	stateSyncPrefix   = []byte("stateSync")
 )
 
 type VM struct {
@@ -87,8 +94,14 @@ type VM struct {
 	// Bootstrapped remembers if this chain has finished bootstrapping or not
 	bootstrapped utils.Atomic[bool]
 
-	txBuilder txbuilder.Builder
-	manager   blockexecutor.Manager
+	txBuilder      txbuilder.Builder
+	manager        blockexecutor.Manager
+	mintCalculator reward.MintCalculator
+	genesisTime    time.Time
+
+	rewardIndexer index.RewardIndexer
+
+	syncer *statesync.Syncer
 
 	// TODO: Remove after v1.11.x is activated
 	pruned utils.Atomic[bool]
@@ -140,7 +153,8 @@ func (vm *VM) Initialize(
 	if err != nil {
 		return err
 	}
-	mintCalculator := reward.NewMintCalculator(vm.MintConfig, genesisState.InitialSupply)
+	vm.mintCalculator = reward.NewScheduledMintCalculator(vm.MintSchedule, genesisState.InitialSupply)
+	vm.genesisTime = time.Unix(int64(genesisState.Timestamp), 0)
 
 	vm.state, err = state.New(
 		vm.dbManager.Current().Database,
@@ -182,10 +196,37 @@ func (vm *VM) Initialize(
 		FlowChecker:  utxoHandler,
 		Uptimes:      vm.uptimeManager,
 		Rewards:      rewards,
-		Mint:         mintCalculator,
+		Mint:         vm.mintCalculator,
 		Bootstrapped: &vm.bootstrapped,
 	}
 
+	if execConfig.RewardIndexEnabled {
+		vm.ctx.Log.Info("reward indexing is enabled")
+		rewardIndexDB := prefixdb.New(rewardIndexPrefix, vm.dbManager.Current().Database)
+		vm.rewardIndexer = index.NewIndexer(rewardIndexDB)
+	} else {
+		vm.ctx.Log.Info("reward indexing is disabled")
+		vm.rewardIndexer = index.NewNoIndexer()
+	}
+
+	if execConfig.StateSyncEnabled {
+		vm.ctx.Log.Info("state sync is enabled")
+	}
+	vm.syncer, err = statesync.New(statesync.Config{
+		Ctx:        vm.ctx,
+		Config:     &vm.Config,
+		Enabled:    execConfig.StateSyncEnabled,
+		State:      vm.state,
+		DB:         prefixdb.New(stateSyncPrefix, vm.dbManager.Current().Database),
+		AppSender:  appSender,
+		ToEngine:   toEngine,
+		Registerer: registerer,
+		OnSynced:   vm.onStateSynced,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create state syncer: %w", err)
+	}
+
 	// Note: There is a circular dependency between the mempool and block
 	//       builder which is broken by passing in the vm.
 	mempool, err := mempool.NewMempool("mempool", registerer, vm)
@@ -199,6 +240,8 @@ func (vm *VM) Initialize(
 		vm.state,
 		txExecutorBackend,
 		validatorManager,
+		vm.rewardIndexer,
+		vm.syncer,
 	)
 	vm.Builder = blockbuilder.New(
 		mempool,
@@ -221,6 +264,12 @@ func (vm *VM) Initialize(
 	chainCtx.Log.Info("initializing last accepted",
 		zap.Stringer("blkID", lastAcceptedID),
 	)
+	if err := vm.reconcileFeeCollector(lastAcceptedID); err != nil {
+		return fmt.Errorf(
+			"failed to reconcile fee collector: %w",
+			err,
+		)
+	}
 	if err := vm.SetPreference(ctx, lastAcceptedID); err != nil {
 		return err
 	}
@@ -252,6 +301,29 @@ func (vm *VM) Initialize(
 	return nil
 }
 
+// reconcileFeeCollector verifies that the fee pool changes committed by this
+// chain match its last accepted block, unwinding them if they don't.
+func (vm *VM) reconcileFeeCollector(lastAcceptedID ids.ID) error {
+	lastAccepted, err := vm.state.GetStatelessBlock(lastAcceptedID)
+	if err != nil {
+		return err
+	}
+	unwound, err := vm.ctx.FeeCollector.Reconcile(
+		vm.ctx.ChainID,
+		lastAcceptedID,
+		lastAccepted.Height(),
+	)
+	if err != nil {
+		return err
+	}
+	if unwound {
+		vm.ctx.Log.Warn("unwound fee collector pools of a block that wasn't accepted",
+			zap.Stringer("lastAcceptedID", lastAcceptedID),
+		)
+	}
+	return nil
+}
+
 // Create all chains that exist that this node validates.
 func (vm *VM) initBlockchains() error {
 	if vm.Config.PartialSyncPrimaryNetwork {
@@ -468,7 +540,10 @@ func (*VM) CreateStaticHandlers(context.Context) (map[string]*common.HTTPHandler
 	}, nil
 }
 
-func (vm *VM) Connected(_ context.Context, nodeID ids.NodeID, _ *version.Application) error {
+func (vm *VM) Connected(ctx context.Context, nodeID ids.NodeID, nodeVersion *version.Application) error {
+	if err := vm.syncer.Connected(ctx, nodeID, nodeVersion); err != nil {
+		return err
+	}
 	return vm.uptimeManager.Connect(nodeID, constants.PrimaryNetworkID)
 }
 
@@ -476,7 +551,10 @@ func (vm *VM) ConnectedSubnet(_ context.Context, nodeID ids.NodeID, subnetID ids
 	return vm.uptimeManager.Connect(nodeID, subnetID)
 }
 
-func (vm *VM) Disconnected(_ context.Context, nodeID ids.NodeID) error {
+func (vm *VM) Disconnected(ctx context.Context, nodeID ids.NodeID) error {
+	if err := vm.syncer.Disconnected(ctx, nodeID); err != nil {
+		return err
+	}
 	if err := vm.uptimeManager.Disconnect(nodeID); err != nil {
 		return err
 	}
@@ -29,6 +29,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/version"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
@@ -359,6 +360,7 @@ func TestUnverifiedParentPanicRegression(t *testing.T) {
 		MaxDelegatorStakeDuration: defaultMaxDelegatorStakingDuration,
 		RewardConfig:              defaultRewardConfig,
 		BanffTime:                 banffForkTime,
+		StateSyncTime:             mockable.MaxTime,
 	}}
 
 	ctx := defaultContext(t)
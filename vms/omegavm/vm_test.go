@@ -47,6 +47,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/resource"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/utils/timer"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/utils/units"
 	"github.com/DioneProtocol/odysseygo/version"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
@@ -324,6 +325,7 @@ func defaultVM(t *testing.T) (*VM, database.Database, *mutableSharedMemory) {
 		ApricotPhase3Time:         defaultValidateEndTime,
 		ApricotPhase5Time:         defaultValidateEndTime,
 		BanffTime:                 banffForkTime,
+		StateSyncTime:             mockable.MaxTime,
 	}}
 
 	baseDBManager := manager.NewMemDB(version.Semantic1_0_0)
@@ -1338,6 +1340,7 @@ func TestRestartFullyAccepted(t *testing.T) {
 		MaxDelegatorStakeDuration: defaultMaxDelegatorStakingDuration,
 		RewardConfig:              defaultRewardConfig,
 		BanffTime:                 banffForkTime,
+		StateSyncTime:             mockable.MaxTime,
 	}}
 
 	firstCtx := defaultContext(t)
@@ -1433,6 +1436,7 @@ func TestRestartFullyAccepted(t *testing.T) {
 		MaxDelegatorStakeDuration: defaultMaxDelegatorStakingDuration,
 		RewardConfig:              defaultRewardConfig,
 		BanffTime:                 banffForkTime,
+		StateSyncTime:             mockable.MaxTime,
 	}}
 
 	secondCtx := defaultContext(t)
@@ -1490,6 +1494,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 		MaxDelegatorStakeDuration: defaultMaxDelegatorStakingDuration,
 		RewardConfig:              defaultRewardConfig,
 		BanffTime:                 banffForkTime,
+		StateSyncTime:             mockable.MaxTime,
 	}}
 
 	initialClkTime := banffForkTime.Add(time.Second)
@@ -1817,6 +1822,7 @@ func TestUnverifiedParent(t *testing.T) {
 		MaxDelegatorStakeDuration: defaultMaxDelegatorStakingDuration,
 		RewardConfig:              defaultRewardConfig,
 		BanffTime:                 banffForkTime,
+		StateSyncTime:             mockable.MaxTime,
 	}}
 
 	initialClkTime := banffForkTime.Add(time.Second)
@@ -1977,6 +1983,7 @@ func TestUptimeDisallowedWithRestart(t *testing.T) {
 		Validators:             firstVdrs,
 		UptimeLockedCalculator: uptime.NewLockedCalculator(),
 		BanffTime:              banffForkTime,
+		StateSyncTime:          mockable.MaxTime,
 	}}
 
 	firstCtx := defaultContext(t)
@@ -2024,6 +2031,7 @@ func TestUptimeDisallowedWithRestart(t *testing.T) {
 		Validators:             secondVdrs,
 		UptimeLockedCalculator: uptime.NewLockedCalculator(),
 		BanffTime:              banffForkTime,
+		StateSyncTime:          mockable.MaxTime,
 	}}
 
 	secondCtx := defaultContext(t)
@@ -2157,6 +2165,7 @@ func TestUptimeDisallowedAfterNeverConnecting(t *testing.T) {
 		Validators:             vdrs,
 		UptimeLockedCalculator: uptime.NewLockedCalculator(),
 		BanffTime:              banffForkTime,
+		StateSyncTime:          mockable.MaxTime,
 	}}
 
 	ctx := defaultContext(t)
@@ -6,8 +6,10 @@ package secp256k1fx
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/DioneProtocol/odysseygo/cache"
+	"github.com/DioneProtocol/odysseygo/codec"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/utils/hashing"
@@ -70,6 +72,18 @@ func (fx *Fx) Initialize(vmIntf interface{}) error {
 	return errs.Err
 }
 
+// RegisterWeightedTypes registers the weighted owners types. They were added
+// after the types registered in Initialize, so VMs must register them after
+// the types of all their fxs to keep the existing type IDs unchanged.
+func RegisterWeightedTypes(c codec.Registry) error {
+	errs := wrappers.Errs{}
+	errs.Add(
+		c.RegisterType(&WeightedTransferOutput{}),
+		c.RegisterType(&WeightedOutputOwners{}),
+	)
+	return errs.Err
+}
+
 func (fx *Fx) InitializeVM(vmIntf interface{}) error {
 	vm, ok := vmIntf.(VM)
 	if !ok {
@@ -102,14 +116,20 @@ func (fx *Fx) VerifyPermission(txIntf, inIntf, credIntf, ownerIntf interface{})
 	if !ok {
 		return ErrWrongCredentialType
 	}
-	owner, ok := ownerIntf.(*OutputOwners)
-	if !ok {
+	switch owner := ownerIntf.(type) {
+	case *OutputOwners:
+		if err := verify.All(in, cred, owner); err != nil {
+			return err
+		}
+		return fx.VerifyCredentials(tx, in, cred, owner)
+	case *WeightedOutputOwners:
+		if err := verify.All(in, cred, owner); err != nil {
+			return err
+		}
+		return fx.VerifyWeightedCredentials(tx, in, cred, owner)
+	default:
 		return ErrWrongOwnerType
 	}
-	if err := verify.All(in, cred, owner); err != nil {
-		return err
-	}
-	return fx.VerifyCredentials(tx, in, cred, owner)
 }
 
 func (fx *Fx) VerifyOperation(txIntf, opIntf, credIntf interface{}, utxosIntf []interface{}) error {
@@ -158,11 +178,14 @@ func (fx *Fx) VerifyTransfer(txIntf, inIntf, credIntf, utxoIntf interface{}) err
 	if !ok {
 		return ErrWrongCredentialType
 	}
-	out, ok := utxoIntf.(*TransferOutput)
-	if !ok {
+	switch out := utxoIntf.(type) {
+	case *TransferOutput:
+		return fx.VerifySpend(tx, in, cred, out)
+	case *WeightedTransferOutput:
+		return fx.VerifyWeightedSpend(tx, in, cred, out)
+	default:
 		return ErrWrongUTXOType
 	}
-	return fx.VerifySpend(tx, in, cred, out)
 }
 
 // VerifySpend ensures that the utxo can be sent to any address
@@ -176,6 +199,17 @@ func (fx *Fx) VerifySpend(utx UnsignedTx, in *TransferInput, cred *Credential, u
 	return fx.VerifyCredentials(utx, &in.Input, cred, &utxo.OutputOwners)
 }
 
+// VerifyWeightedSpend ensures that the weighted utxo can be sent to any address
+func (fx *Fx) VerifyWeightedSpend(utx UnsignedTx, in *TransferInput, cred *Credential, utxo *WeightedTransferOutput) error {
+	if err := verify.All(utxo, in, cred); err != nil {
+		return err
+	} else if utxo.Amt != in.Amt {
+		return fmt.Errorf("%w: %d != %d", ErrMismatchedAmounts, utxo.Amt, in.Amt)
+	}
+
+	return fx.VerifyWeightedCredentials(utx, &in.Input, cred, &utxo.WeightedOutputOwners)
+}
+
 // VerifyCredentials ensures that the output can be spent by the input with the
 // credential. A nil return values means the output can be spent.
 func (fx *Fx) VerifyCredentials(utx UnsignedTx, in *Input, cred *Credential, out *OutputOwners) error {
@@ -193,10 +227,56 @@ func (fx *Fx) VerifyCredentials(utx UnsignedTx, in *Input, cred *Credential, out
 		return nil
 	}
 
+	return fx.verifySignatures(utx, in, cred, out.Addrs)
+}
+
+// VerifyWeightedCredentials ensures that the weighted output can be spent by
+// the input with the credential. The signers must hold at least the threshold
+// weight, and none of them may be superfluous. A nil return values means the
+// output can be spent.
+func (fx *Fx) VerifyWeightedCredentials(utx UnsignedTx, in *Input, cred *Credential, out *WeightedOutputOwners) error {
+	numSigs := len(in.SigIndices)
+	switch {
+	case out.Locktime > fx.VM.Clock().Unix():
+		return ErrTimelocked
+	case numSigs != len(cred.Sigs):
+		return ErrInputCredentialSignersMismatch
+	}
+
+	var (
+		weight    uint64
+		minWeight uint64 = math.MaxUint32
+	)
+	for _, index := range in.SigIndices {
+		if index >= uint32(len(out.Addrs)) {
+			return ErrInputOutputIndexOutOfBounds
+		}
+		addrWeight := uint64(out.Weights[index])
+		weight += addrWeight
+		if addrWeight < minWeight {
+			minWeight = addrWeight
+		}
+	}
+
+	threshold := uint64(out.Threshold)
+	switch {
+	case weight < threshold:
+		return ErrTooFewSigners
+	case numSigs > 0 && weight-minWeight >= threshold:
+		return ErrTooManySigners
+	case !fx.bootstrapped: // disable signature verification during bootstrapping
+		return nil
+	}
+	return fx.verifySignatures(utx, in, cred, out.Addrs)
+}
+
+// verifySignatures ensures that the i-th signature of [cred] is from the
+// address [addrs] at the i-th signature index of [in]
+func (fx *Fx) verifySignatures(utx UnsignedTx, in *Input, cred *Credential, addrs []ids.ShortID) error {
 	txHash := hashing.ComputeHash256(utx.Bytes())
 	for i, index := range in.SigIndices {
 		// Make sure the input references an address that exists
-		if index >= uint32(len(out.Addrs)) {
+		if index >= uint32(len(addrs)) {
 			return ErrInputOutputIndexOutOfBounds
 		}
 		// Make sure each signature in the signature list is from an owner of
@@ -206,7 +286,7 @@ func (fx *Fx) VerifyCredentials(utx UnsignedTx, in *Input, cred *Credential, out
 		if err != nil {
 			return err
 		}
-		if expectedAddress := out.Addrs[index]; expectedAddress != pk.Address() {
+		if expectedAddress := addrs[index]; expectedAddress != pk.Address() {
 			return fmt.Errorf("%w: expected signature from %s but got from %s",
 				ErrWrongSig,
 				expectedAddress,
@@ -220,15 +300,24 @@ func (fx *Fx) VerifyCredentials(utx UnsignedTx, in *Input, cred *Credential, out
 // CreateOutput creates a new output with the provided control group worth
 // the specified amount
 func (*Fx) CreateOutput(amount uint64, ownerIntf interface{}) (interface{}, error) {
-	owner, ok := ownerIntf.(*OutputOwners)
-	if !ok {
+	switch owner := ownerIntf.(type) {
+	case *OutputOwners:
+		if err := owner.Verify(); err != nil {
+			return nil, err
+		}
+		return &TransferOutput{
+			Amt:          amount,
+			OutputOwners: *owner,
+		}, nil
+	case *WeightedOutputOwners:
+		if err := owner.Verify(); err != nil {
+			return nil, err
+		}
+		return &WeightedTransferOutput{
+			Amt:                  amount,
+			WeightedOutputOwners: *owner,
+		}, nil
+	default:
 		return nil, ErrWrongOwnerType
 	}
-	if err := owner.Verify(); err != nil {
-		return nil, err
-	}
-	return &TransferOutput{
-		Amt:          amount,
-		OutputOwners: *owner,
-	}, nil
 }
@@ -1136,3 +1136,206 @@ func TestVerifyPermission(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyWeightedPermission(t *testing.T) {
+	vm := TestVM{
+		Codec: linearcodec.NewDefault(),
+		Log:   logging.NoLog{},
+	}
+	fx := Fx{}
+	require.NoError(t, fx.Initialize(&vm))
+	require.NoError(t, fx.Bootstrapping())
+	require.NoError(t, fx.Bootstrapped())
+
+	now := time.Now()
+	fx.VM.Clock().Set(now)
+
+	type test struct {
+		description string
+		in          *Input
+		cred        *Credential
+		cg          *WeightedOutputOwners
+		expectedErr error
+	}
+	tests := []test{
+		{
+			"threshold 0, no sigs, no addrs",
+			&Input{SigIndices: []uint32{}},
+			&Credential{Sigs: [][secp256k1.SignatureLen]byte{}},
+			&WeightedOutputOwners{
+				Threshold: 0,
+				Addrs:     []ids.ShortID{},
+				Weights:   []uint32{},
+			},
+			nil,
+		},
+		{
+			"invalid owners",
+			&Input{SigIndices: []uint32{0}},
+			&Credential{Sigs: [][secp256k1.SignatureLen]byte{sigBytes}},
+			&WeightedOutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr},
+				Weights:   []uint32{0},
+			},
+			ErrZeroWeight,
+		},
+		{
+			"heavy signer reaches threshold alone",
+			&Input{SigIndices: []uint32{0}},
+			&Credential{Sigs: [][secp256k1.SignatureLen]byte{sigBytes}},
+			&WeightedOutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr, addr2},
+				Weights:   []uint32{2, 1},
+			},
+			nil,
+		},
+		{
+			"light signer is too few",
+			&Input{SigIndices: []uint32{1}},
+			&Credential{Sigs: [][secp256k1.SignatureLen]byte{sig2Bytes}},
+			&WeightedOutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr, addr2},
+				Weights:   []uint32{2, 1},
+			},
+			ErrTooFewSigners,
+		},
+		{
+			"both signers reach threshold",
+			&Input{SigIndices: []uint32{0, 1}},
+			&Credential{Sigs: [][secp256k1.SignatureLen]byte{sigBytes, sig2Bytes}},
+			&WeightedOutputOwners{
+				Threshold: 3,
+				Addrs:     []ids.ShortID{addr, addr2},
+				Weights:   []uint32{2, 1},
+			},
+			nil,
+		},
+		{
+			"superfluous signer",
+			&Input{SigIndices: []uint32{0, 1}},
+			&Credential{Sigs: [][secp256k1.SignatureLen]byte{sigBytes, sig2Bytes}},
+			&WeightedOutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr, addr2},
+				Weights:   []uint32{2, 1},
+			},
+			ErrTooManySigners,
+		},
+		{
+			"wrong signature",
+			&Input{SigIndices: []uint32{0}},
+			&Credential{Sigs: [][secp256k1.SignatureLen]byte{sig2Bytes}},
+			&WeightedOutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr, addr2},
+				Weights:   []uint32{2, 1},
+			},
+			ErrWrongSig,
+		},
+		{
+			"index out of bounds",
+			&Input{SigIndices: []uint32{2}},
+			&Credential{Sigs: [][secp256k1.SignatureLen]byte{sigBytes}},
+			&WeightedOutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr, addr2},
+				Weights:   []uint32{2, 1},
+			},
+			ErrInputOutputIndexOutOfBounds,
+		},
+		{
+			"number of signatures doesn't match",
+			&Input{SigIndices: []uint32{0}},
+			&Credential{Sigs: [][secp256k1.SignatureLen]byte{sigBytes, sig2Bytes}},
+			&WeightedOutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr, addr2},
+				Weights:   []uint32{2, 1},
+			},
+			ErrInputCredentialSignersMismatch,
+		},
+		{
+			"output is locked",
+			&Input{SigIndices: []uint32{0}},
+			&Credential{Sigs: [][secp256k1.SignatureLen]byte{sigBytes}},
+			&WeightedOutputOwners{
+				Locktime:  uint64(now.Add(time.Second).Unix()),
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr, addr2},
+				Weights:   []uint32{2, 1},
+			},
+			ErrTimelocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			tx := &TestTx{UnsignedBytes: txBytes}
+			err := fx.VerifyPermission(tx, test.in, test.cred, test.cg)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestFxVerifyWeightedTransfer(t *testing.T) {
+	require := require.New(t)
+	vm := TestVM{
+		Codec: linearcodec.NewDefault(),
+		Log:   logging.NoLog{},
+	}
+	fx := Fx{}
+	require.NoError(fx.Initialize(&vm))
+	require.NoError(RegisterWeightedTypes(vm.Codec))
+	require.NoError(fx.Bootstrapping())
+	require.NoError(fx.Bootstrapped())
+
+	tx := &TestTx{UnsignedBytes: txBytes}
+	out := &WeightedTransferOutput{
+		Amt: 1,
+		WeightedOutputOwners: WeightedOutputOwners{
+			Threshold: 2,
+			Addrs:     []ids.ShortID{addr, addr2},
+			Weights:   []uint32{2, 1},
+		},
+	}
+	in := &TransferInput{
+		Amt: 1,
+		Input: Input{
+			SigIndices: []uint32{0},
+		},
+	}
+	cred := &Credential{
+		Sigs: [][secp256k1.SignatureLen]byte{
+			sigBytes,
+		},
+	}
+	require.NoError(fx.VerifyTransfer(tx, in, cred, out))
+
+	in.Amt = 2
+	err := fx.VerifyTransfer(tx, in, cred, out)
+	require.ErrorIs(err, ErrMismatchedAmounts)
+}
+
+func TestFxCreateWeightedOutput(t *testing.T) {
+	require := require.New(t)
+	fx := Fx{}
+
+	owner := &WeightedOutputOwners{
+		Threshold: 2,
+		Addrs:     []ids.ShortID{addr, addr2},
+		Weights:   []uint32{2, 1},
+	}
+	out, err := fx.CreateOutput(1, owner)
+	require.NoError(err)
+	require.Equal(&WeightedTransferOutput{
+		Amt:                  1,
+		WeightedOutputOwners: *owner,
+	}, out)
+
+	owner.Weights = []uint32{2}
+	_, err = fx.CreateOutput(1, owner)
+	require.ErrorIs(err, ErrWeightsAddrsMismatch)
+}
@@ -119,6 +119,16 @@ func (kc *Keychain) Spend(out verify.Verifiable, time uint64) (verify.Verifiable
 			}, keys, nil
 		}
 		return nil, nil, errCantSpend
+	case *WeightedTransferOutput:
+		if sigIndices, keys, able := kc.MatchWeighted(&out.WeightedOutputOwners, time); able {
+			return &TransferInput{
+				Amt: out.Amt,
+				Input: Input{
+					SigIndices: sigIndices,
+				},
+			}, keys, nil
+		}
+		return nil, nil, errCantSpend
 	}
 	return nil, nil, fmt.Errorf("can't spend UTXO because it is unexpected type %T", out)
 }
@@ -139,6 +149,26 @@ func (kc *Keychain) Match(owners *OutputOwners, time uint64) ([]uint32, []*secp2
 	return sigs, keys, uint32(len(keys)) == owners.Threshold
 }
 
+// MatchWeighted attempts to match a list of addresses whose weights reach the
+// provided threshold
+func (kc *Keychain) MatchWeighted(owners *WeightedOutputOwners, time uint64) ([]uint32, []*secp256k1.PrivateKey, bool) {
+	if time < owners.Locktime {
+		return nil, nil, false
+	}
+	sigs, able := owners.SelectSigners(func(addr ids.ShortID) bool {
+		_, exists := kc.get(addr)
+		return exists
+	})
+	if !able {
+		return nil, nil, false
+	}
+	keys := make([]*secp256k1.PrivateKey, len(sigs))
+	for i, index := range sigs {
+		keys[i], _ = kc.get(owners.Addrs[index])
+	}
+	return sigs, keys, true
+}
+
 // PrefixedString returns the key chain as a string representation with [prefix]
 // added before every line.
 func (kc *Keychain) PrefixedString(prefix string) string {
@@ -8,7 +8,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"golang.org/x/exp/maps"
+
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/utils/formatting"
 )
@@ -244,3 +247,53 @@ func TestKeychainPrefixedString(t *testing.T) {
 	expected := "xDKey[0]: Key: 0xb1ed77ad48555d49f03a7465f0685a7d86bfd5f3a3ccf1be01971ea8dec5471c Address: B6D4v1VtPYLbiUvYXtW4Px8oE9imC2vGW"
 	require.Equal(expected, kc.PrefixedString("xD"))
 }
+
+func TestKeychainSpendWeightedTransfer(t *testing.T) {
+	require := require.New(t)
+	kc := NewKeychain()
+
+	sks := map[ids.ShortID]*secp256k1.PrivateKey{}
+	for _, keyStr := range keys {
+		skBytes, err := formatting.Decode(formatting.HexNC, keyStr)
+		require.NoError(err)
+
+		sk, err := kc.factory.ToPrivateKey(skBytes)
+		require.NoError(err)
+		sks[sk.PublicKey().Address()] = sk
+	}
+
+	transfer := WeightedTransferOutput{
+		Amt: 12345,
+		WeightedOutputOwners: WeightedOutputOwners{
+			Locktime:  54321,
+			Threshold: 3,
+			Addrs:     maps.Keys(sks),
+			Weights:   []uint32{2, 1, 1},
+		},
+	}
+	utils.Sort(transfer.Addrs)
+	require.NoError(transfer.Verify())
+
+	kc.Add(sks[transfer.Addrs[1]])
+	kc.Add(sks[transfer.Addrs[2]])
+
+	_, _, err := kc.Spend(&transfer, 54321)
+	require.ErrorIs(err, errCantSpend)
+
+	kc.Add(sks[transfer.Addrs[0]])
+
+	_, _, err = kc.Spend(&transfer, 4321)
+	require.ErrorIs(err, errCantSpend)
+
+	vinput, keys, err := kc.Spend(&transfer, 54321)
+	require.NoError(err)
+
+	require.IsType(&TransferInput{}, vinput)
+	input := vinput.(*TransferInput)
+	require.NoError(input.Verify())
+	require.Equal(uint64(12345), input.Amount())
+	require.Equal([]uint32{0, 1}, input.SigIndices)
+	require.Len(keys, 2)
+	require.Equal(transfer.Addrs[0], keys[0].PublicKey().Address())
+	require.Equal(transfer.Addrs[1], keys[1].PublicKey().Address())
+}
@@ -0,0 +1,197 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package secp256k1fx
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+)
+
+var (
+	ErrWeightsAddrsMismatch = errors.New("number of weights and addresses differ")
+	ErrZeroWeight           = errors.New("address has no weight")
+)
+
+// WeightedOutputOwners is similar to [OutputOwners], but every address carries
+// a weight. The output can be spent once the signers hold at least [Threshold]
+// weight.
+type WeightedOutputOwners struct {
+	verify.IsNotState `json:"-"`
+
+	Locktime  uint64        `serialize:"true" json:"locktime"`
+	Threshold uint32        `serialize:"true" json:"threshold"`
+	Addrs     []ids.ShortID `serialize:"true" json:"addresses"`
+	// Weights[i] is the weight of Addrs[i]
+	Weights []uint32 `serialize:"true" json:"weights"`
+
+	// ctx is used in MarshalJSON to convert Addrs into human readable
+	// format with ChainID and NetworkID. Unexported because we don't use
+	// it outside this object.
+	ctx *snow.Context
+}
+
+// InitCtx assigns the WeightedOutputOwners.ctx object to given [ctx] object
+// Must be called at least once for MarshalJSON to work successfully
+func (out *WeightedOutputOwners) InitCtx(ctx *snow.Context) {
+	out.ctx = ctx
+}
+
+// MarshalJSON marshals WeightedOutputOwners as JSON with human readable
+// addresses. Returns errMarshal error if WeightedOutputOwners.ctx is not set.
+func (out *WeightedOutputOwners) MarshalJSON() ([]byte, error) {
+	result, err := out.Fields()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// Fields returns JSON keys in a map that can be used with marshal JSON
+// to serialize WeightedOutputOwners struct
+func (out *WeightedOutputOwners) Fields() (map[string]interface{}, error) {
+	addrsLen := len(out.Addrs)
+
+	// we need out.ctx to do this, if its absent, throw error
+	if addrsLen > 0 && out.ctx == nil {
+		return nil, ErrMarshal
+	}
+
+	addresses := make([]string, addrsLen)
+	for i, addr := range out.Addrs {
+		fAddr, err := formatAddress(out.ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = fAddr
+	}
+	result := map[string]interface{}{
+		"locktime":  out.Locktime,
+		"threshold": out.Threshold,
+		"addresses": addresses,
+		"weights":   out.Weights,
+	}
+
+	return result, nil
+}
+
+// Addresses returns the addresses that manage this output
+func (out *WeightedOutputOwners) Addresses() [][]byte {
+	addrs := make([][]byte, len(out.Addrs))
+	for i, addr := range out.Addrs {
+		addrs[i] = addr.Bytes()
+	}
+	return addrs
+}
+
+// AddressesSet returns addresses as a set
+func (out *WeightedOutputOwners) AddressesSet() set.Set[ids.ShortID] {
+	return set.Of(out.Addrs...)
+}
+
+// Equals returns true if the provided owners create the same condition
+func (out *WeightedOutputOwners) Equals(other *WeightedOutputOwners) bool {
+	if out == other {
+		return true
+	}
+	if out == nil || other == nil || out.Locktime != other.Locktime || out.Threshold != other.Threshold || len(out.Addrs) != len(other.Addrs) || len(out.Weights) != len(other.Weights) {
+		return false
+	}
+	for i, addr := range out.Addrs {
+		if addr != other.Addrs[i] {
+			return false
+		}
+	}
+	for i, weight := range out.Weights {
+		if weight != other.Weights[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// TotalWeight returns the sum of the weights of all the addresses
+func (out *WeightedOutputOwners) TotalWeight() uint64 {
+	var total uint64
+	for _, weight := range out.Weights {
+		total += uint64(weight)
+	}
+	return total
+}
+
+func (out *WeightedOutputOwners) Verify() error {
+	if out == nil {
+		return ErrNilOutput
+	}
+	if len(out.Weights) != len(out.Addrs) {
+		return ErrWeightsAddrsMismatch
+	}
+	for _, weight := range out.Weights {
+		if weight == 0 {
+			return ErrZeroWeight
+		}
+	}
+
+	switch {
+	case uint64(out.Threshold) > out.TotalWeight():
+		return ErrOutputUnspendable
+	case out.Threshold == 0 && len(out.Addrs) > 0:
+		return ErrOutputUnoptimized
+	case !utils.IsSortedAndUnique(out.Addrs):
+		return ErrAddrsNotSortedUnique
+	default:
+		return nil
+	}
+}
+
+// Sort sorts the addresses, keeping every weight attached to its address
+func (out *WeightedOutputOwners) Sort() {
+	weights := make(map[ids.ShortID]uint32, len(out.Addrs))
+	for i, addr := range out.Addrs {
+		weights[addr] = out.Weights[i]
+	}
+	utils.Sort(out.Addrs)
+	for i, addr := range out.Addrs {
+		out.Weights[i] = weights[addr]
+	}
+}
+
+// SelectSigners returns the indices of the addresses, among those [canSign]
+// accepts, whose signatures spend this output. No returned signer is
+// superfluous: dropping any of them would fall below the threshold. Returns
+// false if the accepted addresses don't hold enough weight.
+func (out *WeightedOutputOwners) SelectSigners(canSign func(ids.ShortID) bool) ([]uint32, bool) {
+	var (
+		sigs   []uint32
+		weight uint64
+	)
+	threshold := uint64(out.Threshold)
+	for i := uint32(0); i < uint32(len(out.Addrs)) && weight < threshold; i++ {
+		if canSign(out.Addrs[i]) {
+			sigs = append(sigs, i)
+			weight += uint64(out.Weights[i])
+		}
+	}
+	if weight < threshold {
+		return nil, false
+	}
+
+	// Drop the signers that aren't needed to reach the threshold
+	selected := sigs[:0]
+	for _, index := range sigs {
+		addrWeight := uint64(out.Weights[index])
+		if weight-addrWeight >= threshold {
+			weight -= addrWeight
+			continue
+		}
+		selected = append(selected, index)
+	}
+	return selected, true
+}
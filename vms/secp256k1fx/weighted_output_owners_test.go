@@ -0,0 +1,219 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package secp256k1fx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+)
+
+func TestWeightedOutputOwnersVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		out         *WeightedOutputOwners
+		expectedErr error
+	}{
+		{
+			name:        "nil",
+			out:         nil,
+			expectedErr: ErrNilOutput,
+		},
+		{
+			name: "weights mismatch",
+			out: &WeightedOutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{{1}},
+				Weights:   []uint32{1, 2},
+			},
+			expectedErr: ErrWeightsAddrsMismatch,
+		},
+		{
+			name: "zero weight",
+			out: &WeightedOutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{{1}, {2}},
+				Weights:   []uint32{1, 0},
+			},
+			expectedErr: ErrZeroWeight,
+		},
+		{
+			name: "threshold above max weight",
+			out: &WeightedOutputOwners{
+				Threshold: math.MaxUint32,
+				Addrs:     []ids.ShortID{{1}, {2}},
+				Weights:   []uint32{math.MaxUint32, 1},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "threshold > total weight",
+			out: &WeightedOutputOwners{
+				Threshold: 4,
+				Addrs:     []ids.ShortID{{1}, {2}},
+				Weights:   []uint32{2, 1},
+			},
+			expectedErr: ErrOutputUnspendable,
+		},
+		{
+			name: "unoptimized",
+			out: &WeightedOutputOwners{
+				Threshold: 0,
+				Addrs:     []ids.ShortID{{1}},
+				Weights:   []uint32{1},
+			},
+			expectedErr: ErrOutputUnoptimized,
+		},
+		{
+			name: "not sorted",
+			out: &WeightedOutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{{2}, {1}},
+				Weights:   []uint32{1, 1},
+			},
+			expectedErr: ErrAddrsNotSortedUnique,
+		},
+		{
+			name: "not unique",
+			out: &WeightedOutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{{2}, {2}},
+				Weights:   []uint32{1, 1},
+			},
+			expectedErr: ErrAddrsNotSortedUnique,
+		},
+		{
+			name: "no owners",
+			out: &WeightedOutputOwners{
+				Threshold: 0,
+				Addrs:     []ids.ShortID{},
+				Weights:   []uint32{},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "passes verification",
+			out: &WeightedOutputOwners{
+				Threshold: 3,
+				Addrs:     []ids.ShortID{{1}, {2}, {3}},
+				Weights:   []uint32{2, 1, 1},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.out.Verify()
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestWeightedOutputOwnersEquals(t *testing.T) {
+	require := require.New(t)
+
+	owners := &WeightedOutputOwners{
+		Locktime:  1,
+		Threshold: 3,
+		Addrs:     []ids.ShortID{{1}, {2}},
+		Weights:   []uint32{2, 1},
+	}
+	require.True(owners.Equals(owners))
+	require.True(owners.Equals(&WeightedOutputOwners{
+		Locktime:  1,
+		Threshold: 3,
+		Addrs:     []ids.ShortID{{1}, {2}},
+		Weights:   []uint32{2, 1},
+	}))
+	require.False(owners.Equals(nil))
+	require.False(owners.Equals(&WeightedOutputOwners{
+		Locktime:  1,
+		Threshold: 3,
+		Addrs:     []ids.ShortID{{1}, {2}},
+		Weights:   []uint32{1, 2},
+	}))
+	require.False(owners.Equals(&WeightedOutputOwners{
+		Locktime:  1,
+		Threshold: 2,
+		Addrs:     []ids.ShortID{{1}, {2}},
+		Weights:   []uint32{2, 1},
+	}))
+}
+
+func TestWeightedOutputOwnersSort(t *testing.T) {
+	require := require.New(t)
+
+	owners := &WeightedOutputOwners{
+		Threshold: 3,
+		Addrs:     []ids.ShortID{{3}, {1}, {2}},
+		Weights:   []uint32{3, 1, 2},
+	}
+	owners.Sort()
+	require.Equal([]ids.ShortID{{1}, {2}, {3}}, owners.Addrs)
+	require.Equal([]uint32{1, 2, 3}, owners.Weights)
+	require.NoError(owners.Verify())
+}
+
+func TestWeightedOutputOwnersSelectSigners(t *testing.T) {
+	owners := &WeightedOutputOwners{
+		Threshold: 3,
+		Addrs:     []ids.ShortID{{1}, {2}, {3}, {4}},
+		Weights:   []uint32{1, 3, 1, 1},
+	}
+
+	tests := []struct {
+		name         string
+		signers      []ids.ShortID
+		expectedSigs []uint32
+		expectedOk   bool
+	}{
+		{
+			name:       "not enough weight",
+			signers:    []ids.ShortID{{1}, {3}},
+			expectedOk: false,
+		},
+		{
+			name:       "no signers",
+			signers:    nil,
+			expectedOk: false,
+		},
+		{
+			name:         "heavy signer",
+			signers:      []ids.ShortID{{2}, {3}},
+			expectedSigs: []uint32{1},
+			expectedOk:   true,
+		},
+		{
+			name:         "light signers",
+			signers:      []ids.ShortID{{1}, {3}, {4}},
+			expectedSigs: []uint32{0, 2, 3},
+			expectedOk:   true,
+		},
+		{
+			name:         "superfluous signer dropped",
+			signers:      []ids.ShortID{{1}, {2}, {3}, {4}},
+			expectedSigs: []uint32{1},
+			expectedOk:   true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			signers := make(map[ids.ShortID]bool)
+			for _, signer := range test.signers {
+				signers[signer] = true
+			}
+			sigs, ok := owners.SelectSigners(func(addr ids.ShortID) bool {
+				return signers[addr]
+			})
+			require.Equal(test.expectedOk, ok)
+			require.Equal(test.expectedSigs, sigs)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package secp256k1fx
+
+import (
+	"encoding/json"
+
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+)
+
+var _ verify.State = (*WeightedTransferOutput)(nil)
+
+// WeightedTransferOutput is a [TransferOutput] owned by weighted owners. It is
+// spent by a [TransferInput].
+type WeightedTransferOutput struct {
+	verify.IsState `json:"-"`
+
+	Amt uint64 `serialize:"true" json:"amount"`
+
+	WeightedOutputOwners `serialize:"true"`
+}
+
+// MarshalJSON marshals Amt and the embedded WeightedOutputOwners struct
+// into a JSON readable format
+// If WeightedOutputOwners cannot be serialized then this will return error
+func (out *WeightedTransferOutput) MarshalJSON() ([]byte, error) {
+	result, err := out.WeightedOutputOwners.Fields()
+	if err != nil {
+		return nil, err
+	}
+
+	result["amount"] = out.Amt
+	return json.Marshal(result)
+}
+
+// Amount returns the quantity of the asset this output consumes
+func (out *WeightedTransferOutput) Amount() uint64 {
+	return out.Amt
+}
+
+func (out *WeightedTransferOutput) Verify() error {
+	switch {
+	case out == nil:
+		return ErrNilOutput
+	case out.Amt == 0:
+		return ErrNoValueOutput
+	default:
+		return out.WeightedOutputOwners.Verify()
+	}
+}
+
+func (out *WeightedTransferOutput) Owners() interface{} {
+	return &out.WeightedOutputOwners
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package secp256k1fx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/codec"
+	"github.com/DioneProtocol/odysseygo/codec/linearcodec"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+)
+
+func TestWeightedOutputVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		out         *WeightedTransferOutput
+		expectedErr error
+	}{
+		{
+			name:        "nil",
+			out:         nil,
+			expectedErr: ErrNilOutput,
+		},
+		{
+			name: "no value",
+			out: &WeightedTransferOutput{
+				WeightedOutputOwners: WeightedOutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{{1}},
+					Weights:   []uint32{1},
+				},
+			},
+			expectedErr: ErrNoValueOutput,
+		},
+		{
+			name: "invalid owners",
+			out: &WeightedTransferOutput{
+				Amt: 1,
+				WeightedOutputOwners: WeightedOutputOwners{
+					Threshold: 2,
+					Addrs:     []ids.ShortID{{1}},
+					Weights:   []uint32{1},
+				},
+			},
+			expectedErr: ErrOutputUnspendable,
+		},
+		{
+			name: "passes verification",
+			out: &WeightedTransferOutput{
+				Amt: 1,
+				WeightedOutputOwners: WeightedOutputOwners{
+					Threshold: 2,
+					Addrs:     []ids.ShortID{{1}},
+					Weights:   []uint32{2},
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.out.Verify()
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestWeightedOutputSerialize(t *testing.T) {
+	require := require.New(t)
+	c := linearcodec.NewDefault()
+	m := codec.NewDefaultManager()
+	require.NoError(m.RegisterCodec(0, c))
+
+	expected := []byte{
+		// Codec version
+		0x00, 0x00,
+		// amount:
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x30, 0x39,
+		// locktime:
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd4, 0x31,
+		// threshold:
+		0x00, 0x00, 0x00, 0x03,
+		// number of addresses:
+		0x00, 0x00, 0x00, 0x02,
+		// addrs[0]:
+		0x51, 0x02, 0x5c, 0x61, 0xfb, 0xcf, 0xc0, 0x78,
+		0xf6, 0x93, 0x34, 0xf8, 0x34, 0xbe, 0x6d, 0xd2,
+		0x6d, 0x55, 0xa9, 0x55,
+		// addrs[1]:
+		0xc3, 0x34, 0x41, 0x28, 0xe0, 0x60, 0x12, 0x8e,
+		0xde, 0x35, 0x23, 0xa2, 0x4a, 0x46, 0x1c, 0x89,
+		0x43, 0xab, 0x08, 0x59,
+		// number of weights:
+		0x00, 0x00, 0x00, 0x02,
+		// weights[0]:
+		0x00, 0x00, 0x00, 0x02,
+		// weights[1]:
+		0x00, 0x00, 0x00, 0x01,
+	}
+	out := WeightedTransferOutput{
+		Amt: 12345,
+		WeightedOutputOwners: WeightedOutputOwners{
+			Locktime:  54321,
+			Threshold: 3,
+			Addrs: []ids.ShortID{
+				{
+					0x51, 0x02, 0x5c, 0x61, 0xfb, 0xcf, 0xc0, 0x78,
+					0xf6, 0x93, 0x34, 0xf8, 0x34, 0xbe, 0x6d, 0xd2,
+					0x6d, 0x55, 0xa9, 0x55,
+				},
+				{
+					0xc3, 0x34, 0x41, 0x28, 0xe0, 0x60, 0x12, 0x8e,
+					0xde, 0x35, 0x23, 0xa2, 0x4a, 0x46, 0x1c, 0x89,
+					0x43, 0xab, 0x08, 0x59,
+				},
+			},
+			Weights: []uint32{2, 1},
+		},
+	}
+	require.NoError(out.Verify())
+
+	result, err := m.Marshal(0, &out)
+	require.NoError(err)
+	require.Equal(expected, result)
+
+	parsed := WeightedTransferOutput{}
+	_, err = m.Unmarshal(result, &parsed)
+	require.NoError(err)
+	require.Equal(out, parsed)
+	require.Equal(uint64(12345), parsed.Amount())
+	require.Equal(&parsed.WeightedOutputOwners, parsed.Owners())
+}
+
+func TestWeightedTransferOutputState(t *testing.T) {
+	intf := interface{}(&WeightedTransferOutput{})
+	_, ok := intf.(verify.State)
+	require.True(t, ok)
+}
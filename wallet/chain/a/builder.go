@@ -11,11 +11,13 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
 	"github.com/DioneProtocol/odysseygo/utils/math"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
 	"github.com/DioneProtocol/odysseygo/vms/nftfx"
 	"github.com/DioneProtocol/odysseygo/vms/propertyfx"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
@@ -25,6 +27,9 @@ import (
 var (
 	errNoChangeAddress   = errors.New("no possible change address")
 	errInsufficientFunds = errors.New("insufficient funds")
+	errNoPreimage        = errors.New("no preimage provided")
+	errNotHTLC           = errors.New("UTXO isn't an HTLC")
+	errUnknownHTLC       = errors.New("unknown HTLC")
 
 	_ Builder = (*builder)(nil)
 )
@@ -96,7 +101,8 @@ type Builder interface {
 	// requested asset.
 	//
 	// - [assetID] specifies the asset to mint the NFTs under.
-	// - [payload] specifies the payload to provide each new NFT.
+	// - [payload] specifies the payload to provide each new NFT. If it's a
+	//   JSON object, it must be valid [nftfx.Metadata].
 	// - [owners] specifies the new owners of each NFT.
 	NewOperationTxMintNFT(
 		assetID ids.ID,
@@ -146,6 +152,51 @@ type Builder interface {
 		outputs []*dione.TransferableOutput,
 		options ...common.Option,
 	) (*txs.ExportTx, error)
+
+	// NewHTLCTx creates a new value transfer that locks [amount] of [assetID]
+	// in a hashed timelock contract.
+	//
+	// - [hash] specifies the SHA-256 hash of the preimage [recipient] must
+	//   reveal to claim the funds.
+	// - [timeout] specifies the unix time from which the funds can be refunded
+	//   to [sender].
+	// - [relativeTimeout] specifies the number of seconds after the
+	//   transaction is accepted from which the funds can be refunded to
+	//   [sender]. Exactly one of [timeout] and [relativeTimeout] must be set.
+	NewHTLCTx(
+		assetID ids.ID,
+		amount uint64,
+		hash [hashing.HashLen]byte,
+		timeout uint64,
+		relativeTimeout uint64,
+		recipient *secp256k1fx.OutputOwners,
+		sender *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.BaseTx, error)
+
+	// NewClaimHTLCTx creates a new value transfer that claims the funds locked
+	// in a hashed timelock contract on behalf of its recipient.
+	//
+	// - [utxoID] specifies the UTXO locking the funds.
+	// - [preimage] specifies the preimage of the hash of the contract.
+	// - [to] specifies where to send the claimed funds to.
+	NewClaimHTLCTx(
+		utxoID ids.ID,
+		preimage []byte,
+		to *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.BaseTx, error)
+
+	// NewRefundHTLCTx creates a new value transfer that refunds the funds
+	// locked in a timed out hashed timelock contract to its sender.
+	//
+	// - [utxoID] specifies the UTXO locking the funds.
+	// - [to] specifies where to send the refunded funds to.
+	NewRefundHTLCTx(
+		utxoID ids.ID,
+		to *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.BaseTx, error)
 }
 
 // BuilderBackend specifies the required information needed to build unsigned
@@ -310,6 +361,10 @@ func (b *builder) NewOperationTxMintNFT(
 	owners []*secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.OperationTx, error) {
+	if err := nftfx.VerifyPayload(payload); err != nil {
+		return nil, err
+	}
+
 	ops := common.NewOptions(options)
 	operations, err := b.mintNFTs(assetID, payload, owners, ops)
 	if err != nil {
@@ -486,6 +541,54 @@ func (b *builder) NewExportTx(
 	}, nil
 }
 
+func (b *builder) NewHTLCTx(
+	assetID ids.ID,
+	amount uint64,
+	hash [hashing.HashLen]byte,
+	timeout uint64,
+	relativeTimeout uint64,
+	recipient *secp256k1fx.OutputOwners,
+	sender *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.BaseTx, error) {
+	return b.NewBaseTx(
+		[]*dione.TransferableOutput{{
+			Asset: dione.Asset{ID: assetID},
+			Out: &htlcfx.TransferOutput{
+				Amt:             amount,
+				Hash:            hash,
+				Timeout:         timeout,
+				RelativeTimeout: relativeTimeout,
+				Recipient:       *recipient,
+				Sender:          *sender,
+			},
+		}},
+		options...,
+	)
+}
+
+func (b *builder) NewClaimHTLCTx(
+	utxoID ids.ID,
+	preimage []byte,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.BaseTx, error) {
+	if len(preimage) == 0 {
+		return nil, errNoPreimage
+	}
+	ops := common.NewOptions(options)
+	return b.spendHTLC(utxoID, preimage, to, ops)
+}
+
+func (b *builder) NewRefundHTLCTx(
+	utxoID ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.BaseTx, error) {
+	ops := common.NewOptions(options)
+	return b.spendHTLC(utxoID, nil, to, ops)
+}
+
 func (b *builder) getBalance(
 	chainID ids.ID,
 	options *common.Options,
@@ -620,6 +723,103 @@ func (b *builder) spend(
 	return inputs, outputs, nil
 }
 
+// spendHTLC sends the funds locked in the HTLC [utxoID] to [to]. The funds are
+// claimed by the recipient if [preimage] is provided, and refunded to the
+// sender otherwise.
+func (b *builder) spendHTLC(
+	utxoID ids.ID,
+	preimage []byte,
+	to *secp256k1fx.OutputOwners,
+	options *common.Options,
+) (*txs.BaseTx, error) {
+	utxos, err := b.backend.UTXOs(options.Context(), b.backend.BlockchainID())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		addrs           = options.Addresses(b.addrs)
+		minIssuanceTime = options.MinIssuanceTime()
+		dioneAssetID    = b.backend.DIONEAssetID()
+		txFee           = b.backend.BaseTxFee()
+	)
+	for _, utxo := range utxos {
+		if utxo.InputID() != utxoID {
+			continue
+		}
+
+		out, ok := utxo.Out.(*htlcfx.TransferOutput)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", errNotHTLC, utxoID)
+		}
+
+		in := &htlcfx.TransferInput{
+			Amt:      out.Amt,
+			Preimage: preimage,
+		}
+		owners := &out.Sender
+		switch {
+		case out.RelativeTimeout != 0:
+			// The UTXO wasn't fetched from the chain, which resolves the
+			// timeout once the UTXO is accepted
+			return nil, fmt.Errorf("%w: %q", htlcfx.ErrUnresolvedTimeout, utxoID)
+		case in.IsClaim() && minIssuanceTime >= out.Timeout:
+			return nil, htlcfx.ErrTimedOut
+		case in.IsClaim():
+			owners = &out.Recipient
+		case minIssuanceTime < out.Timeout:
+			return nil, htlcfx.ErrNotTimedOut
+		}
+
+		inputSigIndices, ok := common.MatchOwners(owners, addrs, minIssuanceTime)
+		if !ok {
+			return nil, fmt.Errorf(
+				"%w: provided addresses not able to spend HTLC %q",
+				errInsufficientFunds,
+				utxoID,
+			)
+		}
+		in.SigIndices = inputSigIndices
+
+		// The fee is paid out of the locked funds when possible
+		amount := out.Amt
+		toBurn := map[ids.ID]uint64{}
+		if utxo.AssetID() == dioneAssetID && amount > txFee {
+			amount -= txFee
+		} else {
+			toBurn[dioneAssetID] = txFee
+		}
+
+		inputs, outputs, err := b.spend(toBurn, options)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, &dione.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In:     in,
+		})
+		outputs = append(outputs, &dione.TransferableOutput{
+			Asset: utxo.Asset,
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          amount,
+				OutputOwners: *to,
+			},
+		})
+
+		utils.Sort(inputs)                                     // sort inputs
+		dione.SortTransferableOutputs(outputs, Parser.Codec()) // sort outputs
+		return &txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: b.backend.BlockchainID(),
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         options.Memo(),
+		}}, nil
+	}
+	return nil, fmt.Errorf("%w: %q", errUnknownHTLC, utxoID)
+}
+
 func (b *builder) mintFTs(
 	outputs map[ids.ID]*secp256k1fx.TransferOutput,
 	options *common.Options,
@@ -5,6 +5,7 @@ package a
 
 import (
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
@@ -156,3 +157,51 @@ func (b *builderWithOptions) NewExportTx(
 		common.UnionOptions(b.options, options)...,
 	)
 }
+
+func (b *builderWithOptions) NewHTLCTx(
+	assetID ids.ID,
+	amount uint64,
+	hash [hashing.HashLen]byte,
+	timeout uint64,
+	relativeTimeout uint64,
+	recipient *secp256k1fx.OutputOwners,
+	sender *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.BaseTx, error) {
+	return b.Builder.NewHTLCTx(
+		assetID,
+		amount,
+		hash,
+		timeout,
+		relativeTimeout,
+		recipient,
+		sender,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewClaimHTLCTx(
+	utxoID ids.ID,
+	preimage []byte,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.BaseTx, error) {
+	return b.Builder.NewClaimHTLCTx(
+		utxoID,
+		preimage,
+		to,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewRefundHTLCTx(
+	utxoID ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.BaseTx, error) {
+	return b.Builder.NewRefundHTLCTx(
+		utxoID,
+		to,
+		common.UnionOptions(b.options, options)...,
+	)
+}
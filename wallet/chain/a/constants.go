@@ -6,6 +6,7 @@ package a
 import (
 	"github.com/DioneProtocol/odysseygo/vms/alpha/block"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/fxs"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
 	"github.com/DioneProtocol/odysseygo/vms/nftfx"
 	"github.com/DioneProtocol/odysseygo/vms/propertyfx"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
@@ -15,6 +16,7 @@ const (
 	SECP256K1FxIndex = 0
 	NFTFxIndex       = 1
 	PropertyFxIndex  = 2
+	HTLCFxIndex      = 3
 )
 
 // Parser to support serialization and deserialization
@@ -26,6 +28,7 @@ func init() {
 		&secp256k1fx.Fx{},
 		&nftfx.Fx{},
 		&propertyfx.Fx{},
+		&htlcfx.Fx{},
 	})
 	if err != nil {
 		panic(err)
@@ -55,7 +55,8 @@ func NewContextFromClients(
 		return nil, err
 	}
 
-	txFees, err := infoClient.GetTxFee(ctx)
+	// The fees are scaled by the current fee rate of the A-chain
+	txFees, err := aChainClient.GetFeeRates(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/htlcfx"
 	"github.com/DioneProtocol/odysseygo/vms/nftfx"
 	"github.com/DioneProtocol/odysseygo/vms/propertyfx"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
@@ -99,9 +100,19 @@ func (s *signerVisitor) getSigners(ctx stdcontext.Context, sourceChainID ids.ID,
 	txCreds := make([]verify.Verifiable, len(ins))
 	txSigners := make([][]keychain.Signer, len(ins))
 	for credIndex, transferInput := range ins {
-		txCreds[credIndex] = &secp256k1fx.Credential{}
-		input, ok := transferInput.In.(*secp256k1fx.TransferInput)
-		if !ok {
+		var (
+			input   *secp256k1fx.Input
+			isClaim bool
+		)
+		switch in := transferInput.In.(type) {
+		case *secp256k1fx.TransferInput:
+			txCreds[credIndex] = &secp256k1fx.Credential{}
+			input = &in.Input
+		case *htlcfx.TransferInput:
+			txCreds[credIndex] = &htlcfx.Credential{}
+			input = &in.Input
+			isClaim = in.IsClaim()
+		default:
 			return nil, nil, errUnknownInputType
 		}
 
@@ -119,17 +130,27 @@ func (s *signerVisitor) getSigners(ctx stdcontext.Context, sourceChainID ids.ID,
 			return nil, nil, err
 		}
 
-		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
-		if !ok {
+		var addrs []ids.ShortID
+		switch out := utxo.Out.(type) {
+		case *secp256k1fx.TransferOutput:
+			addrs = out.Addrs
+		case *secp256k1fx.WeightedTransferOutput:
+			addrs = out.Addrs
+		case *htlcfx.TransferOutput:
+			addrs = out.Sender.Addrs
+			if isClaim {
+				addrs = out.Recipient.Addrs
+			}
+		default:
 			return nil, nil, errUnknownOutputType
 		}
 
 		for sigIndex, addrIndex := range input.SigIndices {
-			if addrIndex >= uint32(len(out.Addrs)) {
+			if addrIndex >= uint32(len(addrs)) {
 				return nil, nil, errInvalidUTXOSigIndex
 			}
 
-			addr := out.Addrs[addrIndex]
+			addr := addrs[addrIndex]
 			key, ok := s.kc.Get(addr)
 			if !ok {
 				// If we don't have access to the key, then we can't sign this
@@ -250,6 +271,8 @@ func sign(tx *txs.Tx, creds []verify.Verifiable, txSigners [][]keychain.Signer)
 			cred = &credImpl.Credential
 		case *propertyfx.Credential:
 			cred = &credImpl.Credential
+		case *htlcfx.Credential:
+			cred = &credImpl.Credential
 		default:
 			return errUnknownCredentialType
 		}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow/choices"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
 	"github.com/DioneProtocol/odysseygo/vms/alpha"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
@@ -131,6 +132,53 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// IssueHTLCTx creates, signs, and issues a new value transfer that locks
+	// [amount] of [assetID] in a hashed timelock contract.
+	//
+	// - [hash] specifies the SHA-256 hash of the preimage [recipient] must
+	//   reveal to claim the funds.
+	// - [timeout] specifies the unix time from which the funds can be refunded
+	//   to [sender].
+	// - [relativeTimeout] specifies the number of seconds after the
+	//   transaction is accepted from which the funds can be refunded to
+	//   [sender]. Exactly one of [timeout] and [relativeTimeout] must be set.
+	IssueHTLCTx(
+		assetID ids.ID,
+		amount uint64,
+		hash [hashing.HashLen]byte,
+		timeout uint64,
+		relativeTimeout uint64,
+		recipient *secp256k1fx.OutputOwners,
+		sender *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueClaimHTLCTx creates, signs, and issues a new value transfer that
+	// claims the funds locked in a hashed timelock contract on behalf of its
+	// recipient.
+	//
+	// - [utxoID] specifies the UTXO locking the funds.
+	// - [preimage] specifies the preimage of the hash of the contract.
+	// - [to] specifies where to send the claimed funds to.
+	IssueClaimHTLCTx(
+		utxoID ids.ID,
+		preimage []byte,
+		to *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueRefundHTLCTx creates, signs, and issues a new value transfer that
+	// refunds the funds locked in a timed out hashed timelock contract to its
+	// sender.
+	//
+	// - [utxoID] specifies the UTXO locking the funds.
+	// - [to] specifies where to send the refunded funds to.
+	IssueRefundHTLCTx(
+		utxoID ids.ID,
+		to *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
 	// IssueUnsignedTx signs and issues the unsigned tx.
 	IssueUnsignedTx(
 		utx txs.UnsignedTx,
@@ -280,6 +328,48 @@ func (w *wallet) IssueExportTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueHTLCTx(
+	assetID ids.ID,
+	amount uint64,
+	hash [hashing.HashLen]byte,
+	timeout uint64,
+	relativeTimeout uint64,
+	recipient *secp256k1fx.OutputOwners,
+	sender *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewHTLCTx(assetID, amount, hash, timeout, relativeTimeout, recipient, sender, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueClaimHTLCTx(
+	utxoID ids.ID,
+	preimage []byte,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewClaimHTLCTx(utxoID, preimage, to, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueRefundHTLCTx(
+	utxoID ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewRefundHTLCTx(utxoID, to, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
 func (w *wallet) IssueUnsignedTx(
 	utx txs.UnsignedTx,
 	options ...common.Option,
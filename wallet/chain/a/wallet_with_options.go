@@ -5,6 +5,7 @@ package a
 
 import (
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
@@ -142,6 +143,54 @@ func (w *walletWithOptions) IssueExportTx(
 	)
 }
 
+func (w *walletWithOptions) IssueHTLCTx(
+	assetID ids.ID,
+	amount uint64,
+	hash [hashing.HashLen]byte,
+	timeout uint64,
+	relativeTimeout uint64,
+	recipient *secp256k1fx.OutputOwners,
+	sender *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.Wallet.IssueHTLCTx(
+		assetID,
+		amount,
+		hash,
+		timeout,
+		relativeTimeout,
+		recipient,
+		sender,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueClaimHTLCTx(
+	utxoID ids.ID,
+	preimage []byte,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.Wallet.IssueClaimHTLCTx(
+		utxoID,
+		preimage,
+		to,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueRefundHTLCTx(
+	utxoID ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.Wallet.IssueRefundHTLCTx(
+		utxoID,
+		to,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *walletWithOptions) IssueUnsignedTx(
 	utx txs.UnsignedTx,
 	options ...common.Option,
@@ -13,6 +13,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary/common"
 )
@@ -35,13 +36,26 @@ type backend struct {
 	txsLock sync.RWMutex
 	// txID -> tx
 	txs map[ids.ID]*txs.Tx
+
+	subnetOwnerLock sync.RWMutex
+	// subnetID -> owner, for subnets whose owner was set by a tx accepted by
+	// this backend
+	subnetOwner map[ids.ID]fx.Owner
+
+	validatorRewardsOwnerLock sync.RWMutex
+	// nodeID -> owner of the validation rewards, for primary network
+	// validators added by a tx accepted by this backend
+	validatorRewardsOwner map[ids.NodeID]fx.Owner
 }
 
 func NewBackend(ctx Context, utxos common.ChainUTXOs, txs map[ids.ID]*txs.Tx) Backend {
 	return &backend{
-		Context:    ctx,
-		ChainUTXOs: utxos,
-		txs:        txs,
+		Context:     ctx,
+		ChainUTXOs:  utxos,
+		txs:         txs,
+		subnetOwner: make(map[ids.ID]fx.Owner),
+
+		validatorRewardsOwner: make(map[ids.NodeID]fx.Owner),
 	}
 }
 
@@ -97,3 +111,53 @@ func (b *backend) GetTx(_ stdcontext.Context, txID ids.ID) (*txs.Tx, error) {
 	}
 	return tx, nil
 }
+
+// GetSubnetOwner returns the current owner of [subnetID]. If the ownership of
+// the subnet wasn't transferred by a tx accepted by this backend, the owner is
+// read from the subnet's CreateSubnetTx.
+func (b *backend) GetSubnetOwner(ctx stdcontext.Context, subnetID ids.ID) (fx.Owner, error) {
+	b.subnetOwnerLock.RLock()
+	owner, exists := b.subnetOwner[subnetID]
+	b.subnetOwnerLock.RUnlock()
+	if exists {
+		return owner, nil
+	}
+
+	subnetTx, err := b.GetTx(ctx, subnetID)
+	if err != nil {
+		return nil, err
+	}
+	subnet, ok := subnetTx.Unsigned.(*txs.CreateSubnetTx)
+	if !ok {
+		return nil, errWrongTxType
+	}
+	return subnet.Owner, nil
+}
+
+func (b *backend) setSubnetOwner(subnetID ids.ID, owner fx.Owner) {
+	b.subnetOwnerLock.Lock()
+	defer b.subnetOwnerLock.Unlock()
+
+	b.subnetOwner[subnetID] = owner
+}
+
+// GetValidatorRewardsOwner returns the owner of the validation rewards of the
+// primary network validator [nodeID]. Only validators added by a tx accepted by
+// this backend are known.
+func (b *backend) GetValidatorRewardsOwner(_ stdcontext.Context, nodeID ids.NodeID) (fx.Owner, error) {
+	b.validatorRewardsOwnerLock.RLock()
+	defer b.validatorRewardsOwnerLock.RUnlock()
+
+	owner, exists := b.validatorRewardsOwner[nodeID]
+	if !exists {
+		return nil, database.ErrNotFound
+	}
+	return owner, nil
+}
+
+func (b *backend) setValidatorRewardsOwner(nodeID ids.NodeID, owner fx.Owner) {
+	b.validatorRewardsOwnerLock.Lock()
+	defer b.validatorRewardsOwnerLock.Unlock()
+
+	b.validatorRewardsOwner[nodeID] = owner
+}
@@ -30,6 +30,7 @@ func (*backendVisitor) RewardValidatorTx(*txs.RewardValidatorTx) error {
 }
 
 func (b *backendVisitor) AddValidatorTx(tx *txs.AddValidatorTx) error {
+	b.b.setValidatorRewardsOwner(tx.Validator.NodeID, tx.RewardsOwner)
 	return b.baseTx(&tx.BaseTx)
 }
 
@@ -46,6 +47,7 @@ func (b *backendVisitor) CreateChainTx(tx *txs.CreateChainTx) error {
 }
 
 func (b *backendVisitor) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
+	b.b.setSubnetOwner(b.txID, tx.Owner)
 	return b.baseTx(&tx.BaseTx)
 }
 
@@ -91,6 +93,9 @@ func (b *backendVisitor) TransformSubnetTx(tx *txs.TransformSubnetTx) error {
 }
 
 func (b *backendVisitor) AddPermissionlessValidatorTx(tx *txs.AddPermissionlessValidatorTx) error {
+	if tx.Subnet == constants.PrimaryNetworkID {
+		b.b.setValidatorRewardsOwner(tx.Validator.NodeID, tx.ValidatorRewardsOwner)
+	}
 	return b.baseTx(&tx.BaseTx)
 }
 
@@ -98,6 +103,43 @@ func (b *backendVisitor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionlessD
 	return b.baseTx(&tx.BaseTx)
 }
 
+func (b *backendVisitor) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
+	b.b.setSubnetOwner(tx.Subnet, tx.Owner)
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) RotateBLSKeyTx(tx *txs.RotateBLSKeyTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) SetAutoRestakeTx(tx *txs.SetAutoRestakeTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) EarlyUnstakeTx(tx *txs.EarlyUnstakeTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) RedelegateTx(tx *txs.RedelegateTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) UptimeAttestationTx(tx *txs.UptimeAttestationTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) SetSubnetFeeConfigTx(tx *txs.SetSubnetFeeConfigTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) CancelPendingStakerTx(tx *txs.CancelPendingStakerTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) BaseTx(tx *txs.BaseTx) error {
+	return b.baseTx(tx)
+}
+
 func (b *backendVisitor) baseTx(tx *txs.BaseTx) error {
 	return b.b.removeUTXOs(
 		b.ctx,
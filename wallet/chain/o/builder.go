@@ -16,9 +16,11 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/math"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary/common"
 )
@@ -51,16 +53,14 @@ type Builder interface {
 		options ...common.Option,
 	) (map[ids.ID]uint64, error)
 
-	// NewBaseTx creates a new simple value transfer. Because the O-chain
-	// doesn't intend for balance transfers to occur, this method is expensive
-	// and abuses the creation of subnets.
+	// NewBaseTx creates a new simple value transfer.
 	//
 	// - [outputs] specifies all the recipients and amounts that should be sent
 	//   from this transaction.
 	NewBaseTx(
 		outputs []*dione.TransferableOutput,
 		options ...common.Option,
-	) (*txs.CreateSubnetTx, error)
+	) (*txs.BaseTx, error)
 
 	// NewAddValidatorTx creates a new validator of the primary network.
 	//
@@ -95,6 +95,101 @@ type Builder interface {
 		options ...common.Option,
 	) (*txs.RemoveSubnetValidatorTx, error)
 
+	// NewTransferSubnetOwnershipTx changes the owner of [subnetID] to
+	// [owner].
+	//
+	// - [subnetID] specifies the subnet to be transferred.
+	// - [owner] specifies who has the ability to create new chains and add new
+	//   validators to the subnet after the transfer.
+	NewTransferSubnetOwnershipTx(
+		subnetID ids.ID,
+		owner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.TransferSubnetOwnershipTx, error)
+
+	// NewRotateBLSKeyTx replaces the BLS key of the primary network validator
+	// [nodeID].
+	//
+	// - [nodeID] specifies the validator whose key is rotated. The validation
+	//   rewards of the validator must be owned by the wallet.
+	// - [signer] is the new BLS key of the validator.
+	NewRotateBLSKeyTx(
+		nodeID ids.NodeID,
+		signer *signer.ProofOfPossession,
+		options ...common.Option,
+	) (*txs.RotateBLSKeyTx, error)
+
+	// NewSetAutoRestakeTx opts the primary network validator [nodeID] in or
+	// out of auto-restaking.
+	//
+	// - [nodeID] specifies the configured validator. The validation rewards
+	//   of the validator must be owned by the wallet.
+	// - [autoRestake] specifies whether the validator is restaked, with its
+	//   validation reward, when its staking period ends.
+	NewSetAutoRestakeTx(
+		nodeID ids.NodeID,
+		autoRestake bool,
+		options ...common.Option,
+	) (*txs.SetAutoRestakeTx, error)
+
+	// NewEarlyUnstakeTx removes the primary network staker added by [txID]
+	// before its end time, with a penalty.
+	//
+	// - [txID] specifies the tx that added the validator or delegator. The
+	//   rewards of the staker must be owned by the wallet.
+	NewEarlyUnstakeTx(
+		txID ids.ID,
+		options ...common.Option,
+	) (*txs.EarlyUnstakeTx, error)
+
+	// NewRedelegateTx moves the primary network delegator added by [txID] to
+	// another validator for the rest of its staking period.
+	//
+	// - [txID] specifies the tx that added the delegator. The rewards of the
+	//   delegator must be owned by the wallet.
+	// - [nodeID] specifies the validator to move the delegator to.
+	NewRedelegateTx(
+		txID ids.ID,
+		nodeID ids.NodeID,
+		options ...common.Option,
+	) (*txs.RedelegateTx, error)
+
+	// NewUptimeAttestationTx records the uptimes of primary network validators
+	// attested by a quorum of the stake of the primary network.
+	//
+	// - [attestation] specifies the attested uptimes.
+	// - [signature] specifies the aggregate signature of the warp message of
+	//   [attestation] by the primary network validators.
+	NewUptimeAttestationTx(
+		attestation txs.UptimeAttestation,
+		signature warp.BitSetSignature,
+		options ...common.Option,
+	) (*txs.UptimeAttestationTx, error)
+
+	// NewSetSubnetFeeConfigTx sets the fees charged to add permissionless
+	// stakers to the elastic subnet [subnetID]. The fees are paid in the
+	// staking asset of the subnet and distributed to its stakers.
+	//
+	// - [subnetID] specifies the elastic subnet whose fees are set.
+	// - [addValidatorFee] specifies the fee charged to add a validator.
+	// - [addDelegatorFee] specifies the fee charged to add a delegator.
+	NewSetSubnetFeeConfigTx(
+		subnetID ids.ID,
+		addValidatorFee uint64,
+		addDelegatorFee uint64,
+		options ...common.Option,
+	) (*txs.SetSubnetFeeConfigTx, error)
+
+	// NewCancelPendingStakerTx removes the pending staker added by [txID]
+	// before its start time and refunds its stake.
+	//
+	// - [txID] specifies the tx that added the validator or delegator. The
+	//   stake of the staker must be owned by the wallet.
+	NewCancelPendingStakerTx(
+		txID ids.ID,
+		options ...common.Option,
+	) (*txs.CancelPendingStakerTx, error)
+
 	// NewAddDelegatorTx creates a new delegator to a validator on the primary
 	// network.
 	//
@@ -257,6 +352,8 @@ type BuilderBackend interface {
 	Context
 	UTXOs(ctx stdcontext.Context, sourceChainID ids.ID) ([]*dione.UTXO, error)
 	GetTx(ctx stdcontext.Context, txID ids.ID) (*txs.Tx, error)
+	GetSubnetOwner(ctx stdcontext.Context, subnetID ids.ID) (fx.Owner, error)
+	GetValidatorRewardsOwner(ctx stdcontext.Context, nodeID ids.NodeID) (fx.Owner, error)
 }
 
 type builder struct {
@@ -295,9 +392,9 @@ func (b *builder) GetImportableBalance(
 func (b *builder) NewBaseTx(
 	outputs []*dione.TransferableOutput,
 	options ...common.Option,
-) (*txs.CreateSubnetTx, error) {
+) (*txs.BaseTx, error) {
 	toBurn := map[ids.ID]uint64{
-		b.backend.DIONEAssetID(): b.backend.CreateSubnetTxFee(),
+		b.backend.DIONEAssetID(): b.backend.BaseTxFee(),
 	}
 	for _, out := range outputs {
 		assetID := out.AssetID()
@@ -317,16 +414,13 @@ func (b *builder) NewBaseTx(
 	outputs = append(outputs, changeOutputs...)
 	dione.SortTransferableOutputs(outputs, txs.Codec) // sort the outputs
 
-	return &txs.CreateSubnetTx{
-		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
-			NetworkID:    b.backend.NetworkID(),
-			BlockchainID: constants.OmegaChainID,
-			Ins:          inputs,
-			Outs:         outputs,
-			Memo:         ops.Memo(),
-		}},
-		Owner: &secp256k1fx.OutputOwners{},
-	}, nil
+	return &txs.BaseTx{BaseTx: dione.BaseTx{
+		NetworkID:    b.backend.NetworkID(),
+		BlockchainID: constants.OmegaChainID,
+		Ins:          inputs,
+		Outs:         outputs,
+		Memo:         ops.Memo(),
+	}}, nil
 }
 
 func (b *builder) NewAddValidatorTx(
@@ -430,6 +524,271 @@ func (b *builder) NewRemoveSubnetValidatorTx(
 	}, nil
 }
 
+func (b *builder) NewTransferSubnetOwnershipTx(
+	subnetID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.TransferSubnetOwnershipTx, error) {
+	toBurn := map[ids.ID]uint64{
+		b.backend.DIONEAssetID(): b.backend.BaseTxFee(),
+	}
+	toStake := map[ids.ID]uint64{}
+	ops := common.NewOptions(options)
+	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetAuth, err := b.authorizeSubnet(subnetID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Sort(owner.Addrs)
+	return &txs.TransferSubnetOwnershipTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.OmegaChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Subnet:     subnetID,
+		SubnetAuth: subnetAuth,
+		Owner:      owner,
+	}, nil
+}
+
+func (b *builder) NewRotateBLSKeyTx(
+	nodeID ids.NodeID,
+	signer *signer.ProofOfPossession,
+	options ...common.Option,
+) (*txs.RotateBLSKeyTx, error) {
+	toBurn := map[ids.ID]uint64{
+		b.backend.DIONEAssetID(): b.backend.BaseTxFee(),
+	}
+	toStake := map[ids.ID]uint64{}
+	ops := common.NewOptions(options)
+	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorAuth, err := b.authorizeValidator(nodeID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.RotateBLSKeyTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.OmegaChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		NodeID:        nodeID,
+		Signer:        signer,
+		ValidatorAuth: validatorAuth,
+	}, nil
+}
+
+func (b *builder) NewSetAutoRestakeTx(
+	nodeID ids.NodeID,
+	autoRestake bool,
+	options ...common.Option,
+) (*txs.SetAutoRestakeTx, error) {
+	toBurn := map[ids.ID]uint64{
+		b.backend.DIONEAssetID(): b.backend.BaseTxFee(),
+	}
+	toStake := map[ids.ID]uint64{}
+	ops := common.NewOptions(options)
+	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorAuth, err := b.authorizeValidator(nodeID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.SetAutoRestakeTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.OmegaChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		NodeID:        nodeID,
+		AutoRestake:   autoRestake,
+		ValidatorAuth: validatorAuth,
+	}, nil
+}
+
+func (b *builder) NewEarlyUnstakeTx(
+	txID ids.ID,
+	options ...common.Option,
+) (*txs.EarlyUnstakeTx, error) {
+	toBurn := map[ids.ID]uint64{
+		b.backend.DIONEAssetID(): b.backend.BaseTxFee(),
+	}
+	toStake := map[ids.ID]uint64{}
+	ops := common.NewOptions(options)
+	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	stakerAuth, err := b.authorizeStaker(txID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.EarlyUnstakeTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.OmegaChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		TxID:       txID,
+		StakerAuth: stakerAuth,
+	}, nil
+}
+
+func (b *builder) NewRedelegateTx(
+	txID ids.ID,
+	nodeID ids.NodeID,
+	options ...common.Option,
+) (*txs.RedelegateTx, error) {
+	toBurn := map[ids.ID]uint64{
+		b.backend.DIONEAssetID(): b.backend.BaseTxFee(),
+	}
+	toStake := map[ids.ID]uint64{}
+	ops := common.NewOptions(options)
+	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorAuth, err := b.authorizeStaker(txID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.RedelegateTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.OmegaChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		TxID:          txID,
+		NodeID:        nodeID,
+		DelegatorAuth: delegatorAuth,
+	}, nil
+}
+
+func (b *builder) NewUptimeAttestationTx(
+	attestation txs.UptimeAttestation,
+	signature warp.BitSetSignature,
+	options ...common.Option,
+) (*txs.UptimeAttestationTx, error) {
+	toBurn := map[ids.ID]uint64{
+		b.backend.DIONEAssetID(): b.backend.BaseTxFee(),
+	}
+	toStake := map[ids.ID]uint64{}
+	ops := common.NewOptions(options)
+	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.UptimeAttestationTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.OmegaChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Attestation: attestation,
+		Signature:   signature,
+	}, nil
+}
+
+func (b *builder) NewSetSubnetFeeConfigTx(
+	subnetID ids.ID,
+	addValidatorFee uint64,
+	addDelegatorFee uint64,
+	options ...common.Option,
+) (*txs.SetSubnetFeeConfigTx, error) {
+	toBurn := map[ids.ID]uint64{
+		b.backend.DIONEAssetID(): b.backend.BaseTxFee(),
+	}
+	toStake := map[ids.ID]uint64{}
+	ops := common.NewOptions(options)
+	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetAuth, err := b.authorizeSubnet(subnetID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.SetSubnetFeeConfigTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.OmegaChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Subnet:          subnetID,
+		AddValidatorFee: addValidatorFee,
+		AddDelegatorFee: addDelegatorFee,
+		SubnetAuth:      subnetAuth,
+	}, nil
+}
+
+func (b *builder) NewCancelPendingStakerTx(
+	txID ids.ID,
+	options ...common.Option,
+) (*txs.CancelPendingStakerTx, error) {
+	toBurn := map[ids.ID]uint64{
+		b.backend.DIONEAssetID(): b.backend.BaseTxFee(),
+	}
+	toStake := map[ids.ID]uint64{}
+	ops := common.NewOptions(options)
+	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	stakerAuth, err := b.authorizeStake(txID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.CancelPendingStakerTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.OmegaChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		TxID:       txID,
+		StakerAuth: stakerAuth,
+	}, nil
+}
+
 func (b *builder) NewAddDelegatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -1098,32 +1457,104 @@ func (b *builder) spend(
 }
 
 func (b *builder) authorizeSubnet(subnetID ids.ID, options *common.Options) (*secp256k1fx.Input, error) {
-	subnetTx, err := b.backend.GetTx(options.Context(), subnetID)
+	ownerIntf, err := b.backend.GetSubnetOwner(options.Context(), subnetID)
 	if err != nil {
 		return nil, fmt.Errorf(
-			"failed to fetch subnet %q: %w",
+			"failed to fetch subnet owner for %q: %w",
 			subnetID,
 			err,
 		)
 	}
-	subnet, ok := subnetTx.Unsigned.(*txs.CreateSubnetTx)
-	if !ok {
-		return nil, errWrongTxType
+	return b.authorizeOwner(ownerIntf, options)
+}
+
+func (b *builder) authorizeValidator(nodeID ids.NodeID, options *common.Options) (*secp256k1fx.Input, error) {
+	ownerIntf, err := b.backend.GetValidatorRewardsOwner(options.Context(), nodeID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to fetch validation rewards owner for %q: %w",
+			nodeID,
+			err,
+		)
 	}
+	return b.authorizeOwner(ownerIntf, options)
+}
 
-	owner, ok := subnet.Owner.(*secp256k1fx.OutputOwners)
-	if !ok {
-		return nil, errUnknownOwnerType
+func (b *builder) authorizeStaker(txID ids.ID, options *common.Options) (*secp256k1fx.Input, error) {
+	stakerTx, err := b.backend.GetTx(options.Context(), txID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to fetch staker tx %q: %w",
+			txID,
+			err,
+		)
+	}
+	ownerIntf, err := stakerRewardsOwner(stakerTx)
+	if err != nil {
+		return nil, err
+	}
+	return b.authorizeOwner(ownerIntf, options)
+}
+
+func (b *builder) authorizeStake(txID ids.ID, options *common.Options) (*secp256k1fx.Input, error) {
+	stakerTx, err := b.backend.GetTx(options.Context(), txID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to fetch staker tx %q: %w",
+			txID,
+			err,
+		)
 	}
+	ownerIntf, err := stakeOwner(stakerTx)
+	if err != nil {
+		return nil, err
+	}
+	return b.authorizeOwner(ownerIntf, options)
+}
 
+func (b *builder) authorizeOwner(ownerIntf fx.Owner, options *common.Options) (*secp256k1fx.Input, error) {
 	addrs := options.Addresses(b.addrs)
 	minIssuanceTime := options.MinIssuanceTime()
-	inputSigIndices, ok := common.MatchOwners(owner, addrs, minIssuanceTime)
+
+	var (
+		inputSigIndices []uint32
+		ok              bool
+	)
+	switch owner := ownerIntf.(type) {
+	case *secp256k1fx.OutputOwners:
+		inputSigIndices, ok = common.MatchOwners(owner, addrs, minIssuanceTime)
+	case *secp256k1fx.WeightedOutputOwners:
+		inputSigIndices, ok = common.MatchWeightedOwners(owner, addrs, minIssuanceTime)
+	default:
+		return nil, errUnknownOwnerType
+	}
 	if !ok {
-		// We can't authorize the subnet
+		// We can't authorize the operation
 		return nil, errInsufficientAuthorization
 	}
 	return &secp256k1fx.Input{
 		SigIndices: inputSigIndices,
 	}, nil
 }
+
+// stakerRewardsOwner returns the owner that authorizes the operations on
+// behalf of the staker added by [stakerTx]: the owner of the validation
+// rewards of a validator or of the rewards of a delegator.
+func stakeOwner(stakerTx *txs.Tx) (fx.Owner, error) {
+	uStakerTx, ok := stakerTx.Unsigned.(txs.PermissionlessStaker)
+	if !ok {
+		return nil, errWrongTxType
+	}
+	return txs.StakeOwner(uStakerTx)
+}
+
+func stakerRewardsOwner(stakerTx *txs.Tx) (fx.Owner, error) {
+	switch uStakerTx := stakerTx.Unsigned.(type) {
+	case txs.ValidatorTx:
+		return uStakerTx.ValidationRewardsOwner(), nil
+	case txs.DelegatorTx:
+		return uStakerTx.RewardsOwner(), nil
+	default:
+		return nil, errWrongTxType
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary/common"
 )
@@ -53,6 +54,16 @@ func (b *builderWithOptions) GetImportableBalance(
 	)
 }
 
+func (b *builderWithOptions) NewBaseTx(
+	outputs []*dione.TransferableOutput,
+	options ...common.Option,
+) (*txs.BaseTx, error) {
+	return b.Builder.NewBaseTx(
+		outputs,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
 func (b *builderWithOptions) NewAddValidatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -89,6 +100,100 @@ func (b *builderWithOptions) RemoveSubnetValidatorTx(
 	)
 }
 
+func (b *builderWithOptions) NewTransferSubnetOwnershipTx(
+	subnetID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.TransferSubnetOwnershipTx, error) {
+	return b.Builder.NewTransferSubnetOwnershipTx(
+		subnetID,
+		owner,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewRotateBLSKeyTx(
+	nodeID ids.NodeID,
+	signer *signer.ProofOfPossession,
+	options ...common.Option,
+) (*txs.RotateBLSKeyTx, error) {
+	return b.Builder.NewRotateBLSKeyTx(
+		nodeID,
+		signer,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewSetAutoRestakeTx(
+	nodeID ids.NodeID,
+	autoRestake bool,
+	options ...common.Option,
+) (*txs.SetAutoRestakeTx, error) {
+	return b.Builder.NewSetAutoRestakeTx(
+		nodeID,
+		autoRestake,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewEarlyUnstakeTx(
+	txID ids.ID,
+	options ...common.Option,
+) (*txs.EarlyUnstakeTx, error) {
+	return b.Builder.NewEarlyUnstakeTx(
+		txID,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewRedelegateTx(
+	txID ids.ID,
+	nodeID ids.NodeID,
+	options ...common.Option,
+) (*txs.RedelegateTx, error) {
+	return b.Builder.NewRedelegateTx(
+		txID,
+		nodeID,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewUptimeAttestationTx(
+	attestation txs.UptimeAttestation,
+	signature warp.BitSetSignature,
+	options ...common.Option,
+) (*txs.UptimeAttestationTx, error) {
+	return b.Builder.NewUptimeAttestationTx(
+		attestation,
+		signature,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewSetSubnetFeeConfigTx(
+	subnetID ids.ID,
+	addValidatorFee uint64,
+	addDelegatorFee uint64,
+	options ...common.Option,
+) (*txs.SetSubnetFeeConfigTx, error) {
+	return b.Builder.NewSetSubnetFeeConfigTx(
+		subnetID,
+		addValidatorFee,
+		addDelegatorFee,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewCancelPendingStakerTx(
+	txID ids.ID,
+	options ...common.Option,
+) (*txs.CancelPendingStakerTx, error) {
+	return b.Builder.NewCancelPendingStakerTx(
+		txID,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
 func (b *builderWithOptions) NewAddDelegatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
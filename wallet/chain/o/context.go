@@ -9,6 +9,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/api/info"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/vms/alpha"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm"
 )
 
 var _ Context = (*context)(nil)
@@ -41,13 +42,15 @@ type context struct {
 
 func NewContextFromURI(ctx stdcontext.Context, uri string) (Context, error) {
 	infoClient := info.NewClient(uri)
+	oChainClient := omegavm.NewClient(uri)
 	aChainClient := alpha.NewClient(uri, "A")
-	return NewContextFromClients(ctx, infoClient, aChainClient)
+	return NewContextFromClients(ctx, infoClient, oChainClient, aChainClient)
 }
 
 func NewContextFromClients(
 	ctx stdcontext.Context,
 	infoClient info.Client,
+	oChainClient omegavm.Client,
 	aChainClient alpha.Client,
 ) (Context, error) {
 	networkID, err := infoClient.GetNetworkID(ctx)
@@ -60,7 +63,8 @@ func NewContextFromClients(
 		return nil, err
 	}
 
-	txFees, err := infoClient.GetTxFee(ctx)
+	// The fees are scaled by the current fee rate of the O-chain
+	txFees, err := oChainClient.GetFeeRates(ctx)
 	if err != nil {
 		return nil, err
 	}
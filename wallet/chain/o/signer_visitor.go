@@ -17,6 +17,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/hashing"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
@@ -25,12 +26,14 @@ import (
 var (
 	_ txs.Visitor = (*signerVisitor)(nil)
 
-	errUnsupportedTxType     = errors.New("unsupported tx type")
-	errUnknownInputType      = errors.New("unknown input type")
-	errUnknownCredentialType = errors.New("unknown credential type")
-	errUnknownOutputType     = errors.New("unknown output type")
-	errUnknownSubnetAuthType = errors.New("unknown subnet auth type")
-	errInvalidUTXOSigIndex   = errors.New("invalid UTXO signature index")
+	errUnsupportedTxType        = errors.New("unsupported tx type")
+	errUnknownInputType         = errors.New("unknown input type")
+	errUnknownCredentialType    = errors.New("unknown credential type")
+	errUnknownOutputType        = errors.New("unknown output type")
+	errUnknownSubnetAuthType    = errors.New("unknown subnet auth type")
+	errUnknownValidatorAuthType = errors.New("unknown validator auth type")
+	errUnknownStakerAuthType    = errors.New("unknown staker auth type")
+	errInvalidUTXOSigIndex      = errors.New("invalid UTXO signature index")
 
 	emptySig [secp256k1.SignatureLen]byte
 )
@@ -122,6 +125,14 @@ func (s *signerVisitor) ExportTx(tx *txs.ExportTx) error {
 	return sign(s.tx, false, txSigners)
 }
 
+func (s *signerVisitor) BaseTx(tx *txs.BaseTx) error {
+	txSigners, err := s.getSigners(constants.OmegaChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	return sign(s.tx, false, txSigners)
+}
+
 func (s *signerVisitor) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidatorTx) error {
 	txSigners, err := s.getSigners(constants.OmegaChainID, tx.Ins)
 	if err != nil {
@@ -135,6 +146,105 @@ func (s *signerVisitor) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidatorTx)
 	return sign(s.tx, true, txSigners)
 }
 
+func (s *signerVisitor) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
+	txSigners, err := s.getSigners(constants.OmegaChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	subnetAuthSigners, err := s.getSubnetSigners(tx.Subnet, tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, subnetAuthSigners)
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *signerVisitor) RotateBLSKeyTx(tx *txs.RotateBLSKeyTx) error {
+	txSigners, err := s.getSigners(constants.OmegaChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	validatorAuthSigners, err := s.getValidatorSigners(tx.NodeID, tx.ValidatorAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, validatorAuthSigners)
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *signerVisitor) SetAutoRestakeTx(tx *txs.SetAutoRestakeTx) error {
+	txSigners, err := s.getSigners(constants.OmegaChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	validatorAuthSigners, err := s.getValidatorSigners(tx.NodeID, tx.ValidatorAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, validatorAuthSigners)
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *signerVisitor) EarlyUnstakeTx(tx *txs.EarlyUnstakeTx) error {
+	txSigners, err := s.getSigners(constants.OmegaChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	stakerAuthSigners, err := s.getStakerSigners(tx.TxID, tx.StakerAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, stakerAuthSigners)
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *signerVisitor) RedelegateTx(tx *txs.RedelegateTx) error {
+	txSigners, err := s.getSigners(constants.OmegaChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	delegatorAuthSigners, err := s.getStakerSigners(tx.TxID, tx.DelegatorAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, delegatorAuthSigners)
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *signerVisitor) UptimeAttestationTx(tx *txs.UptimeAttestationTx) error {
+	txSigners, err := s.getSigners(constants.OmegaChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) SetSubnetFeeConfigTx(tx *txs.SetSubnetFeeConfigTx) error {
+	txSigners, err := s.getSigners(constants.OmegaChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	subnetAuthSigners, err := s.getSubnetSigners(tx.Subnet, tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, subnetAuthSigners)
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *signerVisitor) CancelPendingStakerTx(tx *txs.CancelPendingStakerTx) error {
+	txSigners, err := s.getSigners(constants.OmegaChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	stakerAuthSigners, err := s.getStakeSigners(tx.TxID, tx.StakerAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, stakerAuthSigners)
+	return sign(s.tx, true, txSigners)
+}
+
 func (s *signerVisitor) TransformSubnetTx(tx *txs.TransformSubnetTx) error {
 	txSigners, err := s.getSigners(constants.OmegaChainID, tx.Ins)
 	if err != nil {
@@ -196,17 +306,22 @@ func (s *signerVisitor) getSigners(sourceChainID ids.ID, ins []*dione.Transferab
 			outIntf = stakeableOut.TransferableOut
 		}
 
-		out, ok := outIntf.(*secp256k1fx.TransferOutput)
-		if !ok {
+		var addrs []ids.ShortID
+		switch out := outIntf.(type) {
+		case *secp256k1fx.TransferOutput:
+			addrs = out.Addrs
+		case *secp256k1fx.WeightedTransferOutput:
+			addrs = out.Addrs
+		default:
 			return nil, errUnknownOutputType
 		}
 
 		for sigIndex, addrIndex := range input.SigIndices {
-			if addrIndex >= uint32(len(out.Addrs)) {
+			if addrIndex >= uint32(len(addrs)) {
 				return nil, errInvalidUTXOSigIndex
 			}
 
-			addr := out.Addrs[addrIndex]
+			addr := addrs[addrIndex]
 			key, ok := s.kc.Get(addr)
 			if !ok {
 				// If we don't have access to the key, then we can't sign this
@@ -225,31 +340,94 @@ func (s *signerVisitor) getSubnetSigners(subnetID ids.ID, subnetAuth verify.Veri
 		return nil, errUnknownSubnetAuthType
 	}
 
-	subnetTx, err := s.backend.GetTx(s.ctx, subnetID)
+	ownerIntf, err := s.backend.GetSubnetOwner(s.ctx, subnetID)
 	if err != nil {
 		return nil, fmt.Errorf(
-			"failed to fetch subnet %q: %w",
+			"failed to fetch subnet owner for %q: %w",
 			subnetID,
 			err,
 		)
 	}
-	subnet, ok := subnetTx.Unsigned.(*txs.CreateSubnetTx)
+	return s.getOwnerSigners(ownerIntf, subnetInput)
+}
+
+func (s *signerVisitor) getValidatorSigners(nodeID ids.NodeID, validatorAuth verify.Verifiable) ([]keychain.Signer, error) {
+	validatorInput, ok := validatorAuth.(*secp256k1fx.Input)
+	if !ok {
+		return nil, errUnknownValidatorAuthType
+	}
+
+	ownerIntf, err := s.backend.GetValidatorRewardsOwner(s.ctx, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to fetch validation rewards owner for %q: %w",
+			nodeID,
+			err,
+		)
+	}
+	return s.getOwnerSigners(ownerIntf, validatorInput)
+}
+
+func (s *signerVisitor) getStakerSigners(txID ids.ID, stakerAuth verify.Verifiable) ([]keychain.Signer, error) {
+	stakerInput, ok := stakerAuth.(*secp256k1fx.Input)
 	if !ok {
-		return nil, errWrongTxType
+		return nil, errUnknownStakerAuthType
+	}
+
+	stakerTx, err := s.backend.GetTx(s.ctx, txID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to fetch staker tx %q: %w",
+			txID,
+			err,
+		)
+	}
+	ownerIntf, err := stakerRewardsOwner(stakerTx)
+	if err != nil {
+		return nil, err
 	}
+	return s.getOwnerSigners(ownerIntf, stakerInput)
+}
 
-	owner, ok := subnet.Owner.(*secp256k1fx.OutputOwners)
+func (s *signerVisitor) getStakeSigners(txID ids.ID, stakerAuth verify.Verifiable) ([]keychain.Signer, error) {
+	stakerInput, ok := stakerAuth.(*secp256k1fx.Input)
 	if !ok {
+		return nil, errUnknownStakerAuthType
+	}
+
+	stakerTx, err := s.backend.GetTx(s.ctx, txID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to fetch staker tx %q: %w",
+			txID,
+			err,
+		)
+	}
+	ownerIntf, err := stakeOwner(stakerTx)
+	if err != nil {
+		return nil, err
+	}
+	return s.getOwnerSigners(ownerIntf, stakerInput)
+}
+
+func (s *signerVisitor) getOwnerSigners(ownerIntf fx.Owner, input *secp256k1fx.Input) ([]keychain.Signer, error) {
+	var addrs []ids.ShortID
+	switch owner := ownerIntf.(type) {
+	case *secp256k1fx.OutputOwners:
+		addrs = owner.Addrs
+	case *secp256k1fx.WeightedOutputOwners:
+		addrs = owner.Addrs
+	default:
 		return nil, errUnknownOwnerType
 	}
 
-	authSigners := make([]keychain.Signer, len(subnetInput.SigIndices))
-	for sigIndex, addrIndex := range subnetInput.SigIndices {
-		if addrIndex >= uint32(len(owner.Addrs)) {
+	authSigners := make([]keychain.Signer, len(input.SigIndices))
+	for sigIndex, addrIndex := range input.SigIndices {
+		if addrIndex >= uint32(len(addrs)) {
 			return nil, errInvalidUTXOSigIndex
 		}
 
-		addr := owner.Addrs[addrIndex]
+		addr := addrs[addrIndex]
 		key, ok := s.kc.Get(addr)
 		if !ok {
 			// If we don't have access to the key, then we can't sign this
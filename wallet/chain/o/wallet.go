@@ -13,6 +13,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary/common"
 )
@@ -33,8 +34,6 @@ type Wallet interface {
 	Signer() Signer
 
 	// IssueBaseTx creates, signs, and issues a new simple value transfer.
-	// Because the O-chain doesn't intend for balance transfers to occur, this
-	// method is expensive and abuses the creation of subnets.
 	//
 	// - [outputs] specifies all the recipients and amounts that should be sent
 	//   from this transaction.
@@ -80,6 +79,103 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// IssueTransferSubnetOwnershipTx creates, signs, and issues a transaction
+	// that changes the owner of the given subnet.
+	//
+	// - [subnetID] specifies the subnet to be transferred.
+	// - [owner] specifies who has the ability to create new chains and add new
+	//   validators to the subnet after the transfer.
+	IssueTransferSubnetOwnershipTx(
+		subnetID ids.ID,
+		owner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueRotateBLSKeyTx creates, signs, and issues a transaction that
+	// replaces the BLS key of a primary network validator.
+	//
+	// - [nodeID] specifies the validator whose key is rotated. The validation
+	//   rewards of the validator must be owned by the wallet.
+	// - [signer] is the new BLS key of the validator.
+	IssueRotateBLSKeyTx(
+		nodeID ids.NodeID,
+		signer *signer.ProofOfPossession,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueSetAutoRestakeTx creates, signs, and issues a transaction that opts
+	// a primary network validator in or out of auto-restaking.
+	//
+	// - [nodeID] specifies the configured validator. The validation rewards
+	//   of the validator must be owned by the wallet.
+	// - [autoRestake] specifies whether the validator is restaked, with its
+	//   validation reward, when its staking period ends.
+	IssueSetAutoRestakeTx(
+		nodeID ids.NodeID,
+		autoRestake bool,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueEarlyUnstakeTx creates, signs, and issues a transaction that removes
+	// a primary network staker before its end time, with a penalty.
+	//
+	// - [txID] specifies the tx that added the validator or delegator. The
+	//   rewards of the staker must be owned by the wallet.
+	IssueEarlyUnstakeTx(
+		txID ids.ID,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueRedelegateTx creates, signs, and issues a transaction that moves a
+	// primary network delegator to another validator for the rest of its
+	// staking period.
+	//
+	// - [txID] specifies the tx that added the delegator. The rewards of the
+	//   delegator must be owned by the wallet.
+	// - [nodeID] specifies the validator to move the delegator to.
+	IssueRedelegateTx(
+		txID ids.ID,
+		nodeID ids.NodeID,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueUptimeAttestationTx creates, signs, and issues a transaction that
+	// records the uptimes of primary network validators attested by a quorum
+	// of the stake of the primary network.
+	//
+	// - [attestation] specifies the attested uptimes.
+	// - [signature] specifies the aggregate signature of the warp message of
+	//   [attestation] by the primary network validators.
+	IssueUptimeAttestationTx(
+		attestation txs.UptimeAttestation,
+		signature warp.BitSetSignature,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueSetSubnetFeeConfigTx creates, signs, and issues a transaction that
+	// sets the fees charged to add permissionless stakers to an elastic
+	// subnet.
+	//
+	// - [subnetID] specifies the elastic subnet whose fees are set.
+	// - [addValidatorFee] specifies the fee charged to add a validator.
+	// - [addDelegatorFee] specifies the fee charged to add a delegator.
+	IssueSetSubnetFeeConfigTx(
+		subnetID ids.ID,
+		addValidatorFee uint64,
+		addDelegatorFee uint64,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueCancelPendingStakerTx creates, signs, and issues a transaction that
+	// removes a pending staker before its start time and refunds its stake.
+	//
+	// - [txID] specifies the tx that added the validator or delegator. The
+	//   stake of the staker must be owned by the wallet.
+	IssueCancelPendingStakerTx(
+		txID ids.ID,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
 	// IssueAddDelegatorTx creates, signs, and issues a new delegator to a
 	// validator on the primary network.
 	//
@@ -326,6 +422,101 @@ func (w *wallet) IssueRemoveSubnetValidatorTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueTransferSubnetOwnershipTx(
+	subnetID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewTransferSubnetOwnershipTx(subnetID, owner, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueRotateBLSKeyTx(
+	nodeID ids.NodeID,
+	signer *signer.ProofOfPossession,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewRotateBLSKeyTx(nodeID, signer, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueSetAutoRestakeTx(
+	nodeID ids.NodeID,
+	autoRestake bool,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewSetAutoRestakeTx(nodeID, autoRestake, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueEarlyUnstakeTx(
+	txID ids.ID,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewEarlyUnstakeTx(txID, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueRedelegateTx(
+	txID ids.ID,
+	nodeID ids.NodeID,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewRedelegateTx(txID, nodeID, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueUptimeAttestationTx(
+	attestation txs.UptimeAttestation,
+	signature warp.BitSetSignature,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewUptimeAttestationTx(attestation, signature, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueSetSubnetFeeConfigTx(
+	subnetID ids.ID,
+	addValidatorFee uint64,
+	addDelegatorFee uint64,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewSetSubnetFeeConfigTx(subnetID, addValidatorFee, addDelegatorFee, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueCancelPendingStakerTx(
+	txID ids.ID,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewCancelPendingStakerTx(txID, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
 func (w *wallet) IssueAddDelegatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
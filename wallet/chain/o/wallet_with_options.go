@@ -10,6 +10,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary/common"
 )
@@ -84,6 +85,100 @@ func (w *walletWithOptions) IssueRemoveSubnetValidatorTx(
 	)
 }
 
+func (w *walletWithOptions) IssueTransferSubnetOwnershipTx(
+	subnetID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.Wallet.IssueTransferSubnetOwnershipTx(
+		subnetID,
+		owner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueRotateBLSKeyTx(
+	nodeID ids.NodeID,
+	signer *signer.ProofOfPossession,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.Wallet.IssueRotateBLSKeyTx(
+		nodeID,
+		signer,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueSetAutoRestakeTx(
+	nodeID ids.NodeID,
+	autoRestake bool,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.Wallet.IssueSetAutoRestakeTx(
+		nodeID,
+		autoRestake,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueEarlyUnstakeTx(
+	txID ids.ID,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.Wallet.IssueEarlyUnstakeTx(
+		txID,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueRedelegateTx(
+	txID ids.ID,
+	nodeID ids.NodeID,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.Wallet.IssueRedelegateTx(
+		txID,
+		nodeID,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueUptimeAttestationTx(
+	attestation txs.UptimeAttestation,
+	signature warp.BitSetSignature,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.Wallet.IssueUptimeAttestationTx(
+		attestation,
+		signature,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueSetSubnetFeeConfigTx(
+	subnetID ids.ID,
+	addValidatorFee uint64,
+	addDelegatorFee uint64,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.Wallet.IssueSetSubnetFeeConfigTx(
+		subnetID,
+		addValidatorFee,
+		addDelegatorFee,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueCancelPendingStakerTx(
+	txID ids.ID,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.Wallet.IssueCancelPendingStakerTx(
+		txID,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *walletWithOptions) IssueAddDelegatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -77,7 +77,7 @@ func FetchState(
 	aClient := alpha.NewClient(uri, "A")
 	dClient := delta.NewDChainClient(uri)
 
-	oCTX, err := o.NewContextFromClients(ctx, infoClient, aClient)
+	oCTX, err := o.NewContextFromClients(ctx, infoClient, oClient, aClient)
 	if err != nil {
 		return nil, err
 	}
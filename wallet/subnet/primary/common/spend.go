@@ -29,3 +29,17 @@ func MatchOwners(
 	}
 	return sigs, uint32(len(sigs)) == owners.Threshold
 }
+
+// MatchWeightedOwners attempts to match a list of addresses whose weights
+// reach the provided threshold.
+func MatchWeightedOwners(
+	owners *secp256k1fx.WeightedOutputOwners,
+	addrs set.Set[ids.ShortID],
+	minIssuanceTime uint64,
+) ([]uint32, bool) {
+	if owners.Locktime > minIssuanceTime {
+		return nil, false
+	}
+
+	return owners.SelectSigners(addrs.Contains)
+}